@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	shared_factories "tech_challenge/internal/shared/factories"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+type check struct {
+	name string
+	run  func() error
+}
+
+func newCheckCommand() command {
+	return command{
+		name:        "check",
+		description: "Verify configuration, database, migrations and storage",
+		run: func(args []string) error {
+			flags := newFlagSet("check")
+			if err := flags.Parse(args); err != nil {
+				return err
+			}
+
+			connectDatabase()
+			defer database.Close()
+
+			return runChecks([]check{
+				{name: "database", run: database.Ping},
+				{name: "migrations", run: checkMigrations},
+				{name: "storage", run: func() error {
+					return shared_factories.NewFileProvider().CheckBucket()
+				}},
+			})
+		},
+	}
+}
+
+func runChecks(checks []check) error {
+	failed := 0
+	for _, c := range checks {
+		if err := c.run(); err != nil {
+			failed++
+			fmt.Fprintf(stdout, "[fail] %s: %v\n", c.name, err)
+			continue
+		}
+		fmt.Fprintf(stdout, "[ok]   %s\n", c.name)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(checks))
+	}
+	return nil
+}
+
+func checkMigrations() error {
+	pending := database.PendingMigrations()
+	if len(pending) > 0 {
+		return errors.New("missing tables for " + strings.Join(pending, ", "))
+	}
+	return nil
+}
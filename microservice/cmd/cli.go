@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/factories"
+	"tech_challenge/internal/shared/config/env"
+	shared_factories "tech_challenge/internal/shared/factories"
+	"tech_challenge/internal/shared/infra/cache_provider"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+const defaultCommand = "serve"
+
+var (
+	stdout io.Writer = os.Stdout
+	stderr io.Writer = os.Stderr
+)
+
+type command struct {
+	name        string
+	description string
+	run         func(args []string) error
+}
+
+func commands() []command {
+	return []command{
+		newServeCommand(),
+		newMigrateCommand(),
+		newSeedCommand(),
+		newGcStorageCommand(),
+		newExportCommand(),
+		newImportCommand(),
+		newCheckCommand(),
+	}
+}
+
+func Execute(args []string) int {
+	if len(args) == 0 {
+		args = []string{defaultCommand}
+	}
+
+	name := args[0]
+
+	if name == "help" || name == "-h" || name == "--help" {
+		printUsage(stdout)
+		return 0
+	}
+
+	for _, cmd := range commands() {
+		if cmd.name != name {
+			continue
+		}
+
+		if err := cmd.run(args[1:]); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return 0
+			}
+			log.Printf("%s: %v", name, err)
+			return 1
+		}
+		return 0
+	}
+
+	fmt.Fprintf(stderr, "unknown command %q\n\n", name)
+	printUsage(stderr)
+	return 2
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: main <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands() {
+		fmt.Fprintf(w, "  %-12s %s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Run without arguments to start the %q command.\n", defaultCommand)
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	return flags
+}
+
+func connectDatabase() {
+	env.GetConfig()
+	database.Connect()
+	cache_provider.Connect()
+}
+
+func newProductController() *controllers.ProductController {
+	return controllers.NewProductController(
+		factories.NewProductDataSource(),
+		factories.NewCategoryDataSource(),
+		factories.NewStockDataSource(),
+		factories.NewTranslationDataSource(),
+		factories.NewStoreDataSource(),
+		factories.NewPromotionDataSource(),
+		factories.NewTransactionManager(),
+		shared_factories.NewFileProvider(),
+		factories.NewCatalogCache(),
+	)
+}
+
+func newCatalogController() *controllers.CatalogController {
+	return controllers.NewCatalogController(
+		factories.NewProductDataSource(),
+		factories.NewCategoryDataSource(),
+		factories.NewStockDataSource(),
+		factories.NewTranslationDataSource(),
+		factories.NewStoreDataSource(),
+		factories.NewPromotionDataSource(),
+		factories.NewTransactionManager(),
+		shared_factories.NewFileProvider(),
+		factories.NewCatalogCache(),
+	)
+}
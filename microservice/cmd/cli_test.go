@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func captureOutput(t *testing.T) (*bytes.Buffer, *bytes.Buffer) {
+	out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+	previousOut, previousErr := stdout, stderr
+	stdout, stderr = out, errOut
+	t.Cleanup(func() {
+		stdout, stderr = previousOut, previousErr
+	})
+	return out, errOut
+}
+
+func TestExecute_Help(t *testing.T) {
+	out, _ := captureOutput(t)
+	code := Execute([]string{"help"})
+	require.Equal(t, 0, code)
+	for _, name := range []string{"serve", "migrate", "seed", "gc-storage", "export", "import", "check"} {
+		require.Contains(t, out.String(), name)
+	}
+}
+
+func TestExecute_UnknownCommand(t *testing.T) {
+	_, errOut := captureOutput(t)
+	code := Execute([]string{"unknown"})
+	require.Equal(t, 2, code)
+	require.Contains(t, errOut.String(), `unknown command "unknown"`)
+}
+
+func TestExecute_CommandHelpFlag(t *testing.T) {
+	_, errOut := captureOutput(t)
+	code := Execute([]string{"gc-storage", "-h"})
+	require.Equal(t, 0, code)
+	require.Contains(t, errOut.String(), "-apply")
+}
+
+func TestExecute_InvalidFlag(t *testing.T) {
+	captureOutput(t)
+	code := Execute([]string{"export", "--unknown"})
+	require.Equal(t, 1, code)
+}
+
+func TestExecute_ImportRequiresFile(t *testing.T) {
+	captureOutput(t)
+	code := Execute([]string{"import"})
+	require.Equal(t, 1, code)
+}
+
+func TestRunChecks(t *testing.T) {
+	out, _ := captureOutput(t)
+	err := runChecks([]check{
+		{name: "ok-check", run: func() error { return nil }},
+		{name: "failing-check", run: func() error { return errors.New("boom") }},
+	})
+	require.Error(t, err)
+	require.Contains(t, out.String(), "[ok]   ok-check")
+	require.Contains(t, out.String(), "[fail] failing-check: boom")
+}
+
+func TestExecute_SeedMissingFixture(t *testing.T) {
+	captureOutput(t)
+	code := Execute([]string{"seed", "--file", "missing.yaml"})
+	require.Equal(t, 1, code)
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"tech_challenge/internal/product/infra/api/schemas"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+func newExportCommand() command {
+	return command{
+		name:        "export",
+		description: "Export categories and products to a JSON or CSV catalog file",
+		run: func(args []string) error {
+			flags := newFlagSet("export")
+			output := flags.String("output", "catalog-export.json", "path of the generated catalog file (.json or .csv)")
+			if err := flags.Parse(args); err != nil {
+				return err
+			}
+
+			connectDatabase()
+			defer database.Close()
+
+			catalog, err := newCatalogController().Export()
+			if err != nil {
+				return err
+			}
+
+			var content bytes.Buffer
+			if strings.EqualFold(filepath.Ext(*output), ".csv") {
+				err = schemas.WriteCatalogCSV(&content, catalog)
+			} else {
+				encoder := json.NewEncoder(&content)
+				encoder.SetIndent("", "  ")
+				err = encoder.Encode(schemas.ToCatalogSchema(catalog))
+			}
+			if err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(*output, content.Bytes(), 0644); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(stdout, "Exported %d categories and %d products to %s\n", len(catalog.Categories), len(catalog.Products), *output)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+
+	"tech_challenge/internal/shared/infra/database"
+)
+
+func newGcStorageCommand() command {
+	return command{
+		name:        "gc-storage",
+		description: "Remove stored images that are no longer referenced by any product",
+		run: func(args []string) error {
+			flags := newFlagSet("gc-storage")
+			apply := flags.Bool("apply", false, "delete the orphan files (default only lists them)")
+			if err := flags.Parse(args); err != nil {
+				return err
+			}
+
+			connectDatabase()
+			defer database.Close()
+
+			result, err := newProductController().GarbageCollectStorage(!*apply)
+			if err != nil {
+				return err
+			}
+
+			for _, fileName := range result.OrphanFiles {
+				fmt.Fprintln(stdout, fileName)
+			}
+
+			action := "would be removed"
+			if !result.DryRun {
+				action = "removed"
+			}
+			fmt.Fprintf(stdout, "%d stored files, %d referenced, %d orphan files %s\n",
+				result.StoredFiles, result.ReferencedFiles, len(result.OrphanFiles), action)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"tech_challenge/internal/product/infra/api/schemas"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+func newImportCommand() command {
+	return command{
+		name:        "import",
+		description: "Import categories and products from a JSON or CSV catalog file",
+		run: func(args []string) error {
+			flags := newFlagSet("import")
+			file := flags.String("file", "", "path of the catalog file to import (.json or .csv)")
+			dryRun := flags.Bool("dry-run", false, "only validate the file and report what would change")
+			deactivateMissing := flags.Bool("deactivate-missing", false, "deactivate categories and products missing from the file")
+			if err := flags.Parse(args); err != nil {
+				return err
+			}
+
+			if *file == "" {
+				return errors.New("the --file flag is required")
+			}
+
+			catalog, err := readCatalogFile(*file)
+			if err != nil {
+				return err
+			}
+
+			connectDatabase()
+			defer database.Close()
+
+			result, err := newCatalogController().Import(catalog.ToImportDTO(*dryRun, *deactivateMissing))
+			if err != nil {
+				return err
+			}
+
+			printImportResult(schemas.ToImportCatalogResultSchema(result))
+
+			if len(result.Errors) > 0 {
+				return fmt.Errorf("%d invalid rows, nothing was imported", len(result.Errors))
+			}
+			return nil
+		},
+	}
+}
+
+func readCatalogFile(path string) (schemas.ImportCatalogSchema, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return schemas.ImportCatalogSchema{}, err
+	}
+	defer file.Close()
+
+	var catalog schemas.ImportCatalogSchema
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		catalog, err = schemas.ReadImportCatalogCSV(file)
+	} else {
+		err = json.NewDecoder(file).Decode(&catalog)
+	}
+
+	if err != nil {
+		return schemas.ImportCatalogSchema{}, fmt.Errorf("invalid catalog file %s: %w", path, err)
+	}
+
+	return catalog, nil
+}
+
+func printImportResult(result schemas.ImportCatalogResultSchema) {
+	for _, rowError := range result.Errors {
+		fmt.Fprintf(stdout, "%s #%d: %s: %s\n", rowError.Entity, rowError.Row, rowError.Field, rowError.Message)
+	}
+
+	if result.DryRun {
+		fmt.Fprintln(stdout, "Dry run, nothing was changed")
+	}
+	fmt.Fprintf(stdout, "Categories: %d created, %d updated, %d deactivated\n", result.CategoriesCreated, result.CategoriesUpdated, result.CategoriesDeactivated)
+	fmt.Fprintf(stdout, "Products: %d created, %d updated, %d deactivated\n", result.ProductsCreated, result.ProductsUpdated, result.ProductsDeactivated)
+}
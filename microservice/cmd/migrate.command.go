@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"fmt"
+
+	"tech_challenge/internal/shared/infra/database"
+)
+
+func newMigrateCommand() command {
+	return command{
+		name:        "migrate",
+		description: "Apply database migrations",
+		run: func(args []string) error {
+			flags := newFlagSet("migrate")
+			if err := flags.Parse(args); err != nil {
+				return err
+			}
+
+			connectDatabase()
+			defer database.Close()
+
+			if err := database.RunMigrations(); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(stdout, "Migrations applied successfully")
+			return nil
+		},
+	}
+}
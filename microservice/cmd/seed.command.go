@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+
+	"tech_challenge/internal/product/infra/fixtures"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+const defaultSeedFile = "fixtures/catalog.yaml"
+
+func newSeedCommand() command {
+	return command{
+		name:        "seed",
+		description: "Load the demo catalog fixtures into the database",
+		run: func(args []string) error {
+			flags := newFlagSet("seed")
+			file := flags.String("file", defaultSeedFile, "path of the YAML or JSON fixture file")
+			if err := flags.Parse(args); err != nil {
+				return err
+			}
+
+			seedDTO, err := fixtures.LoadCatalogFixture(*file)
+			if err != nil {
+				return err
+			}
+
+			connectDatabase()
+			defer database.Close()
+
+			result, err := newCatalogController().Seed(seedDTO)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(stdout, "Categories: %d created, %d updated\n", result.CategoriesCreated, result.CategoriesUpdated)
+			fmt.Fprintf(stdout, "Products: %d created, %d updated\n", result.ProductsCreated, result.ProductsUpdated)
+			fmt.Fprintf(stdout, "Images: %d uploaded, %d skipped\n", result.ImagesUploaded, result.ImagesSkipped)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import "tech_challenge/internal/shared/infra/api"
+
+func newServeCommand() command {
+	return command{
+		name:        "serve",
+		description: "Start the HTTP API and gRPC servers",
+		run: func(args []string) error {
+			flags := newFlagSet("serve")
+			if err := flags.Parse(args); err != nil {
+				return err
+			}
+
+			api.Init()
+			return nil
+		},
+	}
+}
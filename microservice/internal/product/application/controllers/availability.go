@@ -0,0 +1,44 @@
+package controllers
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/shared/config/env"
+)
+
+// availabilityClock leva o instante avaliado para o fuso do estabelecimento,
+// onde as grades de disponibilidade são definidas
+type availabilityClock struct {
+	location *time.Location
+	now      func() time.Time
+}
+
+func newAvailabilityClock() availabilityClock {
+	return availabilityClock{
+		location: env.GetConfig().Location(),
+		now:      time.Now,
+	}
+}
+
+// localTime devolve at (ou o instante atual, quando nil) no fuso configurado
+func (c availabilityClock) localTime(at *time.Time) time.Time {
+	if at != nil {
+		return at.In(c.location)
+	}
+	return c.now().In(c.location)
+}
+
+// categoryTreeForAvailability carrega as categorias só para avaliar
+// available_now de respostas de um único item, já com a visibilidade da loja.
+// Se a leitura falhar, segue sem a árvore e apenas as grades do próprio item
+// são consideradas
+func categoryTreeForAvailability(gateway gateways.CategoryGateway, overrides entities.StoreOverrides) *entities.CategoryTree {
+	categories, err := gateway.FindAll()
+	if err != nil {
+		return nil
+	}
+	overrides.ApplyToCategories(categories)
+	return entities.NewCategoryTree(categories)
+}
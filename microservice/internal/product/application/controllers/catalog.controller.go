@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/application/presenters"
+	"tech_challenge/internal/product/interfaces"
+	use_cases "tech_challenge/internal/product/use_cases/catalog"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+)
+
+type CatalogController struct {
+	productGateway     gateways.ProductGateway
+	categoryGateway    gateways.CategoryGateway
+	stockGateway       gateways.StockGateway
+	translationGateway gateways.TranslationGateway
+	storeGateway       gateways.StoreGateway
+	promotionGateway   gateways.PromotionGateway
+	transactionGateway gateways.TransactionGateway
+	cacheGateway       gateways.CatalogCacheGateway
+	clock              availabilityClock
+}
+
+func NewCatalogController(
+	productDataSource interfaces.IProductDataSource,
+	categoryDataSource interfaces.ICategoryDataSource,
+	stockDataSource interfaces.IStockDataSource,
+	translationDataSource interfaces.ITranslationDataSource,
+	storeDataSource interfaces.IStoreDataSource,
+	promotionDataSource interfaces.IPromotionDataSource,
+	transactionManager interfaces.ITransactionManager,
+	fileService shared_interfaces.IFileProvider,
+	catalogCache interfaces.ICatalogCache,
+) *CatalogController {
+	return &CatalogController{
+		productGateway:     *gateways.NewProductGateway(productDataSource, fileService),
+		categoryGateway:    gateways.NewCategoryGateway(categoryDataSource),
+		stockGateway:       gateways.NewStockGateway(stockDataSource, nil),
+		translationGateway: gateways.NewTranslationGateway(translationDataSource),
+		storeGateway:       gateways.NewStoreGateway(storeDataSource),
+		promotionGateway:   gateways.NewPromotionGateway(promotionDataSource),
+		transactionGateway: gateways.NewTransactionGateway(transactionManager, fileService),
+		cacheGateway:       gateways.NewCatalogCacheGateway(catalogCache),
+		clock:              newAvailabilityClock(),
+	}
+}
+
+func (c *CatalogController) Export() (dtos.CatalogDTO, error) {
+	exportCatalogUseCase := use_cases.NewExportCatalogUseCase(c.productGateway, c.categoryGateway)
+
+	categories, products, err := exportCatalogUseCase.Execute()
+
+	if err != nil {
+		return dtos.CatalogDTO{}, err
+	}
+
+	return dtos.CatalogDTO{
+		Categories: presenters.CategoriesFromDomainToResultDTO(categories),
+		Products:   presenters.ListProductDomainToResultDTO(products),
+	}, nil
+}
+
+// FindMenu monta o cardápio com o que está disponível no instante at, ou agora
+// quando nil, com os textos no locale e, com storeID, os ajustes da loja
+func (c *CatalogController) FindMenu(at *time.Time, locale string, storeID string) ([]dtos.MenuSectionDTO, error) {
+	overrides, err := findStoreOverrides(c.storeGateway, storeID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	findMenuUseCase := use_cases.NewFindMenuUseCase(c.productGateway, c.categoryGateway, c.stockGateway, c.promotionGateway)
+
+	menu, err := findMenuUseCase.Execute(c.clock.localTime(at), overrides)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := localizeMenu(c.translationGateway, locale, menu); err != nil {
+		return nil, err
+	}
+
+	return presenters.MenuFromDomainToDTO(menu), nil
+}
+
+// Quote valida e precifica os itens de um pedido agora, com os ajustes da loja
+// quando storeID é informado
+func (c *CatalogController) Quote(items []dtos.QuoteItemDTO, storeID string) (dtos.QuoteResultDTO, error) {
+	overrides, err := findStoreOverrides(c.storeGateway, storeID)
+
+	if err != nil {
+		return dtos.QuoteResultDTO{}, err
+	}
+
+	quoteCatalogUseCase := use_cases.NewQuoteCatalogUseCase(c.productGateway, c.categoryGateway, c.stockGateway, c.promotionGateway)
+
+	quote, err := quoteCatalogUseCase.Execute(presenters.QuoteItemsFromDTOToDomain(items), c.clock.localTime(nil), overrides)
+
+	if err != nil {
+		return dtos.QuoteResultDTO{}, err
+	}
+
+	return presenters.QuoteFromDomainToResultDTO(quote), nil
+}
+
+func (c *CatalogController) Import(catalogDTO dtos.ImportCatalogDTO) (dtos.ImportCatalogResultDTO, error) {
+	importCatalogUseCase := use_cases.NewImportCatalogUseCase(c.productGateway, c.categoryGateway, c.transactionGateway, c.cacheGateway)
+
+	return importCatalogUseCase.Execute(catalogDTO)
+}
+
+func (c *CatalogController) Seed(seedDTO dtos.SeedCatalogDTO) (dtos.SeedCatalogResultDTO, error) {
+	seedCatalogUseCase := use_cases.NewSeedCatalogUseCase(c.productGateway, c.categoryGateway, c.transactionGateway, c.cacheGateway)
+
+	return seedCatalogUseCase.Execute(seedDTO)
+}
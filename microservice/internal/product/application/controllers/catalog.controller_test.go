@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/interfaces"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	testmocks "tech_challenge/internal/shared/test"
+)
+
+func TestCatalogController_Export_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	categoryDS := &testmocks.MockCategoryDataSource{
+		FindAllFunc: func() ([]daos.CategoryDAO, error) {
+			return []daos.CategoryDAO{{ID: "catid", Name: "Bebidas", Active: true}}, nil
+		},
+	}
+	productDS := &testmocks.MockProductDataSource{
+		FindAllFunc: func() ([]daos.ProductDAO, error) {
+			return []daos.ProductDAO{{ID: "pid", CategoryID: "catid", Name: "Coca-Cola", Price: 5.99, Active: true}}, nil
+		},
+	}
+	c := NewCatalogController(productDS, categoryDS, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{}, mock_interfaces.NewMockIFileProvider(ctrl), nil)
+	catalog, err := c.Export()
+	require.NoError(t, err)
+	require.Len(t, catalog.Categories, 1)
+	require.Len(t, catalog.Products, 1)
+	require.Equal(t, "Coca-Cola", catalog.Products[0].Name)
+}
+
+func TestCatalogController_Export_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	categoryDS := &testmocks.MockCategoryDataSource{}
+	productDS := &testmocks.MockProductDataSource{
+		FindAllFunc: func() ([]daos.ProductDAO, error) { return nil, errors.New("fail") },
+	}
+	c := NewCatalogController(productDS, categoryDS, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{}, mock_interfaces.NewMockIFileProvider(ctrl), nil)
+	_, err := c.Export()
+	require.Error(t, err)
+}
+
+func TestCatalogController_Import_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	inserted := []daos.CategoryDAO{}
+	productDS := &testmocks.MockProductDataSource{}
+	categoryDS := &testmocks.MockCategoryDataSource{
+		InsertFunc: func(dao daos.CategoryDAO) error {
+			inserted = append(inserted, dao)
+			return nil
+		},
+	}
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDS, CategoryDataSource: categoryDS}
+	c := NewCatalogController(productDS, categoryDS, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, transactionManager, mock_interfaces.NewMockIFileProvider(ctrl), nil)
+	result, err := c.Import(dtos.ImportCatalogDTO{
+		Categories: []dtos.ImportCategoryDTO{{Row: 1, ExternalKey: "bebidas", Name: "Bebidas", Active: true}},
+	})
+	require.NoError(t, err)
+	require.True(t, result.Applied)
+	require.Equal(t, 1, result.CategoriesCreated)
+	require.Len(t, inserted, 1)
+	require.Equal(t, "bebidas", inserted[0].ExternalKey)
+}
+
+func TestCatalogController_Import_TransactionError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	transactionManager := &testmocks.MockTransactionManager{
+		TransactionFunc: func(fn func(interfaces.TransactionDataSources) error) error { return errors.New("connection refused") },
+	}
+	c := NewCatalogController(&testmocks.MockProductDataSource{}, &testmocks.MockCategoryDataSource{}, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, transactionManager, mock_interfaces.NewMockIFileProvider(ctrl), nil)
+	_, err := c.Import(dtos.ImportCatalogDTO{
+		Categories: []dtos.ImportCategoryDTO{{Row: 1, Name: "Bebidas", Active: true}},
+	})
+	require.EqualError(t, err, "connection refused")
+}
@@ -1,36 +1,67 @@
 package controllers
 
 import (
+	"time"
+
 	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/application/gateways"
 	"tech_challenge/internal/product/application/presenters"
+	"tech_challenge/internal/product/domain/entities"
 	"tech_challenge/internal/product/interfaces"
 	use_cases "tech_challenge/internal/product/use_cases/category"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
 )
 
 type CategoryController struct {
-	gateway gateways.CategoryGateway
+	gateway            gateways.CategoryGateway
+	transactionGateway gateways.TransactionGateway
+	fileGateway        gateways.FileGateway
+	translationGateway gateways.TranslationGateway
+	storeGateway       gateways.StoreGateway
+	cacheGateway       gateways.CatalogCacheGateway
+	clock              availabilityClock
 }
 
-func NewCategoryController(dataSource interfaces.ICategoryDataSource) *CategoryController {
+func NewCategoryController(
+	dataSource interfaces.ICategoryDataSource,
+	translationDataSource interfaces.ITranslationDataSource,
+	storeDataSource interfaces.IStoreDataSource,
+	transactionManager interfaces.ITransactionManager,
+	fileService shared_interfaces.IFileProvider,
+	catalogCache interfaces.ICatalogCache,
+) *CategoryController {
 	return &CategoryController{
-		gateway: gateways.NewCategoryGateway(dataSource),
+		gateway:            gateways.NewCategoryGateway(dataSource),
+		transactionGateway: gateways.NewTransactionGateway(transactionManager, fileService),
+		fileGateway:        gateways.NewFileGateway(fileService),
+		translationGateway: gateways.NewTranslationGateway(translationDataSource),
+		storeGateway:       gateways.NewStoreGateway(storeDataSource),
+		cacheGateway:       gateways.NewCatalogCacheGateway(catalogCache),
+		clock:              newAvailabilityClock(),
 	}
 }
 
 func (c *CategoryController) Create(categoryDTO dtos.CreateCategoryDTO) (dtos.CategoryResultDTO, error) {
-	createCategoryUseCase := use_cases.NewCreateCategoryUseCase(c.gateway)
+	createCategoryUseCase := use_cases.NewCreateCategoryUseCase(c.gateway, c.cacheGateway)
 
-	category, err := createCategoryUseCase.Execute(categoryDTO.Name, categoryDTO.Active)
+	category, err := createCategoryUseCase.Execute(categoryDTO)
 
 	if err != nil {
 		return dtos.CategoryResultDTO{}, err
 	}
 
-	return presenters.CategoryFromDomainToResultDTO(category), nil
+	return c.present(category), nil
 }
 
-func (c *CategoryController) FindByID(id string) (dtos.CategoryResultDTO, error) {
+// FindByID devolve nome e descrição no locale, com o idioma padrão como
+// reserva, e, com storeID, a visibilidade da categoria na loja
+func (c *CategoryController) FindByID(id string, locale string, storeID string) (dtos.CategoryResultDTO, error) {
+	overrides, err := findStoreOverrides(c.storeGateway, storeID)
+
+	if err != nil {
+		return dtos.CategoryResultDTO{}, err
+	}
+
 	findCategoryByIDUseCase := use_cases.NewFindCategoryByIDUseCase(c.gateway)
 
 	category, err := findCategoryByIDUseCase.Execute(id)
@@ -39,10 +70,24 @@ func (c *CategoryController) FindByID(id string) (dtos.CategoryResultDTO, error)
 		return dtos.CategoryResultDTO{}, err
 	}
 
-	return presenters.CategoryFromDomainToResultDTO(category), nil
+	if err := localizeCategory(c.translationGateway, locale, &category); err != nil {
+		return dtos.CategoryResultDTO{}, err
+	}
+
+	overrides.ApplyToCategory(&category)
+
+	return presenters.CategoryWithAvailabilityToResultDTO(category, c.clock.localTime(nil), categoryTreeForAvailability(c.gateway, overrides)), nil
 }
 
-func (c *CategoryController) FindAll() ([]dtos.CategoryResultDTO, error) {
+// FindAll informa available_now no instante at, ou agora quando nil, traduz
+// os textos para o locale e, com storeID, aplica a visibilidade da loja
+func (c *CategoryController) FindAll(at *time.Time, locale string, storeID string) ([]dtos.CategoryResultDTO, error) {
+	overrides, err := findStoreOverrides(c.storeGateway, storeID)
+
+	if err != nil {
+		return []dtos.CategoryResultDTO{}, err
+	}
+
 	findAllCategoryUseCase := use_cases.NewFindAllCategoryUseCase(c.gateway)
 
 	categories, err := findAllCategoryUseCase.Execute()
@@ -51,11 +96,41 @@ func (c *CategoryController) FindAll() ([]dtos.CategoryResultDTO, error) {
 		return []dtos.CategoryResultDTO{}, err
 	}
 
-	return presenters.CategoriesFromDomainToResultDTO(categories), nil
+	if err := localizeCategories(c.translationGateway, locale, categories); err != nil {
+		return []dtos.CategoryResultDTO{}, err
+	}
+
+	overrides.ApplyToCategories(categories)
+
+	return presenters.CategoriesWithAvailabilityToResultDTO(categories, c.clock.localTime(at), entities.NewCategoryTree(categories)), nil
+}
+
+func (c *CategoryController) FindTree(at *time.Time, locale string, storeID string) ([]dtos.CategoryTreeDTO, error) {
+	overrides, err := findStoreOverrides(c.storeGateway, storeID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	findCategoryTreeUseCase := use_cases.NewFindCategoryTreeUseCase(c.gateway)
+
+	tree, err := findCategoryTreeUseCase.Execute()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := localizeCategories(c.translationGateway, locale, tree.Descendants("")); err != nil {
+		return nil, err
+	}
+
+	overrides.ApplyToCategories(tree.Descendants(""))
+
+	return presenters.CategoryTreeFromDomainToDTO(tree, c.clock.localTime(at)), nil
 }
 
 func (c *CategoryController) Update(categoryDTO dtos.UpdateCategoryDTO) (dtos.CategoryResultDTO, error) {
-	updateCategoryUseCase := use_cases.NewUpdateCategoryUseCase(c.gateway)
+	updateCategoryUseCase := use_cases.NewUpdateCategoryUseCase(c.gateway, c.transactionGateway, c.cacheGateway)
 
 	category, err := updateCategoryUseCase.Execute(categoryDTO)
 
@@ -63,17 +138,57 @@ func (c *CategoryController) Update(categoryDTO dtos.UpdateCategoryDTO) (dtos.Ca
 		return dtos.CategoryResultDTO{}, err
 	}
 
-	return presenters.CategoryFromDomainToResultDTO(category), nil
+	return c.present(category), nil
+}
+
+func (c *CategoryController) Patch(patchDTO dtos.PatchCategoryDTO) (dtos.CategoryResultDTO, error) {
+	patchCategoryUseCase := use_cases.NewPatchCategoryUseCase(c.gateway, c.transactionGateway, c.cacheGateway)
+
+	category, err := patchCategoryUseCase.Execute(patchDTO)
+
+	if err != nil {
+		return dtos.CategoryResultDTO{}, err
+	}
+
+	return c.present(category), nil
+}
+
+func (c *CategoryController) Delete(deleteDTO dtos.DeleteCategoryDTO) (dtos.DeleteCategoryResultDTO, error) {
+	deleteCategoryUseCase := use_cases.NewDeleteCategoryUseCase(c.gateway, c.transactionGateway, c.cacheGateway)
+
+	return deleteCategoryUseCase.Execute(deleteDTO)
+}
+
+func (c *CategoryController) Reorder(reorderDTO dtos.ReorderCategoriesDTO) ([]dtos.CategoryResultDTO, error) {
+	reorderCategoriesUseCase := use_cases.NewReorderCategoriesUseCase(c.gateway, c.cacheGateway)
+
+	categories, err := reorderCategoriesUseCase.Execute(reorderDTO)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenters.CategoriesWithAvailabilityToResultDTO(categories, c.clock.localTime(nil), entities.NewCategoryTree(categories)), nil
 }
 
-func (c *CategoryController) Delete(id string) error {
-	deleteCategoryUseCase := use_cases.NewDeleteCategoryUseCase(c.gateway)
+func (c *CategoryController) UploadImage(uploadDTO dtos.UploadCategoryImageDTO) (dtos.CategoryResultDTO, error) {
+	uploadCategoryImageUseCase := use_cases.NewUploadCategoryImageUseCase(c.gateway, c.fileGateway, c.cacheGateway)
 
-	err := deleteCategoryUseCase.Execute(id)
+	category, err := uploadCategoryImageUseCase.Execute(uploadDTO)
 
 	if err != nil {
-		return err
+		return dtos.CategoryResultDTO{}, err
 	}
 
-	return nil
+	return c.present(category), nil
+}
+
+func (c *CategoryController) DeleteImage(id string) error {
+	deleteCategoryImageUseCase := use_cases.NewDeleteCategoryImageUseCase(c.gateway, c.fileGateway, c.cacheGateway)
+
+	return deleteCategoryImageUseCase.Execute(id)
+}
+
+func (c *CategoryController) present(category entities.Category) dtos.CategoryResultDTO {
+	return presenters.CategoryWithAvailabilityToResultDTO(category, c.clock.localTime(nil), categoryTreeForAvailability(c.gateway, entities.StoreOverrides{}))
 }
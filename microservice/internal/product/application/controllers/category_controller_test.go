@@ -18,7 +18,7 @@ func TestCategoryController_Create_Success(t *testing.T) {
 	mockDS := &testmocks.MockCategoryDataSource{
 		InsertFunc: func(dao daos.CategoryDAO) error { return nil },
 	}
-	c := NewCategoryController(mockDS)
+	c := NewCategoryController(mockDS, nil, nil, nil, nil, nil)
 	dto := dtos.CreateCategoryDTO{Name: "Bebidas", Active: true}
 	res, err := c.Create(dto)
 	require.NoError(t, err)
@@ -31,7 +31,7 @@ func TestCategoryController_Create_Error(t *testing.T) {
 	mockDS := &testmocks.MockCategoryDataSource{
 		InsertFunc: func(dao daos.CategoryDAO) error { return errors.New("fail") },
 	}
-	c := NewCategoryController(mockDS)
+	c := NewCategoryController(mockDS, nil, nil, nil, nil, nil)
 	dto := dtos.CreateCategoryDTO{Name: "Bebidas", Active: true}
 	_, err := c.Create(dto)
 	require.Error(t, err)
@@ -43,8 +43,8 @@ func TestCategoryController_FindByID_Success(t *testing.T) {
 			return daos.CategoryDAO{ID: id, Name: "Bebidas", Active: true}, nil
 		},
 	}
-	c := NewCategoryController(mockDS)
-	res, err := c.FindByID("catid")
+	c := NewCategoryController(mockDS, nil, nil, nil, nil, nil)
+	res, err := c.FindByID("catid", "", "")
 	require.NoError(t, err)
 	require.Equal(t, "catid", res.ID)
 }
@@ -53,8 +53,8 @@ func TestCategoryController_FindByID_Error(t *testing.T) {
 	mockDS := &testmocks.MockCategoryDataSource{
 		FindByIDFunc: func(id string) (daos.CategoryDAO, error) { return daos.CategoryDAO{}, errors.New("fail") },
 	}
-	c := NewCategoryController(mockDS)
-	_, err := c.FindByID("catid")
+	c := NewCategoryController(mockDS, nil, nil, nil, nil, nil)
+	_, err := c.FindByID("catid", "", "")
 	require.Error(t, err)
 }
 
@@ -64,8 +64,8 @@ func TestCategoryController_FindAll_Success(t *testing.T) {
 			return []daos.CategoryDAO{{ID: "catid", Name: "Bebidas", Active: true}}, nil
 		},
 	}
-	c := NewCategoryController(mockDS)
-	res, err := c.FindAll()
+	c := NewCategoryController(mockDS, nil, nil, nil, nil, nil)
+	res, err := c.FindAll(nil, "", "")
 	require.NoError(t, err)
 	require.Len(t, res, 1)
 	require.Equal(t, "catid", res[0].ID)
@@ -75,8 +75,8 @@ func TestCategoryController_FindAll_Error(t *testing.T) {
 	mockDS := &testmocks.MockCategoryDataSource{
 		FindAllFunc: func() ([]daos.CategoryDAO, error) { return nil, errors.New("fail") },
 	}
-	c := NewCategoryController(mockDS)
-	_, err := c.FindAll()
+	c := NewCategoryController(mockDS, nil, nil, nil, nil, nil)
+	_, err := c.FindAll(nil, "", "")
 	require.Error(t, err)
 }
 
@@ -87,7 +87,7 @@ func TestCategoryController_Update_Success(t *testing.T) {
 			return daos.CategoryDAO{ID: id, Name: "Bebidas", Active: true}, nil
 		},
 	}
-	c := NewCategoryController(mockDS)
+	c := NewCategoryController(mockDS, nil, nil, nil, nil, nil)
 	dto := dtos.UpdateCategoryDTO{ID: "catid", Name: "Bebidas", Active: true}
 	res, err := c.Update(dto)
 	require.NoError(t, err)
@@ -101,7 +101,7 @@ func TestCategoryController_Update_Error(t *testing.T) {
 			return daos.CategoryDAO{ID: id, Name: "Bebidas", Active: true}, nil
 		},
 	}
-	c := NewCategoryController(mockDS)
+	c := NewCategoryController(mockDS, nil, nil, nil, nil, nil)
 	dto := dtos.UpdateCategoryDTO{ID: "catid", Name: "Bebidas", Active: true}
 	_, err := c.Update(dto)
 	require.Error(t, err)
@@ -109,22 +109,48 @@ func TestCategoryController_Update_Error(t *testing.T) {
 
 func TestCategoryController_Delete_Success(t *testing.T) {
 	mockDS := &testmocks.MockCategoryDataSource{
-		DeleteFunc: func(id string) error { return nil },
+		DeleteFunc: func(id string, version int64) error { return nil },
 		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
 			return daos.CategoryDAO{ID: id, Name: "Bebidas", Active: true}, nil
 		},
 	}
-	c := NewCategoryController(mockDS)
-	require.NoError(t, c.Delete("catid"))
+	c := NewCategoryController(mockDS, nil, nil, nil, nil, nil)
+	res, err := c.Delete(dtos.DeleteCategoryDTO{ID: "catid"})
+	require.NoError(t, err)
+	require.True(t, res.Deleted)
+	require.Equal(t, "restrict", res.Strategy)
 }
 
 func TestCategoryController_Delete_Error(t *testing.T) {
 	mockDS := &testmocks.MockCategoryDataSource{
-		DeleteFunc: func(id string) error { return errors.New("fail") },
+		DeleteFunc: func(id string, version int64) error { return errors.New("fail") },
 		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
 			return daos.CategoryDAO{ID: id, Name: "Bebidas", Active: true}, nil
 		},
 	}
-	c := NewCategoryController(mockDS)
-	require.Error(t, c.Delete("catid"))
+	c := NewCategoryController(mockDS, nil, nil, nil, nil, nil)
+	_, err := c.Delete(dtos.DeleteCategoryDTO{ID: "catid"})
+	require.Error(t, err)
+}
+
+func TestCategoryController_Reorder(t *testing.T) {
+	mockDS := &testmocks.MockCategoryDataSource{
+		FindAllFunc: func() ([]daos.CategoryDAO, error) {
+			return []daos.CategoryDAO{{ID: "a", Name: "Lanches", Position: 1}, {ID: "b", Name: "Bebidas", Position: 2}}, nil
+		},
+	}
+	c := NewCategoryController(mockDS, nil, nil, nil, nil, nil)
+	res, err := c.Reorder(dtos.ReorderCategoriesDTO{CategoryIDs: []string{"b"}})
+	require.NoError(t, err)
+	require.Equal(t, "b", res[0].ID)
+	require.Equal(t, 1, res[0].Position)
+	require.Equal(t, 2, res[1].Position)
+}
+
+func TestCategoryController_DeleteImage_NotFound(t *testing.T) {
+	mockDS := &testmocks.MockCategoryDataSource{
+		FindByIDFunc: func(id string) (daos.CategoryDAO, error) { return daos.CategoryDAO{}, errors.New("fail") },
+	}
+	c := NewCategoryController(mockDS, nil, nil, nil, nil, nil)
+	require.Error(t, c.DeleteImage("catid"))
 }
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+// ContentLocales é o idioma padrão seguido dos idiomas com tradução, na ordem
+// usada para negociar o Accept-Language
+func ContentLocales() []string {
+	locales := []string{value_objects.DefaultLocale.String()}
+	for _, locale := range value_objects.TranslationLocales {
+		locales = append(locales, locale.String())
+	}
+	return locales
+}
+
+// Os textos sem tradução no idioma pedido ficam no idioma padrão. Uma falha ao
+// ler as traduções falha a resposta: devolver o idioma padrão anunciando outro
+// no Content-Language deixaria o cache HTTP com a versão errada
+
+func localizeProducts(gateway gateways.TranslationGateway, locale string, products []entities.Product) error {
+	translations, err := gateway.FindByLocale(entities.ProductTranslationTarget, value_objects.Locale(locale))
+	if err != nil {
+		return err
+	}
+
+	for i := range products {
+		translations.LocalizeProduct(&products[i])
+	}
+	return nil
+}
+
+func localizeProduct(gateway gateways.TranslationGateway, locale string, product *entities.Product) error {
+	translations, err := gateway.FindOne(entities.ProductTranslationTarget, product.ID, value_objects.Locale(locale))
+	if err != nil {
+		return err
+	}
+
+	translations.LocalizeProduct(product)
+	return nil
+}
+
+func localizeCategories(gateway gateways.TranslationGateway, locale string, categories []*entities.Category) error {
+	translations, err := gateway.FindByLocale(entities.CategoryTranslationTarget, value_objects.Locale(locale))
+	if err != nil {
+		return err
+	}
+
+	for _, category := range categories {
+		translations.LocalizeCategory(category)
+	}
+	return nil
+}
+
+func localizeCategory(gateway gateways.TranslationGateway, locale string, category *entities.Category) error {
+	translations, err := gateway.FindOne(entities.CategoryTranslationTarget, category.ID, value_objects.Locale(locale))
+	if err != nil {
+		return err
+	}
+
+	translations.LocalizeCategory(category)
+	return nil
+}
+
+func localizeMenu(gateway gateways.TranslationGateway, locale string, menu []entities.MenuSection) error {
+	productTranslations, err := gateway.FindByLocale(entities.ProductTranslationTarget, value_objects.Locale(locale))
+	if err != nil {
+		return err
+	}
+
+	categoryTranslations, err := gateway.FindByLocale(entities.CategoryTranslationTarget, value_objects.Locale(locale))
+	if err != nil {
+		return err
+	}
+
+	entities.LocalizeMenu(menu, productTranslations, categoryTranslations)
+	return nil
+}
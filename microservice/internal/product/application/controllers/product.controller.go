@@ -1,9 +1,12 @@
 package controllers
 
 import (
+	"time"
+
 	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/application/gateways"
 	"tech_challenge/internal/product/application/presenters"
+	"tech_challenge/internal/product/domain/entities"
 
 	"tech_challenge/internal/product/interfaces"
 	use_cases "tech_challenge/internal/product/use_cases/product"
@@ -11,23 +14,43 @@ import (
 )
 
 type ProductController struct {
-	productGateway  gateways.ProductGateway
-	categoryGateway gateways.CategoryGateway
+	productGateway     gateways.ProductGateway
+	categoryGateway    gateways.CategoryGateway
+	stockGateway       gateways.StockGateway
+	transactionGateway gateways.TransactionGateway
+	translationGateway gateways.TranslationGateway
+	storeGateway       gateways.StoreGateway
+	promotionGateway   gateways.PromotionGateway
+	cacheGateway       gateways.CatalogCacheGateway
+	clock              availabilityClock
 }
 
 func NewProductController(
 	productDataSource interfaces.IProductDataSource,
 	categoryDataSource interfaces.ICategoryDataSource,
+	stockDataSource interfaces.IStockDataSource,
+	translationDataSource interfaces.ITranslationDataSource,
+	storeDataSource interfaces.IStoreDataSource,
+	promotionDataSource interfaces.IPromotionDataSource,
+	transactionManager interfaces.ITransactionManager,
 	fileService shared_interfaces.IFileProvider,
+	catalogCache interfaces.ICatalogCache,
 ) *ProductController {
 	return &ProductController{
-		productGateway:  *gateways.NewProductGateway(productDataSource, fileService),
-		categoryGateway: gateways.NewCategoryGateway(categoryDataSource),
+		productGateway:     *gateways.NewProductGateway(productDataSource, fileService),
+		categoryGateway:    gateways.NewCategoryGateway(categoryDataSource),
+		stockGateway:       gateways.NewStockGateway(stockDataSource, nil),
+		transactionGateway: gateways.NewTransactionGateway(transactionManager, fileService),
+		translationGateway: gateways.NewTranslationGateway(translationDataSource),
+		storeGateway:       gateways.NewStoreGateway(storeDataSource),
+		promotionGateway:   gateways.NewPromotionGateway(promotionDataSource),
+		cacheGateway:       gateways.NewCatalogCacheGateway(catalogCache),
+		clock:              newAvailabilityClock(),
 	}
 }
 
 func (c *ProductController) Create(productDTO dtos.CreateProductDTO) (dtos.ProductResultDTO, error) {
-	createProductUseCase := use_cases.NewCreateProductUseCase(c.productGateway, c.categoryGateway)
+	createProductUseCase := use_cases.NewCreateProductUseCase(c.productGateway, c.categoryGateway, c.cacheGateway)
 
 	product, err := createProductUseCase.Execute(productDTO)
 
@@ -35,10 +58,19 @@ func (c *ProductController) Create(productDTO dtos.CreateProductDTO) (dtos.Produ
 		return dtos.ProductResultDTO{}, err
 	}
 
-	return presenters.ProductFromDomainToResultDTO(product), nil
+	return c.present(product), nil
 }
 
-func (c *ProductController) FindByID(productID string) (dtos.ProductResultDTO, error) {
+// FindByID devolve nome e descrição no locale, com o idioma padrão como
+// reserva, e, com storeID, o preço e a disponibilidade da loja. As promoções
+// são aplicadas sobre o preço da loja e o estoque informa se está esgotado
+func (c *ProductController) FindByID(productID string, locale string, storeID string) (dtos.ProductResultDTO, error) {
+	overrides, err := findStoreOverrides(c.storeGateway, storeID)
+
+	if err != nil {
+		return dtos.ProductResultDTO{}, err
+	}
+
 	findProductUseCase := use_cases.NewFindProductByIDUseCase(c.productGateway)
 
 	product, err := findProductUseCase.Execute(productID)
@@ -47,23 +79,113 @@ func (c *ProductController) FindByID(productID string) (dtos.ProductResultDTO, e
 		return dtos.ProductResultDTO{}, err
 	}
 
-	return presenters.ProductFromDomainToResultDTO(product), nil
+	if err := localizeProduct(c.translationGateway, locale, &product); err != nil {
+		return dtos.ProductResultDTO{}, err
+	}
+
+	overrides.ApplyToProduct(&product)
+
+	stock, err := c.stockGateway.FindByProductID(product.ID)
+
+	if err != nil {
+		return dtos.ProductResultDTO{}, err
+	}
+
+	promotions, err := c.promotionGateway.FindAll()
+
+	if err != nil {
+		return dtos.ProductResultDTO{}, err
+	}
+
+	stocks := entities.NewStockLevels([]entities.ProductStock{stock})
+
+	return presenters.ProductWithAvailabilityToResultDTO(product, c.clock.localTime(nil), categoryTreeForAvailability(c.categoryGateway, overrides), stocks, promotions), nil
 }
 
-func (c *ProductController) FindAll(categoryID *string) ([]dtos.ProductResultDTO, error) {
+// FindAll informa available_now e o preço com promoções no instante at, ou
+// agora quando nil, traduz os textos para o locale e, com storeID, aplica os
+// ajustes da loja
+func (c *ProductController) FindAll(filter dtos.ProductFilterDTO, at *time.Time, locale string, storeID string) ([]dtos.ProductResultDTO, error) {
+	overrides, err := findStoreOverrides(c.storeGateway, storeID)
+
+	if err != nil {
+		return nil, err
+	}
+
 	findAllProductsUseCase := use_cases.NewFindAllProductsUseCase(c.productGateway, c.categoryGateway)
 
-	products, err := findAllProductsUseCase.Execute(categoryID)
+	products, err := findAllProductsUseCase.Execute(filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presentList(products, at, locale, overrides)
+}
+
+// FindByIDs busca um lote de produtos em uma única consulta, com as mesmas
+// regras de FindAll, e informa os IDs que não existem
+func (c *ProductController) FindByIDs(ids []string, at *time.Time, locale string, storeID string) (dtos.ProductBatchResultDTO, error) {
+	overrides, err := findStoreOverrides(c.storeGateway, storeID)
+
+	if err != nil {
+		return dtos.ProductBatchResultDTO{}, err
+	}
+
+	findProductsByIDsUseCase := use_cases.NewFindProductsByIDsUseCase(c.productGateway)
+
+	products, missingIDs, err := findProductsByIDsUseCase.Execute(ids)
+
+	if err != nil {
+		return dtos.ProductBatchResultDTO{}, err
+	}
+
+	result, err := c.presentList(products, at, locale, overrides)
+
+	if err != nil {
+		return dtos.ProductBatchResultDTO{}, err
+	}
+
+	return dtos.ProductBatchResultDTO{Products: result, MissingIDs: missingIDs}, nil
+}
+
+func (c *ProductController) presentList(products []entities.Product, at *time.Time, locale string, overrides entities.StoreOverrides) ([]dtos.ProductResultDTO, error) {
+	if err := localizeProducts(c.translationGateway, locale, products); err != nil {
+		return nil, err
+	}
+
+	overrides.ApplyToProducts(products)
+
+	categories, err := c.categoryGateway.FindAll()
 
 	if err != nil {
 		return nil, err
 	}
 
-	return presenters.ListProductDomainToResultDTO(products), nil
+	overrides.ApplyToCategories(categories)
+
+	productIDs := make([]string, len(products))
+	for i, product := range products {
+		productIDs[i] = product.ID
+	}
+
+	stocks, err := c.stockGateway.FindAllByProductIDs(productIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	promotions, err := c.promotionGateway.FindAll()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenters.ListProductWithAvailabilityToResultDTO(products, c.clock.localTime(at), entities.NewCategoryTree(categories), stocks, promotions), nil
 }
 
 func (c *ProductController) Update(productDTO dtos.UpdateProductDTO) (dtos.ProductResultDTO, error) {
-	updateProductUseCase := use_cases.NewUpdateProductUseCase(c.productGateway)
+	updateProductUseCase := use_cases.NewUpdateProductUseCase(c.productGateway, c.categoryGateway, c.cacheGateway)
 
 	product, err := updateProductUseCase.Execute(productDTO)
 
@@ -71,24 +193,36 @@ func (c *ProductController) Update(productDTO dtos.UpdateProductDTO) (dtos.Produ
 		return dtos.ProductResultDTO{}, err
 	}
 
-	return presenters.ProductFromDomainToResultDTO(product), nil
+	return c.present(product), nil
+}
+
+func (c *ProductController) Patch(patchDTO dtos.PatchProductDTO) (dtos.ProductResultDTO, error) {
+	patchProductUseCase := use_cases.NewPatchProductUseCase(c.productGateway, c.categoryGateway, c.cacheGateway)
+
+	product, err := patchProductUseCase.Execute(patchDTO)
+
+	if err != nil {
+		return dtos.ProductResultDTO{}, err
+	}
+
+	return c.present(product), nil
 }
 
 func (c *ProductController) UploadImage(uploadDTO dtos.UploadProductImageDTO) error {
-	uploadProductImageUseCase := use_cases.NewUploadProductImageUseCase(c.productGateway)
+	uploadProductImageUseCase := use_cases.NewUploadProductImageUseCase(c.productGateway, c.cacheGateway)
 	return uploadProductImageUseCase.Execute(uploadDTO)
 }
 
 func (c *ProductController) DeleteImage(productID string, imageFileName string) error {
-	deleteProductImageUseCase := use_cases.NewDeleteProductImageUseCase(c.productGateway)
+	deleteProductImageUseCase := use_cases.NewDeleteProductImageUseCase(c.productGateway, c.cacheGateway)
 
 	return deleteProductImageUseCase.Execute(productID, imageFileName)
 }
 
-func (c *ProductController) Delete(productID string) error {
-	deleteProductUseCase := use_cases.NewDeleteProductUseCase(c.productGateway)
+func (c *ProductController) Delete(deleteDTO dtos.DeleteProductDTO) error {
+	deleteProductUseCase := use_cases.NewDeleteProductUseCase(c.productGateway, c.transactionGateway, c.cacheGateway)
 
-	return deleteProductUseCase.Execute(productID)
+	return deleteProductUseCase.Execute(deleteDTO)
 }
 
 func (c *ProductController) FindAllImagesProductById(productId string) ([]dtos.ProductImageDTO, error) {
@@ -98,3 +232,26 @@ func (c *ProductController) FindAllImagesProductById(productId string) ([]dtos.P
 	}
 	return presenters.ProductImagesFromDomainToResultDTO(product.Images), nil
 }
+
+func (c *ProductController) GarbageCollectStorage(dryRun bool) (dtos.StorageGarbageCollectionResultDTO, error) {
+	garbageCollectStorageUseCase := use_cases.NewGarbageCollectStorageUseCase(c.productGateway)
+
+	return garbageCollectStorageUseCase.Execute(dryRun)
+}
+
+func (c *ProductController) BulkUpdate(bulkDTO dtos.BulkUpdateProductsDTO) (dtos.BulkUpdateProductsResultDTO, error) {
+	bulkUpdateProductsUseCase := use_cases.NewBulkUpdateProductsUseCase(c.productGateway, c.categoryGateway, c.transactionGateway, c.cacheGateway)
+
+	return bulkUpdateProductsUseCase.Execute(bulkDTO)
+}
+
+// present responde às escritas; se a leitura do estoque ou das promoções
+// falhar, o produto gravado segue como não esgotado e com o preço cadastrado
+// como preço efetivo
+func (c *ProductController) present(product entities.Product) dtos.ProductResultDTO {
+	stock, _ := c.stockGateway.FindByProductID(product.ID)
+	promotions, _ := c.promotionGateway.FindAll()
+	stocks := entities.NewStockLevels([]entities.ProductStock{stock})
+
+	return presenters.ProductWithAvailabilityToResultDTO(product, c.clock.localTime(nil), categoryTreeForAvailability(c.categoryGateway, entities.StoreOverrides{}), stocks, promotions)
+}
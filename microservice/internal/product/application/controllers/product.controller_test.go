@@ -30,7 +30,7 @@ func TestProductController_Create_Error(t *testing.T) {
 	mockCategoryDs, mockProductDs, mockFileProvider, ctrl := setupProductControllerTest(t)
 	defer ctrl.Finish()
 	mockProductDs.InsertFunc = func(dao daos.ProductDAO) error { return nil }
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
 	productDTO := dtos.CreateProductDTO{
 		CategoryID:  "cat1",
 		Name:        "Produto Teste",
@@ -51,7 +51,7 @@ func TestProductController_Create_ReturnsError(t *testing.T) {
 	mockCategoryDs, mockProductDs, mockFileProvider, ctrl := setupProductControllerTest(t)
 	defer ctrl.Finish()
 	mockProductDs.InsertFunc = func(dao daos.ProductDAO) error { return errors.New("insert error") }
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
 	productDTO := dtos.CreateProductDTO{
 		CategoryID:  "cat1",
 		Name:        "Produto Teste",
@@ -70,8 +70,8 @@ func TestProductController_FindByID_Success(t *testing.T) {
 	mockProductDs.FindByIDFunc = func(id string) (daos.ProductDAO, error) {
 		return daos.ProductDAO{ID: id, Name: "Produto Teste", Description: "desc", Price: 10.0, CategoryID: "cat1", Active: true}, nil
 	}
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
-	res, err := c.FindByID("pid")
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
+	res, err := c.FindByID("pid", "", "")
 	require.NoError(t, err)
 	require.Equal(t, "pid", res.ID)
 	require.Equal(t, "Produto Teste", res.Name)
@@ -83,8 +83,8 @@ func TestProductController_FindByID_Error(t *testing.T) {
 	mockProductDs.FindByIDFunc = func(id string) (daos.ProductDAO, error) {
 		return daos.ProductDAO{}, errors.New("not found")
 	}
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
-	res, err := c.FindByID("pid")
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
+	res, err := c.FindByID("pid", "", "")
 	require.Error(t, err)
 	require.Equal(t, dtos.ProductResultDTO{}, res)
 }
@@ -97,21 +97,61 @@ func TestProductController_FindAll_Success(t *testing.T) {
 			{ID: "pid", Name: "Produto Teste", Description: "desc", Price: 10.0, CategoryID: "cat1", Active: true},
 		}, nil
 	}
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
-	res, err := c.FindAll(nil)
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
+	res, err := c.FindAll(dtos.ProductFilterDTO{}, nil, "", "")
 	require.NoError(t, err)
 	require.Len(t, res, 1)
 	require.Equal(t, "pid", res[0].ID)
 }
 
+func TestProductController_SoldOut(t *testing.T) {
+	mockCategoryDs, mockProductDs, mockFileProvider, ctrl := setupProductControllerTest(t)
+	defer ctrl.Finish()
+	mockProductDs.FindByIDFunc = func(id string) (daos.ProductDAO, error) {
+		return daos.ProductDAO{ID: id, Name: "Produto Teste", Description: "desc", Price: 10.0, CategoryID: "cat1", Active: true}, nil
+	}
+	mockProductDs.FindAllFunc = func() ([]daos.ProductDAO, error) {
+		return []daos.ProductDAO{
+			{ID: "esgotado", Name: "Esgotado", Description: "desc", Price: 10.0, CategoryID: "cat1", Active: true},
+			{ID: "zerado", Name: "Zerado", Description: "desc", Price: 10.0, CategoryID: "cat1", Active: true},
+			{ID: "livre", Name: "Livre", Description: "desc", Price: 10.0, CategoryID: "cat1", Active: true},
+		}, nil
+	}
+	stockDs := &testmocks.MockStockDataSource{
+		FindByProductIDFunc: func(productID string) (daos.ProductStockDAO, error) {
+			return daos.ProductStockDAO{ProductID: productID, SoldOut: true}, nil
+		},
+		FindAllFunc: func() ([]daos.ProductStockDAO, error) {
+			return []daos.ProductStockDAO{
+				{ProductID: "esgotado", SoldOut: true},
+				{ProductID: "zerado", Tracked: true, Quantity: 0},
+			}, nil
+		},
+	}
+	c := NewProductController(mockProductDs, mockCategoryDs, stockDs, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
+
+	product, err := c.FindByID("pid", "", "")
+	require.NoError(t, err)
+	require.True(t, product.SoldOut)
+	require.False(t, product.AvailableNow)
+
+	products, err := c.FindAll(dtos.ProductFilterDTO{}, nil, "", "")
+	require.NoError(t, err)
+	require.Len(t, products, 3)
+	for _, product := range products {
+		require.Equal(t, product.ID != "livre", product.SoldOut, product.ID)
+		require.Equal(t, product.ID == "livre", product.AvailableNow, product.ID)
+	}
+}
+
 func TestProductController_FindAll_Error(t *testing.T) {
 	mockCategoryDs, mockProductDs, mockFileProvider, ctrl := setupProductControllerTest(t)
 	defer ctrl.Finish()
 	mockProductDs.FindAllFunc = func() ([]daos.ProductDAO, error) {
 		return nil, errors.New("find all error")
 	}
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
-	res, err := c.FindAll(nil)
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
+	res, err := c.FindAll(dtos.ProductFilterDTO{}, nil, "", "")
 	require.Error(t, err)
 	require.Nil(t, res)
 }
@@ -123,7 +163,7 @@ func TestProductController_Update_Success(t *testing.T) {
 	mockProductDs.FindByIDFunc = func(id string) (daos.ProductDAO, error) {
 		return daos.ProductDAO{ID: id, Name: "Produto Atualizado", Description: "desc", Price: 20.0, CategoryID: "cat1", Active: true}, nil
 	}
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
 	updateDTO := dtos.UpdateProductDTO{
 		ID:          "pid",
 		CategoryID:  "cat1",
@@ -142,7 +182,7 @@ func TestProductController_Update_Error(t *testing.T) {
 	mockCategoryDs, mockProductDs, mockFileProvider, ctrl := setupProductControllerTest(t)
 	defer ctrl.Finish()
 	mockProductDs.UpdateFunc = func(dao daos.ProductDAO) error { return errors.New("update error") }
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
 	updateDTO := dtos.UpdateProductDTO{
 		ID:          "pid",
 		CategoryID:  "cat1",
@@ -165,7 +205,7 @@ func TestProductController_UploadImage_Success(t *testing.T) {
 	mockProductDs.UploadImageFunc = func(uploadDTO dtos.UploadProductImageDTO) error { return nil }
 	mockFileProvider.EXPECT().UploadFile(gomock.Any(), gomock.Any()).Return(nil)
 	mockFileProvider.EXPECT().GetPresignedURL(gomock.Any()).Return("http://localhost:8080/uploads/test-bucket/img.jpg", nil).AnyTimes()
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
 	uploadDTO := dtos.UploadProductImageDTO{
 		ProductID:   "pid",
 		FileName:    "img.jpg",
@@ -183,7 +223,7 @@ func TestProductController_UploadImage_Error(t *testing.T) {
 	}
 	mockProductDs.UploadImageFunc = func(uploadDTO dtos.UploadProductImageDTO) error { return errors.New("upload error") }
 	mockFileProvider.EXPECT().UploadFile(gomock.Any(), gomock.Any()).Return(errors.New("upload error"))
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
 	uploadDTO := dtos.UploadProductImageDTO{
 		ProductID:   "pid",
 		FileName:    "img.jpg",
@@ -207,7 +247,7 @@ func TestProductController_DeleteImage_Success(t *testing.T) {
 	}
 	mockProductDs.DeleteImageFunc = func(imageFileName string) error { return nil }
 	mockFileProvider.EXPECT().DeleteFile(gomock.Any()).Return(nil).AnyTimes()
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
 	err := c.DeleteImage("pid", "img.jpg")
 	require.NoError(t, err)
 }
@@ -217,7 +257,7 @@ func TestProductController_DeleteImage_Error(t *testing.T) {
 	defer ctrl.Finish()
 	mockProductDs.DeleteImageFunc = func(imageFileName string) error { return errors.New("delete image error") }
 	mockFileProvider.EXPECT().DeleteFiles(gomock.Any()).Return(nil).AnyTimes()
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
 	err := c.DeleteImage("pid", "img.jpg")
 	require.Error(t, err)
 }
@@ -228,22 +268,22 @@ func TestProductController_Delete_Success(t *testing.T) {
 	mockProductDs.FindByIDFunc = func(id string) (daos.ProductDAO, error) {
 		return daos.ProductDAO{ID: id, Name: "Produto Teste", Description: "desc", Price: 10.0, CategoryID: "cat1", Active: true}, nil
 	}
-	mockProductDs.DeleteFunc = func(id string) error { return nil }
+	mockProductDs.DeleteFunc = func(id string, version int64) error { return nil }
 	mockFileProvider.EXPECT().DeleteFiles(gomock.Any()).Return(nil).AnyTimes()
 	mockFileProvider.EXPECT().DeleteFile(gomock.Any()).Return(nil).AnyTimes()
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
-	err := c.Delete("pid")
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
+	err := c.Delete(dtos.DeleteProductDTO{ID: "pid"})
 	require.NoError(t, err)
 }
 
 func TestProductController_Delete_Error(t *testing.T) {
 	mockCategoryDs, mockProductDs, mockFileProvider, ctrl := setupProductControllerTest(t)
 	defer ctrl.Finish()
-	mockProductDs.DeleteFunc = func(id string) error { return errors.New("delete error") }
+	mockProductDs.DeleteFunc = func(id string, version int64) error { return errors.New("delete error") }
 	mockFileProvider.EXPECT().DeleteFiles(gomock.Any()).Return(nil).AnyTimes()
 	mockFileProvider.EXPECT().DeleteFile(gomock.Any()).Return(nil).AnyTimes()
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
-	err := c.Delete("pid")
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
+	err := c.Delete(dtos.DeleteProductDTO{ID: "pid"})
 	require.Error(t, err)
 }
 
@@ -256,7 +296,7 @@ func TestProductController_FindAllImagesProductById_Success(t *testing.T) {
 			{ID: "imgid2", ProductID: productID, FileName: "img2.jpg", CreatedAt: time.Now()},
 		}, nil
 	}
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
 	res, err := c.FindAllImagesProductById("pid")
 	require.NoError(t, err)
 	require.Len(t, res, 2)
@@ -269,8 +309,45 @@ func TestProductController_FindAllImagesProductById_Error(t *testing.T) {
 	mockProductDs.FindAllImagesProductByIdFunc = func(productID string) ([]daos.ProductImageDAO, error) {
 		return nil, errors.New("find images error")
 	}
-	c := NewProductController(mockProductDs, mockCategoryDs, mockFileProvider)
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
 	res, err := c.FindAllImagesProductById("pid")
 	require.Error(t, err)
 	require.Nil(t, res)
 }
+
+func TestProductController_GarbageCollectStorage(t *testing.T) {
+	mockCategoryDs, mockProductDs, mockFileProvider, ctrl := setupProductControllerTest(t)
+	defer ctrl.Finish()
+	mockProductDs.FindAllImageFileNamesFunc = func() ([]string, error) { return []string{"used.png"}, nil }
+	mockFileProvider.EXPECT().ListFiles().Return([]string{"used.png", "orphan.png"}, nil)
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
+	result, err := c.GarbageCollectStorage(true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"orphan.png"}, result.OrphanFiles)
+}
+
+func TestProductController_BulkUpdate(t *testing.T) {
+	mockCategoryDs, mockProductDs, mockFileProvider, ctrl := setupProductControllerTest(t)
+	defer ctrl.Finish()
+	mockProductDs.FindAllByCategoryIDFunc = func(categoryID string) ([]daos.ProductDAO, error) {
+		return []daos.ProductDAO{{ID: "pid", CategoryID: categoryID, Name: "Produto Teste", Price: 10.0, Active: true}}, nil
+	}
+	var updated daos.ProductDAO
+	mockProductDs.UpdateFunc = func(dao daos.ProductDAO) error {
+		updated = dao
+		return nil
+	}
+	c := NewProductController(mockProductDs, mockCategoryDs, &testmocks.MockStockDataSource{}, nil, nil, &testmocks.MockPromotionDataSource{}, &testmocks.MockTransactionManager{ProductDataSource: mockProductDs, CategoryDataSource: mockCategoryDs}, mockFileProvider, nil)
+	categoryID := "cat1"
+	result, err := c.BulkUpdate(dtos.BulkUpdateProductsDTO{
+		Filter: dtos.BulkProductFilterDTO{CategoryID: &categoryID},
+		Operation: dtos.BulkProductOperationDTO{
+			Type:            "adjust_price",
+			PriceAdjustment: dtos.BulkPriceAdjustmentDTO{Mode: "fixed", Value: 2.5},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, result.Applied)
+	require.Equal(t, 1, result.Changed)
+	require.Equal(t, 12.5, updated.Price)
+}
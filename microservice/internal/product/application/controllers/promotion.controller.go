@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/application/presenters"
+	"tech_challenge/internal/product/interfaces"
+	use_cases "tech_challenge/internal/product/use_cases/promotion"
+)
+
+type PromotionController struct {
+	promotionGateway gateways.PromotionGateway
+	productGateway   gateways.ProductGateway
+	categoryGateway  gateways.CategoryGateway
+	cacheGateway     gateways.CatalogCacheGateway
+}
+
+func NewPromotionController(
+	promotionDataSource interfaces.IPromotionDataSource,
+	productDataSource interfaces.IProductDataSource,
+	categoryDataSource interfaces.ICategoryDataSource,
+	catalogCache interfaces.ICatalogCache,
+) *PromotionController {
+	return &PromotionController{
+		promotionGateway: gateways.NewPromotionGateway(promotionDataSource),
+		productGateway:   *gateways.NewProductGateway(productDataSource, nil),
+		categoryGateway:  gateways.NewCategoryGateway(categoryDataSource),
+		cacheGateway:     gateways.NewCatalogCacheGateway(catalogCache),
+	}
+}
+
+func (c *PromotionController) Create(promotionDTO dtos.CreatePromotionDTO) (dtos.PromotionResultDTO, error) {
+	createPromotionUseCase := use_cases.NewCreatePromotionUseCase(c.promotionGateway, c.productGateway, c.categoryGateway, c.cacheGateway)
+
+	promotion, err := createPromotionUseCase.Execute(promotionDTO)
+
+	if err != nil {
+		return dtos.PromotionResultDTO{}, err
+	}
+
+	return presenters.PromotionFromDomainToResultDTO(promotion), nil
+}
+
+func (c *PromotionController) FindAll() ([]dtos.PromotionResultDTO, error) {
+	findAllPromotionsUseCase := use_cases.NewFindAllPromotionsUseCase(c.promotionGateway)
+
+	promotions, err := findAllPromotionsUseCase.Execute()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenters.PromotionsFromDomainToResultDTO(promotions), nil
+}
+
+func (c *PromotionController) FindByID(id string) (dtos.PromotionResultDTO, error) {
+	findPromotionByIDUseCase := use_cases.NewFindPromotionByIDUseCase(c.promotionGateway)
+
+	promotion, err := findPromotionByIDUseCase.Execute(id)
+
+	if err != nil {
+		return dtos.PromotionResultDTO{}, err
+	}
+
+	return presenters.PromotionFromDomainToResultDTO(promotion), nil
+}
+
+func (c *PromotionController) Update(promotionDTO dtos.UpdatePromotionDTO) (dtos.PromotionResultDTO, error) {
+	updatePromotionUseCase := use_cases.NewUpdatePromotionUseCase(c.promotionGateway, c.productGateway, c.categoryGateway, c.cacheGateway)
+
+	promotion, err := updatePromotionUseCase.Execute(promotionDTO)
+
+	if err != nil {
+		return dtos.PromotionResultDTO{}, err
+	}
+
+	return presenters.PromotionFromDomainToResultDTO(promotion), nil
+}
+
+func (c *PromotionController) Delete(id string) error {
+	deletePromotionUseCase := use_cases.NewDeletePromotionUseCase(c.promotionGateway, c.cacheGateway)
+
+	return deletePromotionUseCase.Execute(id)
+}
@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/application/presenters"
+	"tech_challenge/internal/product/interfaces"
+	use_cases "tech_challenge/internal/product/use_cases/stock"
+	"tech_challenge/internal/shared/config/env"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+)
+
+type StockController struct {
+	productGateway     gateways.ProductGateway
+	stockGateway       gateways.StockGateway
+	transactionGateway gateways.TransactionGateway
+	reservationTTL     time.Duration
+	now                func() time.Time
+}
+
+func NewStockController(
+	productDataSource interfaces.IProductDataSource,
+	stockDataSource interfaces.IStockDataSource,
+	transactionManager interfaces.ITransactionManager,
+	eventPublisher shared_interfaces.IEventPublisher,
+) *StockController {
+	return &StockController{
+		productGateway:     *gateways.NewProductGateway(productDataSource, nil),
+		stockGateway:       gateways.NewStockGateway(stockDataSource, eventPublisher),
+		transactionGateway: gateways.NewTransactionGateway(transactionManager, nil),
+		reservationTTL:     env.GetConfig().Stock.ReservationTTL,
+		now:                time.Now,
+	}
+}
+
+func (c *StockController) FindByProductID(productID string) (dtos.ProductStockResultDTO, error) {
+	findProductStockUseCase := use_cases.NewFindProductStockUseCase(c.productGateway, c.stockGateway)
+
+	stock, err := findProductStockUseCase.Execute(productID)
+
+	if err != nil {
+		return dtos.ProductStockResultDTO{}, err
+	}
+
+	return presenters.ProductStockFromDomainToResultDTO(stock), nil
+}
+
+func (c *StockController) Update(stockDTO dtos.UpdateProductStockDTO) (dtos.ProductStockResultDTO, error) {
+	updateProductStockUseCase := use_cases.NewUpdateProductStockUseCase(c.productGateway, c.stockGateway, c.transactionGateway)
+
+	stock, err := updateProductStockUseCase.Execute(stockDTO)
+
+	if err != nil {
+		return dtos.ProductStockResultDTO{}, err
+	}
+
+	return presenters.ProductStockFromDomainToResultDTO(stock), nil
+}
+
+// Reserve usa a validade de STOCK_RESERVATION_TTL quando o pedido não informa uma
+func (c *StockController) Reserve(reserveDTO dtos.ReserveStockDTO) (dtos.StockReservationResultDTO, error) {
+	if reserveDTO.TTL == 0 {
+		reserveDTO.TTL = c.reservationTTL
+	}
+
+	reserveStockUseCase := use_cases.NewReserveStockUseCase(c.productGateway, c.stockGateway, c.transactionGateway)
+
+	reservation, err := reserveStockUseCase.Execute(reserveDTO, c.now())
+
+	if err != nil {
+		return dtos.StockReservationResultDTO{}, err
+	}
+
+	return presenters.StockReservationFromDomainToResultDTO(*reservation), nil
+}
+
+func (c *StockController) FindReservation(id string) (dtos.StockReservationResultDTO, error) {
+	findStockReservationUseCase := use_cases.NewFindStockReservationUseCase(c.stockGateway)
+
+	reservation, err := findStockReservationUseCase.Execute(id)
+
+	if err != nil {
+		return dtos.StockReservationResultDTO{}, err
+	}
+
+	return presenters.StockReservationFromDomainToResultDTO(*reservation), nil
+}
+
+func (c *StockController) ConfirmReservation(id string) (dtos.StockReservationResultDTO, error) {
+	confirmStockReservationUseCase := use_cases.NewConfirmStockReservationUseCase(c.stockGateway, c.transactionGateway)
+
+	reservation, err := confirmStockReservationUseCase.Execute(id, c.now())
+
+	if err != nil {
+		return dtos.StockReservationResultDTO{}, err
+	}
+
+	return presenters.StockReservationFromDomainToResultDTO(*reservation), nil
+}
+
+func (c *StockController) ReleaseReservation(id string) (dtos.StockReservationResultDTO, error) {
+	releaseStockReservationUseCase := use_cases.NewReleaseStockReservationUseCase(c.stockGateway, c.transactionGateway)
+
+	reservation, err := releaseStockReservationUseCase.Execute(id, c.now())
+
+	if err != nil {
+		return dtos.StockReservationResultDTO{}, err
+	}
+
+	return presenters.StockReservationFromDomainToResultDTO(*reservation), nil
+}
+
+// ExpireReservations libera as reservas vencidas e devolve quantas foram expiradas
+func (c *StockController) ExpireReservations() (int, error) {
+	expireStockReservationsUseCase := use_cases.NewExpireStockReservationsUseCase(c.stockGateway, c.transactionGateway)
+
+	return expireStockReservationsUseCase.Execute(c.now())
+}
@@ -0,0 +1,21 @@
+package controllers
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+// findStoreOverrides carrega os ajustes das leituras feitas para uma loja.
+// Sem loja devolve o valor zero, que mantém o catálogo como cadastrado; uma
+// loja inexistente falha a leitura em vez de cair no catálogo sem ajustes
+func findStoreOverrides(gateway gateways.StoreGateway, storeID string) (entities.StoreOverrides, error) {
+	if storeID == "" {
+		return entities.StoreOverrides{}, nil
+	}
+
+	if _, err := gateway.FindByID(storeID); err != nil {
+		return entities.StoreOverrides{}, err
+	}
+
+	return gateway.FindOverrides(storeID)
+}
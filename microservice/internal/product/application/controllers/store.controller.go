@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/application/presenters"
+	"tech_challenge/internal/product/interfaces"
+	use_cases "tech_challenge/internal/product/use_cases/store"
+)
+
+type StoreController struct {
+	storeGateway       gateways.StoreGateway
+	productGateway     gateways.ProductGateway
+	categoryGateway    gateways.CategoryGateway
+	transactionGateway gateways.TransactionGateway
+	cacheGateway       gateways.CatalogCacheGateway
+}
+
+func NewStoreController(
+	storeDataSource interfaces.IStoreDataSource,
+	productDataSource interfaces.IProductDataSource,
+	categoryDataSource interfaces.ICategoryDataSource,
+	transactionManager interfaces.ITransactionManager,
+	catalogCache interfaces.ICatalogCache,
+) *StoreController {
+	return &StoreController{
+		storeGateway:       gateways.NewStoreGateway(storeDataSource),
+		productGateway:     *gateways.NewProductGateway(productDataSource, nil),
+		categoryGateway:    gateways.NewCategoryGateway(categoryDataSource),
+		transactionGateway: gateways.NewTransactionGateway(transactionManager, nil),
+		cacheGateway:       gateways.NewCatalogCacheGateway(catalogCache),
+	}
+}
+
+func (c *StoreController) Create(storeDTO dtos.CreateStoreDTO) (dtos.StoreResultDTO, error) {
+	createStoreUseCase := use_cases.NewCreateStoreUseCase(c.storeGateway, c.cacheGateway)
+
+	store, err := createStoreUseCase.Execute(storeDTO)
+
+	if err != nil {
+		return dtos.StoreResultDTO{}, err
+	}
+
+	return presenters.StoreFromDomainToResultDTO(store), nil
+}
+
+func (c *StoreController) FindAll() ([]dtos.StoreResultDTO, error) {
+	findAllStoresUseCase := use_cases.NewFindAllStoresUseCase(c.storeGateway)
+
+	stores, err := findAllStoresUseCase.Execute()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenters.StoresFromDomainToResultDTO(stores), nil
+}
+
+func (c *StoreController) FindByID(id string) (dtos.StoreResultDTO, error) {
+	findStoreByIDUseCase := use_cases.NewFindStoreByIDUseCase(c.storeGateway)
+
+	store, err := findStoreByIDUseCase.Execute(id)
+
+	if err != nil {
+		return dtos.StoreResultDTO{}, err
+	}
+
+	return presenters.StoreFromDomainToResultDTO(store), nil
+}
+
+func (c *StoreController) Update(storeDTO dtos.UpdateStoreDTO) (dtos.StoreResultDTO, error) {
+	updateStoreUseCase := use_cases.NewUpdateStoreUseCase(c.storeGateway, c.cacheGateway)
+
+	store, err := updateStoreUseCase.Execute(storeDTO)
+
+	if err != nil {
+		return dtos.StoreResultDTO{}, err
+	}
+
+	return presenters.StoreFromDomainToResultDTO(store), nil
+}
+
+func (c *StoreController) Delete(id string) error {
+	deleteStoreUseCase := use_cases.NewDeleteStoreUseCase(c.storeGateway, c.cacheGateway)
+
+	return deleteStoreUseCase.Execute(id)
+}
+
+func (c *StoreController) FindOverrides(storeID string) (dtos.StoreOverridesResultDTO, error) {
+	findStoreOverridesUseCase := use_cases.NewFindStoreOverridesUseCase(c.storeGateway)
+
+	overrides, err := findStoreOverridesUseCase.Execute(storeID)
+
+	if err != nil {
+		return dtos.StoreOverridesResultDTO{}, err
+	}
+
+	return presenters.StoreOverridesFromDomainToResultDTO(overrides), nil
+}
+
+func (c *StoreController) SaveOverrides(saveDTO dtos.SaveStoreOverridesDTO) (dtos.StoreOverridesResultDTO, error) {
+	saveStoreOverridesUseCase := use_cases.NewSaveStoreOverridesUseCase(c.storeGateway, c.productGateway, c.categoryGateway, c.transactionGateway, c.cacheGateway)
+
+	overrides, err := saveStoreOverridesUseCase.Execute(saveDTO)
+
+	if err != nil {
+		return dtos.StoreOverridesResultDTO{}, err
+	}
+
+	return presenters.StoreOverridesFromDomainToResultDTO(overrides), nil
+}
+
+func (c *StoreController) ClearOverrides(storeID string) error {
+	clearStoreOverridesUseCase := use_cases.NewClearStoreOverridesUseCase(c.storeGateway, c.transactionGateway, c.cacheGateway)
+
+	return clearStoreOverridesUseCase.Execute(storeID)
+}
@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/application/presenters"
+	"tech_challenge/internal/product/interfaces"
+	use_cases "tech_challenge/internal/product/use_cases/translation"
+)
+
+type TranslationController struct {
+	productGateway     gateways.ProductGateway
+	categoryGateway    gateways.CategoryGateway
+	translationGateway gateways.TranslationGateway
+	transactionGateway gateways.TransactionGateway
+	cacheGateway       gateways.CatalogCacheGateway
+}
+
+func NewTranslationController(
+	productDataSource interfaces.IProductDataSource,
+	categoryDataSource interfaces.ICategoryDataSource,
+	translationDataSource interfaces.ITranslationDataSource,
+	transactionManager interfaces.ITransactionManager,
+	catalogCache interfaces.ICatalogCache,
+) *TranslationController {
+	return &TranslationController{
+		productGateway:     *gateways.NewProductGateway(productDataSource, nil),
+		categoryGateway:    gateways.NewCategoryGateway(categoryDataSource),
+		translationGateway: gateways.NewTranslationGateway(translationDataSource),
+		transactionGateway: gateways.NewTransactionGateway(transactionManager, nil),
+		cacheGateway:       gateways.NewCatalogCacheGateway(catalogCache),
+	}
+}
+
+func (c *TranslationController) FindAll(target string, entityID string) ([]dtos.TranslationResultDTO, error) {
+	findTranslationsUseCase := use_cases.NewFindTranslationsUseCase(c.productGateway, c.categoryGateway, c.translationGateway)
+
+	translations, err := findTranslationsUseCase.Execute(target, entityID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenters.TranslationsFromDomainToResultDTO(translations), nil
+}
+
+func (c *TranslationController) Save(translationDTO dtos.SaveTranslationDTO) (dtos.TranslationResultDTO, error) {
+	saveTranslationUseCase := use_cases.NewSaveTranslationUseCase(c.transactionGateway, c.cacheGateway)
+
+	translation, err := saveTranslationUseCase.Execute(translationDTO)
+
+	if err != nil {
+		return dtos.TranslationResultDTO{}, err
+	}
+
+	return presenters.TranslationFromDomainToResultDTO(translation), nil
+}
+
+func (c *TranslationController) Delete(deleteDTO dtos.DeleteTranslationDTO) error {
+	deleteTranslationUseCase := use_cases.NewDeleteTranslationUseCase(c.transactionGateway, c.cacheGateway)
+
+	return deleteTranslationUseCase.Execute(deleteDTO)
+}
+
+// FindMissing devolve o relatório do idioma informado ou, vazio, de todos
+func (c *TranslationController) FindMissing(locale string) ([]dtos.MissingTranslationsDTO, error) {
+	findMissingTranslationsUseCase := use_cases.NewFindMissingTranslationsUseCase(c.productGateway, c.categoryGateway, c.translationGateway)
+
+	return findMissingTranslationsUseCase.Execute(locale)
+}
@@ -0,0 +1,25 @@
+package dtos
+
+type WeeklyAvailabilityDTO struct {
+	Weekdays []string
+	From     string
+	To       string
+}
+
+type AvailabilityExceptionDTO struct {
+	Date string
+	From string
+	To   string
+}
+
+// AvailabilityDTO sem nenhum campo preenchido remove as restrições de horário
+type AvailabilityDTO struct {
+	Weekly     []WeeklyAvailabilityDTO
+	StartDate  string
+	EndDate    string
+	Exceptions []AvailabilityExceptionDTO
+}
+
+func (d *AvailabilityDTO) IsEmpty() bool {
+	return d == nil || (len(d.Weekly) == 0 && d.StartDate == "" && d.EndDate == "" && len(d.Exceptions) == 0)
+}
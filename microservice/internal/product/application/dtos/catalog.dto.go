@@ -0,0 +1,89 @@
+package dtos
+
+type CatalogDTO struct {
+	Categories []CategoryResultDTO
+	Products   []ProductResultDTO
+}
+
+type ImportCategoryDTO struct {
+	Row         int
+	ID          string
+	ExternalKey string
+	Name        string
+	Active      bool
+}
+
+type ImportProductDTO struct {
+	Row         int
+	ID          string
+	ExternalKey string
+	CategoryID  string
+	CategoryKey string
+	Name        string
+	Description string
+	Price       float64
+	Active      bool
+}
+
+// RowErrors são as linhas que não puderam ser lidas do arquivo; qualquer uma
+// delas impede a aplicação, como os erros de validação
+type ImportCatalogDTO struct {
+	Categories        []ImportCategoryDTO
+	Products          []ImportProductDTO
+	RowErrors         []ImportRowErrorDTO
+	DryRun            bool
+	DeactivateMissing bool
+}
+
+type ImportRowErrorDTO struct {
+	Entity  string
+	Row     int
+	Field   string
+	Message string
+}
+
+type ImportCatalogResultDTO struct {
+	DryRun                bool
+	Applied               bool
+	CategoriesCreated     int
+	CategoriesUpdated     int
+	CategoriesDeactivated int
+	ProductsCreated       int
+	ProductsUpdated       int
+	ProductsDeactivated   int
+	Errors                []ImportRowErrorDTO
+}
+
+type SeedCategoryDTO struct {
+	Key    string
+	ID     string
+	Name   string
+	Active bool
+}
+
+type SeedImageDTO struct {
+	FileName    string
+	FileContent []byte
+}
+
+type SeedProductDTO struct {
+	Key         string
+	ID          string
+	CategoryKey string
+	Name        string
+	Description string
+	Price       float64
+	Active      bool
+	Images      []SeedImageDTO
+}
+
+type SeedCatalogDTO struct {
+	Categories []SeedCategoryDTO
+	Products   []SeedProductDTO
+}
+
+type SeedCatalogResultDTO struct {
+	ImportCatalogResultDTO
+	ImagesUploaded int
+	ImagesSkipped  int
+}
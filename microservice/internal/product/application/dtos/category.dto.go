@@ -1,18 +1,84 @@
 package dtos
 
+import "time"
+
 type CreateCategoryDTO struct {
-	Name   string
-	Active bool
+	ParentID     string
+	Name         string
+	Description  string
+	Active       bool
+	Availability *AvailabilityDTO
 }
 
 type UpdateCategoryDTO struct {
-	ID     string
-	Name   string
-	Active bool
+	ID           string
+	ParentID     string
+	Name         string
+	Description  string
+	Active       bool
+	Availability *AvailabilityDTO
+	Precondition Precondition
+}
+
+// PatchCategoryDTO traz apenas os campos enviados no merge patch; nil mantém
+// o valor atual, ParentID vazio torna a categoria raiz e uma Availability
+// vazia remove a grade
+type PatchCategoryDTO struct {
+	ID           string
+	ParentID     *string
+	Name         *string
+	Description  *string
+	Active       *bool
+	Availability *AvailabilityDTO
+	Precondition Precondition
+}
+
+type ReorderCategoriesDTO struct {
+	CategoryIDs []string
+}
+
+type UploadCategoryImageDTO struct {
+	CategoryID  string
+	FileName    string
+	FileContent []byte
 }
 
 type CategoryResultDTO struct {
-	ID     string
-	Name   string
-	Active bool
+	ID            string
+	ExternalKey   string
+	ParentID      string
+	Name          string
+	Description   string
+	Position      int
+	ImageFileName string
+	ImageUrl      string
+	Active        bool
+	Availability  *AvailabilityDTO
+	AvailableNow  bool
+	// Scheduled indica que AvailableNow depende do horário, então a resposta
+	// pode mudar sem que a versão mude
+	Scheduled bool
+	Version   int64
+	UpdatedAt time.Time
+}
+
+type CategoryTreeDTO struct {
+	CategoryResultDTO
+	Children []CategoryTreeDTO
+}
+
+type DeleteCategoryDTO struct {
+	ID               string
+	Strategy         string
+	TargetCategoryID string
+	Precondition     Precondition
+}
+
+type DeleteCategoryResultDTO struct {
+	CategoryID            string
+	Strategy              string
+	TargetCategoryID      string
+	Deleted               bool
+	DeactivatedCategories []string
+	AffectedProducts      []string
 }
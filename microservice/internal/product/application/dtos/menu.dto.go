@@ -0,0 +1,12 @@
+package dtos
+
+type MenuSectionDTO struct {
+	Category      CategoryResultDTO
+	Products      []MenuProductDTO
+	Subcategories []MenuSectionDTO
+}
+
+type MenuProductDTO struct {
+	ProductResultDTO
+	SoldOut bool
+}
@@ -0,0 +1,21 @@
+package dtos
+
+type NutritionFactsDTO struct {
+	ServingSize   float64
+	ServingUnit   string
+	EnergyKcal    float64
+	Carbohydrates float64
+	TotalSugars   float64
+	AddedSugars   float64
+	Proteins      float64
+	TotalFat      float64
+	SaturatedFat  float64
+	TransFat      float64
+	DietaryFiber  float64
+	Sodium        float64
+}
+
+// IsEmpty é verdadeiro para nil e para o DTO zerado, que remove a tabela
+func (d *NutritionFactsDTO) IsEmpty() bool {
+	return d == nil || *d == NutritionFactsDTO{}
+}
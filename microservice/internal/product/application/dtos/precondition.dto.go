@@ -0,0 +1,18 @@
+package dtos
+
+import "slices"
+
+// Precondition traz as versões aceitas no If-Match de uma alteração. Sem
+// If-Match (valor zero) ou com If-Match: * qualquer versão atual é aceita.
+type Precondition struct {
+	IfMatch  bool
+	Any      bool
+	Versions []int64
+}
+
+func (p Precondition) Matches(version int64) bool {
+	if !p.IfMatch || p.Any {
+		return true
+	}
+	return slices.Contains(p.Versions, version)
+}
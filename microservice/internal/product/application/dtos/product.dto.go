@@ -1,20 +1,51 @@
 package dtos
 
+import "time"
+
 type CreateProductDTO struct {
-	CategoryID  string
-	Name        string
-	Description string
-	Price       float64
-	Active      bool
+	CategoryID   string
+	Name         string
+	Description  string
+	Price        float64
+	Active       bool
+	Availability *AvailabilityDTO
+	Nutrition    *NutritionFactsDTO
+	// Allergens nil deixa os alérgenos como não declarados
+	Allergens []string
 }
 
 type UpdateProductDTO struct {
-	ID          string
-	Name        string
-	Description string
-	Price       float64
-	Active      bool
-	CategoryID  string
+	ID           string
+	Name         string
+	Description  string
+	Price        float64
+	Active       bool
+	CategoryID   string
+	Availability *AvailabilityDTO
+	Nutrition    *NutritionFactsDTO
+	Allergens    []string
+	Precondition Precondition
+}
+
+// PatchProductDTO traz apenas os campos enviados no merge patch; nil mantém
+// o valor atual, uma Availability ou Nutrition vazia remove a grade ou a
+// tabela e Allergens apontando para nil volta a "não declarado"
+type PatchProductDTO struct {
+	ID           string
+	Name         *string
+	Description  *string
+	Price        *float64
+	Active       *bool
+	CategoryID   *string
+	Availability *AvailabilityDTO
+	Nutrition    *NutritionFactsDTO
+	Allergens    *[]string
+	Precondition Precondition
+}
+
+type DeleteProductDTO struct {
+	ID           string
+	Precondition Precondition
 }
 
 type UploadProductImageDTO struct {
@@ -31,11 +62,95 @@ type ProductImageDTO struct {
 }
 
 type ProductResultDTO struct {
-	ID          string
-	Name        string
-	Description string
-	Price       float64
-	Active      bool
-	CategoryID  string
-	Images      []ProductImageDTO
+	ID           string
+	ExternalKey  string
+	Name         string
+	Description  string
+	Price        float64
+	Active       bool
+	CategoryID   string
+	Images       []ProductImageDTO
+	Availability *AvailabilityDTO
+	Nutrition    *NutritionFactsDTO
+	Allergens    []string
+	// AvailableNow considera ativação, estoque e grades do produto e das
+	// categorias acima dele no horário avaliado
+	AvailableNow bool
+	// SoldOut indica o produto esgotado, pela marcação manual ou pelo estoque
+	// controlado zerado
+	SoldOut bool
+	// Scheduled indica que AvailableNow depende do horário, então a resposta
+	// pode mudar sem que a versão mude
+	Scheduled bool
+	// OriginalPrice é o preço antes das promoções e EffectivePrice o preço
+	// cobrado; AppliedPromotions vem na ordem de aplicação
+	OriginalPrice     float64
+	EffectivePrice    float64
+	AppliedPromotions []AppliedPromotionDTO
+	Version           int64
+	UpdatedAt         time.Time
+}
+
+// ProductFilterDTO restringe a listagem de produtos; campos vazios não filtram
+type ProductFilterDTO struct {
+	CategoryID       *string
+	ExcludeAllergens []string
+}
+
+// ProductBatchResultDTO traz os produtos encontrados na ordem pedida e os IDs
+// que não existem
+type ProductBatchResultDTO struct {
+	Products   []ProductResultDTO
+	MissingIDs []string
+}
+
+type StorageGarbageCollectionResultDTO struct {
+	DryRun          bool
+	StoredFiles     int
+	ReferencedFiles int
+	OrphanFiles     []string
+}
+
+type BulkProductFilterDTO struct {
+	CategoryID *string
+	IDs        []string
+	Active     *bool
+}
+
+type BulkPriceAdjustmentDTO struct {
+	Mode     string
+	Value    float64
+	Rounding string
+}
+
+type BulkProductOperationDTO struct {
+	Type             string
+	TargetCategoryID string
+	PriceAdjustment  BulkPriceAdjustmentDTO
+}
+
+type BulkUpdateProductsDTO struct {
+	Filter    BulkProductFilterDTO
+	Operation BulkProductOperationDTO
+	DryRun    bool
+}
+
+type BulkProductChangeDTO struct {
+	ProductID          string
+	Name               string
+	PreviousActive     bool
+	Active             bool
+	PreviousCategoryID string
+	CategoryID         string
+	PreviousPrice      float64
+	Price              float64
+}
+
+type BulkUpdateProductsResultDTO struct {
+	DryRun    bool
+	Applied   bool
+	Operation string
+	Matched   int
+	Changed   int
+	Changes   []BulkProductChangeDTO
 }
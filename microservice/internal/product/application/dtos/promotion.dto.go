@@ -0,0 +1,45 @@
+package dtos
+
+import "time"
+
+type CreatePromotionDTO struct {
+	Name          string
+	DiscountType  string
+	DiscountValue float64
+	ProductIDs    []string
+	CategoryIDs   []string
+	Schedule      *AvailabilityDTO
+	Priority      int
+	Stackable     bool
+	Active        bool
+}
+
+type UpdatePromotionDTO struct {
+	ID string
+	CreatePromotionDTO
+}
+
+type PromotionResultDTO struct {
+	ID            string
+	Name          string
+	DiscountType  string
+	DiscountValue float64
+	ProductIDs    []string
+	CategoryIDs   []string
+	Schedule      *AvailabilityDTO
+	Priority      int
+	Stackable     bool
+	Active        bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// AppliedPromotionDTO é uma promoção aplicada ao preço de um produto, com o
+// valor descontado por ela
+type AppliedPromotionDTO struct {
+	ID            string
+	Name          string
+	DiscountType  string
+	DiscountValue float64
+	Amount        float64
+}
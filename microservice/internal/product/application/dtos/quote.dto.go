@@ -0,0 +1,35 @@
+package dtos
+
+type QuoteItemDTO struct {
+	ProductID string
+	Quantity  int
+	Options   []string
+}
+
+type QuoteIssueDTO struct {
+	Code    string
+	Message string
+}
+
+// QuoteLineDTO é um item cotado. Name e os preços ficam vazios quando o
+// produto não existe
+type QuoteLineDTO struct {
+	ProductID          string
+	Name               string
+	Quantity           int
+	Options            []string
+	UnitPrice          float64
+	EffectiveUnitPrice float64
+	Total              float64
+	AppliedPromotions  []AppliedPromotionDTO
+	Orderable          bool
+	Issues             []QuoteIssueDTO
+}
+
+type QuoteResultDTO struct {
+	Lines     []QuoteLineDTO
+	Subtotal  float64
+	Discount  float64
+	Total     float64
+	Orderable bool
+}
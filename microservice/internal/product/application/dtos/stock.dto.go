@@ -0,0 +1,41 @@
+package dtos
+
+import "time"
+
+type UpdateProductStockDTO struct {
+	ProductID string
+	Tracked   bool
+	Quantity  int
+	SoldOut   bool
+}
+
+// ProductStockResultDTO traz a marcação manual em SoldOut e, em Available, se
+// o produto pode ser vendido agora considerando também a quantidade
+type ProductStockResultDTO struct {
+	ProductID string
+	Tracked   bool
+	Quantity  int
+	SoldOut   bool
+	Available bool
+	UpdatedAt time.Time
+}
+
+type StockReservationItemDTO struct {
+	ProductID string
+	Quantity  int
+}
+
+// ReserveStockDTO usa a validade padrão de STOCK_RESERVATION_TTL quando TTL é zero
+type ReserveStockDTO struct {
+	Items []StockReservationItemDTO
+	TTL   time.Duration
+}
+
+type StockReservationResultDTO struct {
+	ID        string
+	Items     []StockReservationItemDTO
+	Status    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,49 @@
+package dtos
+
+import "time"
+
+type CreateStoreDTO struct {
+	Name string
+}
+
+type UpdateStoreDTO struct {
+	ID   string
+	Name string
+}
+
+type StoreResultDTO struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ProductStoreOverrideDTO traz só os campos ajustados na loja; sem nenhum
+// campo, o ajuste do produto é removido
+type ProductStoreOverrideDTO struct {
+	ProductID    string
+	Price        *float64
+	Active       *bool
+	Availability *AvailabilityDTO
+	UpdatedAt    time.Time
+}
+
+type CategoryStoreOverrideDTO struct {
+	CategoryID string
+	Visible    *bool
+	UpdatedAt  time.Time
+}
+
+// SaveStoreOverridesDTO grava os ajustes informados; os demais ajustes da
+// loja ficam como estão
+type SaveStoreOverridesDTO struct {
+	StoreID    string
+	Products   []ProductStoreOverrideDTO
+	Categories []CategoryStoreOverrideDTO
+}
+
+type StoreOverridesResultDTO struct {
+	StoreID    string
+	Products   []ProductStoreOverrideDTO
+	Categories []CategoryStoreOverrideDTO
+}
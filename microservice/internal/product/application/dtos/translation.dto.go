@@ -0,0 +1,52 @@
+package dtos
+
+import "time"
+
+// Targets aceitos nas traduções
+const (
+	ProductTranslationTarget  = "product"
+	CategoryTranslationTarget = "category"
+)
+
+// SaveTranslationDTO cria ou substitui a tradução de um produto ou categoria
+// (Target "product" ou "category") num idioma
+type SaveTranslationDTO struct {
+	Target      string
+	EntityID    string
+	Locale      string
+	Name        string
+	Description string
+}
+
+type DeleteTranslationDTO struct {
+	Target   string
+	EntityID string
+	Locale   string
+}
+
+type TranslationResultDTO struct {
+	Locale      string
+	Name        string
+	Description string
+	UpdatedAt   time.Time
+}
+
+// MissingTranslationsDTO é o relatório de um idioma: quantos itens existem,
+// quantos estão totalmente traduzidos e quais faltam
+type MissingTranslationsDTO struct {
+	Locale     string
+	Products   TranslationCoverageDTO
+	Categories TranslationCoverageDTO
+}
+
+type TranslationCoverageDTO struct {
+	Total    int
+	Complete int
+	Missing  []TranslationGapDTO
+}
+
+type TranslationGapDTO struct {
+	ID            string
+	Name          string
+	MissingFields []string
+}
@@ -0,0 +1,58 @@
+package gateways
+
+import (
+	"tech_challenge/internal/product/daos"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+// A grade já foi validada ao ser gravada, então a leitura só copia os campos
+
+func availabilityToDAO(availability *value_objects.Availability) *daos.AvailabilityDAO {
+	if availability == nil {
+		return nil
+	}
+
+	availabilityDAO := &daos.AvailabilityDAO{
+		StartDate: availability.StartDate,
+		EndDate:   availability.EndDate,
+	}
+	for _, window := range availability.Weekly {
+		availabilityDAO.Weekly = append(availabilityDAO.Weekly, daos.WeeklyAvailabilityDAO{
+			Weekdays:     window.Weekdays,
+			TimeRangeDAO: daos.TimeRangeDAO{From: window.From, To: window.To},
+		})
+	}
+	for _, exception := range availability.Exceptions {
+		availabilityDAO.Exceptions = append(availabilityDAO.Exceptions, daos.AvailabilityExceptionDAO{
+			Date:         exception.Date,
+			TimeRangeDAO: daos.TimeRangeDAO{From: exception.From, To: exception.To},
+		})
+	}
+
+	return availabilityDAO
+}
+
+func availabilityFromDAO(availabilityDAO *daos.AvailabilityDAO) *value_objects.Availability {
+	if availabilityDAO == nil {
+		return nil
+	}
+
+	availability := &value_objects.Availability{
+		StartDate: availabilityDAO.StartDate,
+		EndDate:   availabilityDAO.EndDate,
+	}
+	for _, window := range availabilityDAO.Weekly {
+		availability.Weekly = append(availability.Weekly, value_objects.WeeklyAvailability{
+			Weekdays:  window.Weekdays,
+			TimeRange: value_objects.TimeRange{From: window.From, To: window.To},
+		})
+	}
+	for _, exception := range availabilityDAO.Exceptions {
+		availability.Exceptions = append(availability.Exceptions, value_objects.AvailabilityException{
+			Date:      exception.Date,
+			TimeRange: value_objects.TimeRange{From: exception.From, To: exception.To},
+		})
+	}
+
+	return availability
+}
@@ -0,0 +1,31 @@
+package gateways
+
+import (
+	"log"
+
+	"tech_challenge/internal/product/interfaces"
+)
+
+// CatalogCacheGateway é chamado pelos casos de uso depois de gravar no
+// catálogo, já com a transação confirmada. A limpeza acontece mesmo quando a
+// gravação falha, pois uma falha no commit não garante que nada foi gravado,
+// e uma falha do cache só vai para o log: as entradas expiram pelo TTL
+type CatalogCacheGateway struct {
+	cache interfaces.ICatalogCache
+}
+
+func NewCatalogCacheGateway(cache interfaces.ICatalogCache) CatalogCacheGateway {
+	return CatalogCacheGateway{
+		cache: cache,
+	}
+}
+
+func (g *CatalogCacheGateway) Invalidate() {
+	if g.cache == nil {
+		return
+	}
+
+	if err := g.cache.Invalidate(); err != nil {
+		log.Printf("catalog cache invalidation failed: %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package gateways
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testenv "tech_challenge/internal/shared/test"
+)
+
+func TestCatalogCacheGateway_Invalidate(t *testing.T) {
+	cache := &testenv.MockCatalogCache{}
+	gw := NewCatalogCacheGateway(cache)
+
+	gw.Invalidate()
+
+	require.Equal(t, 1, cache.Invalidations)
+}
+
+func TestCatalogCacheGateway_InvalidateFailureIsOnlyLogged(t *testing.T) {
+	cache := &testenv.MockCatalogCache{InvalidateFunc: func() error { return errors.New("redis down") }}
+	gw := NewCatalogCacheGateway(cache)
+
+	require.NotPanics(t, gw.Invalidate)
+	require.Equal(t, 1, cache.Invalidations)
+}
+
+func TestCatalogCacheGateway_WithoutCache(t *testing.T) {
+	gw := NewCatalogCacheGateway(nil)
+
+	require.NotPanics(t, gw.Invalidate)
+}
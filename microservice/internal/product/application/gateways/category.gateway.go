@@ -1,8 +1,11 @@
 package gateways
 
 import (
+	"time"
+
 	"tech_challenge/internal/product/daos"
 	"tech_challenge/internal/product/domain/entities"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
 	"tech_challenge/internal/product/interfaces"
 )
 
@@ -17,11 +20,7 @@ func NewCategoryGateway(dataSource interfaces.ICategoryDataSource) CategoryGatew
 }
 
 func (g *CategoryGateway) Insert(category entities.Category) error {
-	return g.dataSource.Insert(daos.CategoryDAO{
-		ID:     category.ID,
-		Name:   category.Name.Value(),
-		Active: category.Active,
-	})
+	return g.dataSource.Insert(categoryToDAO(category))
 }
 
 func (g *CategoryGateway) FindAll() ([]*entities.Category, error) {
@@ -31,13 +30,25 @@ func (g *CategoryGateway) FindAll() ([]*entities.Category, error) {
 		return nil, err
 	}
 
+	return categoriesFromDAO(categories)
+}
+
+// FindAllWithAncestors devolve as categorias informadas e as categorias acima
+// delas, o suficiente para montar a árvore de disponibilidade dessas categorias
+func (g *CategoryGateway) FindAllWithAncestors(ids []string) ([]*entities.Category, error) {
+	categories, err := g.dataSource.FindAllWithAncestors(ids)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return categoriesFromDAO(categories)
+}
+
+func categoriesFromDAO(categories []daos.CategoryDAO) ([]*entities.Category, error) {
 	result := make([]*entities.Category, 0, len(categories))
 	for _, category := range categories {
-		categoryEntity, err := entities.NewCategory(
-			category.ID,
-			category.Name,
-			category.Active,
-		)
+		categoryEntity, err := categoryFromDAO(category)
 
 		if err != nil {
 			return nil, err
@@ -56,6 +67,65 @@ func (g *CategoryGateway) FindByID(id string) (*entities.Category, error) {
 		return nil, err
 	}
 
+	return categoryFromDAO(category)
+}
+
+func (g *CategoryGateway) FindByExternalKey(externalKey string) (*entities.Category, error) {
+	category, err := g.dataSource.FindByExternalKey(externalKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return categoryFromDAO(category)
+}
+
+// Update só grava se a categoria não mudou desde a leitura (mesma Version) e,
+// em caso de sucesso, avança a versão e o UpdatedAt da entidade
+func (g *CategoryGateway) Update(category *entities.Category) error {
+	categoryDAO := categoryToDAO(*category)
+	categoryDAO.UpdatedAt = time.Now()
+
+	if err := g.dataSource.Update(categoryDAO); err != nil {
+		return err
+	}
+
+	category.Version++
+	category.UpdatedAt = categoryDAO.UpdatedAt
+	return nil
+}
+
+func (g *CategoryGateway) UpdatePositions(orderedIDs []string) error {
+	return g.dataSource.UpdatePositions(orderedIDs)
+}
+
+func (g *CategoryGateway) Delete(id string, version int64) error {
+	return g.dataSource.Delete(id, version)
+}
+
+func categoryToDAO(category entities.Category) daos.CategoryDAO {
+	categoryDAO := daos.CategoryDAO{
+		ID:           category.ID,
+		ExternalKey:  category.ExternalKey,
+		ParentID:     category.ParentID,
+		Name:         category.Name.Value(),
+		Description:  category.Description,
+		Position:     category.Position,
+		Active:       category.Active,
+		Availability: availabilityToDAO(category.Availability),
+		Version:      category.Version,
+		UpdatedAt:    category.UpdatedAt,
+	}
+
+	if category.Image != nil {
+		categoryDAO.ImageFileName = category.Image.FileName
+		categoryDAO.ImageUrl = category.Image.Url
+	}
+
+	return categoryDAO
+}
+
+func categoryFromDAO(category daos.CategoryDAO) (*entities.Category, error) {
 	categoryEntity, err := entities.NewCategory(
 		category.ID,
 		category.Name,
@@ -66,17 +136,21 @@ func (g *CategoryGateway) FindByID(id string) (*entities.Category, error) {
 		return nil, err
 	}
 
-	return categoryEntity, nil
-}
-
-func (g *CategoryGateway) Update(category entities.Category) error {
-	return g.dataSource.Update(daos.CategoryDAO{
-		ID:     category.ID,
-		Name:   category.Name.Value(),
-		Active: category.Active,
-	})
-}
+	categoryEntity.ExternalKey = category.ExternalKey
+	categoryEntity.ParentID = category.ParentID
+	categoryEntity.Description = category.Description
+	categoryEntity.Position = category.Position
+	categoryEntity.Availability = availabilityFromDAO(category.Availability)
+	categoryEntity.Version = category.Version
+	categoryEntity.UpdatedAt = category.UpdatedAt
+
+	if category.ImageFileName != "" {
+		categoryEntity.Image = &value_objects.Image{
+			FileName:  category.ImageFileName,
+			Url:       category.ImageUrl,
+			IsDefault: true,
+		}
+	}
 
-func (g *CategoryGateway) Delete(id string) error {
-	return g.dataSource.Delete(id)
+	return categoryEntity, nil
 }
@@ -5,16 +5,19 @@ import (
 	"tech_challenge/internal/product/daos"
 	"tech_challenge/internal/product/domain/entities"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
 
 type mockCategoryDataSource struct {
-	insertFunc   func(dao daos.CategoryDAO) error
-	findAllFunc  func() ([]daos.CategoryDAO, error)
-	findByIDFunc func(id string) (daos.CategoryDAO, error)
-	updateFunc   func(dao daos.CategoryDAO) error
-	deleteFunc   func(id string) error
+	insertFunc            func(dao daos.CategoryDAO) error
+	findAllFunc           func() ([]daos.CategoryDAO, error)
+	findByIDFunc          func(id string) (daos.CategoryDAO, error)
+	updateFunc            func(dao daos.CategoryDAO) error
+	deleteFunc            func(id string) error
+	findByExternalKeyFunc func(externalKey string) (daos.CategoryDAO, error)
+	updatePositionsFunc   func(orderedIDs []string) error
 }
 
 func (m *mockCategoryDataSource) Insert(dao daos.CategoryDAO) error {
@@ -23,15 +26,24 @@ func (m *mockCategoryDataSource) Insert(dao daos.CategoryDAO) error {
 func (m *mockCategoryDataSource) FindAll() ([]daos.CategoryDAO, error) {
 	return m.findAllFunc()
 }
+func (m *mockCategoryDataSource) FindAllWithAncestors(ids []string) ([]daos.CategoryDAO, error) {
+	return m.findAllFunc()
+}
 func (m *mockCategoryDataSource) FindByID(id string) (daos.CategoryDAO, error) {
 	return m.findByIDFunc(id)
 }
 func (m *mockCategoryDataSource) Update(dao daos.CategoryDAO) error {
 	return m.updateFunc(dao)
 }
-func (m *mockCategoryDataSource) Delete(id string) error {
+func (m *mockCategoryDataSource) Delete(id string, version int64) error {
 	return m.deleteFunc(id)
 }
+func (m *mockCategoryDataSource) FindByExternalKey(externalKey string) (daos.CategoryDAO, error) {
+	return m.findByExternalKeyFunc(externalKey)
+}
+func (m *mockCategoryDataSource) UpdatePositions(orderedIDs []string) error {
+	return m.updatePositionsFunc(orderedIDs)
+}
 
 func TestCategoryGateway_Insert(t *testing.T) {
 	gw := NewCategoryGateway(&mockCategoryDataSource{
@@ -105,16 +117,61 @@ func TestCategoryGateway_FindByID_Error_Entity(t *testing.T) {
 }
 
 func TestCategoryGateway_Update(t *testing.T) {
+	var saved daos.CategoryDAO
 	gw := NewCategoryGateway(&mockCategoryDataSource{
-		updateFunc: func(dao daos.CategoryDAO) error { return nil },
+		updateFunc: func(dao daos.CategoryDAO) error {
+			saved = dao
+			return nil
+		},
 	})
 	cat, _ := entities.NewCategory("id", "Bebidas", true)
-	require.NoError(t, gw.Update(*cat))
+	cat.UpdatedAt = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, gw.Update(cat))
+	require.Equal(t, int64(2), cat.Version)
+	require.True(t, cat.UpdatedAt.After(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.Equal(t, saved.UpdatedAt, cat.UpdatedAt)
 }
 
 func TestCategoryGateway_Delete(t *testing.T) {
 	gw := NewCategoryGateway(&mockCategoryDataSource{
 		deleteFunc: func(id string) error { return nil },
 	})
-	require.NoError(t, gw.Delete("id"))
+	require.NoError(t, gw.Delete("id", 1))
+}
+
+func TestCategoryGateway_DisplayMetadata(t *testing.T) {
+	var saved daos.CategoryDAO
+	gw := NewCategoryGateway(&mockCategoryDataSource{
+		findByIDFunc: func(id string) (daos.CategoryDAO, error) {
+			return daos.CategoryDAO{ID: id, Name: "Bebidas", Description: "Geladas", Position: 2, ImageFileName: "icon.png", ImageUrl: "http://localhost/icon.png"}, nil
+		},
+		updateFunc: func(dao daos.CategoryDAO) error {
+			saved = dao
+			return nil
+		},
+	})
+
+	cat, err := gw.FindByID("catid")
+	require.NoError(t, err)
+	require.Equal(t, "Geladas", cat.Description)
+	require.Equal(t, 2, cat.Position)
+	require.Equal(t, "icon.png", cat.Image.FileName)
+
+	_, _ = cat.RemoveImage()
+	require.NoError(t, gw.Update(cat))
+	require.Equal(t, 2, saved.Position)
+	require.Empty(t, saved.ImageFileName)
+	require.Empty(t, saved.ImageUrl)
+}
+
+func TestCategoryGateway_UpdatePositions(t *testing.T) {
+	var got []string
+	gw := NewCategoryGateway(&mockCategoryDataSource{
+		updatePositionsFunc: func(orderedIDs []string) error {
+			got = orderedIDs
+			return nil
+		},
+	})
+	require.NoError(t, gw.UpdatePositions([]string{"b", "a"}))
+	require.Equal(t, []string{"b", "a"}, got)
 }
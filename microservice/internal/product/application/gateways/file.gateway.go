@@ -0,0 +1,41 @@
+package gateways
+
+import (
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+)
+
+// FileGateway expõe o provedor de arquivos (MinIO/S3) para imagens que não
+// pertencem a produtos, como o ícone das categorias
+type FileGateway struct {
+	fileService shared_interfaces.IFileProvider
+}
+
+func NewFileGateway(fileService shared_interfaces.IFileProvider) FileGateway {
+	return FileGateway{
+		fileService: fileService,
+	}
+}
+
+func (g *FileGateway) UploadImage(fileName string, fileContent []byte) (string, error) {
+	return uploadImage(g.fileService, fileName, fileContent)
+}
+
+func (g *FileGateway) DeleteImage(fileName string) error {
+	return g.fileService.DeleteFile(fileName)
+}
+
+func uploadImage(fileService shared_interfaces.IFileProvider, fileName string, fileContent []byte) (string, error) {
+	err := fileService.UploadFile(fileName, fileContent)
+	if err != nil {
+		return "", err
+	}
+	return presignedImageUrl(fileService, fileName), nil
+}
+
+func presignedImageUrl(fileService shared_interfaces.IFileProvider, fileName string) string {
+	url, err := fileService.GetPresignedURL(fileName)
+	if err != nil {
+		return ""
+	}
+	return url
+}
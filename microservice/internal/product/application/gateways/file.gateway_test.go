@@ -0,0 +1,26 @@
+package gateways
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileGateway_UploadImage(t *testing.T) {
+	gw := NewFileGateway(&mockFileProvider{})
+	url, err := gw.UploadImage("icon.png", []byte("data"))
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost/icon.png", url)
+}
+
+func TestFileGateway_UploadImage_Error(t *testing.T) {
+	gw := NewFileGateway(&mockFileProviderErrorUpload{})
+	url, err := gw.UploadImage("icon.png", []byte("data"))
+	require.Error(t, err)
+	require.Equal(t, "", url)
+}
+
+func TestFileGateway_DeleteImage(t *testing.T) {
+	gw := NewFileGateway(&mockFileProvider{})
+	require.NoError(t, gw.DeleteImage("icon.png"))
+}
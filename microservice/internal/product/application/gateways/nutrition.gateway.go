@@ -0,0 +1,38 @@
+package gateways
+
+import (
+	"tech_challenge/internal/product/daos"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+func nutritionToDAO(nutrition *value_objects.NutritionFacts) *daos.NutritionFactsDAO {
+	if nutrition == nil {
+		return nil
+	}
+
+	nutritionDAO := daos.NutritionFactsDAO(*nutrition)
+	return &nutritionDAO
+}
+
+func nutritionFromDAO(nutritionDAO *daos.NutritionFactsDAO) *value_objects.NutritionFacts {
+	if nutritionDAO == nil {
+		return nil
+	}
+
+	nutrition := value_objects.NutritionFacts(*nutritionDAO)
+	return &nutrition
+}
+
+// allergensFromDAO não revalida a lista gravada, só preserva a diferença
+// entre não declarada (nil) e vazia
+func allergensFromDAO(allergens []string) value_objects.Allergens {
+	if allergens == nil {
+		return nil
+	}
+
+	result := make(value_objects.Allergens, len(allergens))
+	for i, allergen := range allergens {
+		result[i] = value_objects.Allergen(allergen)
+	}
+	return result
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"tech_challenge/internal/product/daos"
 	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
 	value_objects "tech_challenge/internal/product/domain/value-objects"
 	"tech_challenge/internal/product/interfaces"
 	shared_interfaces "tech_challenge/internal/shared/interfaces"
+	"time"
 )
 
 type ProductGateway struct {
@@ -22,27 +24,7 @@ func NewProductGateway(dataSource interfaces.IProductDataSource, fileService sha
 }
 
 func (g *ProductGateway) Insert(product entities.Product) error {
-	productImages := make([]daos.ProductImageDAO, len(product.Images))
-	for i, img := range product.Images {
-		productImages[i] = daos.ProductImageDAO{
-			ID:        img.ID,
-			ProductID: product.ID,
-			FileName:  img.FileName,
-			Url:       img.Url,
-			IsDefault: img.IsDefault,
-			CreatedAt: img.CreatedAt,
-		}
-	}
-
-	return g.dataSource.Insert(daos.ProductDAO{
-		ID:          product.ID,
-		Name:        product.Name.Value(),
-		Description: product.Description,
-		Price:       product.Price.Value(),
-		CategoryID:  product.CategoryID,
-		Images:      productImages,
-		Active:      product.Active,
-	})
+	return g.dataSource.Insert(productToDAO(product))
 }
 
 func (g *ProductGateway) FindAll() ([]entities.Product, error) {
@@ -50,34 +32,15 @@ func (g *ProductGateway) FindAll() ([]entities.Product, error) {
 	if err != nil {
 		return nil, err
 	}
-	products := make([]entities.Product, len(productsDAO))
-	for i, p := range productsDAO {
-		productImages := make([]*value_objects.Image, len(p.Images))
-		for j, img := range p.Images {
-			productImages[j] = &value_objects.Image{
-				FileName:  img.FileName,
-				Url:       img.Url,
-				CreatedAt: img.CreatedAt,
-				ID:        img.ID,
-				IsDefault: img.IsDefault,
-			}
-		}
-		product, err := entities.NewProductWithImages(
-			p.ID,
-			p.CategoryID,
-			p.Name,
-			p.Description,
-			p.Price,
-			p.Active,
-			[]struct{ FileName, Url string }{},
-		)
-		if err != nil {
-			return nil, err
-		}
-		product.Images = productImages
-		products[i] = *product
+	return productsFromDAO(productsDAO)
+}
+
+func (g *ProductGateway) FindAllActive() ([]entities.Product, error) {
+	productsDAO, err := g.dataSource.FindAllActive()
+	if err != nil {
+		return nil, err
 	}
-	return products, nil
+	return productsFromDAO(productsDAO)
 }
 
 func (g *ProductGateway) FindAllByCategoryID(categoryID string) ([]entities.Product, error) {
@@ -85,34 +48,23 @@ func (g *ProductGateway) FindAllByCategoryID(categoryID string) ([]entities.Prod
 	if err != nil {
 		return nil, err
 	}
-	products := make([]entities.Product, len(productsDAO))
-	for i, p := range productsDAO {
-		productImages := make([]*value_objects.Image, len(p.Images))
-		for j, img := range p.Images {
-			productImages[j] = &value_objects.Image{
-				FileName:  img.FileName,
-				Url:       img.Url,
-				CreatedAt: img.CreatedAt,
-				ID:        img.ID,
-				IsDefault: img.IsDefault,
-			}
-		}
-		product, err := entities.NewProductWithImages(
-			p.ID,
-			p.CategoryID,
-			p.Name,
-			p.Description,
-			p.Price,
-			p.Active,
-			[]struct{ FileName, Url string }{},
-		)
-		if err != nil {
-			return nil, err
-		}
-		product.Images = productImages
-		products[i] = *product
+	return productsFromDAO(productsDAO)
+}
+
+func (g *ProductGateway) FindAllByCategoryIDs(categoryIDs []string) ([]entities.Product, error) {
+	productsDAO, err := g.dataSource.FindAllByCategoryIDs(categoryIDs)
+	if err != nil {
+		return nil, err
 	}
-	return products, nil
+	return productsFromDAO(productsDAO)
+}
+
+func (g *ProductGateway) FindAllByIDs(ids []string) ([]entities.Product, error) {
+	productsDAO, err := g.dataSource.FindAllByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	return productsFromDAO(productsDAO)
 }
 
 func (g *ProductGateway) FindByID(id string) (entities.Product, error) {
@@ -120,67 +72,44 @@ func (g *ProductGateway) FindByID(id string) (entities.Product, error) {
 	if err != nil {
 		return entities.Product{}, err
 	}
-	productImages := make([]*value_objects.Image, 0, len(productDAO.Images))
-	if len(productDAO.Images) > 0 {
-		img := productDAO.Images[0]
-		productImages = append(productImages, &value_objects.Image{
-			FileName:  img.FileName,
-			Url:       img.Url,
-			CreatedAt: img.CreatedAt,
-			ID:        img.ID,
-			IsDefault: img.IsDefault,
-		})
+	if productDAO.ID == "" {
+		return entities.Product{}, &exceptions.RecordNotFoundException{}
 	}
-	product, err := entities.NewProductWithImages(
-		productDAO.ID,
-		productDAO.CategoryID,
-		productDAO.Name,
-		productDAO.Description,
-		productDAO.Price,
-		productDAO.Active,
-		[]struct{ FileName, Url string }{},
-	)
+	if len(productDAO.Images) > 1 {
+		productDAO.Images = productDAO.Images[:1]
+	}
+	return productFromDAO(productDAO)
+}
+
+func (g *ProductGateway) FindByExternalKey(externalKey string) (entities.Product, error) {
+	productDAO, err := g.dataSource.FindByExternalKey(externalKey)
 	if err != nil {
 		return entities.Product{}, err
 	}
-	product.Images = productImages
-	return *product, nil
+	return productFromDAO(productDAO)
 }
 
-func (g *ProductGateway) Update(product entities.Product) error {
-	productImages := make([]daos.ProductImageDAO, len(product.Images))
-	for i, img := range product.Images {
-		productImages[i] = daos.ProductImageDAO{
-			ID:        img.ID,
-			ProductID: product.ID,
-			FileName:  img.FileName,
-			Url:       img.Url,
-			IsDefault: img.IsDefault,
-			CreatedAt: img.CreatedAt,
-		}
+// Update só grava se o produto não mudou desde a leitura (mesma Version) e,
+// em caso de sucesso, avança a versão e o UpdatedAt da entidade
+func (g *ProductGateway) Update(product *entities.Product) error {
+	productDAO := productToDAO(*product)
+	productDAO.UpdatedAt = time.Now()
+
+	if err := g.dataSource.Update(productDAO); err != nil {
+		return err
 	}
 
-	return g.dataSource.Update(daos.ProductDAO{
-		ID:          product.ID,
-		Name:        product.Name.Value(),
-		Description: product.Description,
-		Price:       product.Price.Value(),
-		CategoryID:  product.CategoryID,
-		Images:      productImages,
-		Active:      product.Active,
-	})
+	product.Version++
+	product.UpdatedAt = productDAO.UpdatedAt
+	return nil
 }
 
-func (g *ProductGateway) Delete(id string) error {
-	return g.dataSource.Delete(id)
+func (g *ProductGateway) Delete(id string, version int64) error {
+	return g.dataSource.Delete(id, version)
 }
 
 func (g *ProductGateway) UploadImage(fileName string, fileContent []byte) (string, error) {
-	err := g.fileService.UploadFile(fileName, fileContent)
-	if err != nil {
-		return "", err
-	}
-	return g.GetImageUrl(fileName), nil
+	return uploadImage(g.fileService, fileName, fileContent)
 }
 
 func (g *ProductGateway) DeleteImage(fileName string) error {
@@ -188,11 +117,7 @@ func (g *ProductGateway) DeleteImage(fileName string) error {
 }
 
 func (g *ProductGateway) GetImageUrl(fileName string) string {
-	url, err := g.fileService.GetPresignedURL(fileName)
-	if err != nil {
-		return ""
-	}
-	return url
+	return presignedImageUrl(g.fileService, fileName)
 }
 
 func (g *ProductGateway) AddProductImage(img daos.ProductImageDAO) error {
@@ -275,3 +200,90 @@ func (g *ProductGateway) DeleteFiles(images []*value_objects.Image) error {
 	}
 	return g.fileService.DeleteFiles(fileNames)
 }
+
+func (g *ProductGateway) FindAllImageFileNames() ([]string, error) {
+	return g.dataSource.FindAllImageFileNames()
+}
+
+func (g *ProductGateway) ListStoredFiles() ([]string, error) {
+	return g.fileService.ListFiles()
+}
+
+func (g *ProductGateway) DeleteStoredFiles(fileNames []string) error {
+	return g.fileService.DeleteFiles(fileNames)
+}
+
+func productToDAO(product entities.Product) daos.ProductDAO {
+	productImages := make([]daos.ProductImageDAO, len(product.Images))
+	for i, img := range product.Images {
+		productImages[i] = daos.ProductImageDAO{
+			ID:        img.ID,
+			ProductID: product.ID,
+			FileName:  img.FileName,
+			Url:       img.Url,
+			IsDefault: img.IsDefault,
+			CreatedAt: img.CreatedAt,
+		}
+	}
+
+	return daos.ProductDAO{
+		ID:           product.ID,
+		ExternalKey:  product.ExternalKey,
+		Name:         product.Name.Value(),
+		Description:  product.Description,
+		Price:        product.Price.Value(),
+		CategoryID:   product.CategoryID,
+		Images:       productImages,
+		Active:       product.Active,
+		Availability: availabilityToDAO(product.Availability),
+		Nutrition:    nutritionToDAO(product.Nutrition),
+		Allergens:    product.Allergens.Strings(),
+		Version:      product.Version,
+		UpdatedAt:    product.UpdatedAt,
+	}
+}
+
+func productFromDAO(productDAO daos.ProductDAO) (entities.Product, error) {
+	productImages := make([]*value_objects.Image, len(productDAO.Images))
+	for i, img := range productDAO.Images {
+		productImages[i] = &value_objects.Image{
+			FileName:  img.FileName,
+			Url:       img.Url,
+			CreatedAt: img.CreatedAt,
+			ID:        img.ID,
+			IsDefault: img.IsDefault,
+		}
+	}
+	product, err := entities.NewProductWithImages(
+		productDAO.ID,
+		productDAO.CategoryID,
+		productDAO.Name,
+		productDAO.Description,
+		productDAO.Price,
+		productDAO.Active,
+		[]struct{ FileName, Url string }{},
+	)
+	if err != nil {
+		return entities.Product{}, err
+	}
+	product.ExternalKey = productDAO.ExternalKey
+	product.Availability = availabilityFromDAO(productDAO.Availability)
+	product.Nutrition = nutritionFromDAO(productDAO.Nutrition)
+	product.Allergens = allergensFromDAO(productDAO.Allergens)
+	product.Version = productDAO.Version
+	product.UpdatedAt = productDAO.UpdatedAt
+	product.Images = productImages
+	return *product, nil
+}
+
+func productsFromDAO(productsDAO []daos.ProductDAO) ([]entities.Product, error) {
+	products := make([]entities.Product, len(productsDAO))
+	for i, productDAO := range productsDAO {
+		product, err := productFromDAO(productDAO)
+		if err != nil {
+			return nil, err
+		}
+		products[i] = product
+	}
+	return products, nil
+}
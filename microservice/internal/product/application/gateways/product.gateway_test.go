@@ -5,6 +5,7 @@ import (
 	"os"
 	"tech_challenge/internal/product/daos"
 	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
 	value_objects "tech_challenge/internal/product/domain/value-objects"
 	testenv "tech_challenge/internal/shared/test"
 	"testing"
@@ -22,15 +23,20 @@ func TestMain(m *testing.M) {
 type mockProductDataSource struct {
 	insertFunc                           func(dao daos.ProductDAO) error
 	findAllFunc                          func() ([]daos.ProductDAO, error)
+	findAllActiveFunc                    func() ([]daos.ProductDAO, error)
 	findByIDFunc                         func(id string) (daos.ProductDAO, error)
+	findAllByIDsFunc                     func(ids []string) ([]daos.ProductDAO, error)
 	updateFunc                           func(dao daos.ProductDAO) error
 	deleteFunc                           func(id string) error
 	findAllByCategoryIDFunc              func(categoryID string) ([]daos.ProductDAO, error)
+	findAllByCategoryIDsFunc             func(categoryIDs []string) ([]daos.ProductDAO, error)
 	addProductImageFunc                  func(img daos.ProductImageDAO) error
 	setAllPreviousImagesAsNotDefaultFunc func(productID, exceptImageID string) error
 	findAllImagesProductByIdFunc         func(productID string) ([]daos.ProductImageDAO, error)
 	setImageAsDefaultFunc                func(productID, imageID string) error
 	deleteImageFunc                      func(imageFileName string) error
+	findAllImageFileNamesFunc            func() ([]string, error)
+	findByExternalKeyFunc                func(externalKey string) (daos.ProductDAO, error)
 }
 
 func (m *mockProductDataSource) Insert(dao daos.ProductDAO) error {
@@ -39,18 +45,27 @@ func (m *mockProductDataSource) Insert(dao daos.ProductDAO) error {
 func (m *mockProductDataSource) FindAll() ([]daos.ProductDAO, error) {
 	return m.findAllFunc()
 }
+func (m *mockProductDataSource) FindAllActive() ([]daos.ProductDAO, error) {
+	return m.findAllActiveFunc()
+}
 func (m *mockProductDataSource) FindByID(id string) (daos.ProductDAO, error) {
 	return m.findByIDFunc(id)
 }
+func (m *mockProductDataSource) FindAllByIDs(ids []string) ([]daos.ProductDAO, error) {
+	return m.findAllByIDsFunc(ids)
+}
 func (m *mockProductDataSource) Update(dao daos.ProductDAO) error {
 	return m.updateFunc(dao)
 }
-func (m *mockProductDataSource) Delete(id string) error {
+func (m *mockProductDataSource) Delete(id string, version int64) error {
 	return m.deleteFunc(id)
 }
 func (m *mockProductDataSource) FindAllByCategoryID(categoryID string) ([]daos.ProductDAO, error) {
 	return m.findAllByCategoryIDFunc(categoryID)
 }
+func (m *mockProductDataSource) FindAllByCategoryIDs(categoryIDs []string) ([]daos.ProductDAO, error) {
+	return m.findAllByCategoryIDsFunc(categoryIDs)
+}
 func (m *mockProductDataSource) AddProductImage(img daos.ProductImageDAO) error {
 	return m.addProductImageFunc(img)
 }
@@ -66,6 +81,12 @@ func (m *mockProductDataSource) SetImageAsDefault(productID, imageID string) err
 func (m *mockProductDataSource) DeleteImage(imageFileName string) error {
 	return m.deleteImageFunc(imageFileName)
 }
+func (m *mockProductDataSource) FindAllImageFileNames() ([]string, error) {
+	return m.findAllImageFileNamesFunc()
+}
+func (m *mockProductDataSource) FindByExternalKey(externalKey string) (daos.ProductDAO, error) {
+	return m.findByExternalKeyFunc(externalKey)
+}
 
 type mockFileProvider struct{}
 
@@ -75,6 +96,7 @@ func (m *mockFileProvider) GetPresignedURL(fileName string) (string, error) {
 	return "http://localhost/" + fileName, nil
 }
 func (m *mockFileProvider) DeleteFiles(fileNames []string) error { return nil }
+func (m *mockFileProvider) ListFiles() ([]string, error)         { return nil, nil }
 
 func TestProductGateway_Insert(t *testing.T) {
 	gw := NewProductGateway(&mockProductDataSource{
@@ -98,6 +120,23 @@ func TestProductGateway_FindAll_Success(t *testing.T) {
 	require.Equal(t, "pid", prods[0].ID)
 }
 
+func TestProductGateway_FindAllActive(t *testing.T) {
+	gw := NewProductGateway(&mockProductDataSource{
+		findAllActiveFunc: func() ([]daos.ProductDAO, error) {
+			return []daos.ProductDAO{{ID: "pid", Name: "Coca-Cola", CategoryID: "catid", Price: 5.99, Active: true}}, nil
+		},
+	}, &mockFileProvider{})
+	prods, err := gw.FindAllActive()
+	require.NoError(t, err)
+	require.Len(t, prods, 1)
+
+	gw = NewProductGateway(&mockProductDataSource{
+		findAllActiveFunc: func() ([]daos.ProductDAO, error) { return nil, errors.New("fail") },
+	}, &mockFileProvider{})
+	_, err = gw.FindAllActive()
+	require.Error(t, err)
+}
+
 func TestProductGateway_FindAll_Error(t *testing.T) {
 	gw := NewProductGateway(&mockProductDataSource{
 		findAllFunc: func() ([]daos.ProductDAO, error) { return nil, errors.New("fail") },
@@ -194,20 +233,41 @@ func TestProductGateway_FindByID_WithImages(t *testing.T) {
 }
 
 func TestProductGateway_Update(t *testing.T) {
+	var saved daos.ProductDAO
 	gw := NewProductGateway(&mockProductDataSource{
-		updateFunc: func(dao daos.ProductDAO) error { return nil },
+		updateFunc: func(dao daos.ProductDAO) error {
+			saved = dao
+			return nil
+		},
 	}, &mockFileProvider{})
 	name, _ := value_objects.NewName("Coca-Cola")
 	price, _ := value_objects.NewPrice(5.99)
 	prod, _ := entities.NewProduct("pid", "catid", name.Value(), "desc", price.Value(), true)
-	require.NoError(t, gw.Update(*prod))
+	prod.UpdatedAt = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, gw.Update(prod))
+	require.Equal(t, int64(2), prod.Version)
+	require.True(t, prod.UpdatedAt.After(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.Equal(t, saved.UpdatedAt, prod.UpdatedAt)
+}
+
+func TestProductGateway_UpdateConflictKeepsVersion(t *testing.T) {
+	gw := NewProductGateway(&mockProductDataSource{
+		updateFunc: func(dao daos.ProductDAO) error {
+			require.Equal(t, int64(3), dao.Version)
+			return &exceptions.VersionConflictException{}
+		},
+	}, &mockFileProvider{})
+	prod, _ := entities.NewProduct("pid", "catid", "Coca-Cola", "desc", 5.99, true)
+	prod.Version = 3
+	require.IsType(t, &exceptions.VersionConflictException{}, gw.Update(prod))
+	require.Equal(t, int64(3), prod.Version)
 }
 
 func TestProductGateway_Delete(t *testing.T) {
 	gw := NewProductGateway(&mockProductDataSource{
 		deleteFunc: func(id string) error { return nil },
 	}, &mockFileProvider{})
-	require.NoError(t, gw.Delete("pid"))
+	require.NoError(t, gw.Delete("pid", 1))
 }
 
 func TestProductGateway_UploadImage(t *testing.T) {
@@ -234,6 +294,7 @@ func (m *mockFileProviderErrorUpload) GetPresignedURL(fileName string) (string,
 	return "", nil
 }
 func (m *mockFileProviderErrorUpload) DeleteFiles(fileNames []string) error { return nil }
+func (m *mockFileProviderErrorUpload) ListFiles() ([]string, error)         { return nil, nil }
 
 func TestProductGateway_DeleteImage(t *testing.T) {
 	gw := NewProductGateway(&mockProductDataSource{}, &mockFileProvider{})
@@ -262,6 +323,7 @@ func (m *mockFileProviderError) GetPresignedURL(fileName string) (string, error)
 	return "", errors.New("fail")
 }
 func (m *mockFileProviderError) DeleteFiles(fileNames []string) error { return nil }
+func (m *mockFileProviderError) ListFiles() ([]string, error)         { return nil, nil }
 
 func TestProductGateway_AddProductImage(t *testing.T) {
 	gw := NewProductGateway(&mockProductDataSource{
@@ -482,6 +544,49 @@ func TestProductGateway_FindAllByCategoryID_Error_Entity(t *testing.T) {
 	require.Nil(t, prods)
 }
 
+func TestProductGateway_FindAllByCategoryIDs(t *testing.T) {
+	gw := NewProductGateway(&mockProductDataSource{
+		findAllByCategoryIDsFunc: func(categoryIDs []string) ([]daos.ProductDAO, error) {
+			require.Equal(t, []string{"catid", "subcatid"}, categoryIDs)
+			return []daos.ProductDAO{{ID: "pid", Name: "Coca-Cola", CategoryID: "subcatid", Price: 5.99, Active: true}}, nil
+		},
+	}, &mockFileProvider{})
+	prods, err := gw.FindAllByCategoryIDs([]string{"catid", "subcatid"})
+	require.NoError(t, err)
+	require.Len(t, prods, 1)
+	require.Equal(t, "subcatid", prods[0].CategoryID)
+
+	gw = NewProductGateway(&mockProductDataSource{
+		findAllByCategoryIDsFunc: func(categoryIDs []string) ([]daos.ProductDAO, error) {
+			return nil, errors.New("fail")
+		},
+	}, &mockFileProvider{})
+	prods, err = gw.FindAllByCategoryIDs([]string{"catid"})
+	require.Error(t, err)
+	require.Nil(t, prods)
+}
+
+func TestProductGateway_FindAllByIDs(t *testing.T) {
+	gw := NewProductGateway(&mockProductDataSource{
+		findAllByIDsFunc: func(ids []string) ([]daos.ProductDAO, error) {
+			require.Equal(t, []string{"pid", "other"}, ids)
+			return []daos.ProductDAO{{ID: "pid", Name: "Coca-Cola", CategoryID: "catid", Price: 5.99, Active: true}}, nil
+		},
+	}, &mockFileProvider{})
+	prods, err := gw.FindAllByIDs([]string{"pid", "other"})
+	require.NoError(t, err)
+	require.Len(t, prods, 1)
+
+	gw = NewProductGateway(&mockProductDataSource{
+		findAllByIDsFunc: func(ids []string) ([]daos.ProductDAO, error) {
+			return nil, errors.New("fail")
+		},
+	}, &mockFileProvider{})
+	prods, err = gw.FindAllByIDs([]string{"pid"})
+	require.Error(t, err)
+	require.Nil(t, prods)
+}
+
 func TestProductGateway_FindAll_Error_Entity(t *testing.T) {
 	gw := NewProductGateway(&mockProductDataSource{
 		findAllFunc: func() ([]daos.ProductDAO, error) {
@@ -0,0 +1,114 @@
+package gateways
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+	"tech_challenge/internal/product/interfaces"
+)
+
+type PromotionGateway struct {
+	dataSource interfaces.IPromotionDataSource
+}
+
+func NewPromotionGateway(dataSource interfaces.IPromotionDataSource) PromotionGateway {
+	return PromotionGateway{dataSource: dataSource}
+}
+
+func (g *PromotionGateway) Insert(promotion entities.Promotion) error {
+	return g.dataSource.Insert(promotionToDAO(promotion))
+}
+
+// FindAll devolve as promoções já na ordem em que são aplicadas
+func (g *PromotionGateway) FindAll() (entities.Promotions, error) {
+	promotionsDAO, err := g.dataSource.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return promotionsFromDAO(promotionsDAO), nil
+}
+
+// FindAllByTargets devolve só as promoções que alcançam algum dos produtos ou
+// das categorias, na mesma ordem de FindAll
+func (g *PromotionGateway) FindAllByTargets(productIDs []string, categoryIDs []string) (entities.Promotions, error) {
+	promotionsDAO, err := g.dataSource.FindAllByTargets(productIDs, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return promotionsFromDAO(promotionsDAO), nil
+}
+
+func promotionsFromDAO(promotionsDAO []daos.PromotionDAO) entities.Promotions {
+	promotions := make([]*entities.Promotion, 0, len(promotionsDAO))
+	for _, promotionDAO := range promotionsDAO {
+		promotions = append(promotions, promotionFromDAO(promotionDAO))
+	}
+	return entities.NewPromotions(promotions)
+}
+
+func (g *PromotionGateway) FindByID(id string) (*entities.Promotion, error) {
+	promotionDAO, err := g.dataSource.FindByID(id)
+	if err != nil {
+		return nil, promotionNotFound(err)
+	}
+
+	return promotionFromDAO(promotionDAO), nil
+}
+
+func (g *PromotionGateway) Update(promotion *entities.Promotion) error {
+	promotion.UpdatedAt = time.Now()
+
+	return promotionNotFound(g.dataSource.Update(promotionToDAO(*promotion)))
+}
+
+func (g *PromotionGateway) Delete(id string) error {
+	return promotionNotFound(g.dataSource.Delete(id))
+}
+
+func promotionToDAO(promotion entities.Promotion) daos.PromotionDAO {
+	return daos.PromotionDAO{
+		ID:            promotion.ID,
+		Name:          promotion.Name,
+		DiscountType:  promotion.Discount.Type(),
+		DiscountValue: promotion.Discount.Value(),
+		ProductIDs:    promotion.ProductIDs,
+		CategoryIDs:   promotion.CategoryIDs,
+		Schedule:      availabilityToDAO(promotion.Schedule),
+		Priority:      promotion.Priority,
+		Stackable:     promotion.Stackable,
+		Active:        promotion.Active,
+		CreatedAt:     promotion.CreatedAt,
+		UpdatedAt:     promotion.UpdatedAt,
+	}
+}
+
+func promotionFromDAO(promotionDAO daos.PromotionDAO) *entities.Promotion {
+	// O desconto já foi validado ao ser gravado
+	discount, _ := value_objects.NewDiscount(promotionDAO.DiscountType, promotionDAO.DiscountValue)
+
+	return &entities.Promotion{
+		ID:          promotionDAO.ID,
+		Name:        promotionDAO.Name,
+		Discount:    discount,
+		ProductIDs:  promotionDAO.ProductIDs,
+		CategoryIDs: promotionDAO.CategoryIDs,
+		Schedule:    availabilityFromDAO(promotionDAO.Schedule),
+		Priority:    promotionDAO.Priority,
+		Stackable:   promotionDAO.Stackable,
+		Active:      promotionDAO.Active,
+		CreatedAt:   promotionDAO.CreatedAt,
+		UpdatedAt:   promotionDAO.UpdatedAt,
+	}
+}
+
+func promotionNotFound(err error) error {
+	if exceptions.IsRecordNotFound(err) {
+		return &exceptions.PromotionNotFoundException{}
+	}
+	return err
+}
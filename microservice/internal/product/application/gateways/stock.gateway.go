@@ -0,0 +1,242 @@
+package gateways
+
+import (
+	"log"
+	"time"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/interfaces"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+)
+
+// StockAvailabilityChangedEvent é publicado quando um produto fica esgotado
+// ou volta a ficar disponível
+const StockAvailabilityChangedEvent = "product.stock.availability_changed"
+
+type stockAvailabilityChangedData struct {
+	ProductID string `json:"product_id"`
+	Available bool   `json:"available"`
+	Tracked   bool   `json:"tracked"`
+	Quantity  int    `json:"quantity"`
+	SoldOut   bool   `json:"sold_out"`
+}
+
+type StockGateway struct {
+	dataSource interfaces.IStockDataSource
+	publisher  shared_interfaces.IEventPublisher
+}
+
+func NewStockGateway(dataSource interfaces.IStockDataSource, publisher shared_interfaces.IEventPublisher) StockGateway {
+	return StockGateway{
+		dataSource: dataSource,
+		publisher:  publisher,
+	}
+}
+
+// FindByProductID devolve o estoque do produto; sem registro, o produto não
+// controla quantidade e não está esgotado
+func (g *StockGateway) FindByProductID(productID string) (entities.ProductStock, error) {
+	stockDAO, err := g.dataSource.FindByProductID(productID)
+
+	if exceptions.IsRecordNotFound(err) {
+		return entities.ProductStock{ProductID: productID}, nil
+	}
+
+	if err != nil {
+		return entities.ProductStock{}, err
+	}
+
+	return stockFromDAO(stockDAO), nil
+}
+
+// FindByProductIDForUpdate é o FindByProductID que bloqueia o estoque do
+// produto até o fim da transação
+func (g *StockGateway) FindByProductIDForUpdate(productID string) (entities.ProductStock, error) {
+	stockDAO, err := g.dataSource.FindByProductIDForUpdate(productID)
+
+	if exceptions.IsRecordNotFound(err) {
+		return entities.ProductStock{ProductID: productID}, nil
+	}
+
+	if err != nil {
+		return entities.ProductStock{}, err
+	}
+
+	return stockFromDAO(stockDAO), nil
+}
+
+func (g *StockGateway) FindAll() (entities.StockLevels, error) {
+	stocksDAO, err := g.dataSource.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	stocks := make([]entities.ProductStock, 0, len(stocksDAO))
+	for _, stockDAO := range stocksDAO {
+		stocks = append(stocks, stockFromDAO(stockDAO))
+	}
+	return entities.NewStockLevels(stocks), nil
+}
+
+// FindAllByProductIDs devolve o estoque só dos produtos informados
+func (g *StockGateway) FindAllByProductIDs(productIDs []string) (entities.StockLevels, error) {
+	stocksDAO, err := g.dataSource.FindAllByProductIDs(productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	stocks := make([]entities.ProductStock, 0, len(stocksDAO))
+	for _, stockDAO := range stocksDAO {
+		stocks = append(stocks, stockFromDAO(stockDAO))
+	}
+	return entities.NewStockLevels(stocks), nil
+}
+
+func (g *StockGateway) Save(stock *entities.ProductStock) error {
+	stock.UpdatedAt = time.Now()
+
+	return g.dataSource.Save(daos.ProductStockDAO{
+		ProductID: stock.ProductID,
+		Tracked:   stock.Tracked,
+		Quantity:  stock.Quantity,
+		SoldOut:   stock.SoldOut,
+		UpdatedAt: stock.UpdatedAt,
+	})
+}
+
+func (g *StockGateway) Decrement(productID string, quantity int) (entities.ProductStock, error) {
+	stockDAO, err := g.dataSource.Decrement(productID, quantity)
+	if err != nil {
+		return entities.ProductStock{}, err
+	}
+	return stockFromDAO(stockDAO), nil
+}
+
+// Increment devolve as unidades e informa false quando o produto não controla
+// quantidade, caso em que não há o que devolver
+func (g *StockGateway) Increment(productID string, quantity int) (entities.ProductStock, bool, error) {
+	stockDAO, err := g.dataSource.Increment(productID, quantity)
+
+	if exceptions.IsRecordNotFound(err) {
+		return entities.ProductStock{}, false, nil
+	}
+
+	if err != nil {
+		return entities.ProductStock{}, false, err
+	}
+
+	return stockFromDAO(stockDAO), true, nil
+}
+
+func (g *StockGateway) InsertReservation(reservation entities.StockReservation) error {
+	return g.dataSource.InsertReservation(reservationToDAO(reservation))
+}
+
+func (g *StockGateway) FindReservationByID(id string) (*entities.StockReservation, error) {
+	reservationDAO, err := g.dataSource.FindReservationByID(id)
+
+	if exceptions.IsRecordNotFound(err) {
+		return nil, &exceptions.StockReservationNotFoundException{}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reservationFromDAO(reservationDAO), nil
+}
+
+// UpdateReservationStatus grava o novo status só se a reserva ainda estiver
+// com o status from; se outra requisição chegou antes, a transição é recusada
+func (g *StockGateway) UpdateReservationStatus(reservation entities.StockReservation, from entities.StockReservationStatus) error {
+	err := g.dataSource.UpdateReservationStatus(reservation.ID, string(from), string(reservation.Status), reservation.UpdatedAt)
+
+	if _, ok := err.(*exceptions.VersionConflictException); ok {
+		return &exceptions.InvalidStockReservationStateException{Message: "reservation was changed by another request"}
+	}
+
+	return err
+}
+
+func (g *StockGateway) FindExpiredReservations(now time.Time, limit int) ([]*entities.StockReservation, error) {
+	reservationsDAO, err := g.dataSource.FindExpiredReservations(now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	reservations := make([]*entities.StockReservation, 0, len(reservationsDAO))
+	for _, reservationDAO := range reservationsDAO {
+		reservations = append(reservations, reservationFromDAO(reservationDAO))
+	}
+	return reservations, nil
+}
+
+// PublishAvailabilityChanged avisa outros serviços da mudança. A publicação é
+// feita depois da gravação e uma falha só é registrada no log: o estoque já
+// mudou e quem consome o evento pode reconciliar pela API. Sem publisher
+// (gateways só de leitura), nada é enviado
+func (g *StockGateway) PublishAvailabilityChanged(stock entities.ProductStock, occurredAt time.Time) {
+	if g.publisher == nil {
+		return
+	}
+
+	err := g.publisher.Publish(shared_interfaces.Event{
+		Type:       StockAvailabilityChangedEvent,
+		OccurredAt: occurredAt,
+		Data: stockAvailabilityChangedData{
+			ProductID: stock.ProductID,
+			Available: !stock.IsSoldOut(),
+			Tracked:   stock.Tracked,
+			Quantity:  stock.Quantity,
+			SoldOut:   stock.SoldOut,
+		},
+	})
+
+	if err != nil {
+		log.Printf("failed to publish %s for product %s: %v", StockAvailabilityChangedEvent, stock.ProductID, err)
+	}
+}
+
+func stockFromDAO(stockDAO daos.ProductStockDAO) entities.ProductStock {
+	return entities.ProductStock{
+		ProductID: stockDAO.ProductID,
+		Tracked:   stockDAO.Tracked,
+		Quantity:  stockDAO.Quantity,
+		SoldOut:   stockDAO.SoldOut,
+		UpdatedAt: stockDAO.UpdatedAt,
+	}
+}
+
+func reservationToDAO(reservation entities.StockReservation) daos.StockReservationDAO {
+	items := make([]daos.StockReservationItemDAO, 0, len(reservation.Items))
+	for _, item := range reservation.Items {
+		items = append(items, daos.StockReservationItemDAO{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+
+	return daos.StockReservationDAO{
+		ID:        reservation.ID,
+		Items:     items,
+		Status:    string(reservation.Status),
+		ExpiresAt: reservation.ExpiresAt,
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+}
+
+func reservationFromDAO(reservationDAO daos.StockReservationDAO) *entities.StockReservation {
+	items := make([]entities.StockReservationItem, 0, len(reservationDAO.Items))
+	for _, item := range reservationDAO.Items {
+		items = append(items, entities.StockReservationItem{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+
+	return &entities.StockReservation{
+		ID:        reservationDAO.ID,
+		Items:     items,
+		Status:    entities.StockReservationStatus(reservationDAO.Status),
+		ExpiresAt: reservationDAO.ExpiresAt,
+		CreatedAt: reservationDAO.CreatedAt,
+		UpdatedAt: reservationDAO.UpdatedAt,
+	}
+}
@@ -0,0 +1,165 @@
+package gateways
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+	"tech_challenge/internal/product/interfaces"
+)
+
+type StoreGateway struct {
+	dataSource interfaces.IStoreDataSource
+}
+
+func NewStoreGateway(dataSource interfaces.IStoreDataSource) StoreGateway {
+	return StoreGateway{dataSource: dataSource}
+}
+
+func (g *StoreGateway) Insert(store entities.Store) error {
+	return g.dataSource.Insert(storeToDAO(store))
+}
+
+func (g *StoreGateway) FindAll() ([]entities.Store, error) {
+	storesDAO, err := g.dataSource.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	stores := make([]entities.Store, 0, len(storesDAO))
+	for _, storeDAO := range storesDAO {
+		stores = append(stores, storeFromDAO(storeDAO))
+	}
+	return stores, nil
+}
+
+func (g *StoreGateway) FindByID(id string) (*entities.Store, error) {
+	storeDAO, err := g.dataSource.FindByID(id)
+	if err != nil {
+		return nil, storeNotFound(err)
+	}
+
+	store := storeFromDAO(storeDAO)
+	return &store, nil
+}
+
+func (g *StoreGateway) Update(store *entities.Store) error {
+	store.UpdatedAt = time.Now()
+
+	return storeNotFound(g.dataSource.Update(storeToDAO(*store)))
+}
+
+func (g *StoreGateway) Delete(id string) error {
+	return storeNotFound(g.dataSource.Delete(id))
+}
+
+// FindOverrides carrega todos os ajustes da loja de uma vez, indexados para
+// serem aplicados sobre o catálogo já lido
+func (g *StoreGateway) FindOverrides(storeID string) (entities.StoreOverrides, error) {
+	productsDAO, err := g.dataSource.FindProductOverrides(storeID)
+	if err != nil {
+		return entities.StoreOverrides{}, err
+	}
+
+	categoriesDAO, err := g.dataSource.FindCategoryOverrides(storeID)
+	if err != nil {
+		return entities.StoreOverrides{}, err
+	}
+
+	products := make([]entities.ProductStoreOverride, 0, len(productsDAO))
+	for _, overrideDAO := range productsDAO {
+		override := entities.ProductStoreOverride{
+			StoreID:      overrideDAO.StoreID,
+			ProductID:    overrideDAO.ProductID,
+			Active:       overrideDAO.Active,
+			Availability: availabilityFromDAO(overrideDAO.Availability),
+			UpdatedAt:    overrideDAO.UpdatedAt,
+		}
+		// O preço já foi validado ao ser gravado
+		if overrideDAO.Price != nil {
+			price, _ := value_objects.NewPrice(*overrideDAO.Price)
+			override.Price = &price
+		}
+		products = append(products, override)
+	}
+
+	categories := make([]entities.CategoryStoreOverride, 0, len(categoriesDAO))
+	for _, overrideDAO := range categoriesDAO {
+		categories = append(categories, entities.CategoryStoreOverride{
+			StoreID:    overrideDAO.StoreID,
+			CategoryID: overrideDAO.CategoryID,
+			Visible:    overrideDAO.Visible,
+			UpdatedAt:  overrideDAO.UpdatedAt,
+		})
+	}
+
+	return entities.NewStoreOverrides(storeID, products, categories), nil
+}
+
+// SaveProductOverride grava o ajuste do produto; um ajuste sem nenhum campo
+// remove o existente
+func (g *StoreGateway) SaveProductOverride(override *entities.ProductStoreOverride) error {
+	if override.IsEmpty() {
+		return g.dataSource.DeleteProductOverride(override.StoreID, override.ProductID)
+	}
+
+	override.UpdatedAt = time.Now()
+	overrideDAO := daos.ProductStoreOverrideDAO{
+		StoreID:      override.StoreID,
+		ProductID:    override.ProductID,
+		Active:       override.Active,
+		Availability: availabilityToDAO(override.Availability),
+		UpdatedAt:    override.UpdatedAt,
+	}
+	if override.Price != nil {
+		price := override.Price.Value()
+		overrideDAO.Price = &price
+	}
+
+	return g.dataSource.SaveProductOverride(overrideDAO)
+}
+
+func (g *StoreGateway) SaveCategoryOverride(override *entities.CategoryStoreOverride) error {
+	if override.IsEmpty() {
+		return g.dataSource.DeleteCategoryOverride(override.StoreID, override.CategoryID)
+	}
+
+	override.UpdatedAt = time.Now()
+	return g.dataSource.SaveCategoryOverride(daos.CategoryStoreOverrideDAO{
+		StoreID:    override.StoreID,
+		CategoryID: override.CategoryID,
+		Visible:    override.Visible,
+		UpdatedAt:  override.UpdatedAt,
+	})
+}
+
+func (g *StoreGateway) DeleteOverrides(storeID string) error {
+	return g.dataSource.DeleteOverrides(storeID)
+}
+
+func storeToDAO(store entities.Store) daos.StoreDAO {
+	return daos.StoreDAO{
+		ID:        store.ID,
+		Name:      store.Name,
+		CreatedAt: store.CreatedAt,
+		UpdatedAt: store.UpdatedAt,
+	}
+}
+
+func storeFromDAO(storeDAO daos.StoreDAO) entities.Store {
+	return entities.Store{
+		ID:        storeDAO.ID,
+		Name:      storeDAO.Name,
+		CreatedAt: storeDAO.CreatedAt,
+		UpdatedAt: storeDAO.UpdatedAt,
+	}
+}
+
+func storeNotFound(err error) error {
+	if exceptions.IsRecordNotFound(err) {
+		return &exceptions.StoreNotFoundException{}
+	}
+	return err
+}
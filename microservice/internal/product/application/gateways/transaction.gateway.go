@@ -0,0 +1,41 @@
+package gateways
+
+import (
+	"tech_challenge/internal/product/interfaces"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+)
+
+type TransactionGateways struct {
+	Product     ProductGateway
+	Category    CategoryGateway
+	Translation TranslationGateway
+	Store       StoreGateway
+	Stock       StockGateway
+}
+
+type TransactionGateway struct {
+	transactionManager interfaces.ITransactionManager
+	fileService        shared_interfaces.IFileProvider
+}
+
+func NewTransactionGateway(transactionManager interfaces.ITransactionManager, fileService shared_interfaces.IFileProvider) TransactionGateway {
+	return TransactionGateway{
+		transactionManager: transactionManager,
+		fileService:        fileService,
+	}
+}
+
+// Run executa fn com gateways ligados a uma única transação. O gateway de
+// estoque da transação não publica eventos: eles saem depois do commit, pelo
+// gateway de estoque do caso de uso
+func (g *TransactionGateway) Run(fn func(gateways TransactionGateways) error) error {
+	return g.transactionManager.Transaction(func(dataSources interfaces.TransactionDataSources) error {
+		return fn(TransactionGateways{
+			Product:     *NewProductGateway(dataSources.Product, g.fileService),
+			Category:    NewCategoryGateway(dataSources.Category),
+			Translation: NewTranslationGateway(dataSources.Translation),
+			Store:       NewStoreGateway(dataSources.Store),
+			Stock:       NewStockGateway(dataSources.Stock, nil),
+		})
+	})
+}
@@ -0,0 +1,51 @@
+package gateways
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/interfaces"
+)
+
+type mockTransactionManager struct {
+	dataSources interfaces.TransactionDataSources
+}
+
+func (m *mockTransactionManager) Transaction(fn func(dataSources interfaces.TransactionDataSources) error) error {
+	return fn(m.dataSources)
+}
+
+func TestTransactionGateway_Run(t *testing.T) {
+	inserted := false
+	gw := NewTransactionGateway(&mockTransactionManager{
+		dataSources: interfaces.TransactionDataSources{
+			Product: &mockProductDataSource{},
+			Category: &mockCategoryDataSource{
+				insertFunc: func(dao daos.CategoryDAO) error {
+					inserted = true
+					return nil
+				},
+			},
+		},
+	}, &mockFileProvider{})
+
+	err := gw.Run(func(gateways TransactionGateways) error {
+		category, _ := entities.NewCategory("catid", "Bebidas", true)
+		return gateways.Category.Insert(*category)
+	})
+	require.NoError(t, err)
+	require.True(t, inserted)
+}
+
+func TestTransactionGateway_Run_Error(t *testing.T) {
+	gw := NewTransactionGateway(&mockTransactionManager{}, &mockFileProvider{})
+
+	err := gw.Run(func(gateways TransactionGateways) error {
+		return errors.New("boom")
+	})
+	require.EqualError(t, err, "boom")
+}
@@ -0,0 +1,97 @@
+package gateways
+
+import (
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+	"tech_challenge/internal/product/interfaces"
+	"time"
+)
+
+type TranslationGateway struct {
+	dataSource interfaces.ITranslationDataSource
+}
+
+func NewTranslationGateway(dataSource interfaces.ITranslationDataSource) TranslationGateway {
+	return TranslationGateway{dataSource: dataSource}
+}
+
+func (g *TranslationGateway) FindByEntityID(target entities.TranslationTarget, entityID string) ([]entities.Translation, error) {
+	translationsDAO, err := g.dataSource.FindByEntityID(string(target), entityID)
+	if err != nil {
+		return nil, err
+	}
+	return translationsFromDAO(translationsDAO), nil
+}
+
+// FindByLocale indexa as traduções do idioma. No idioma padrão não há o que
+// traduzir e o banco não é consultado
+func (g *TranslationGateway) FindByLocale(target entities.TranslationTarget, locale value_objects.Locale) (entities.Translations, error) {
+	if locale.IsDefault() {
+		return entities.Translations{}, nil
+	}
+
+	translationsDAO, err := g.dataSource.FindAllByLocale(string(target), string(locale))
+	if err != nil {
+		return nil, err
+	}
+	return entities.NewTranslations(translationsFromDAO(translationsDAO)), nil
+}
+
+// FindOne devolve só a tradução do item no idioma, indexada como em FindByLocale
+func (g *TranslationGateway) FindOne(target entities.TranslationTarget, entityID string, locale value_objects.Locale) (entities.Translations, error) {
+	if locale.IsDefault() {
+		return entities.Translations{}, nil
+	}
+
+	translations, err := g.FindByEntityID(target, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, translation := range translations {
+		if translation.Locale == locale {
+			return entities.NewTranslations([]entities.Translation{translation}), nil
+		}
+	}
+	return entities.Translations{}, nil
+}
+
+func (g *TranslationGateway) Save(translation *entities.Translation) error {
+	translation.UpdatedAt = time.Now()
+
+	return g.dataSource.Save(daos.TranslationDAO{
+		Target:      string(translation.Target),
+		EntityID:    translation.EntityID,
+		Locale:      string(translation.Locale),
+		Name:        translation.Name,
+		Description: translation.Description,
+		UpdatedAt:   translation.UpdatedAt,
+	})
+}
+
+func (g *TranslationGateway) Delete(target entities.TranslationTarget, entityID string, locale value_objects.Locale) error {
+	err := g.dataSource.Delete(string(target), entityID, string(locale))
+
+	if exceptions.IsRecordNotFound(err) {
+		return &exceptions.TranslationNotFoundException{}
+	}
+
+	return err
+}
+
+func translationsFromDAO(translationsDAO []daos.TranslationDAO) []entities.Translation {
+	translations := make([]entities.Translation, 0, len(translationsDAO))
+	for _, translationDAO := range translationsDAO {
+		translations = append(translations, entities.Translation{
+			Target:      entities.TranslationTarget(translationDAO.Target),
+			EntityID:    translationDAO.EntityID,
+			Locale:      value_objects.Locale(translationDAO.Locale),
+			Name:        translationDAO.Name,
+			Description: translationDAO.Description,
+			UpdatedAt:   translationDAO.UpdatedAt,
+		})
+	}
+	return translations
+}
@@ -0,0 +1,60 @@
+package presenters
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+func AvailabilityFromDomainToDTO(availability *value_objects.Availability) *dtos.AvailabilityDTO {
+	if availability == nil {
+		return nil
+	}
+
+	dto := &dtos.AvailabilityDTO{
+		StartDate: availability.StartDate,
+		EndDate:   availability.EndDate,
+	}
+	for _, window := range availability.Weekly {
+		dto.Weekly = append(dto.Weekly, dtos.WeeklyAvailabilityDTO{
+			Weekdays: window.Weekdays,
+			From:     window.From,
+			To:       window.To,
+		})
+	}
+	for _, exception := range availability.Exceptions {
+		dto.Exceptions = append(dto.Exceptions, dtos.AvailabilityExceptionDTO{
+			Date: exception.Date,
+			From: exception.From,
+			To:   exception.To,
+		})
+	}
+
+	return dto
+}
+
+// AvailabilityFromDTOToDomain valida a grade recebida; uma grade vazia vira
+// nil, ou seja, sem restrição de horário
+func AvailabilityFromDTOToDomain(dto *dtos.AvailabilityDTO) (*value_objects.Availability, error) {
+	if dto.IsEmpty() {
+		return nil, nil
+	}
+
+	availability := value_objects.Availability{
+		StartDate: dto.StartDate,
+		EndDate:   dto.EndDate,
+	}
+	for _, window := range dto.Weekly {
+		availability.Weekly = append(availability.Weekly, value_objects.WeeklyAvailability{
+			Weekdays:  window.Weekdays,
+			TimeRange: value_objects.TimeRange{From: window.From, To: window.To},
+		})
+	}
+	for _, exception := range dto.Exceptions {
+		availability.Exceptions = append(availability.Exceptions, value_objects.AvailabilityException{
+			Date:      exception.Date,
+			TimeRange: value_objects.TimeRange{From: exception.From, To: exception.To},
+		})
+	}
+
+	return value_objects.NewAvailability(availability)
+}
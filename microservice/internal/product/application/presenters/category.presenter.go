@@ -1,16 +1,32 @@
 package presenters
 
 import (
+	"time"
+
 	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/domain/entities"
 )
 
 func CategoryFromDomainToResultDTO(category entities.Category) dtos.CategoryResultDTO {
-	return dtos.CategoryResultDTO{
-		ID:     category.ID,
-		Name:   category.Name.Value(),
-		Active: category.Active,
+	categoryDTO := dtos.CategoryResultDTO{
+		ID:           category.ID,
+		ExternalKey:  category.ExternalKey,
+		ParentID:     category.ParentID,
+		Name:         category.Name.Value(),
+		Description:  category.Description,
+		Position:     category.Position,
+		Active:       category.Active,
+		Availability: AvailabilityFromDomainToDTO(category.Availability),
+		Version:      category.Version,
+		UpdatedAt:    category.UpdatedAt,
+	}
+
+	if category.Image != nil {
+		categoryDTO.ImageFileName = category.Image.FileName
+		categoryDTO.ImageUrl = category.Image.Url
 	}
+
+	return categoryDTO
 }
 
 func CategoriesFromDomainToResultDTO(categories []*entities.Category) []dtos.CategoryResultDTO {
@@ -22,3 +38,40 @@ func CategoriesFromDomainToResultDTO(categories []*entities.Category) []dtos.Cat
 
 	return result
 }
+
+// CategoryWithAvailabilityToResultDTO avalia available_now no horário local
+// com a própria categoria e as categorias acima dela em tree, que pode ser nil
+func CategoryWithAvailabilityToResultDTO(category entities.Category, local time.Time, tree *entities.CategoryTree) dtos.CategoryResultDTO {
+	categoryDTO := CategoryFromDomainToResultDTO(category)
+	categoryDTO.AvailableNow = category.Active && category.Availability.IsAvailableAt(local) &&
+		(tree == nil || tree.IsAvailableAt(category.ParentID, local))
+	categoryDTO.Scheduled = tree == nil || category.Availability.IsScheduled() || tree.IsScheduled(category.ParentID)
+	return categoryDTO
+}
+
+func CategoriesWithAvailabilityToResultDTO(categories []*entities.Category, local time.Time, tree *entities.CategoryTree) []dtos.CategoryResultDTO {
+	result := make([]dtos.CategoryResultDTO, 0, len(categories))
+
+	for _, category := range categories {
+		result = append(result, CategoryWithAvailabilityToResultDTO(*category, local, tree))
+	}
+
+	return result
+}
+
+func CategoryTreeFromDomainToDTO(tree *entities.CategoryTree, local time.Time) []dtos.CategoryTreeDTO {
+	return categoryTreeNodesToDTO(tree, tree.Roots(), local)
+}
+
+func categoryTreeNodesToDTO(tree *entities.CategoryTree, categories []*entities.Category, local time.Time) []dtos.CategoryTreeDTO {
+	result := make([]dtos.CategoryTreeDTO, 0, len(categories))
+
+	for _, category := range categories {
+		result = append(result, dtos.CategoryTreeDTO{
+			CategoryResultDTO: CategoryWithAvailabilityToResultDTO(*category, local, tree),
+			Children:          categoryTreeNodesToDTO(tree, tree.Children(category.ID), local),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,38 @@
+package presenters
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+func MenuFromDomainToDTO(sections []entities.MenuSection) []dtos.MenuSectionDTO {
+	result := make([]dtos.MenuSectionDTO, 0, len(sections))
+
+	for _, section := range sections {
+		result = append(result, dtos.MenuSectionDTO{
+			Category:      CategoryFromDomainToResultDTO(*section.Category),
+			Products:      menuProductsFromDomainToDTO(section.Products),
+			Subcategories: MenuFromDomainToDTO(section.Subcategories),
+		})
+	}
+
+	return result
+}
+
+// No cardápio cada produto leva apenas a imagem padrão
+func menuProductsFromDomainToDTO(items []entities.MenuItem) []dtos.MenuProductDTO {
+	result := make([]dtos.MenuProductDTO, len(items))
+
+	for i, item := range items {
+		result[i].ProductResultDTO = ProductFromDomainToResultDTO(item.Product)
+		result[i].Images = nil
+		result[i].SoldOut = item.SoldOut
+		applyPricing(&result[i].ProductResultDTO, item.Pricing)
+
+		if image := item.DefaultImage(); image != nil {
+			result[i].Images = []dtos.ProductImageDTO{ProductImageFromDomainToDTO(*image)}
+		}
+	}
+
+	return result
+}
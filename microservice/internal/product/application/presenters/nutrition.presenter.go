@@ -0,0 +1,25 @@
+package presenters
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+func NutritionFromDomainToDTO(nutrition *value_objects.NutritionFacts) *dtos.NutritionFactsDTO {
+	if nutrition == nil {
+		return nil
+	}
+
+	dto := dtos.NutritionFactsDTO(*nutrition)
+	return &dto
+}
+
+// NutritionFromDTOToDomain valida a tabela recebida; uma tabela vazia vira
+// nil, ou seja, sem informação nutricional
+func NutritionFromDTOToDomain(dto *dtos.NutritionFactsDTO) (*value_objects.NutritionFacts, error) {
+	if dto.IsEmpty() {
+		return nil, nil
+	}
+
+	return value_objects.NewNutritionFacts(value_objects.NutritionFacts(*dto))
+}
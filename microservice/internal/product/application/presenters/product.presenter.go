@@ -1,6 +1,8 @@
 package presenters
 
 import (
+	"time"
+
 	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/domain/entities"
 	value_objects "tech_challenge/internal/product/domain/value-objects"
@@ -12,13 +14,22 @@ func ProductFromDomainToResultDTO(product entities.Product) dtos.ProductResultDT
 		productImages[i] = ProductImageFromDomainToDTO(*img)
 	}
 	return dtos.ProductResultDTO{
-		ID:          product.ID,
-		Name:        product.Name.Value(),
-		Description: product.Description,
-		Price:       product.Price.Value(),
-		Active:      product.Active,
-		CategoryID:  product.CategoryID,
-		Images:      productImages,
+		ID:           product.ID,
+		ExternalKey:  product.ExternalKey,
+		Name:         product.Name.Value(),
+		Description:  product.Description,
+		Price:        product.Price.Value(),
+		Active:       product.Active,
+		CategoryID:   product.CategoryID,
+		Images:       productImages,
+		Availability: AvailabilityFromDomainToDTO(product.Availability),
+		Nutrition:    NutritionFromDomainToDTO(product.Nutrition),
+		Allergens:    product.Allergens.Strings(),
+		// Sem promoções avaliadas, o preço cobrado é o próprio preço
+		OriginalPrice:  product.Price.Value(),
+		EffectivePrice: product.Price.Value(),
+		Version:        product.Version,
+		UpdatedAt:      product.UpdatedAt,
 	}
 }
 
@@ -30,6 +41,48 @@ func ListProductDomainToResultDTO(products []entities.Product) []dtos.ProductRes
 	return result
 }
 
+// ProductWithAvailabilityToResultDTO também informa se o produto está à venda
+// no horário local, considerando as categorias acima dele e o estoque, e o
+// preço com as promoções que valem nesse horário
+func ProductWithAvailabilityToResultDTO(product entities.Product, local time.Time, categories *entities.CategoryTree, stocks entities.StockLevels, promotions entities.Promotions) dtos.ProductResultDTO {
+	result := ProductFromDomainToResultDTO(product)
+	result.SoldOut = stocks.IsSoldOut(product.ID)
+	result.AvailableNow = product.IsAvailableAt(local, categories) && !result.SoldOut
+	result.Scheduled = product.IsScheduled(categories)
+	applyPricing(&result, promotions.PriceFor(&product, categories, local))
+	return result
+}
+
+func ListProductWithAvailabilityToResultDTO(products []entities.Product, local time.Time, categories *entities.CategoryTree, stocks entities.StockLevels, promotions entities.Promotions) []dtos.ProductResultDTO {
+	result := make([]dtos.ProductResultDTO, len(products))
+	for i, p := range products {
+		result[i] = ProductWithAvailabilityToResultDTO(p, local, categories, stocks, promotions)
+	}
+	return result
+}
+
+func applyPricing(result *dtos.ProductResultDTO, pricing entities.ProductPricing) {
+	result.OriginalPrice = pricing.OriginalPrice
+	result.EffectivePrice = pricing.EffectivePrice
+	result.AppliedPromotions = appliedPromotionsToDTO(pricing.Applied)
+}
+
+func appliedPromotionsToDTO(applied []entities.AppliedPromotion) []dtos.AppliedPromotionDTO {
+	var result []dtos.AppliedPromotionDTO
+
+	for _, promotion := range applied {
+		result = append(result, dtos.AppliedPromotionDTO{
+			ID:            promotion.Promotion.ID,
+			Name:          promotion.Promotion.Name,
+			DiscountType:  promotion.Promotion.Discount.Type(),
+			DiscountValue: promotion.Promotion.Discount.Value(),
+			Amount:        promotion.Amount,
+		})
+	}
+
+	return result
+}
+
 func ProductImagesFromDomainToResultDTO(images []*value_objects.Image) []dtos.ProductImageDTO {
 	imagesResult := make([]dtos.ProductImageDTO, len(images))
 	for i, img := range images {
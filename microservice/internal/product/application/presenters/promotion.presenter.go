@@ -0,0 +1,31 @@
+package presenters
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+func PromotionFromDomainToResultDTO(promotion entities.Promotion) dtos.PromotionResultDTO {
+	return dtos.PromotionResultDTO{
+		ID:            promotion.ID,
+		Name:          promotion.Name,
+		DiscountType:  promotion.Discount.Type(),
+		DiscountValue: promotion.Discount.Value(),
+		ProductIDs:    promotion.ProductIDs,
+		CategoryIDs:   promotion.CategoryIDs,
+		Schedule:      AvailabilityFromDomainToDTO(promotion.Schedule),
+		Priority:      promotion.Priority,
+		Stackable:     promotion.Stackable,
+		Active:        promotion.Active,
+		CreatedAt:     promotion.CreatedAt,
+		UpdatedAt:     promotion.UpdatedAt,
+	}
+}
+
+func PromotionsFromDomainToResultDTO(promotions entities.Promotions) []dtos.PromotionResultDTO {
+	result := make([]dtos.PromotionResultDTO, 0, len(promotions))
+	for _, promotion := range promotions {
+		result = append(result, PromotionFromDomainToResultDTO(*promotion))
+	}
+	return result
+}
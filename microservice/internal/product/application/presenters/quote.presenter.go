@@ -0,0 +1,51 @@
+package presenters
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+func QuoteItemsFromDTOToDomain(items []dtos.QuoteItemDTO) []entities.QuoteItem {
+	result := make([]entities.QuoteItem, len(items))
+	for i, item := range items {
+		result[i] = entities.QuoteItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Options:   item.Options,
+		}
+	}
+	return result
+}
+
+func QuoteFromDomainToResultDTO(quote entities.Quote) dtos.QuoteResultDTO {
+	lines := make([]dtos.QuoteLineDTO, len(quote.Lines))
+
+	for i, line := range quote.Lines {
+		lines[i] = dtos.QuoteLineDTO{
+			ProductID: line.ProductID,
+			Quantity:  line.Quantity,
+			Options:   line.Options,
+			Orderable: line.IsOrderable(),
+		}
+
+		if line.Product != nil {
+			lines[i].Name = line.Product.Name.Value()
+			lines[i].UnitPrice = line.Pricing.OriginalPrice
+			lines[i].EffectiveUnitPrice = line.Pricing.EffectivePrice
+			lines[i].Total = line.Total
+			lines[i].AppliedPromotions = appliedPromotionsToDTO(line.Pricing.Applied)
+		}
+
+		for _, issue := range line.Issues {
+			lines[i].Issues = append(lines[i].Issues, dtos.QuoteIssueDTO{Code: string(issue.Code), Message: issue.Message})
+		}
+	}
+
+	return dtos.QuoteResultDTO{
+		Lines:     lines,
+		Subtotal:  quote.Subtotal,
+		Discount:  quote.Discount,
+		Total:     quote.Total,
+		Orderable: quote.IsOrderable(),
+	}
+}
@@ -0,0 +1,33 @@
+package presenters
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+func ProductStockFromDomainToResultDTO(stock entities.ProductStock) dtos.ProductStockResultDTO {
+	return dtos.ProductStockResultDTO{
+		ProductID: stock.ProductID,
+		Tracked:   stock.Tracked,
+		Quantity:  stock.Quantity,
+		SoldOut:   stock.SoldOut,
+		Available: !stock.IsSoldOut(),
+		UpdatedAt: stock.UpdatedAt,
+	}
+}
+
+func StockReservationFromDomainToResultDTO(reservation entities.StockReservation) dtos.StockReservationResultDTO {
+	items := make([]dtos.StockReservationItemDTO, 0, len(reservation.Items))
+	for _, item := range reservation.Items {
+		items = append(items, dtos.StockReservationItemDTO{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+
+	return dtos.StockReservationResultDTO{
+		ID:        reservation.ID,
+		Items:     items,
+		Status:    string(reservation.Status),
+		ExpiresAt: reservation.ExpiresAt,
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+}
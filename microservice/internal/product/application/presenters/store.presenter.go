@@ -0,0 +1,67 @@
+package presenters
+
+import (
+	"slices"
+	"strings"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+func StoreFromDomainToResultDTO(store entities.Store) dtos.StoreResultDTO {
+	return dtos.StoreResultDTO{
+		ID:        store.ID,
+		Name:      store.Name,
+		CreatedAt: store.CreatedAt,
+		UpdatedAt: store.UpdatedAt,
+	}
+}
+
+func StoresFromDomainToResultDTO(stores []entities.Store) []dtos.StoreResultDTO {
+	result := make([]dtos.StoreResultDTO, 0, len(stores))
+	for _, store := range stores {
+		result = append(result, StoreFromDomainToResultDTO(store))
+	}
+	return result
+}
+
+// StoreOverridesFromDomainToResultDTO lista os ajustes ordenados pelo id do
+// item, para que a resposta não dependa da ordem do mapa
+func StoreOverridesFromDomainToResultDTO(overrides entities.StoreOverrides) dtos.StoreOverridesResultDTO {
+	result := dtos.StoreOverridesResultDTO{
+		StoreID:    overrides.StoreID,
+		Products:   make([]dtos.ProductStoreOverrideDTO, 0, len(overrides.Products)),
+		Categories: make([]dtos.CategoryStoreOverrideDTO, 0, len(overrides.Categories)),
+	}
+
+	for _, override := range overrides.Products {
+		overrideDTO := dtos.ProductStoreOverrideDTO{
+			ProductID:    override.ProductID,
+			Active:       override.Active,
+			Availability: AvailabilityFromDomainToDTO(override.Availability),
+			UpdatedAt:    override.UpdatedAt,
+		}
+		if override.Price != nil {
+			price := override.Price.Value()
+			overrideDTO.Price = &price
+		}
+		result.Products = append(result.Products, overrideDTO)
+	}
+
+	for _, override := range overrides.Categories {
+		result.Categories = append(result.Categories, dtos.CategoryStoreOverrideDTO{
+			CategoryID: override.CategoryID,
+			Visible:    override.Visible,
+			UpdatedAt:  override.UpdatedAt,
+		})
+	}
+
+	slices.SortFunc(result.Products, func(a, b dtos.ProductStoreOverrideDTO) int {
+		return strings.Compare(a.ProductID, b.ProductID)
+	})
+	slices.SortFunc(result.Categories, func(a, b dtos.CategoryStoreOverrideDTO) int {
+		return strings.Compare(a.CategoryID, b.CategoryID)
+	})
+
+	return result
+}
@@ -0,0 +1,23 @@
+package presenters
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+func TranslationFromDomainToResultDTO(translation entities.Translation) dtos.TranslationResultDTO {
+	return dtos.TranslationResultDTO{
+		Locale:      translation.Locale.String(),
+		Name:        translation.Name,
+		Description: translation.Description,
+		UpdatedAt:   translation.UpdatedAt,
+	}
+}
+
+func TranslationsFromDomainToResultDTO(translations []entities.Translation) []dtos.TranslationResultDTO {
+	result := make([]dtos.TranslationResultDTO, 0, len(translations))
+	for _, translation := range translations {
+		result = append(result, TranslationFromDomainToResultDTO(translation))
+	}
+	return result
+}
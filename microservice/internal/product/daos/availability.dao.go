@@ -0,0 +1,23 @@
+package daos
+
+type TimeRangeDAO struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+}
+
+type WeeklyAvailabilityDAO struct {
+	Weekdays []string `json:"weekdays"`
+	TimeRangeDAO
+}
+
+type AvailabilityExceptionDAO struct {
+	Date string `json:"date"`
+	TimeRangeDAO
+}
+
+type AvailabilityDAO struct {
+	Weekly     []WeeklyAvailabilityDAO    `json:"weekly,omitempty"`
+	StartDate  string                     `json:"start_date,omitempty"`
+	EndDate    string                     `json:"end_date,omitempty"`
+	Exceptions []AvailabilityExceptionDAO `json:"exceptions,omitempty"`
+}
@@ -1,7 +1,18 @@
 package daos
 
+import "time"
+
 type CategoryDAO struct {
-	ID     string
-	Name   string
-	Active bool
+	ID            string
+	ExternalKey   string
+	ParentID      string
+	Name          string
+	Description   string
+	Position      int
+	ImageFileName string
+	ImageUrl      string
+	Active        bool
+	Availability  *AvailabilityDAO
+	Version       int64
+	UpdatedAt     time.Time
 }
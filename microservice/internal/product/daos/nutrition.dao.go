@@ -0,0 +1,16 @@
+package daos
+
+type NutritionFactsDAO struct {
+	ServingSize   float64 `json:"serving_size"`
+	ServingUnit   string  `json:"serving_unit"`
+	EnergyKcal    float64 `json:"energy_kcal"`
+	Carbohydrates float64 `json:"carbohydrates_g"`
+	TotalSugars   float64 `json:"total_sugars_g"`
+	AddedSugars   float64 `json:"added_sugars_g"`
+	Proteins      float64 `json:"proteins_g"`
+	TotalFat      float64 `json:"total_fat_g"`
+	SaturatedFat  float64 `json:"saturated_fat_g"`
+	TransFat      float64 `json:"trans_fat_g"`
+	DietaryFiber  float64 `json:"dietary_fiber_g"`
+	Sodium        float64 `json:"sodium_mg"`
+}
@@ -1,11 +1,19 @@
 package daos
 
+import "time"
+
 type ProductDAO struct {
-	ID          string
-	CategoryID  string
-	Name        string
-	Description string
-	Price       float64
-	Images      []ProductImageDAO
-	Active      bool
+	ID           string
+	ExternalKey  string
+	CategoryID   string
+	Name         string
+	Description  string
+	Price        float64
+	Images       []ProductImageDAO
+	Active       bool
+	Availability *AvailabilityDAO
+	Nutrition    *NutritionFactsDAO
+	Allergens    []string
+	Version      int64
+	UpdatedAt    time.Time
 }
@@ -9,6 +9,7 @@ type ProductImageDAO struct {
 	Url       string
 	IsDefault bool
 	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 func (ProductImageDAO) TableName() string {
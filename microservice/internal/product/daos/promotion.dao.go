@@ -0,0 +1,18 @@
+package daos
+
+import "time"
+
+type PromotionDAO struct {
+	ID            string
+	Name          string
+	DiscountType  string
+	DiscountValue float64
+	ProductIDs    []string
+	CategoryIDs   []string
+	Schedule      *AvailabilityDAO
+	Priority      int
+	Stackable     bool
+	Active        bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,25 @@
+package daos
+
+import "time"
+
+type ProductStockDAO struct {
+	ProductID string
+	Tracked   bool
+	Quantity  int
+	SoldOut   bool
+	UpdatedAt time.Time
+}
+
+type StockReservationItemDAO struct {
+	ProductID string `json:"product_id"`
+	Quantity  int    `json:"quantity"`
+}
+
+type StockReservationDAO struct {
+	ID        string
+	Items     []StockReservationItemDAO
+	Status    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
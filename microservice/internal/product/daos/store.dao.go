@@ -0,0 +1,28 @@
+package daos
+
+import "time"
+
+type StoreDAO struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ProductStoreOverrideDAO guarda só os campos ajustados na loja; nil segue o
+// cadastro do produto
+type ProductStoreOverrideDAO struct {
+	StoreID      string
+	ProductID    string
+	Price        *float64
+	Active       *bool
+	Availability *AvailabilityDAO
+	UpdatedAt    time.Time
+}
+
+type CategoryStoreOverrideDAO struct {
+	StoreID    string
+	CategoryID string
+	Visible    *bool
+	UpdatedAt  time.Time
+}
@@ -0,0 +1,13 @@
+package daos
+
+import "time"
+
+// TranslationDAO é o texto de um produto ou categoria (Target) num idioma
+type TranslationDAO struct {
+	Target      string
+	EntityID    string
+	Locale      string
+	Name        string
+	Description string
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,197 @@
+package entities
+
+import (
+	"slices"
+	"time"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+// Profundidade máxima da árvore: Bebidas > Refrigerantes > Lata
+const CategoryMaxDepth = 3
+
+// CategoryTree indexa as categorias por pai para validar e percorrer a
+// hierarquia sem novas consultas ao banco
+type CategoryTree struct {
+	byID     map[string]*Category
+	children map[string][]*Category
+}
+
+func NewCategoryTree(categories []*Category) *CategoryTree {
+	tree := &CategoryTree{
+		byID:     make(map[string]*Category, len(categories)),
+		children: make(map[string][]*Category),
+	}
+
+	for _, category := range categories {
+		tree.byID[category.ID] = category
+	}
+
+	for _, category := range categories {
+		tree.children[category.ParentID] = append(tree.children[category.ParentID], category)
+	}
+
+	for _, children := range tree.children {
+		slices.SortStableFunc(children, func(a, b *Category) int {
+			return a.Position - b.Position
+		})
+	}
+
+	return tree
+}
+
+func (t *CategoryTree) Find(id string) (*Category, bool) {
+	category, ok := t.byID[id]
+	return category, ok
+}
+
+func (t *CategoryTree) Roots() []*Category {
+	return t.children[""]
+}
+
+func (t *CategoryTree) Children(id string) []*Category {
+	return t.children[id]
+}
+
+// Descendants devolve filhos, netos etc. em profundidade
+func (t *CategoryTree) Descendants(id string) []*Category {
+	var descendants []*Category
+
+	for _, child := range t.children[id] {
+		descendants = append(descendants, child)
+		descendants = append(descendants, t.Descendants(child.ID)...)
+	}
+
+	return descendants
+}
+
+// Depth é 1 para categorias raiz
+func (t *CategoryTree) Depth(id string) int {
+	depth := 0
+
+	for category, ok := t.byID[id]; ok && depth <= len(t.byID); category, ok = t.byID[category.ParentID] {
+		depth++
+	}
+
+	return depth
+}
+
+// Height é 1 para categorias sem filhos
+func (t *CategoryTree) Height(id string) int {
+	height := 0
+
+	for _, child := range t.children[id] {
+		height = max(height, t.Height(child.ID))
+	}
+
+	return height + 1
+}
+
+// IsWithin indica se a categoria id é ancestorID ou fica abaixo dela. Sem a
+// árvore (nil), só a própria categoria é comparada
+func (t *CategoryTree) IsWithin(id, ancestorID string) bool {
+	if id == ancestorID {
+		return true
+	}
+	if t == nil {
+		return false
+	}
+
+	depth := 0
+	for category, ok := t.byID[id]; ok && depth <= len(t.byID); category, ok = t.byID[category.ParentID] {
+		if category.ParentID == ancestorID {
+			return true
+		}
+		depth++
+	}
+
+	return false
+}
+
+// IsAvailableAt exige que a categoria e todas as categorias acima dela estejam
+// ativas e dentro das suas grades no horário local. Categorias desconhecidas
+// não restringem nada
+func (t *CategoryTree) IsAvailableAt(id string, local time.Time) bool {
+	depth := 0
+
+	for category, ok := t.byID[id]; ok && depth <= len(t.byID); category, ok = t.byID[category.ParentID] {
+		if !category.Active || !category.Availability.IsAvailableAt(local) {
+			return false
+		}
+		depth++
+	}
+
+	return true
+}
+
+// IsScheduled indica se a categoria ou alguma acima dela tem grade de
+// disponibilidade
+func (t *CategoryTree) IsScheduled(id string) bool {
+	depth := 0
+
+	for category, ok := t.byID[id]; ok && depth <= len(t.byID); category, ok = t.byID[category.ParentID] {
+		if category.Availability.IsScheduled() {
+			return true
+		}
+		depth++
+	}
+
+	return false
+}
+
+// ValidateActive impede subcategorias ativas sob uma categoria inativa
+func (t *CategoryTree) ValidateActive(parentID string, active bool) error {
+	parent, ok := t.byID[parentID]
+	if !active || !ok || parent.Active {
+		return nil
+	}
+
+	return &exceptions.InvalidCategoryDataException{
+		Message: "subcategory cannot be active while its parent category is inactive",
+	}
+}
+
+// ValidateParent verifica se a categoria pode ficar sob parentID sem criar
+// ciclos nem ultrapassar a profundidade máxima. categoryID vazio indica uma
+// categoria nova.
+func (t *CategoryTree) ValidateParent(categoryID, parentID string) error {
+	if parentID == "" {
+		return nil
+	}
+
+	parent, ok := t.byID[parentID]
+	if !ok {
+		return &exceptions.CategoryNotFoundException{
+			Message: "Parent category %s not found", Args: []any{parentID},
+		}
+	}
+
+	if categoryID != "" {
+		if parentID == categoryID {
+			return &exceptions.InvalidCategoryDataException{
+				Message: "category cannot be its own parent",
+			}
+		}
+
+		for ancestor, ok := parent, true; ok; ancestor, ok = t.byID[ancestor.ParentID] {
+			if ancestor.ID == categoryID {
+				return &exceptions.InvalidCategoryDataException{
+					Message: "category cannot be moved under one of its subcategories",
+				}
+			}
+		}
+	}
+
+	height := 1
+	if categoryID != "" {
+		height = t.Height(categoryID)
+	}
+
+	if t.Depth(parent.ID)+height > CategoryMaxDepth {
+		return &exceptions.InvalidCategoryDataException{
+			Message: "categories can be nested at most %d levels deep", Args: []any{CategoryMaxDepth},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+func newTreeCategory(t *testing.T, id, parentID string, position int) *Category {
+	category, err := NewCategory(id, "Categoria "+id, true)
+	require.NoError(t, err)
+	category.ParentID = parentID
+	category.Position = position
+	return category
+}
+
+func TestCategoryTree(t *testing.T) {
+	tree := NewCategoryTree([]*Category{
+		newTreeCategory(t, "bebidas", "", 2),
+		newTreeCategory(t, "lanches", "", 1),
+		newTreeCategory(t, "sucos", "bebidas", 2),
+		newTreeCategory(t, "refrigerantes", "bebidas", 1),
+		newTreeCategory(t, "latas", "refrigerantes", 1),
+	})
+
+	ids := func(categories []*Category) []string {
+		result := []string{}
+		for _, category := range categories {
+			result = append(result, category.ID)
+		}
+		return result
+	}
+
+	require.Equal(t, []string{"lanches", "bebidas"}, ids(tree.Roots()))
+	require.Equal(t, []string{"refrigerantes", "sucos"}, ids(tree.Children("bebidas")))
+	require.Equal(t, []string{"refrigerantes", "latas", "sucos"}, ids(tree.Descendants("bebidas")))
+	require.Empty(t, tree.Descendants("lanches"))
+	require.Equal(t, 3, tree.Depth("latas"))
+	require.Equal(t, 3, tree.Height("bebidas"))
+	require.Equal(t, 1, tree.Height("lanches"))
+
+	_, ok := tree.Find("latas")
+	require.True(t, ok)
+	_, ok = tree.Find("sobremesas")
+	require.False(t, ok)
+
+	require.True(t, tree.IsWithin("latas", "bebidas"))
+	require.True(t, tree.IsWithin("sucos", "sucos"))
+	require.False(t, tree.IsWithin("bebidas", "latas"))
+	require.False(t, tree.IsWithin("lanches", "bebidas"))
+}
+
+func TestCategoryTree_ValidateParent(t *testing.T) {
+	tree := NewCategoryTree([]*Category{
+		newTreeCategory(t, "bebidas", "", 1),
+		newTreeCategory(t, "refrigerantes", "bebidas", 1),
+		newTreeCategory(t, "latas", "refrigerantes", 1),
+		newTreeCategory(t, "lanches", "", 2),
+	})
+
+	require.NoError(t, tree.ValidateParent("", ""))
+	require.NoError(t, tree.ValidateParent("", "refrigerantes"))
+	require.NoError(t, tree.ValidateParent("latas", "bebidas"))
+	require.NoError(t, tree.ValidateParent("refrigerantes", "lanches"))
+
+	require.EqualError(t, tree.ValidateParent("", "sobremesas"), "Parent category sobremesas not found")
+	require.EqualError(t, tree.ValidateParent("bebidas", "bebidas"), "category cannot be its own parent")
+	require.EqualError(t, tree.ValidateParent("bebidas", "latas"), "category cannot be moved under one of its subcategories")
+	require.EqualError(t, tree.ValidateParent("", "latas"), "categories can be nested at most 3 levels deep")
+	require.EqualError(t, tree.ValidateParent("bebidas", "lanches"), "categories can be nested at most 3 levels deep")
+}
+
+func TestCategoryTree_ValidateActive(t *testing.T) {
+	inactive := newTreeCategory(t, "bebidas", "", 1)
+	inactive.Active = false
+	tree := NewCategoryTree([]*Category{inactive, newTreeCategory(t, "lanches", "", 2)})
+
+	require.NoError(t, tree.ValidateActive("", true))
+	require.NoError(t, tree.ValidateActive("lanches", true))
+	require.NoError(t, tree.ValidateActive("bebidas", false))
+	require.EqualError(t, tree.ValidateActive("bebidas", true), "subcategory cannot be active while its parent category is inactive")
+}
+
+func TestCategoryTree_IsAvailableAt(t *testing.T) {
+	weekends, err := value_objects.NewAvailability(value_objects.Availability{
+		Weekly: []value_objects.WeeklyAvailability{{
+			Weekdays:  []string{"sat", "sun"},
+			TimeRange: value_objects.TimeRange{From: "00:00", To: "24:00"},
+		}},
+	})
+	require.NoError(t, err)
+
+	sobremesas := newTreeCategory(t, "sobremesas", "", 1)
+	sobremesas.Availability = weekends
+	tree := NewCategoryTree([]*Category{
+		sobremesas,
+		newTreeCategory(t, "sorvetes", "sobremesas", 1),
+		newTreeCategory(t, "lanches", "", 2),
+	})
+
+	wednesday := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	saturday := time.Date(2025, 1, 18, 12, 0, 0, 0, time.UTC)
+
+	require.False(t, tree.IsAvailableAt("sorvetes", wednesday))
+	require.True(t, tree.IsAvailableAt("sorvetes", saturday))
+	require.True(t, tree.IsAvailableAt("lanches", wednesday))
+	require.True(t, tree.IsAvailableAt("desconhecida", wednesday))
+
+	picole, err := NewProduct("picole", "sorvetes", "Picolé", "desc", 5, true)
+	require.NoError(t, err)
+	require.False(t, picole.IsAvailableAt(wednesday, tree))
+	require.True(t, picole.IsAvailableAt(saturday, tree))
+	require.True(t, picole.IsAvailableAt(wednesday, nil))
+
+	picole.Active = false
+	require.False(t, picole.IsAvailableAt(saturday, tree))
+}
+
+func TestCategoryTree_IsScheduled(t *testing.T) {
+	lunch, err := value_objects.NewAvailability(value_objects.Availability{
+		Weekly: []value_objects.WeeklyAvailability{{
+			Weekdays:  []string{"mon", "tue", "wed", "thu", "fri"},
+			TimeRange: value_objects.TimeRange{From: "11:00", To: "15:00"},
+		}},
+	})
+	require.NoError(t, err)
+
+	almoco := newTreeCategory(t, "almoco", "", 1)
+	almoco.Availability = lunch
+	bebidas := newTreeCategory(t, "bebidas", "", 2)
+	bebidas.Availability = &value_objects.Availability{}
+	tree := NewCategoryTree([]*Category{
+		almoco,
+		newTreeCategory(t, "pratos", "almoco", 1),
+		bebidas,
+	})
+
+	require.True(t, tree.IsScheduled("pratos"))
+	require.False(t, tree.IsScheduled("bebidas"))
+	require.False(t, tree.IsScheduled("desconhecida"))
+
+	suco, err := NewProduct("suco", "bebidas", "Suco", "desc", 8, true)
+	require.NoError(t, err)
+	require.False(t, suco.IsScheduled(tree))
+	require.True(t, suco.IsScheduled(nil))
+
+	suco.Availability = lunch
+	require.True(t, suco.IsScheduled(tree))
+}
@@ -1,11 +1,28 @@
 package entities
 
-import value_objects "tech_challenge/internal/product/domain/value-objects"
+import (
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+	"time"
+)
+
+const CategoryDescriptionMaxLength = 255
 
 type Category struct {
-	ID     string
-	Name   value_objects.CategoryName
-	Active bool
+	ID          string
+	ExternalKey string
+	ParentID    string
+	Name        value_objects.CategoryName
+	Description string
+	Position    int
+	Image       *value_objects.Image
+	Active      bool
+	// Availability restringe os horários em que a categoria e seus produtos
+	// aparecem
+	Availability *value_objects.Availability
+	// Version avança a cada gravação e é usada no controle de concorrência
+	Version   int64
+	UpdatedAt time.Time
 }
 
 func NewCategory(id, name string, active bool) (*Category, error) {
@@ -16,11 +33,18 @@ func NewCategory(id, name string, active bool) (*Category, error) {
 	}
 
 	return &Category{
-		ID:     id,
-		Name:   categoryName,
-		Active: active,
+		ID:        id,
+		Name:      categoryName,
+		Active:    active,
+		Version:   1,
+		UpdatedAt: time.Now(),
 	}, nil
 }
+
+func (c *Category) IsRoot() bool {
+	return c.ParentID == ""
+}
+
 func (c *Category) SetName(name string) error {
 	newName, err := value_objects.NewCategoryName(name)
 	if err != nil {
@@ -30,3 +54,48 @@ func (c *Category) SetName(name string) error {
 	c.Name = newName
 	return nil
 }
+
+func (c *Category) SetDescription(description string) error {
+	if len([]rune(description)) > CategoryDescriptionMaxLength {
+		return &exceptions.InvalidCategoryDataException{
+			Message: "category description must have at most 255 characters",
+		}
+	}
+
+	c.Description = description
+	return nil
+}
+
+func (c *Category) SetPosition(position int) error {
+	if position < 0 {
+		return &exceptions.InvalidCategoryDataException{
+			Message: "category position must not be negative",
+		}
+	}
+
+	c.Position = position
+	return nil
+}
+
+// SetImage troca o ícone da categoria e devolve o anterior, para que o
+// arquivo antigo possa ser removido do bucket
+func (c *Category) SetImage(originalFileName string) (*value_objects.Image, error) {
+	img, err := value_objects.NewImage(originalFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := c.Image
+	c.Image = &img
+	return previous, nil
+}
+
+func (c *Category) RemoveImage() (*value_objects.Image, error) {
+	if c.Image == nil {
+		return nil, &exceptions.ImageNotFoundException{}
+	}
+
+	previous := c.Image
+	c.Image = nil
+	return previous, nil
+}
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -32,3 +33,45 @@ func TestCategory_SetName_Valid(t *testing.T) {
 	require.NoError(t, c.SetName("Refrigerantes"))
 	require.Equal(t, "Refrigerantes", c.Name.Value())
 }
+
+func TestCategory_SetDescription(t *testing.T) {
+	c, err := NewCategory("id", "Bebidas", true)
+	require.NoError(t, err)
+	require.NoError(t, c.SetDescription("Bebidas geladas"))
+	require.Equal(t, "Bebidas geladas", c.Description)
+	require.Error(t, c.SetDescription(strings.Repeat("a", 256)))
+}
+
+func TestCategory_SetPosition(t *testing.T) {
+	c, err := NewCategory("id", "Bebidas", true)
+	require.NoError(t, err)
+	require.NoError(t, c.SetPosition(3))
+	require.Equal(t, 3, c.Position)
+	require.Error(t, c.SetPosition(-1))
+}
+
+func TestCategory_SetAndRemoveImage(t *testing.T) {
+	c, err := NewCategory("id", "Bebidas", true)
+	require.NoError(t, err)
+
+	_, err = c.RemoveImage()
+	require.Error(t, err)
+
+	previous, err := c.SetImage("icone bebidas.png")
+	require.NoError(t, err)
+	require.Nil(t, previous)
+	first := c.Image
+
+	previous, err = c.SetImage("banner.png")
+	require.NoError(t, err)
+	require.Equal(t, first, previous)
+	require.Contains(t, c.Image.FileName, "banner_")
+
+	removed, err := c.RemoveImage()
+	require.NoError(t, err)
+	require.Contains(t, removed.FileName, "banner_")
+	require.Nil(t, c.Image)
+
+	_, err = c.SetImage("")
+	require.Error(t, err)
+}
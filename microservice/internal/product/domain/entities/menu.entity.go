@@ -0,0 +1,61 @@
+package entities
+
+import "time"
+
+// MenuSection é uma categoria disponível do cardápio com seus produtos
+// disponíveis e as subcategorias disponíveis, todas na ordem de exibição
+type MenuSection struct {
+	Category      *Category
+	Products      []MenuItem
+	Subcategories []MenuSection
+}
+
+// MenuItem é um produto do cardápio. Produtos esgotados continuam listados,
+// marcados com SoldOut, para que o totem os mostre indisponíveis. Pricing traz
+// o preço com as promoções que valem no horário do cardápio
+type MenuItem struct {
+	Product
+	SoldOut bool
+	Pricing ProductPricing
+}
+
+// NewMenu monta o cardápio a partir de todas as categorias e dos produtos já
+// carregados, no horário local informado. Uma categoria inativa ou fora da
+// sua grade esconde toda a sua subárvore, e produtos de categorias fora do
+// cardápio ficam de fora. stocks marca os produtos esgotados e promotions
+// define o preço efetivo de cada um
+func NewMenu(categories []*Category, products []Product, stocks StockLevels, promotions Promotions, local time.Time) []MenuSection {
+	tree := NewCategoryTree(categories)
+
+	productsByCategory := make(map[string][]MenuItem)
+	for _, product := range products {
+		if product.Active && product.Availability.IsAvailableAt(local) {
+			item := MenuItem{
+				Product: product,
+				SoldOut: stocks.IsSoldOut(product.ID),
+				Pricing: promotions.PriceFor(&product, tree, local),
+			}
+			productsByCategory[product.CategoryID] = append(productsByCategory[product.CategoryID], item)
+		}
+	}
+
+	return menuSections(tree, tree.Roots(), productsByCategory, local)
+}
+
+func menuSections(tree *CategoryTree, categories []*Category, productsByCategory map[string][]MenuItem, local time.Time) []MenuSection {
+	sections := make([]MenuSection, 0, len(categories))
+
+	for _, category := range categories {
+		if !category.Active || !category.Availability.IsAvailableAt(local) {
+			continue
+		}
+
+		sections = append(sections, MenuSection{
+			Category:      category,
+			Products:      productsByCategory[category.ID],
+			Subcategories: menuSections(tree, tree.Children(category.ID), productsByCategory, local),
+		})
+	}
+
+	return sections
+}
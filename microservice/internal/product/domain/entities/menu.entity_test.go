@@ -0,0 +1,104 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+func newMenuProduct(t *testing.T, id, categoryID string, active bool) Product {
+	product, err := NewProduct(id, categoryID, "Produto "+id, "desc", 10, active)
+	require.NoError(t, err)
+	return *product
+}
+
+func TestNewMenu(t *testing.T) {
+	sobremesas := newTreeCategory(t, "sobremesas", "", 3)
+	sobremesas.Active = false
+	sorvetes := newTreeCategory(t, "sorvetes", "sobremesas", 1)
+	sorvetes.Active = false
+
+	menu := NewMenu([]*Category{
+		newTreeCategory(t, "bebidas", "", 2),
+		newTreeCategory(t, "lanches", "", 1),
+		newTreeCategory(t, "refrigerantes", "bebidas", 1),
+		sobremesas,
+		sorvetes,
+	}, []Product{
+		newMenuProduct(t, "x-salada", "lanches", true),
+		newMenuProduct(t, "x-velho", "lanches", false),
+		newMenuProduct(t, "coca", "refrigerantes", true),
+		newMenuProduct(t, "pudim", "sobremesas", true),
+		newMenuProduct(t, "orfao", "inexistente", true),
+	}, nil, nil, time.Now())
+
+	require.Len(t, menu, 2)
+	require.Equal(t, "lanches", menu[0].Category.ID)
+	require.Len(t, menu[0].Products, 1)
+	require.Equal(t, "x-salada", menu[0].Products[0].ID)
+	require.Empty(t, menu[0].Subcategories)
+
+	require.Equal(t, "bebidas", menu[1].Category.ID)
+	require.Empty(t, menu[1].Products)
+	require.Len(t, menu[1].Subcategories, 1)
+	require.Equal(t, "coca", menu[1].Subcategories[0].Products[0].ID)
+}
+
+func TestNewMenu_HidesItemsOutsideTheirSchedule(t *testing.T) {
+	breakfast, err := value_objects.NewAvailability(value_objects.Availability{
+		Weekly: []value_objects.WeeklyAvailability{{
+			Weekdays:  []string{"mon", "tue", "wed", "thu", "fri"},
+			TimeRange: value_objects.TimeRange{From: "06:00", To: "10:30"},
+		}},
+	})
+	require.NoError(t, err)
+
+	cafe := newTreeCategory(t, "cafe", "", 1)
+	cafe.Availability = breakfast
+	lanches := newTreeCategory(t, "lanches", "", 2)
+	panqueca := newMenuProduct(t, "panqueca", "lanches", true)
+	panqueca.Availability = breakfast
+
+	categories := []*Category{cafe, lanches}
+	products := []Product{
+		newMenuProduct(t, "pao-de-queijo", "cafe", true),
+		panqueca,
+		newMenuProduct(t, "x-salada", "lanches", true),
+	}
+
+	// 2025-01-15 é uma quarta-feira
+	morning := NewMenu(categories, products, nil, nil, time.Date(2025, 1, 15, 8, 0, 0, 0, time.UTC))
+	require.Len(t, morning, 2)
+	require.Equal(t, "cafe", morning[0].Category.ID)
+	require.Len(t, morning[1].Products, 2)
+
+	afternoon := NewMenu(categories, products, nil, nil, time.Date(2025, 1, 15, 15, 0, 0, 0, time.UTC))
+	require.Len(t, afternoon, 1)
+	require.Equal(t, "lanches", afternoon[0].Category.ID)
+	require.Len(t, afternoon[0].Products, 1)
+	require.Equal(t, "x-salada", afternoon[0].Products[0].ID)
+}
+
+func TestNewMenu_MarksSoldOutProducts(t *testing.T) {
+	categories := []*Category{newTreeCategory(t, "lanches", "", 1)}
+	products := []Product{
+		newMenuProduct(t, "x-salada", "lanches", true),
+		newMenuProduct(t, "x-bacon", "lanches", true),
+		newMenuProduct(t, "x-tudo", "lanches", true),
+	}
+	stocks := NewStockLevels([]ProductStock{
+		{ProductID: "x-salada", Tracked: true, Quantity: 0},
+		{ProductID: "x-bacon", SoldOut: true},
+		{ProductID: "x-tudo", Tracked: true, Quantity: 3},
+	})
+
+	menu := NewMenu(categories, products, stocks, nil, time.Now())
+
+	require.Len(t, menu[0].Products, 3)
+	require.True(t, menu[0].Products[0].SoldOut)
+	require.True(t, menu[0].Products[1].SoldOut)
+	require.False(t, menu[0].Products[2].SoldOut)
+}
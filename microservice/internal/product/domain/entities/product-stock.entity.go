@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+// ProductStock controla se o produto pode ser vendido, independente de Active:
+// um produto esgotado continua no catálogo e no back-office. Produtos sem
+// registro de estoque não controlam quantidade e estão sempre disponíveis
+type ProductStock struct {
+	ProductID string
+	// Tracked indica que Quantity é controlada e descontada pelas reservas
+	Tracked  bool
+	Quantity int
+	// SoldOut é a marcação manual de esgotado, válida com ou sem controle de quantidade
+	SoldOut   bool
+	UpdatedAt time.Time
+}
+
+func NewProductStock(productID string, tracked bool, quantity int, soldOut bool) (*ProductStock, error) {
+	if quantity < 0 {
+		return nil, &exceptions.InvalidStockDataException{Message: "quantity must not be negative"}
+	}
+
+	if !tracked && quantity != 0 {
+		return nil, &exceptions.InvalidStockDataException{Message: "quantity requires track_quantity"}
+	}
+
+	return &ProductStock{
+		ProductID: productID,
+		Tracked:   tracked,
+		Quantity:  quantity,
+		SoldOut:   soldOut,
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+// IsSoldOut considera a marcação manual e, com controle de quantidade, o
+// estoque zerado, que esgota o produto automaticamente
+func (s ProductStock) IsSoldOut() bool {
+	return s.SoldOut || (s.Tracked && s.Quantity == 0)
+}
+
+// CanReserve verifica se quantity unidades podem ser reservadas agora
+func (s ProductStock) CanReserve(quantity int) error {
+	if s.SoldOut {
+		return &exceptions.ProductSoldOutException{Message: "product %s is sold out", Args: []any{s.ProductID}}
+	}
+
+	if s.Tracked && s.Quantity < quantity {
+		return &exceptions.InsufficientStockException{Message: "insufficient stock for product %s", Args: []any{s.ProductID}}
+	}
+
+	return nil
+}
+
+// StockLevels indexa o estoque por produto; produtos ausentes não estão esgotados
+type StockLevels map[string]ProductStock
+
+func NewStockLevels(stocks []ProductStock) StockLevels {
+	levels := make(StockLevels, len(stocks))
+	for _, stock := range stocks {
+		levels[stock.ProductID] = stock
+	}
+	return levels
+}
+
+func (l StockLevels) IsSoldOut(productID string) bool {
+	stock, ok := l[productID]
+	return ok && stock.IsSoldOut()
+}
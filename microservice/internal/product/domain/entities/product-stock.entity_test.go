@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+func TestNewProductStock(t *testing.T) {
+	stock, err := NewProductStock("p1", true, 5, false)
+	require.NoError(t, err)
+	require.Equal(t, 5, stock.Quantity)
+	require.False(t, stock.IsSoldOut())
+
+	_, err = NewProductStock("p1", true, -1, false)
+	require.IsType(t, &exceptions.InvalidStockDataException{}, err)
+
+	_, err = NewProductStock("p1", false, 3, false)
+	require.IsType(t, &exceptions.InvalidStockDataException{}, err)
+}
+
+func TestProductStock_IsSoldOut(t *testing.T) {
+	require.False(t, ProductStock{}.IsSoldOut())
+	require.True(t, ProductStock{SoldOut: true}.IsSoldOut())
+	require.True(t, ProductStock{Tracked: true, Quantity: 0}.IsSoldOut())
+	require.True(t, ProductStock{Tracked: true, Quantity: 2, SoldOut: true}.IsSoldOut())
+	require.False(t, ProductStock{Tracked: true, Quantity: 2}.IsSoldOut())
+}
+
+func TestProductStock_CanReserve(t *testing.T) {
+	require.NoError(t, ProductStock{ProductID: "p1"}.CanReserve(100))
+	require.NoError(t, ProductStock{ProductID: "p1", Tracked: true, Quantity: 2}.CanReserve(2))
+
+	err := ProductStock{ProductID: "p1", Tracked: true, Quantity: 1}.CanReserve(2)
+	require.EqualError(t, err, "insufficient stock for product p1")
+	require.IsType(t, &exceptions.InsufficientStockException{}, err)
+
+	err = ProductStock{ProductID: "p1", SoldOut: true}.CanReserve(1)
+	require.IsType(t, &exceptions.ProductSoldOutException{}, err)
+}
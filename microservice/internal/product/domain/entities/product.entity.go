@@ -4,16 +4,26 @@ import (
 	"slices"
 	"tech_challenge/internal/product/domain/exceptions"
 	value_objects "tech_challenge/internal/product/domain/value-objects"
+	"time"
 )
 
 type Product struct {
 	ID          string
+	ExternalKey string
 	CategoryID  string
 	Name        value_objects.Name
 	Description string
 	Price       value_objects.Price
 	Images      []*value_objects.Image
 	Active      bool
+	// Availability restringe os horários em que o produto ativo é oferecido
+	Availability *value_objects.Availability
+	// Nutrition é a tabela nutricional por porção, quando informada
+	Nutrition *value_objects.NutritionFacts
+	Allergens value_objects.Allergens
+	// Version avança a cada gravação e é usada no controle de concorrência
+	Version   int64
+	UpdatedAt time.Time
 }
 
 func NewProduct(id, categoryID, name, description string, price float64, active bool) (*Product, error) {
@@ -42,6 +52,8 @@ func NewProduct(id, categoryID, name, description string, price float64, active
 		Price:       productPrice,
 		Images:      []*value_objects.Image{defaultImagePtr},
 		Active:      active,
+		Version:     1,
+		UpdatedAt:   time.Now(),
 	}, nil
 }
 
@@ -86,6 +98,8 @@ func NewProductWithImages(
 		Price:       productPrice,
 		Images:      productImages,
 		Active:      active,
+		Version:     1,
+		UpdatedAt:   time.Now(),
 	}, nil
 }
 
@@ -166,6 +180,32 @@ func (c *Product) ImageIsDefault(imageFileName string) bool {
 	return false
 }
 
+// IsAvailableAt indica se o produto pode ser vendido no horário local: ele
+// precisa estar ativo, dentro da sua grade e numa categoria disponível
+func (p *Product) IsAvailableAt(local time.Time, categories *CategoryTree) bool {
+	if !p.Active || !p.Availability.IsAvailableAt(local) {
+		return false
+	}
+	return categories == nil || categories.IsAvailableAt(p.CategoryID, local)
+}
+
+// IsScheduled indica se IsAvailableAt depende do horário, por haver grade no
+// produto ou nas categorias acima dele. Sem a árvore, as categorias são
+// desconhecidas e o produto é tratado como se tivesse grade
+func (p *Product) IsScheduled(categories *CategoryTree) bool {
+	return categories == nil || p.Availability.IsScheduled() || categories.IsScheduled(p.CategoryID)
+}
+
+// DefaultImage devolve a imagem exibida nas listagens, ou nil se não houver
+func (c *Product) DefaultImage() *value_objects.Image {
+	for _, img := range c.Images {
+		if img.IsDefault {
+			return img
+		}
+	}
+	return nil
+}
+
 func (c *Product) IsEmpty() bool {
 	return c.ID == ""
 }
@@ -0,0 +1,159 @@
+package entities
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+const PromotionMaxPriority = 1000
+
+// Promotion desconta o preço dos produtos listados e dos produtos das
+// categorias listadas, inclusive das subcategorias. Schedule reaproveita a
+// grade de disponibilidade: período de validade, dias da semana e horários em
+// que a promoção vale. Entre as promoções que valem para um produto, a de
+// maior Priority é aplicada; promoções Stackable se acumulam entre si
+type Promotion struct {
+	ID          string
+	Name        string
+	Discount    value_objects.Discount
+	ProductIDs  []string
+	CategoryIDs []string
+	Schedule    *value_objects.Availability
+	Priority    int
+	Stackable   bool
+	Active      bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewPromotion valida a promoção e normaliza o nome e os alvos, que ficam
+// ordenados e sem repetição
+func NewPromotion(promotion Promotion) (*Promotion, error) {
+	promotion.Name = strings.TrimSpace(promotion.Name)
+
+	if len(promotion.Name) < 3 {
+		return nil, &exceptions.InvalidPromotionException{Message: "name must be at least 3 characters long"}
+	}
+
+	if len(promotion.Name) > 100 {
+		return nil, &exceptions.InvalidPromotionException{Message: "name must be at most 100 characters long"}
+	}
+
+	promotion.ProductIDs = uniqueSorted(promotion.ProductIDs)
+	promotion.CategoryIDs = uniqueSorted(promotion.CategoryIDs)
+
+	if len(promotion.ProductIDs) == 0 && len(promotion.CategoryIDs) == 0 {
+		return nil, &exceptions.InvalidPromotionException{Message: "promotion must target at least one product or category"}
+	}
+
+	if promotion.Priority < 0 || promotion.Priority > PromotionMaxPriority {
+		return nil, &exceptions.InvalidPromotionException{Message: "priority must be between 0 and 1000"}
+	}
+
+	if promotion.CreatedAt.IsZero() {
+		promotion.CreatedAt = time.Now()
+	}
+	promotion.UpdatedAt = time.Now()
+
+	return &promotion, nil
+}
+
+// AppliesTo indica se a promoção vale para o produto no horário local. Sem a
+// árvore de categorias, só a categoria do próprio produto é considerada
+func (p *Promotion) AppliesTo(product *Product, categories *CategoryTree, local time.Time) bool {
+	if !p.Active || !p.Schedule.IsAvailableAt(local) {
+		return false
+	}
+
+	if slices.Contains(p.ProductIDs, product.ID) {
+		return true
+	}
+
+	for _, categoryID := range p.CategoryIDs {
+		if categories.IsWithin(product.CategoryID, categoryID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AppliedPromotion é uma promoção aplicada ao preço, com o valor que ela
+// descontou
+type AppliedPromotion struct {
+	Promotion *Promotion
+	Amount    float64
+}
+
+// ProductPricing é o preço de um produto antes e depois das promoções. Applied
+// vem na ordem de aplicação; vazio quando nenhuma promoção vale
+type ProductPricing struct {
+	OriginalPrice  float64
+	EffectivePrice float64
+	Applied        []AppliedPromotion
+}
+
+// Promotions fica ordenada por prioridade, da maior para a menor, com o ID
+// desempatando para que o resultado não dependa da ordem de leitura
+type Promotions []*Promotion
+
+func NewPromotions(promotions []*Promotion) Promotions {
+	sorted := slices.Clone(promotions)
+	slices.SortStableFunc(sorted, func(a, b *Promotion) int {
+		if a.Priority != b.Priority {
+			return b.Priority - a.Priority
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return sorted
+}
+
+// PriceFor aplica as promoções que valem para o produto no horário local. A
+// de maior prioridade sempre é aplicada; se ela for acumulável, as demais
+// acumuláveis são aplicadas em seguida, cada uma sobre o preço já descontado.
+// Promoções não acumuláveis só valem quando são as de maior prioridade
+func (p Promotions) PriceFor(product *Product, categories *CategoryTree, local time.Time) ProductPricing {
+	pricing := ProductPricing{
+		OriginalPrice:  product.Price.Value(),
+		EffectivePrice: product.Price.Value(),
+	}
+
+	for _, promotion := range p {
+		if !promotion.AppliesTo(product, categories, local) {
+			continue
+		}
+
+		if len(pricing.Applied) > 0 && !promotion.Stackable {
+			continue
+		}
+
+		discounted := promotion.Discount.Apply(pricing.EffectivePrice)
+		pricing.Applied = append(pricing.Applied, AppliedPromotion{
+			Promotion: promotion,
+			Amount:    roundCents(pricing.EffectivePrice - discounted),
+		})
+		pricing.EffectivePrice = discounted
+
+		if !promotion.Stackable || pricing.EffectivePrice == 0 {
+			break
+		}
+	}
+
+	return pricing
+}
+
+func roundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func uniqueSorted(values []string) []string {
+	result := slices.Clone(values)
+	slices.Sort(result)
+	return slices.Compact(result)
+}
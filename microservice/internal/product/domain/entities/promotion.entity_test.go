@@ -0,0 +1,135 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+func newTestPromotion(t *testing.T, id string, kind string, value float64, priority int, stackable bool) *Promotion {
+	discount, err := value_objects.NewDiscount(kind, value)
+	require.NoError(t, err)
+	promotion, err := NewPromotion(Promotion{
+		ID:         id,
+		Name:       "Promoção " + id,
+		Discount:   discount,
+		ProductIDs: []string{"p1"},
+		Priority:   priority,
+		Stackable:  stackable,
+		Active:     true,
+	})
+	require.NoError(t, err)
+	return promotion
+}
+
+func TestNewPromotion(t *testing.T) {
+	discount, err := value_objects.NewDiscount(value_objects.DiscountPercentage, 20)
+	require.NoError(t, err)
+
+	promotion, err := NewPromotion(Promotion{
+		ID:          "promo",
+		Name:        "  Terça das sobremesas ",
+		Discount:    discount,
+		CategoryIDs: []string{"sorvetes", "sobremesas", "sorvetes"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Terça das sobremesas", promotion.Name)
+	require.Equal(t, []string{"sobremesas", "sorvetes"}, promotion.CategoryIDs)
+	require.False(t, promotion.CreatedAt.IsZero())
+
+	_, err = NewPromotion(Promotion{Name: "Sem alvo", Discount: discount})
+	require.IsType(t, &exceptions.InvalidPromotionException{}, err)
+	require.EqualError(t, err, "promotion must target at least one product or category")
+
+	_, err = NewPromotion(Promotion{Name: "Prioridade", Discount: discount, ProductIDs: []string{"p1"}, Priority: 1001})
+	require.EqualError(t, err, "priority must be between 0 and 1000")
+
+	_, err = NewPromotion(Promotion{Name: "ab", Discount: discount, ProductIDs: []string{"p1"}})
+	require.EqualError(t, err, "name must be at least 3 characters long")
+}
+
+func TestPromotion_AppliesTo(t *testing.T) {
+	tuesday := time.Date(2025, 1, 14, 15, 0, 0, 0, time.UTC)
+	wednesday := tuesday.AddDate(0, 0, 1)
+
+	tree := NewCategoryTree([]*Category{
+		newTreeCategory(t, "sobremesas", "", 1),
+		newTreeCategory(t, "sorvetes", "sobremesas", 1),
+		newTreeCategory(t, "lanches", "", 2),
+	})
+	sorvete, err := NewProduct("sorvete", "sorvetes", "Sorvete", "", 12, true)
+	require.NoError(t, err)
+	lanche, err := NewProduct("x-bacon", "lanches", "X-Bacon", "", 32.9, true)
+	require.NoError(t, err)
+
+	discount, err := value_objects.NewDiscount(value_objects.DiscountPercentage, 20)
+	require.NoError(t, err)
+	promotion, err := NewPromotion(Promotion{
+		Name:        "Terça das sobremesas",
+		Discount:    discount,
+		CategoryIDs: []string{"sobremesas"},
+		Schedule:    &value_objects.Availability{Weekly: []value_objects.WeeklyAvailability{{Weekdays: []string{"tue"}, TimeRange: value_objects.TimeRange{From: "00:00", To: "24:00"}}}},
+		Active:      true,
+	})
+	require.NoError(t, err)
+
+	// Produtos das subcategorias também entram
+	require.True(t, promotion.AppliesTo(sorvete, tree, tuesday))
+	require.False(t, promotion.AppliesTo(sorvete, tree, wednesday))
+	require.False(t, promotion.AppliesTo(lanche, tree, tuesday))
+	// Sem a árvore, só a categoria do próprio produto é comparada
+	require.False(t, promotion.AppliesTo(sorvete, nil, tuesday))
+
+	promotion.Active = false
+	require.False(t, promotion.AppliesTo(sorvete, tree, tuesday))
+}
+
+func TestPromotions_PriceFor(t *testing.T) {
+	product := newTestProduct(t, "p1", 40, true)
+	now := time.Now()
+
+	t.Run("without promotions", func(t *testing.T) {
+		pricing := Promotions(nil).PriceFor(&product, nil, now)
+		require.Equal(t, ProductPricing{OriginalPrice: 40, EffectivePrice: 40}, pricing)
+	})
+
+	t.Run("highest priority wins", func(t *testing.T) {
+		low := newTestPromotion(t, "low", value_objects.DiscountPercentage, 50, 1, false)
+		high := newTestPromotion(t, "high", value_objects.DiscountFixed, 5, 10, false)
+
+		pricing := NewPromotions([]*Promotion{low, high}).PriceFor(&product, nil, now)
+
+		require.Equal(t, 35.0, pricing.EffectivePrice)
+		require.Len(t, pricing.Applied, 1)
+		require.Same(t, high, pricing.Applied[0].Promotion)
+		require.Equal(t, 5.0, pricing.Applied[0].Amount)
+	})
+
+	t.Run("stackable promotions compound", func(t *testing.T) {
+		first := newTestPromotion(t, "first", value_objects.DiscountPercentage, 10, 10, true)
+		exclusive := newTestPromotion(t, "exclusive", value_objects.DiscountPercentage, 50, 5, false)
+		second := newTestPromotion(t, "second", value_objects.DiscountFixed, 6, 1, true)
+
+		pricing := NewPromotions([]*Promotion{second, exclusive, first}).PriceFor(&product, nil, now)
+
+		require.Equal(t, 30.0, pricing.EffectivePrice)
+		require.Len(t, pricing.Applied, 2)
+		require.Same(t, first, pricing.Applied[0].Promotion)
+		require.Equal(t, 4.0, pricing.Applied[0].Amount)
+		require.Same(t, second, pricing.Applied[1].Promotion)
+		require.Equal(t, 6.0, pricing.Applied[1].Amount)
+	})
+
+	t.Run("price never goes below zero", func(t *testing.T) {
+		promotion := newTestPromotion(t, "free", value_objects.DiscountFixed, 100, 1, true)
+
+		pricing := NewPromotions([]*Promotion{promotion}).PriceFor(&product, nil, now)
+
+		require.Equal(t, 0.0, pricing.EffectivePrice)
+		require.Equal(t, 40.0, pricing.Applied[0].Amount)
+	})
+}
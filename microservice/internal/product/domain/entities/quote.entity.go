@@ -0,0 +1,137 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// QuoteIssueCode é o motivo pelo qual um item da cotação não pode ser pedido
+type QuoteIssueCode string
+
+const (
+	QuoteIssueUnknown          QuoteIssueCode = "unknown"
+	QuoteIssueInactive         QuoteIssueCode = "inactive"
+	QuoteIssueUnavailable      QuoteIssueCode = "unavailable"
+	QuoteIssueInvalidSelection QuoteIssueCode = "invalid_selection"
+)
+
+type QuoteIssue struct {
+	Code    QuoteIssueCode
+	Message string
+}
+
+// QuoteItem é um item pedido: o produto, a quantidade e as opções escolhidas
+type QuoteItem struct {
+	ProductID string
+	Quantity  int
+	Options   []string
+}
+
+// QuoteLine é o item validado. Product é nil quando o produto não existe;
+// Pricing e Total só são calculados para produtos conhecidos
+type QuoteLine struct {
+	QuoteItem
+	Product *Product
+	Pricing ProductPricing
+	Total   float64
+	Issues  []QuoteIssue
+}
+
+func (l QuoteLine) IsOrderable() bool {
+	return len(l.Issues) == 0
+}
+
+// Quote é a cotação dos itens na ordem em que foram pedidos. Os totais somam
+// apenas as linhas que podem ser pedidas: Subtotal pelo preço original,
+// Discount o desconto das promoções e Total o valor a cobrar
+type Quote struct {
+	Lines    []QuoteLine
+	Subtotal float64
+	Discount float64
+	Total    float64
+}
+
+func (q Quote) IsOrderable() bool {
+	for _, line := range q.Lines {
+		if !line.IsOrderable() {
+			return false
+		}
+	}
+	return true
+}
+
+// NewQuote valida e precifica os itens no horário local com as mesmas regras
+// do cardápio: o produto precisa estar ativo, dentro da sua grade e da grade
+// da categoria, e não esgotado. Itens repetidos do mesmo produto somam as
+// quantidades na conferência do estoque. Os produtos ainda não têm opções, então
+// qualquer opção escolhida é uma seleção inválida
+func NewQuote(items []QuoteItem, products []Product, categories []*Category, stocks StockLevels, promotions Promotions, local time.Time) Quote {
+	tree := NewCategoryTree(categories)
+
+	productsByID := make(map[string]*Product, len(products))
+	for i := range products {
+		productsByID[products[i].ID] = &products[i]
+	}
+
+	requested := make(map[string]int, len(items))
+	for _, item := range items {
+		requested[item.ProductID] += item.Quantity
+	}
+
+	quote := Quote{Lines: make([]QuoteLine, 0, len(items))}
+
+	for _, item := range items {
+		line := QuoteLine{QuoteItem: item, Product: productsByID[item.ProductID]}
+
+		if line.Product == nil {
+			line.Issues = append(line.Issues, QuoteIssue{Code: QuoteIssueUnknown, Message: fmt.Sprintf("product %s not found", item.ProductID)})
+		} else {
+			line.Pricing = promotions.PriceFor(line.Product, tree, local)
+			line.Total = roundCents(line.Pricing.EffectivePrice * float64(item.Quantity))
+			line.Issues = quoteIssues(line.Product, tree, stocks, requested[item.ProductID], local)
+		}
+
+		if item.Quantity < 1 {
+			line.Issues = append(line.Issues, QuoteIssue{Code: QuoteIssueInvalidSelection, Message: "quantity must be at least 1"})
+		}
+
+		for _, option := range item.Options {
+			line.Issues = append(line.Issues, QuoteIssue{Code: QuoteIssueInvalidSelection, Message: fmt.Sprintf("product %s has no option %s", item.ProductID, option)})
+		}
+
+		if line.IsOrderable() {
+			quote.Subtotal += line.Pricing.OriginalPrice * float64(item.Quantity)
+			quote.Total += line.Total
+		}
+
+		quote.Lines = append(quote.Lines, line)
+	}
+
+	quote.Subtotal = roundCents(quote.Subtotal)
+	quote.Total = roundCents(quote.Total)
+	quote.Discount = roundCents(quote.Subtotal - quote.Total)
+
+	return quote
+}
+
+func quoteIssues(product *Product, tree *CategoryTree, stocks StockLevels, quantity int, local time.Time) []QuoteIssue {
+	if !product.Active {
+		return []QuoteIssue{{Code: QuoteIssueInactive, Message: fmt.Sprintf("product %s is inactive", product.ID)}}
+	}
+
+	if !product.IsAvailableAt(local, tree) {
+		return []QuoteIssue{{Code: QuoteIssueUnavailable, Message: fmt.Sprintf("product %s is not available at this time", product.ID)}}
+	}
+
+	if stocks.IsSoldOut(product.ID) {
+		return []QuoteIssue{{Code: QuoteIssueUnavailable, Message: fmt.Sprintf("product %s is sold out", product.ID)}}
+	}
+
+	if stock, ok := stocks[product.ID]; ok {
+		if err := stock.CanReserve(quantity); err != nil {
+			return []QuoteIssue{{Code: QuoteIssueUnavailable, Message: err.Error()}}
+		}
+	}
+
+	return nil
+}
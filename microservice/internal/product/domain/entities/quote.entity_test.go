@@ -0,0 +1,75 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewQuote(t *testing.T) {
+	lanches := newTreeCategory(t, "lanches", "", 1)
+	sobremesas := newTreeCategory(t, "sobremesas", "", 2)
+	sobremesas.Active = false
+
+	products := []Product{
+		newMenuProduct(t, "x-salada", "lanches", true),
+		newMenuProduct(t, "x-velho", "lanches", false),
+		newMenuProduct(t, "pudim", "sobremesas", true),
+		newMenuProduct(t, "x-bacon", "lanches", true),
+		newMenuProduct(t, "x-tudo", "lanches", true),
+	}
+	stocks := NewStockLevels([]ProductStock{
+		{ProductID: "x-bacon", SoldOut: true},
+		{ProductID: "x-tudo", Tracked: true, Quantity: 3},
+	})
+	promotion := newTestPromotion(t, "promo", "percentage", 10, 1, false)
+	promotion.ProductIDs = []string{"x-salada"}
+
+	quote := NewQuote([]QuoteItem{
+		{ProductID: "x-salada", Quantity: 3},
+		{ProductID: "x-tudo", Quantity: 2},
+		{ProductID: "inexistente", Quantity: 1},
+		{ProductID: "x-velho", Quantity: 1},
+		{ProductID: "pudim", Quantity: 1},
+		{ProductID: "x-bacon", Quantity: 1},
+		{ProductID: "x-tudo", Quantity: 2},
+		{ProductID: "x-salada", Quantity: 1, Options: []string{"sem-cebola"}},
+	}, products, []*Category{lanches, sobremesas}, stocks, NewPromotions([]*Promotion{promotion}), time.Now())
+
+	require.False(t, quote.IsOrderable())
+	require.Len(t, quote.Lines, 8)
+
+	require.True(t, quote.Lines[0].IsOrderable())
+	require.Equal(t, 9.0, quote.Lines[0].Pricing.EffectivePrice)
+	require.Equal(t, 27.0, quote.Lines[0].Total)
+
+	require.Equal(t, []QuoteIssue{{Code: QuoteIssueUnknown, Message: "product inexistente not found"}}, quote.Lines[2].Issues)
+	require.Nil(t, quote.Lines[2].Product)
+	require.Equal(t, QuoteIssueInactive, quote.Lines[3].Issues[0].Code)
+	require.Equal(t, "product pudim is not available at this time", quote.Lines[4].Issues[0].Message)
+	require.Equal(t, "product x-bacon is sold out", quote.Lines[5].Issues[0].Message)
+	// As duas linhas do x-tudo pedem 4 unidades, mas só há 3
+	require.Equal(t, QuoteIssueUnavailable, quote.Lines[1].Issues[0].Code)
+	require.Equal(t, QuoteIssueUnavailable, quote.Lines[6].Issues[0].Code)
+	require.Equal(t, []QuoteIssue{{Code: QuoteIssueInvalidSelection, Message: "product x-salada has no option sem-cebola"}}, quote.Lines[7].Issues)
+
+	// Apenas a primeira linha entra nos totais
+	require.Equal(t, 30.0, quote.Subtotal)
+	require.Equal(t, 3.0, quote.Discount)
+	require.Equal(t, 27.0, quote.Total)
+}
+
+func TestNewQuote_AllOrderable(t *testing.T) {
+	products := []Product{newMenuProduct(t, "x-salada", "lanches", true), newMenuProduct(t, "coca", "lanches", true)}
+
+	quote := NewQuote([]QuoteItem{
+		{ProductID: "x-salada", Quantity: 2},
+		{ProductID: "coca", Quantity: 1},
+	}, products, []*Category{newTreeCategory(t, "lanches", "", 1)}, nil, nil, time.Now())
+
+	require.True(t, quote.IsOrderable())
+	require.Equal(t, 30.0, quote.Subtotal)
+	require.Equal(t, 0.0, quote.Discount)
+	require.Equal(t, 30.0, quote.Total)
+}
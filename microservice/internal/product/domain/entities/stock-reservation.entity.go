@@ -0,0 +1,122 @@
+package entities
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+type StockReservationStatus string
+
+const (
+	StockReservationPending   StockReservationStatus = "pending"
+	StockReservationConfirmed StockReservationStatus = "confirmed"
+	StockReservationReleased  StockReservationStatus = "released"
+	StockReservationExpired   StockReservationStatus = "expired"
+)
+
+type StockReservationItem struct {
+	ProductID string
+	Quantity  int
+}
+
+// StockReservation segura unidades de um pedido até ser confirmada ou
+// liberada. Se nenhuma das duas acontecer até ExpiresAt, a reserva expira e as
+// unidades voltam para o estoque
+type StockReservation struct {
+	ID        string
+	Items     []StockReservationItem
+	Status    StockReservationStatus
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewStockReservation soma itens repetidos do mesmo produto, mantendo a ordem
+// da primeira ocorrência
+func NewStockReservation(id string, items []StockReservationItem, now time.Time, ttl time.Duration) (*StockReservation, error) {
+	if len(items) == 0 {
+		return nil, &exceptions.InvalidStockDataException{Message: "at least one item is required"}
+	}
+
+	if ttl <= 0 {
+		return nil, &exceptions.InvalidStockDataException{Message: "reservation ttl must be positive"}
+	}
+
+	merged := make([]StockReservationItem, 0, len(items))
+	positions := make(map[string]int, len(items))
+
+	for i, item := range items {
+		if item.ProductID == "" {
+			return nil, &exceptions.InvalidStockDataException{Message: "items[%d]: product_id is required", Args: []any{i}}
+		}
+
+		if item.Quantity <= 0 {
+			return nil, &exceptions.InvalidStockDataException{Message: "items[%d]: quantity must be greater than 0", Args: []any{i}}
+		}
+
+		if position, ok := positions[item.ProductID]; ok {
+			merged[position].Quantity += item.Quantity
+			continue
+		}
+
+		positions[item.ProductID] = len(merged)
+		merged = append(merged, item)
+	}
+
+	return &StockReservation{
+		ID:        id,
+		Items:     merged,
+		Status:    StockReservationPending,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (r *StockReservation) IsExpired(now time.Time) bool {
+	return r.Status == StockReservationPending && !now.Before(r.ExpiresAt)
+}
+
+// Confirm consome as unidades reservadas. Confirmar de novo não faz nada, para
+// que o serviço de pedidos possa repetir a chamada com segurança
+func (r *StockReservation) Confirm(now time.Time) (bool, error) {
+	if r.Status == StockReservationConfirmed {
+		return false, nil
+	}
+
+	if r.IsExpired(now) || r.Status == StockReservationExpired {
+		return false, &exceptions.StockReservationExpiredException{}
+	}
+
+	if r.Status != StockReservationPending {
+		return false, r.invalidTransition("confirmed")
+	}
+
+	r.Status = StockReservationConfirmed
+	r.UpdatedAt = now
+	return true, nil
+}
+
+// Release devolve as unidades de uma reserva pendente com o status informado
+// (released ou expired). Liberar uma reserva já liberada ou expirada não faz
+// nada; uma reserva confirmada não pode mais ser liberada
+func (r *StockReservation) Release(status StockReservationStatus, now time.Time) (bool, error) {
+	if r.Status == StockReservationReleased || r.Status == StockReservationExpired {
+		return false, nil
+	}
+
+	if r.Status != StockReservationPending {
+		return false, r.invalidTransition("released")
+	}
+
+	r.Status = status
+	r.UpdatedAt = now
+	return true, nil
+}
+
+func (r *StockReservation) invalidTransition(target string) error {
+	return &exceptions.InvalidStockReservationStateException{
+		Message: "reservation is %s and cannot be %s", Args: []any{r.Status, target},
+	}
+}
@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+var reservationNow = time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+func newPendingReservation(t *testing.T) *StockReservation {
+	reservation, err := NewStockReservation("r1", []StockReservationItem{{ProductID: "p1", Quantity: 1}}, reservationNow, 10*time.Minute)
+	require.NoError(t, err)
+	return reservation
+}
+
+func TestNewStockReservation_MergesRepeatedProducts(t *testing.T) {
+	reservation, err := NewStockReservation("r1", []StockReservationItem{
+		{ProductID: "p1", Quantity: 1},
+		{ProductID: "p2", Quantity: 2},
+		{ProductID: "p1", Quantity: 3},
+	}, reservationNow, 10*time.Minute)
+
+	require.NoError(t, err)
+	require.Equal(t, []StockReservationItem{{ProductID: "p1", Quantity: 4}, {ProductID: "p2", Quantity: 2}}, reservation.Items)
+	require.Equal(t, StockReservationPending, reservation.Status)
+	require.Equal(t, reservationNow.Add(10*time.Minute), reservation.ExpiresAt)
+}
+
+func TestNewStockReservation_Invalid(t *testing.T) {
+	cases := map[string][]StockReservationItem{
+		"at least one item is required":             nil,
+		"items[0]: product_id is required":          {{Quantity: 1}},
+		"items[1]: quantity must be greater than 0": {{ProductID: "p1", Quantity: 1}, {ProductID: "p2"}},
+	}
+
+	for message, items := range cases {
+		_, err := NewStockReservation("r1", items, reservationNow, time.Minute)
+		require.EqualError(t, err, message)
+		require.IsType(t, &exceptions.InvalidStockDataException{}, err)
+	}
+}
+
+func TestStockReservation_Confirm(t *testing.T) {
+	reservation := newPendingReservation(t)
+
+	changed, err := reservation.Confirm(reservationNow.Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, StockReservationConfirmed, reservation.Status)
+
+	// Repetir a confirmação não é erro
+	changed, err = reservation.Confirm(reservationNow.Add(time.Hour))
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	_, err = reservation.Release(StockReservationReleased, reservationNow)
+	require.IsType(t, &exceptions.InvalidStockReservationStateException{}, err)
+}
+
+func TestStockReservation_ConfirmExpired(t *testing.T) {
+	reservation := newPendingReservation(t)
+
+	_, err := reservation.Confirm(reservationNow.Add(10 * time.Minute))
+	require.IsType(t, &exceptions.StockReservationExpiredException{}, err)
+	require.Equal(t, StockReservationPending, reservation.Status)
+}
+
+func TestStockReservation_Release(t *testing.T) {
+	reservation := newPendingReservation(t)
+
+	changed, err := reservation.Release(StockReservationExpired, reservationNow)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, StockReservationExpired, reservation.Status)
+
+	changed, err = reservation.Release(StockReservationReleased, reservationNow)
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	_, err = reservation.Confirm(reservationNow)
+	require.IsType(t, &exceptions.StockReservationExpiredException{}, err)
+}
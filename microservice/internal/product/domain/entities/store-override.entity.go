@@ -0,0 +1,146 @@
+package entities
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+// ProductStoreOverride ajusta um produto numa loja. Campos nil seguem o
+// cadastro do produto
+type ProductStoreOverride struct {
+	StoreID      string
+	ProductID    string
+	Price        *value_objects.Price
+	Active       *bool
+	Availability *value_objects.Availability
+	UpdatedAt    time.Time
+}
+
+func NewProductStoreOverride(storeID, productID string, price *float64, active *bool, availability *value_objects.Availability) (*ProductStoreOverride, error) {
+	override := &ProductStoreOverride{
+		StoreID:      storeID,
+		ProductID:    productID,
+		Active:       active,
+		Availability: availability,
+		UpdatedAt:    time.Now(),
+	}
+
+	if price != nil {
+		storePrice, err := value_objects.NewPrice(*price)
+		if err != nil {
+			return nil, &exceptions.InvalidStoreOverrideException{Message: "%s", Args: []any{err}}
+		}
+		override.Price = &storePrice
+	}
+
+	return override, nil
+}
+
+// IsEmpty indica um ajuste sem nenhum campo, que equivale a não ter ajuste
+func (o *ProductStoreOverride) IsEmpty() bool {
+	return o.Price == nil && o.Active == nil && o.Availability == nil
+}
+
+// CategoryStoreOverride mostra ou esconde uma categoria numa loja. Uma
+// categoria escondida esconde também as subcategorias e os produtos delas
+type CategoryStoreOverride struct {
+	StoreID    string
+	CategoryID string
+	Visible    *bool
+	UpdatedAt  time.Time
+}
+
+func NewCategoryStoreOverride(storeID, categoryID string, visible *bool) *CategoryStoreOverride {
+	return &CategoryStoreOverride{
+		StoreID:    storeID,
+		CategoryID: categoryID,
+		Visible:    visible,
+		UpdatedAt:  time.Now(),
+	}
+}
+
+func (o *CategoryStoreOverride) IsEmpty() bool {
+	return o.Visible == nil
+}
+
+// StoreOverrides indexa os ajustes de uma loja pelo id do produto ou da
+// categoria. O valor zero não altera nada e representa o catálogo sem loja
+type StoreOverrides struct {
+	StoreID    string
+	Products   map[string]ProductStoreOverride
+	Categories map[string]CategoryStoreOverride
+}
+
+func NewStoreOverrides(storeID string, products []ProductStoreOverride, categories []CategoryStoreOverride) StoreOverrides {
+	overrides := StoreOverrides{
+		StoreID:    storeID,
+		Products:   make(map[string]ProductStoreOverride, len(products)),
+		Categories: make(map[string]CategoryStoreOverride, len(categories)),
+	}
+	for _, product := range products {
+		overrides.Products[product.ProductID] = product
+	}
+	for _, category := range categories {
+		overrides.Categories[category.CategoryID] = category
+	}
+	return overrides
+}
+
+// IsStoreScoped indica se a leitura é de uma loja, mesmo sem nenhum ajuste
+func (o StoreOverrides) IsStoreScoped() bool {
+	return o.StoreID != ""
+}
+
+// ActivatesProducts indica se a loja ativa algum produto, que pode estar
+// inativo no catálogo
+func (o StoreOverrides) ActivatesProducts() bool {
+	for _, override := range o.Products {
+		if override.Active != nil && *override.Active {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyToProduct troca preço, active e grade do produto pelos da loja
+func (o StoreOverrides) ApplyToProduct(product *Product) {
+	override, ok := o.Products[product.ID]
+	if !ok {
+		return
+	}
+
+	if override.Price != nil {
+		product.Price = *override.Price
+	}
+	if override.Active != nil {
+		product.Active = *override.Active
+	}
+	if override.Availability != nil {
+		product.Availability = override.Availability
+	}
+}
+
+func (o StoreOverrides) ApplyToProducts(products []Product) {
+	for i := range products {
+		o.ApplyToProduct(&products[i])
+	}
+}
+
+// ApplyToCategory usa a visibilidade da loja como active da categoria, o que
+// já esconde a subárvore no cardápio e no available_now
+func (o StoreOverrides) ApplyToCategory(category *Category) {
+	override, ok := o.Categories[category.ID]
+	if !ok || override.Visible == nil {
+		return
+	}
+
+	category.Active = *override.Visible
+}
+
+func (o StoreOverrides) ApplyToCategories(categories []*Category) {
+	for _, category := range categories {
+		o.ApplyToCategory(category)
+	}
+}
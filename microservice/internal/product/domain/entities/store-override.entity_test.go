@@ -0,0 +1,100 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+func TestNewProductStoreOverride(t *testing.T) {
+	price := 19.9
+	override, err := NewProductStoreOverride("s1", "p1", &price, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 19.9, override.Price.Value())
+	require.False(t, override.IsEmpty())
+
+	zero := 0.0
+	_, err = NewProductStoreOverride("s1", "p1", &zero, nil, nil)
+	require.IsType(t, &exceptions.InvalidStoreOverrideException{}, err)
+
+	empty, err := NewProductStoreOverride("s1", "p1", nil, nil, nil)
+	require.NoError(t, err)
+	require.True(t, empty.IsEmpty())
+}
+
+func TestStoreOverrides_ApplyToProduct(t *testing.T) {
+	price := 30.0
+	inactive := false
+	weekends := &value_objects.Availability{Weekly: []value_objects.WeeklyAvailability{{Weekdays: []string{"sat", "sun"}}}}
+	priceOverride, err := NewProductStoreOverride("s1", "p1", &price, nil, nil)
+	require.NoError(t, err)
+	fullOverride, err := NewProductStoreOverride("s1", "p2", nil, &inactive, weekends)
+	require.NoError(t, err)
+
+	overrides := NewStoreOverrides("s1", []ProductStoreOverride{*priceOverride, *fullOverride}, nil)
+	products := []Product{
+		newTestProduct(t, "p1", 25, true),
+		newTestProduct(t, "p2", 10, true),
+		newTestProduct(t, "p3", 12, true),
+	}
+
+	overrides.ApplyToProducts(products)
+
+	require.Equal(t, 30.0, products[0].Price.Value())
+	require.True(t, products[0].Active)
+	require.Equal(t, 10.0, products[1].Price.Value())
+	require.False(t, products[1].Active)
+	require.Same(t, weekends, products[1].Availability)
+	require.Equal(t, 12.0, products[2].Price.Value())
+}
+
+func TestStoreOverrides_ZeroValueChangesNothing(t *testing.T) {
+	product := newTestProduct(t, "p1", 25, false)
+	category := &Category{ID: "c1", Active: true}
+
+	var overrides StoreOverrides
+	overrides.ApplyToProduct(&product)
+	overrides.ApplyToCategory(category)
+
+	require.False(t, overrides.IsStoreScoped())
+	require.Equal(t, 25.0, product.Price.Value())
+	require.False(t, product.Active)
+	require.True(t, category.Active)
+}
+
+func TestStoreOverrides_HiddenCategoryLeavesMenu(t *testing.T) {
+	hidden, visible := false, true
+	categories := []*Category{
+		{ID: "bebidas", Name: mustCategoryName(t, "Bebidas"), Position: 1, Active: true},
+		{ID: "refri", ParentID: "bebidas", Name: mustCategoryName(t, "Refrigerantes"), Position: 1, Active: true},
+		{ID: "sobremesas", Name: mustCategoryName(t, "Sobremesas"), Position: 2, Active: false},
+	}
+	overrides := NewStoreOverrides("s1", nil, []CategoryStoreOverride{
+		*NewCategoryStoreOverride("s1", "bebidas", &hidden),
+		*NewCategoryStoreOverride("s1", "sobremesas", &visible),
+	})
+
+	overrides.ApplyToCategories(categories)
+	products := []Product{newTestProduct(t, "p1", 8, true)}
+	products[0].CategoryID = "refri"
+	menu := NewMenu(categories, products, nil, nil, time.Now())
+
+	require.Len(t, menu, 1)
+	require.Equal(t, "sobremesas", menu[0].Category.ID)
+}
+
+func newTestProduct(t *testing.T, id string, price float64, active bool) Product {
+	product, err := NewProduct(id, "c1", "Produto "+id, "", price, active)
+	require.NoError(t, err)
+	return *product
+}
+
+func mustCategoryName(t *testing.T, name string) value_objects.CategoryName {
+	categoryName, err := value_objects.NewCategoryName(name)
+	require.NoError(t, err)
+	return categoryName
+}
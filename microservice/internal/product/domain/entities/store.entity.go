@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+// Store é uma loja que vende o catálogo compartilhado. Preço, disponibilidade
+// e visibilidade podem ser ajustados por loja com StoreOverrides, sem copiar
+// os produtos
+type Store struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewStore(id, name string) (*Store, error) {
+	store := &Store{ID: id, CreatedAt: time.Now(), UpdatedAt: time.Now()}
+
+	if err := store.SetName(name); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *Store) SetName(name string) error {
+	name = strings.TrimSpace(name)
+
+	if len(name) < 3 {
+		return &exceptions.InvalidStoreDataException{Message: "name must be at least 3 characters long"}
+	}
+
+	if len(name) > 100 {
+		return &exceptions.InvalidStoreDataException{Message: "name must be at most 100 characters long"}
+	}
+
+	s.Name = name
+	return nil
+}
@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+func TestNewStore(t *testing.T) {
+	store, err := NewStore("s1", "  Aeroporto GRU  ")
+	require.NoError(t, err)
+	require.Equal(t, "Aeroporto GRU", store.Name)
+
+	_, err = NewStore("s1", "AB")
+	require.IsType(t, &exceptions.InvalidStoreDataException{}, err)
+}
@@ -0,0 +1,135 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+// TranslationTarget indica se a tradução é de um produto ou de uma categoria
+type TranslationTarget string
+
+const (
+	ProductTranslationTarget  TranslationTarget = "product"
+	CategoryTranslationTarget TranslationTarget = "category"
+)
+
+// Translation é o nome e a descrição de um produto ou categoria num idioma
+// além do padrão. Uma descrição vazia mantém a do idioma padrão
+type Translation struct {
+	Target      TranslationTarget
+	EntityID    string
+	Locale      value_objects.Locale
+	Name        string
+	Description string
+	UpdatedAt   time.Time
+}
+
+// NewTranslation aplica ao nome traduzido os mesmos limites do nome
+// original e, nas categorias, o limite de descrição
+func NewTranslation(target TranslationTarget, entityID string, locale value_objects.Locale, name, description string) (*Translation, error) {
+	name = strings.TrimSpace(name)
+
+	if len(name) < 3 {
+		return nil, &exceptions.InvalidTranslationException{Message: "name must be at least 3 characters long"}
+	}
+
+	if len(name) > 100 {
+		return nil, &exceptions.InvalidTranslationException{Message: "name must be at most 100 characters long"}
+	}
+
+	if target == CategoryTranslationTarget && len([]rune(description)) > CategoryDescriptionMaxLength {
+		return nil, &exceptions.InvalidTranslationException{Message: "category description must have at most 255 characters"}
+	}
+
+	return &Translation{
+		Target:      target,
+		EntityID:    entityID,
+		Locale:      locale,
+		Name:        name,
+		Description: description,
+		UpdatedAt:   time.Now(),
+	}, nil
+}
+
+// Translations indexa as traduções de um idioma pelo id do produto ou
+// categoria; itens ausentes ficam no idioma padrão
+type Translations map[string]Translation
+
+func NewTranslations(translations []Translation) Translations {
+	index := make(Translations, len(translations))
+	for _, translation := range translations {
+		index[translation.EntityID] = translation
+	}
+	return index
+}
+
+func (t Translations) LocalizeProduct(product *Product) {
+	translation, ok := t[product.ID]
+	if !ok {
+		return
+	}
+
+	if name, err := value_objects.NewName(translation.Name); err == nil {
+		product.Name = name
+	}
+	if translation.Description != "" {
+		product.Description = translation.Description
+	}
+}
+
+func (t Translations) LocalizeCategory(category *Category) {
+	translation, ok := t[category.ID]
+	if !ok {
+		return
+	}
+
+	if name, err := value_objects.NewCategoryName(translation.Name); err == nil {
+		category.Name = name
+	}
+	if translation.Description != "" {
+		category.Description = translation.Description
+	}
+}
+
+// TranslationGap aponta um produto ou categoria sem tradução, ou com a
+// descrição por traduzir, num idioma
+type TranslationGap struct {
+	EntityID string
+	// Name é o nome no idioma padrão, para identificar o item no relatório
+	Name          string
+	MissingFields []string
+}
+
+// Gap compara o item com sua tradução; a descrição só é cobrada quando o
+// item tem descrição no idioma padrão
+func (t Translations) Gap(entityID, name, description string) (TranslationGap, bool) {
+	var missing []string
+
+	translation, ok := t[entityID]
+	if !ok {
+		missing = append(missing, "name")
+	}
+	if description != "" && translation.Description == "" {
+		missing = append(missing, "description")
+	}
+
+	if len(missing) == 0 {
+		return TranslationGap{}, false
+	}
+
+	return TranslationGap{EntityID: entityID, Name: name, MissingFields: missing}, true
+}
+
+// LocalizeMenu traduz as categorias e os produtos do cardápio já montado
+func LocalizeMenu(sections []MenuSection, products, categories Translations) {
+	for i := range sections {
+		categories.LocalizeCategory(sections[i].Category)
+		for j := range sections[i].Products {
+			products.LocalizeProduct(&sections[i].Products[j].Product)
+		}
+		LocalizeMenu(sections[i].Subcategories, products, categories)
+	}
+}
@@ -0,0 +1,89 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+func TestNewTranslation(t *testing.T) {
+	translation, err := NewTranslation(ProductTranslationTarget, "p1", value_objects.LocaleEnglish, "  Cheeseburger ", "Beef and cheese")
+	require.NoError(t, err)
+	require.Equal(t, "Cheeseburger", translation.Name)
+	require.Equal(t, value_objects.LocaleEnglish, translation.Locale)
+
+	_, err = NewTranslation(ProductTranslationTarget, "p1", value_objects.LocaleEnglish, "ab", "")
+	require.IsType(t, &exceptions.InvalidTranslationException{}, err)
+
+	_, err = NewTranslation(CategoryTranslationTarget, "c1", value_objects.LocaleSpanish, "Bebidas", strings.Repeat("a", 256))
+	require.EqualError(t, err, "category description must have at most 255 characters")
+
+	_, err = NewTranslation(ProductTranslationTarget, "p1", value_objects.LocaleSpanish, "Hamburguesa", strings.Repeat("a", 256))
+	require.NoError(t, err)
+}
+
+func TestTranslations_LocalizeProduct(t *testing.T) {
+	translations := NewTranslations([]Translation{
+		{EntityID: "p1", Name: "Cheeseburger", Description: "Beef and cheese"},
+		{EntityID: "p2", Name: "Soda"},
+	})
+
+	burger := newMenuProduct(t, "p1", "lanches", true)
+	translations.LocalizeProduct(&burger)
+	require.Equal(t, "Cheeseburger", burger.Name.Value())
+	require.Equal(t, "Beef and cheese", burger.Description)
+
+	// Sem descrição traduzida, fica a do idioma padrão
+	soda := newMenuProduct(t, "p2", "bebidas", true)
+	translations.LocalizeProduct(&soda)
+	require.Equal(t, "Soda", soda.Name.Value())
+	require.Equal(t, "desc", soda.Description)
+
+	untranslated := newMenuProduct(t, "p3", "bebidas", true)
+	translations.LocalizeProduct(&untranslated)
+	require.Equal(t, "Produto p3", untranslated.Name.Value())
+}
+
+func TestTranslations_Gap(t *testing.T) {
+	translations := NewTranslations([]Translation{
+		{EntityID: "p1", Name: "Cheeseburger", Description: "Beef and cheese"},
+		{EntityID: "p2", Name: "Soda"},
+	})
+
+	_, missing := translations.Gap("p1", "X-Salada", "Pão, carne e queijo")
+	require.False(t, missing)
+
+	gap, missing := translations.Gap("p2", "Refrigerante", "Lata 350ml")
+	require.True(t, missing)
+	require.Equal(t, []string{"description"}, gap.MissingFields)
+
+	_, missing = translations.Gap("p2", "Refrigerante", "")
+	require.False(t, missing)
+
+	gap, missing = translations.Gap("p3", "Pudim", "Pudim de leite")
+	require.True(t, missing)
+	require.Equal(t, TranslationGap{EntityID: "p3", Name: "Pudim", MissingFields: []string{"name", "description"}}, gap)
+}
+
+func TestLocalizeMenu(t *testing.T) {
+	menu := NewMenu([]*Category{
+		newTreeCategory(t, "bebidas", "", 1),
+		newTreeCategory(t, "sucos", "bebidas", 1),
+	}, []Product{
+		newMenuProduct(t, "laranja", "sucos", true),
+	}, nil, nil, time.Now())
+
+	LocalizeMenu(menu,
+		NewTranslations([]Translation{{EntityID: "laranja", Name: "Orange juice"}}),
+		NewTranslations([]Translation{{EntityID: "bebidas", Name: "Drinks"}, {EntityID: "sucos", Name: "Juices"}}),
+	)
+
+	require.Equal(t, "Drinks", menu[0].Category.Name.Value())
+	require.Equal(t, "Juices", menu[0].Subcategories[0].Category.Name.Value())
+	require.Equal(t, "Orange juice", menu[0].Subcategories[0].Products[0].Name.Value())
+}
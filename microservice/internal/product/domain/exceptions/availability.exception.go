@@ -0,0 +1,14 @@
+package exceptions
+
+type InvalidAvailabilityException struct {
+	Message string
+	Args    []any
+}
+
+func (e *InvalidAvailabilityException) Error() string {
+	return render(e.Text())
+}
+
+func (e *InvalidAvailabilityException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Invalid availability schedule")
+}
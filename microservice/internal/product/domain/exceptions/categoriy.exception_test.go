@@ -29,3 +29,9 @@ func TestInvalidCategoryDataException_Error(t *testing.T) {
 	req.Equal("Invalid category data", (&InvalidCategoryDataException{}).Error())
 	req.Equal("Custom", (&InvalidCategoryDataException{Message: "Custom"}).Error())
 }
+
+func TestCategoryHasChildrenException_Error(t *testing.T) {
+	req := require.New(t)
+	req.Equal("Cannot delete category because it has subcategories.", (&CategoryHasChildrenException{}).Error())
+	req.Equal("Custom", (&CategoryHasChildrenException{Message: "Custom"}).Error())
+}
@@ -2,41 +2,62 @@ package exceptions
 
 type CategoryNotFoundException struct {
 	Message string
+	Args    []any
 }
 type CategoryAlreadyExistsException struct {
 	Message string
+	Args    []any
 }
 type CategoryHasProductsException struct {
 	Message string
+	Args    []any
 }
 
 func (e *CategoryNotFoundException) Error() string {
-	if e.Message == "" {
-		return "Category not found"
-	}
-	return e.Message
+	return render(e.Text())
+}
+
+func (e *CategoryNotFoundException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Category not found")
 }
 
 func (e *CategoryAlreadyExistsException) Error() string {
-	if e.Message == "" {
-		return "Category already exists"
-	}
-	return e.Message
+	return render(e.Text())
+}
+
+func (e *CategoryAlreadyExistsException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Category already exists")
 }
 
 type InvalidCategoryDataException struct {
 	Message string
+	Args    []any
 }
 
 func (e *InvalidCategoryDataException) Error() string {
-	if e.Message == "" {
-		return "Invalid category data"
-	}
-	return e.Message
+	return render(e.Text())
+}
+
+func (e *InvalidCategoryDataException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Invalid category data")
 }
 func (e *CategoryHasProductsException) Error() string {
-	if e.Message == "" {
-		return "Cannot delete category because there are products linked to it."
-	}
-	return e.Message
+	return render(e.Text())
+}
+
+func (e *CategoryHasProductsException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Cannot delete category because there are products linked to it.")
+}
+
+type CategoryHasChildrenException struct {
+	Message string
+	Args    []any
+}
+
+func (e *CategoryHasChildrenException) Error() string {
+	return render(e.Text())
+}
+
+func (e *CategoryHasChildrenException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Cannot delete category because it has subcategories.")
 }
@@ -0,0 +1,28 @@
+package exceptions
+
+import "fmt"
+
+// As exceptions guardam a mensagem como um formato em inglês (Message) e os
+// argumentos dele (Args). O formato é a chave da tradução: a API traduz a
+// partir dele, sem reinterpretar o texto já montado, e traduz também os
+// argumentos que forem exceptions
+
+// Localizable é implementada por todas as exceptions do domínio
+type Localizable interface {
+	error
+	Text() (format string, args []any)
+}
+
+func text(message string, args []any, fallback string) (string, []any) {
+	if message == "" {
+		return fallback, nil
+	}
+	return message, args
+}
+
+func render(format string, args []any) string {
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
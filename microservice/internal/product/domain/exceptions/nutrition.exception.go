@@ -0,0 +1,27 @@
+package exceptions
+
+type InvalidNutritionFactsException struct {
+	Message string
+	Args    []any
+}
+
+func (e *InvalidNutritionFactsException) Error() string {
+	return render(e.Text())
+}
+
+func (e *InvalidNutritionFactsException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Invalid nutrition facts")
+}
+
+type InvalidAllergenException struct {
+	Message string
+	Args    []any
+}
+
+func (e *InvalidAllergenException) Error() string {
+	return render(e.Text())
+}
+
+func (e *InvalidAllergenException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Invalid allergen")
+}
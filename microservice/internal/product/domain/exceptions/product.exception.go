@@ -2,63 +2,85 @@ package exceptions
 
 type ProductNotFoundException struct {
 	Message string
+	Args    []any
+}
+
+type ProductAlreadyExistsException struct {
+	Message string
+	Args    []any
 }
 
 type InvalidProductDataException struct {
 	Message string
+	Args    []any
 }
 type InvalidProductImageException struct {
 	Message string
+	Args    []any
 }
 type ImageNotFoundException struct {
 	Message string
+	Args    []any
 }
 type ProductImagesNotFoundException struct {
 	Message string
+	Args    []any
 }
 type ProductImageCannotBeEmptyException struct {
 	Message string
+	Args    []any
 }
 
 func (e *ProductNotFoundException) Error() string {
-	if e.Message == "" {
-		return "Product not found"
-	}
-	return e.Message
+	return render(e.Text())
+}
+
+func (e *ProductNotFoundException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Product not found")
+}
+
+func (e *ProductAlreadyExistsException) Error() string {
+	return render(e.Text())
+}
+
+func (e *ProductAlreadyExistsException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Product already exists")
 }
 
 func (e *InvalidProductDataException) Error() string {
-	if e.Message == "" {
-		return "Invalid product data"
-	}
+	return render(e.Text())
+}
 
-	return e.Message
+func (e *InvalidProductDataException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Invalid product data")
 }
 
 func (e *InvalidProductImageException) Error() string {
-	if e.Message == "" {
-		return "Invalid product image"
-	}
+	return render(e.Text())
+}
 
-	return e.Message
+func (e *InvalidProductImageException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Invalid product image")
 }
 
 func (e *ImageNotFoundException) Error() string {
-	if e.Message == "" {
-		return "Image not found"
-	}
+	return render(e.Text())
+}
 
-	return e.Message
+func (e *ImageNotFoundException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Image not found")
 }
 func (e *ProductImagesNotFoundException) Error() string {
-	if e.Message == "" {
-		return "No images found for this product."
-	}
-	return e.Message
+	return render(e.Text())
+}
+
+func (e *ProductImagesNotFoundException) Text() (string, []any) {
+	return text(e.Message, e.Args, "No images found for this product.")
 }
 func (e *ProductImageCannotBeEmptyException) Error() string {
-	if e.Message == "" {
-		return "Product image cannot be empty, at least one image is required"
-	}
-	return e.Message
+	return render(e.Text())
+}
+
+func (e *ProductImageCannotBeEmptyException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Product image cannot be empty, at least one image is required")
 }
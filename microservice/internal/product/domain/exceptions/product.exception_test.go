@@ -41,3 +41,9 @@ func TestProductImageCannotBeEmptyException_Error(t *testing.T) {
 	req.Equal("Product image cannot be empty, at least one image is required", (&ProductImageCannotBeEmptyException{}).Error())
 	req.Equal("Custom", (&ProductImageCannotBeEmptyException{Message: "Custom"}).Error())
 }
+
+func TestProductAlreadyExistsException_Error(t *testing.T) {
+	req := require.New(t)
+	req.Equal("Product already exists", (&ProductAlreadyExistsException{}).Error())
+	req.Equal("Custom", (&ProductAlreadyExistsException{Message: "Custom"}).Error())
+}
@@ -0,0 +1,27 @@
+package exceptions
+
+type PromotionNotFoundException struct {
+	Message string
+	Args    []any
+}
+
+func (e *PromotionNotFoundException) Error() string {
+	return render(e.Text())
+}
+
+func (e *PromotionNotFoundException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Promotion not found")
+}
+
+type InvalidPromotionException struct {
+	Message string
+	Args    []any
+}
+
+func (e *InvalidPromotionException) Error() string {
+	return render(e.Text())
+}
+
+func (e *InvalidPromotionException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Invalid promotion")
+}
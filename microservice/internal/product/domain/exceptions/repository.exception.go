@@ -0,0 +1,139 @@
+package exceptions
+
+import "errors"
+
+// Erros retornados pelos data sources, já traduzidos dos códigos do GORM/pgx.
+// Err guarda o erro original do banco para log e não é exposto ao cliente.
+
+type RecordNotFoundException struct {
+	Message string
+	Args    []any
+	Err     error
+}
+type RecordConflictException struct {
+	Message    string
+	Args       []any
+	Constraint string
+	Err        error
+}
+type ForeignKeyViolationException struct {
+	Message    string
+	Args       []any
+	Constraint string
+	Err        error
+}
+
+// VersionConflictException indica que o registro mudou desde que foi lido:
+// a versão informada no If-Match ou a lida antes da gravação não é mais a atual
+type VersionConflictException struct {
+	Message string
+	Args    []any
+}
+
+type RepositoryTimeoutException struct {
+	Message    string
+	Args       []any
+	RetryAfter int
+	Err        error
+}
+type RepositoryUnavailableException struct {
+	Message    string
+	Args       []any
+	RetryAfter int
+	Err        error
+}
+
+// Segundos sugeridos ao cliente no Retry-After quando o data source não informa
+const (
+	DefaultTimeoutRetryAfter     = 2
+	DefaultUnavailableRetryAfter = 5
+)
+
+func (e *RecordNotFoundException) Error() string {
+	return render(e.Text())
+}
+
+func (e *RecordNotFoundException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Record not found")
+}
+
+func (e *RecordNotFoundException) Unwrap() error {
+	return e.Err
+}
+
+func (e *RecordConflictException) Error() string {
+	return render(e.Text())
+}
+
+func (e *RecordConflictException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Record conflicts with an existing one")
+}
+
+func (e *RecordConflictException) Unwrap() error {
+	return e.Err
+}
+
+func (e *ForeignKeyViolationException) Error() string {
+	return render(e.Text())
+}
+
+func (e *ForeignKeyViolationException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Record references or is referenced by another record")
+}
+
+func (e *ForeignKeyViolationException) Unwrap() error {
+	return e.Err
+}
+
+func (e *VersionConflictException) Error() string {
+	return render(e.Text())
+}
+
+func (e *VersionConflictException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Record was modified by another request")
+}
+
+func (e *RepositoryTimeoutException) Error() string {
+	return render(e.Text())
+}
+
+func (e *RepositoryTimeoutException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Database operation timed out")
+}
+
+func (e *RepositoryTimeoutException) Unwrap() error {
+	return e.Err
+}
+
+func (e *RepositoryTimeoutException) RetryAfterSeconds() int {
+	if e.RetryAfter <= 0 {
+		return DefaultTimeoutRetryAfter
+	}
+	return e.RetryAfter
+}
+
+func (e *RepositoryUnavailableException) Error() string {
+	return render(e.Text())
+}
+
+func (e *RepositoryUnavailableException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Database is unavailable")
+}
+
+func (e *RepositoryUnavailableException) Unwrap() error {
+	return e.Err
+}
+
+func (e *RepositoryUnavailableException) RetryAfterSeconds() int {
+	if e.RetryAfter <= 0 {
+		return DefaultUnavailableRetryAfter
+	}
+	return e.RetryAfter
+}
+
+// IsRecordNotFound permite aos casos de uso separar "não existe" de falhas
+// de infraestrutura, que devem ser propagadas sem alteração
+func IsRecordNotFound(err error) bool {
+	var notFound *RecordNotFoundException
+	return errors.As(err, &notFound)
+}
@@ -0,0 +1,41 @@
+package exceptions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepositoryExceptions_Error(t *testing.T) {
+	req := require.New(t)
+	req.Equal("Record not found", (&RecordNotFoundException{}).Error())
+	req.Equal("Record conflicts with an existing one", (&RecordConflictException{}).Error())
+	req.Equal("Record references or is referenced by another record", (&ForeignKeyViolationException{}).Error())
+	req.Equal("Database operation timed out", (&RepositoryTimeoutException{}).Error())
+	req.Equal("Database is unavailable", (&RepositoryUnavailableException{}).Error())
+	req.Equal("Custom", (&RepositoryUnavailableException{Message: "Custom"}).Error())
+}
+
+func TestRepositoryExceptions_RetryAfter(t *testing.T) {
+	req := require.New(t)
+	req.Equal(DefaultTimeoutRetryAfter, (&RepositoryTimeoutException{}).RetryAfterSeconds())
+	req.Equal(DefaultUnavailableRetryAfter, (&RepositoryUnavailableException{}).RetryAfterSeconds())
+	req.Equal(10, (&RepositoryUnavailableException{RetryAfter: 10}).RetryAfterSeconds())
+}
+
+func TestRepositoryExceptions_Unwrap(t *testing.T) {
+	cause := errors.New("connection refused")
+	req := require.New(t)
+	req.ErrorIs(&RepositoryUnavailableException{Err: cause}, cause)
+	req.ErrorIs(&RecordNotFoundException{Err: cause}, cause)
+}
+
+func TestIsRecordNotFound(t *testing.T) {
+	req := require.New(t)
+	req.True(IsRecordNotFound(&RecordNotFoundException{}))
+	req.True(IsRecordNotFound(fmt.Errorf("find: %w", &RecordNotFoundException{})))
+	req.False(IsRecordNotFound(&RepositoryTimeoutException{}))
+	req.False(IsRecordNotFound(errors.New("record not found")))
+}
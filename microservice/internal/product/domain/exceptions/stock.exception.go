@@ -0,0 +1,79 @@
+package exceptions
+
+type InvalidStockDataException struct {
+	Message string
+	Args    []any
+}
+
+type InsufficientStockException struct {
+	Message string
+	Args    []any
+}
+
+type ProductSoldOutException struct {
+	Message string
+	Args    []any
+}
+
+type StockReservationNotFoundException struct {
+	Message string
+	Args    []any
+}
+
+type StockReservationExpiredException struct {
+	Message string
+	Args    []any
+}
+
+type InvalidStockReservationStateException struct {
+	Message string
+	Args    []any
+}
+
+func (e *InvalidStockDataException) Error() string {
+	return render(e.Text())
+}
+
+func (e *InvalidStockDataException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Invalid stock data")
+}
+
+func (e *InsufficientStockException) Error() string {
+	return render(e.Text())
+}
+
+func (e *InsufficientStockException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Insufficient stock")
+}
+
+func (e *ProductSoldOutException) Error() string {
+	return render(e.Text())
+}
+
+func (e *ProductSoldOutException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Product is sold out")
+}
+
+func (e *StockReservationNotFoundException) Error() string {
+	return render(e.Text())
+}
+
+func (e *StockReservationNotFoundException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Stock reservation not found")
+}
+
+func (e *StockReservationExpiredException) Error() string {
+	return render(e.Text())
+}
+
+func (e *StockReservationExpiredException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Stock reservation has expired")
+}
+
+func (e *InvalidStockReservationStateException) Error() string {
+	return render(e.Text())
+}
+
+func (e *InvalidStockReservationStateException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Invalid stock reservation state")
+}
@@ -2,22 +2,25 @@ package exceptions
 
 type DeleteImagesStorageException struct {
 	Message string
+	Args    []any
 }
 type BucketNotFoundException struct {
 	Message string
+	Args    []any
 }
 
 func (e *DeleteImagesStorageException) Error() string {
-	if e.Message == "" {
-		return "Failed to delete file(s) from storage"
-	}
+	return render(e.Text())
+}
 
-	return e.Message
+func (e *DeleteImagesStorageException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Failed to delete file(s) from storage")
 }
 
 func (e *BucketNotFoundException) Error() string {
-	if e.Message == "" {
-		return "Bucket S3 não existe ou é inválido"
-	}
-	return e.Message
+	return render(e.Text())
+}
+
+func (e *BucketNotFoundException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Bucket S3 não existe ou é inválido")
 }
@@ -0,0 +1,40 @@
+package exceptions
+
+type StoreNotFoundException struct {
+	Message string
+	Args    []any
+}
+
+func (e *StoreNotFoundException) Error() string {
+	return render(e.Text())
+}
+
+func (e *StoreNotFoundException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Store not found")
+}
+
+type InvalidStoreDataException struct {
+	Message string
+	Args    []any
+}
+
+func (e *InvalidStoreDataException) Error() string {
+	return render(e.Text())
+}
+
+func (e *InvalidStoreDataException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Invalid store data")
+}
+
+type InvalidStoreOverrideException struct {
+	Message string
+	Args    []any
+}
+
+func (e *InvalidStoreOverrideException) Error() string {
+	return render(e.Text())
+}
+
+func (e *InvalidStoreOverrideException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Invalid store override")
+}
@@ -0,0 +1,40 @@
+package exceptions
+
+type InvalidLocaleException struct {
+	Message string
+	Args    []any
+}
+
+func (e *InvalidLocaleException) Error() string {
+	return render(e.Text())
+}
+
+func (e *InvalidLocaleException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Invalid locale")
+}
+
+type InvalidTranslationException struct {
+	Message string
+	Args    []any
+}
+
+func (e *InvalidTranslationException) Error() string {
+	return render(e.Text())
+}
+
+func (e *InvalidTranslationException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Invalid translation")
+}
+
+type TranslationNotFoundException struct {
+	Message string
+	Args    []any
+}
+
+func (e *TranslationNotFoundException) Error() string {
+	return render(e.Text())
+}
+
+func (e *TranslationNotFoundException) Text() (string, []any) {
+	return text(e.Message, e.Args, "Translation not found")
+}
@@ -0,0 +1,108 @@
+package value_objects
+
+import (
+	"slices"
+	"strings"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+// Allergen segue os alérgenos de declaração obrigatória da ANVISA (RDC
+// 26/2015 e, para lactose, RDC 136/2017)
+type Allergen string
+
+const (
+	AllergenGluten      Allergen = "gluten"
+	AllergenLactose     Allergen = "lactose"
+	AllergenMilk        Allergen = "milk"
+	AllergenEggs        Allergen = "eggs"
+	AllergenFish        Allergen = "fish"
+	AllergenCrustaceans Allergen = "crustaceans"
+	AllergenPeanuts     Allergen = "peanuts"
+	AllergenTreeNuts    Allergen = "tree_nuts"
+	AllergenSoy         Allergen = "soy"
+	AllergenLatex       Allergen = "latex"
+)
+
+// AllergenValues é a enumeração completa, na ordem em que os alérgenos são
+// devolvidos
+var AllergenValues = []Allergen{
+	AllergenGluten,
+	AllergenLactose,
+	AllergenMilk,
+	AllergenEggs,
+	AllergenFish,
+	AllergenCrustaceans,
+	AllergenPeanuts,
+	AllergenTreeNuts,
+	AllergenSoy,
+	AllergenLatex,
+}
+
+// Allergens lista os alérgenos que o produto contém. nil quer dizer que o
+// produto ainda não declarou seus alérgenos; vazio, que declarou não ter
+// nenhum
+type Allergens []Allergen
+
+// NewAllergens valida os valores, remove repetições e ordena pela
+// enumeração. Uma lista nil continua nil (não declarada)
+func NewAllergens(values []string) (Allergens, error) {
+	if values == nil {
+		return nil, nil
+	}
+
+	allergens := make(Allergens, 0, len(values))
+	for i, value := range values {
+		allergen, ok := ParseAllergen(value)
+		if !ok {
+			return nil, &exceptions.InvalidAllergenException{Message: "allergens[%d]: unknown allergen %q", Args: []any{i, value}}
+		}
+
+		if !slices.Contains(allergens, allergen) {
+			allergens = append(allergens, allergen)
+		}
+	}
+
+	slices.SortFunc(allergens, func(a, b Allergen) int {
+		return slices.Index(AllergenValues, a) - slices.Index(AllergenValues, b)
+	})
+
+	return allergens, nil
+}
+
+// ParseAllergen aceita o valor da enumeração sem diferenciar maiúsculas
+func ParseAllergen(value string) (Allergen, bool) {
+	allergen := Allergen(strings.ToLower(strings.TrimSpace(value)))
+	return allergen, slices.Contains(AllergenValues, allergen)
+}
+
+func (a Allergens) IsDeclared() bool {
+	return a != nil
+}
+
+// ContainsAny indica se o produto pode conter algum dos alérgenos. Sem
+// declaração não há como garantir a ausência, então a resposta é sim
+func (a Allergens) ContainsAny(allergens Allergens) bool {
+	if !a.IsDeclared() {
+		return len(allergens) > 0
+	}
+
+	for _, allergen := range allergens {
+		if slices.Contains(a, allergen) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a Allergens) Strings() []string {
+	if a == nil {
+		return nil
+	}
+
+	values := make([]string, len(a))
+	for i, allergen := range a {
+		values[i] = string(allergen)
+	}
+	return values
+}
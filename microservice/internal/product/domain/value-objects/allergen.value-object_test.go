@@ -0,0 +1,43 @@
+package value_objects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+func TestNewAllergens_NormalizesAndOrders(t *testing.T) {
+	allergens, err := NewAllergens([]string{"Soy", "gluten", " soy", "milk"})
+	require.NoError(t, err)
+	require.Equal(t, Allergens{AllergenGluten, AllergenMilk, AllergenSoy}, allergens)
+}
+
+func TestNewAllergens_DeclaredVsUndeclared(t *testing.T) {
+	undeclared, err := NewAllergens(nil)
+	require.NoError(t, err)
+	require.False(t, undeclared.IsDeclared())
+
+	none, err := NewAllergens([]string{})
+	require.NoError(t, err)
+	require.True(t, none.IsDeclared())
+	require.Empty(t, none)
+}
+
+func TestNewAllergens_Unknown(t *testing.T) {
+	_, err := NewAllergens([]string{"gluten", "mustard"})
+	require.EqualError(t, err, `allergens[1]: unknown allergen "mustard"`)
+	require.IsType(t, &exceptions.InvalidAllergenException{}, err)
+}
+
+func TestAllergens_ContainsAny(t *testing.T) {
+	excluded := Allergens{AllergenGluten, AllergenLactose}
+
+	require.True(t, Allergens{AllergenLactose}.ContainsAny(excluded))
+	require.False(t, Allergens{AllergenSoy}.ContainsAny(excluded))
+	require.False(t, Allergens{}.ContainsAny(excluded))
+	// Sem declaração, não há como garantir a ausência
+	require.True(t, Allergens(nil).ContainsAny(excluded))
+	require.False(t, Allergens(nil).ContainsAny(nil))
+}
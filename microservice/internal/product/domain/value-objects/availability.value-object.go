@@ -0,0 +1,217 @@
+package value_objects
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+const AvailabilityDateLayout = "2006-01-02"
+
+var availabilityWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// TimeRange é um intervalo [From, To) no relógio local, em "HH:MM"; To pode
+// ser "24:00" para ir até o fim do dia
+type TimeRange struct {
+	From string
+	To   string
+}
+
+type WeeklyAvailability struct {
+	Weekdays []string
+	TimeRange
+}
+
+// AvailabilityException substitui a grade semanal em uma data (ex.: feriado).
+// Sem intervalo, o item fica indisponível o dia todo
+type AvailabilityException struct {
+	Date string
+	TimeRange
+}
+
+// Availability restringe quando um produto ou categoria ativo aparece. A
+// avaliação segue a ordem: período (StartDate/EndDate, inclusivos), exceções
+// da data e, por fim, a grade semanal; grade vazia libera o dia inteiro
+type Availability struct {
+	Weekly     []WeeklyAvailability
+	StartDate  string
+	EndDate    string
+	Exceptions []AvailabilityException
+}
+
+func NewAvailability(availability Availability) (*Availability, error) {
+	availability.Weekly = slices.Clone(availability.Weekly)
+
+	for i, window := range availability.Weekly {
+		if len(window.Weekdays) == 0 {
+			return nil, invalidAvailability("weekly[%d]: at least one weekday is required", i)
+		}
+
+		weekdays := make([]string, len(window.Weekdays))
+		for j, weekday := range window.Weekdays {
+			weekdays[j] = strings.ToLower(weekday)
+			if _, ok := availabilityWeekdays[weekdays[j]]; !ok {
+				return nil, invalidAvailability("weekly[%d]: unknown weekday %q", i, weekday)
+			}
+		}
+		availability.Weekly[i].Weekdays = weekdays
+
+		if err := window.TimeRange.validate(fmt.Sprintf("weekly[%d]", i)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateAvailabilityDate("start_date", availability.StartDate); err != nil {
+		return nil, err
+	}
+	if err := validateAvailabilityDate("end_date", availability.EndDate); err != nil {
+		return nil, err
+	}
+	if availability.StartDate != "" && availability.EndDate != "" && availability.EndDate < availability.StartDate {
+		return nil, invalidAvailability("end_date must not be before start_date")
+	}
+
+	for i, exception := range availability.Exceptions {
+		field := fmt.Sprintf("exceptions[%d]", i)
+		if exception.Date == "" {
+			return nil, invalidAvailability("%s: date is required", field)
+		}
+		if err := validateAvailabilityDate(field, exception.Date); err != nil {
+			return nil, err
+		}
+		if exception.From == "" && exception.To == "" {
+			continue
+		}
+		if err := exception.TimeRange.validate(field); err != nil {
+			return nil, err
+		}
+	}
+
+	return &availability, nil
+}
+
+// IsScheduled indica se há alguma restrição de data ou horário, ou seja, se
+// IsAvailableAt pode mudar com o passar do tempo
+func (a *Availability) IsScheduled() bool {
+	return a != nil && (len(a.Weekly) > 0 || a.StartDate != "" || a.EndDate != "" || len(a.Exceptions) > 0)
+}
+
+// IsAvailableAt avalia o horário de parede de local, que já deve estar no
+// fuso do estabelecimento. Sem restrições (nil), está sempre disponível
+func (a *Availability) IsAvailableAt(local time.Time) bool {
+	if a == nil {
+		return true
+	}
+
+	date := local.Format(AvailabilityDateLayout)
+	if a.StartDate != "" && date < a.StartDate {
+		return false
+	}
+	if a.EndDate != "" && date > a.EndDate {
+		return false
+	}
+
+	minute := local.Hour()*60 + local.Minute()
+
+	exceptionFound := false
+	for _, exception := range a.Exceptions {
+		if exception.Date != date {
+			continue
+		}
+		exceptionFound = true
+		if exception.From != "" && exception.TimeRange.contains(minute) {
+			return true
+		}
+	}
+	if exceptionFound {
+		return false
+	}
+
+	if len(a.Weekly) == 0 {
+		return true
+	}
+
+	for _, window := range a.Weekly {
+		for _, weekday := range window.Weekdays {
+			if availabilityWeekdays[weekday] == local.Weekday() && window.TimeRange.contains(minute) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (r TimeRange) validate(field string) error {
+	from, ok := parseClock(r.From)
+	if !ok || from == 24*60 {
+		return invalidAvailability("%s: from must be a time between 00:00 and 23:59", field)
+	}
+
+	to, ok := parseClock(r.To)
+	if !ok {
+		return invalidAvailability("%s: to must be a time between 00:01 and 24:00", field)
+	}
+
+	if to <= from {
+		return invalidAvailability("%s: to must be after from", field)
+	}
+
+	return nil
+}
+
+func (r TimeRange) contains(minute int) bool {
+	from, _ := parseClock(r.From)
+	to, _ := parseClock(r.To)
+	return minute >= from && minute < to
+}
+
+// parseClock converte "HH:MM" em minutos desde a meia-noite
+func parseClock(value string) (int, bool) {
+	hours, minutes, found := strings.Cut(value, ":")
+	if !found || len(hours) != 2 || len(minutes) != 2 {
+		return 0, false
+	}
+
+	h, err := strconv.Atoi(hours)
+	if err != nil {
+		return 0, false
+	}
+	m, err := strconv.Atoi(minutes)
+	if err != nil {
+		return 0, false
+	}
+
+	total := h*60 + m
+	if h < 0 || m < 0 || m > 59 || total > 24*60 {
+		return 0, false
+	}
+
+	return total, true
+}
+
+func validateAvailabilityDate(field, value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, err := time.Parse(AvailabilityDateLayout, value); err != nil {
+		return invalidAvailability("%s must be a date in the format YYYY-MM-DD", field)
+	}
+	return nil
+}
+
+func invalidAvailability(format string, args ...any) error {
+	return &exceptions.InvalidAvailabilityException{Message: format, Args: args}
+}
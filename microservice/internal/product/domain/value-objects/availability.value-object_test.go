@@ -0,0 +1,91 @@
+package value_objects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+func weekdayWindow(from, to string, weekdays ...string) WeeklyAvailability {
+	return WeeklyAvailability{Weekdays: weekdays, TimeRange: TimeRange{From: from, To: to}}
+}
+
+// 2025-01-15 é uma quarta-feira
+func at(day, hour, minute int) time.Time {
+	return time.Date(2025, 1, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestNewAvailability_Invalid(t *testing.T) {
+	cases := []Availability{
+		{Weekly: []WeeklyAvailability{weekdayWindow("06:00", "10:30")}},
+		{Weekly: []WeeklyAvailability{weekdayWindow("06:00", "10:30", "funday")}},
+		{Weekly: []WeeklyAvailability{weekdayWindow("6:00", "10:30", "mon")}},
+		{Weekly: []WeeklyAvailability{weekdayWindow("10:30", "06:00", "mon")}},
+		{Weekly: []WeeklyAvailability{weekdayWindow("24:00", "24:00", "mon")}},
+		{Weekly: []WeeklyAvailability{weekdayWindow("06:00", "24:01", "mon")}},
+		{StartDate: "2025-13-01"},
+		{StartDate: "2025-02-01", EndDate: "2025-01-01"},
+		{Exceptions: []AvailabilityException{{}}},
+		{Exceptions: []AvailabilityException{{Date: "2025-12-25", TimeRange: TimeRange{From: "10:00"}}}},
+	}
+
+	for _, availability := range cases {
+		_, err := NewAvailability(availability)
+		require.IsType(t, &exceptions.InvalidAvailabilityException{}, err, "%+v", availability)
+	}
+}
+
+func TestNewAvailability_NormalizesWeekdays(t *testing.T) {
+	weekly := []WeeklyAvailability{weekdayWindow("18:00", "24:00", "FRI", "Sat")}
+
+	availability, err := NewAvailability(Availability{Weekly: weekly})
+	require.NoError(t, err)
+	require.Equal(t, []string{"fri", "sat"}, availability.Weekly[0].Weekdays)
+	require.Equal(t, []string{"FRI", "Sat"}, weekly[0].Weekdays)
+}
+
+func TestAvailability_IsAvailableAt(t *testing.T) {
+	var unrestricted *Availability
+	require.True(t, unrestricted.IsAvailableAt(at(15, 3, 0)))
+
+	breakfast, err := NewAvailability(Availability{
+		Weekly: []WeeklyAvailability{weekdayWindow("06:00", "10:30", "mon", "tue", "wed", "thu", "fri")},
+	})
+	require.NoError(t, err)
+	require.False(t, breakfast.IsAvailableAt(at(15, 5, 59)))
+	require.True(t, breakfast.IsAvailableAt(at(15, 6, 0)))
+	require.True(t, breakfast.IsAvailableAt(at(15, 10, 29)))
+	require.False(t, breakfast.IsAvailableAt(at(15, 10, 30)))
+	require.False(t, breakfast.IsAvailableAt(at(18, 8, 0)))
+
+	lateNight, err := NewAvailability(Availability{
+		Weekly: []WeeklyAvailability{weekdayWindow("22:00", "24:00", "sat")},
+	})
+	require.NoError(t, err)
+	require.True(t, lateNight.IsAvailableAt(at(18, 23, 59)))
+}
+
+func TestAvailability_DateRangeAndExceptions(t *testing.T) {
+	seasonal, err := NewAvailability(Availability{
+		StartDate: "2025-01-10",
+		EndDate:   "2025-01-20",
+		Exceptions: []AvailabilityException{
+			{Date: "2025-01-15"},
+			{Date: "2025-01-16", TimeRange: TimeRange{From: "10:00", To: "14:00"}},
+		},
+	})
+	require.NoError(t, err)
+
+	require.False(t, seasonal.IsAvailableAt(at(9, 12, 0)))
+	require.True(t, seasonal.IsAvailableAt(at(10, 0, 0)))
+	require.True(t, seasonal.IsAvailableAt(at(20, 23, 59)))
+	require.False(t, seasonal.IsAvailableAt(at(21, 0, 0)))
+
+	// Feriado fechado o dia todo e dia com horário reduzido
+	require.False(t, seasonal.IsAvailableAt(at(15, 12, 0)))
+	require.True(t, seasonal.IsAvailableAt(at(16, 12, 0)))
+	require.False(t, seasonal.IsAvailableAt(at(16, 15, 0)))
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/shared/pkg/normalizer"
 )
 
 type CategoryName struct {
@@ -31,3 +32,8 @@ func NewCategoryName(value string) (CategoryName, error) {
 func (n CategoryName) Value() string {
 	return n.value
 }
+
+// Key identifica nomes equivalentes, ignorando maiúsculas e acentos
+func (n CategoryName) Key() string {
+	return normalizer.NameKey(n.value)
+}
@@ -23,3 +23,10 @@ func TestNewCategoryName_Valid(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "Bebidas", name.Value())
 }
+
+func TestCategoryName_Key(t *testing.T) {
+	name, _ := NewCategoryName("Açaí e Sorvetes")
+	other, _ := NewCategoryName("acai E SORVETES")
+	require.Equal(t, "acai e sorvetes", name.Key())
+	require.Equal(t, name.Key(), other.Key())
+}
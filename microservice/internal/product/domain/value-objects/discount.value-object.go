@@ -0,0 +1,60 @@
+package value_objects
+
+import (
+	"math"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+const (
+	DiscountPercentage = "percentage"
+	DiscountFixed      = "fixed"
+)
+
+// Discount reduz um preço por percentual (20 = 20% de desconto) ou por um
+// valor fixo em reais. O preço com desconto nunca fica negativo
+type Discount struct {
+	kind  string
+	value float64
+}
+
+func NewDiscount(kind string, value float64) (Discount, error) {
+	switch kind {
+	case DiscountPercentage:
+		if value <= 0 || value > 100 {
+			return Discount{}, &exceptions.InvalidPromotionException{
+				Message: "percentage discount must be greater than 0 and at most 100",
+			}
+		}
+	case DiscountFixed:
+		if value <= 0 || value >= 1000000 {
+			return Discount{}, &exceptions.InvalidPromotionException{
+				Message: "fixed discount must be greater than 0 and less than 1000000",
+			}
+		}
+	default:
+		return Discount{}, &exceptions.InvalidPromotionException{
+			Message: "discount type must be percentage or fixed",
+		}
+	}
+
+	return Discount{kind: kind, value: value}, nil
+}
+
+func (d Discount) Type() string {
+	return d.kind
+}
+
+func (d Discount) Value() float64 {
+	return d.value
+}
+
+// Apply devolve o preço com desconto, arredondado para centavos
+func (d Discount) Apply(price float64) float64 {
+	discounted := price - d.value
+	if d.kind == DiscountPercentage {
+		discounted = price * (1 - d.value/100)
+	}
+
+	return max(0, math.Round(discounted*100)/100)
+}
@@ -0,0 +1,39 @@
+package value_objects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDiscount_Invalid(t *testing.T) {
+	_, err := NewDiscount("bogo", 10)
+	require.EqualError(t, err, "discount type must be percentage or fixed")
+	_, err = NewDiscount(DiscountPercentage, 0)
+	require.EqualError(t, err, "percentage discount must be greater than 0 and at most 100")
+	_, err = NewDiscount(DiscountPercentage, 120)
+	require.EqualError(t, err, "percentage discount must be greater than 0 and at most 100")
+	_, err = NewDiscount(DiscountFixed, -5)
+	require.EqualError(t, err, "fixed discount must be greater than 0 and less than 1000000")
+}
+
+func TestDiscount_Apply(t *testing.T) {
+	cases := []struct {
+		kind     string
+		value    float64
+		price    float64
+		expected float64
+	}{
+		{DiscountPercentage, 20, 12.5, 10},
+		{DiscountPercentage, 15, 9.99, 8.49},
+		{DiscountPercentage, 100, 9.99, 0},
+		{DiscountFixed, 5, 32.9, 27.9},
+		{DiscountFixed, 10, 7.5, 0},
+	}
+
+	for _, c := range cases {
+		discount, err := NewDiscount(c.kind, c.value)
+		require.NoError(t, err)
+		require.InDelta(t, c.expected, discount.Apply(c.price), 0.0001)
+	}
+}
@@ -43,7 +43,7 @@ func NewImage(originalFileName string) (Image, error) {
 
 	if len(fileName) > 255 {
 		return Image{}, &exceptions.InvalidProductDataException{
-			Message: fmt.Sprintf("Image file name '%s' exceeds the maximum length of 255 characters", fileName),
+			Message: "Image file name '%s' exceeds the maximum length of 255 characters", Args: []any{fileName},
 		}
 	}
 	if len(fileName) == 0 {
@@ -0,0 +1,54 @@
+package value_objects
+
+import (
+	"strings"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+// Locale é o idioma dos textos de produtos e categorias. Nome e descrição
+// cadastrados no próprio produto ou categoria estão no idioma padrão; os
+// demais idiomas vêm das traduções
+type Locale string
+
+const (
+	DefaultLocale Locale = "pt-BR"
+	LocaleEnglish Locale = "en"
+	LocaleSpanish Locale = "es"
+)
+
+// TranslationLocales são os idiomas que aceitam tradução, na ordem em que
+// aparecem nos relatórios
+var TranslationLocales = []Locale{
+	LocaleEnglish,
+	LocaleSpanish,
+}
+
+// NewTranslationLocale aceita um dos TranslationLocales, sem diferenciar
+// maiúsculas. O idioma padrão é recusado: ele é editado no próprio produto
+// ou categoria
+func NewTranslationLocale(value string) (Locale, error) {
+	if strings.EqualFold(value, string(DefaultLocale)) {
+		return "", &exceptions.InvalidLocaleException{
+			Message: "pt-BR is the default locale and is edited on the item itself",
+		}
+	}
+
+	for _, locale := range TranslationLocales {
+		if strings.EqualFold(value, string(locale)) {
+			return locale, nil
+		}
+	}
+
+	return "", &exceptions.InvalidLocaleException{
+		Message: "unsupported locale %q", Args: []any{value},
+	}
+}
+
+func (l Locale) IsDefault() bool {
+	return l == "" || l == DefaultLocale
+}
+
+func (l Locale) String() string {
+	return string(l)
+}
@@ -0,0 +1,41 @@
+package value_objects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+func TestNewTranslationLocale(t *testing.T) {
+	locale, err := NewTranslationLocale("EN")
+	require.NoError(t, err)
+	require.Equal(t, LocaleEnglish, locale)
+
+	locale, err = NewTranslationLocale("es")
+	require.NoError(t, err)
+	require.Equal(t, LocaleSpanish, locale)
+}
+
+func TestNewTranslationLocale_RejectsDefaultLocale(t *testing.T) {
+	_, err := NewTranslationLocale("pt-br")
+
+	var localeErr *exceptions.InvalidLocaleException
+	require.ErrorAs(t, err, &localeErr)
+	require.Equal(t, "pt-BR is the default locale and is edited on the item itself", err.Error())
+}
+
+func TestNewTranslationLocale_RejectsUnsupportedLocale(t *testing.T) {
+	_, err := NewTranslationLocale("fr")
+
+	var localeErr *exceptions.InvalidLocaleException
+	require.ErrorAs(t, err, &localeErr)
+	require.Equal(t, `unsupported locale "fr"`, err.Error())
+}
+
+func TestLocale_IsDefault(t *testing.T) {
+	require.True(t, Locale("").IsDefault())
+	require.True(t, DefaultLocale.IsDefault())
+	require.False(t, LocaleEnglish.IsDefault())
+}
@@ -3,6 +3,7 @@ package value_objects
 import (
 	"strings"
 	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/shared/pkg/normalizer"
 )
 
 type Name struct {
@@ -28,3 +29,8 @@ func NewName(value string) (Name, error) {
 func (n *Name) Value() string {
 	return n.value
 }
+
+// Key identifica nomes equivalentes, ignorando maiúsculas e acentos
+func (n *Name) Key() string {
+	return normalizer.NameKey(n.value)
+}
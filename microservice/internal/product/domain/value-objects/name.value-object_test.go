@@ -23,3 +23,8 @@ func TestNewName_Valid(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "Coca-Cola", name.Value())
 }
+
+func TestName_Key(t *testing.T) {
+	name, _ := NewName("Pão de Queijo")
+	require.Equal(t, "pao de queijo", name.Key())
+}
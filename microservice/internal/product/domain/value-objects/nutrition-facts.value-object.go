@@ -0,0 +1,86 @@
+package value_objects
+
+import (
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+const (
+	ServingUnitGrams       = "g"
+	ServingUnitMilliliters = "ml"
+)
+
+// NutritionFacts é a tabela nutricional por porção, nos moldes da RDC
+// 429/2020: energia em kcal, sódio em mg e os demais nutrientes em gramas
+type NutritionFacts struct {
+	ServingSize   float64
+	ServingUnit   string
+	EnergyKcal    float64
+	Carbohydrates float64
+	TotalSugars   float64
+	AddedSugars   float64
+	Proteins      float64
+	TotalFat      float64
+	SaturatedFat  float64
+	TransFat      float64
+	DietaryFiber  float64
+	Sodium        float64
+}
+
+func NewNutritionFacts(facts NutritionFacts) (*NutritionFacts, error) {
+	if facts.ServingSize <= 0 {
+		return nil, invalidNutrition("nutrition.serving_size must be greater than 0")
+	}
+
+	if facts.ServingUnit == "" {
+		facts.ServingUnit = ServingUnitGrams
+	}
+	if facts.ServingUnit != ServingUnitGrams && facts.ServingUnit != ServingUnitMilliliters {
+		return nil, invalidNutrition("nutrition.serving_unit must be g or ml")
+	}
+
+	amounts := []struct {
+		field string
+		value float64
+	}{
+		{"energy_kcal", facts.EnergyKcal},
+		{"carbohydrates_g", facts.Carbohydrates},
+		{"total_sugars_g", facts.TotalSugars},
+		{"added_sugars_g", facts.AddedSugars},
+		{"proteins_g", facts.Proteins},
+		{"total_fat_g", facts.TotalFat},
+		{"saturated_fat_g", facts.SaturatedFat},
+		{"trans_fat_g", facts.TransFat},
+		{"dietary_fiber_g", facts.DietaryFiber},
+		{"sodium_mg", facts.Sodium},
+	}
+	for _, amount := range amounts {
+		if amount.value < 0 {
+			return nil, invalidNutrition("nutrition.%s must not be negative", amount.field)
+		}
+	}
+
+	// Os nutrientes de um grupo não podem passar do total do grupo
+	if facts.AddedSugars > facts.TotalSugars {
+		return nil, invalidNutrition("nutrition.added_sugars_g must not exceed total_sugars_g")
+	}
+	if facts.TotalSugars > facts.Carbohydrates {
+		return nil, invalidNutrition("nutrition.total_sugars_g must not exceed carbohydrates_g")
+	}
+	if facts.SaturatedFat+facts.TransFat > facts.TotalFat {
+		return nil, invalidNutrition("nutrition: saturated and trans fat must not exceed total_fat_g")
+	}
+
+	// Em gramas dá para conferir contra a porção; em ml dependeria da densidade
+	if facts.ServingUnit == ServingUnitGrams {
+		total := facts.Carbohydrates + facts.Proteins + facts.TotalFat + facts.DietaryFiber + facts.Sodium/1000
+		if total > facts.ServingSize {
+			return nil, invalidNutrition("nutrition: nutrients must not exceed serving_size")
+		}
+	}
+
+	return &facts, nil
+}
+
+func invalidNutrition(format string, args ...any) error {
+	return &exceptions.InvalidNutritionFactsException{Message: format, Args: args}
+}
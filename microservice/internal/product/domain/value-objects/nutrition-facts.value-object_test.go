@@ -0,0 +1,58 @@
+package value_objects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+func validNutritionFacts() NutritionFacts {
+	return NutritionFacts{
+		ServingSize:   180,
+		EnergyKcal:    420,
+		Carbohydrates: 38,
+		TotalSugars:   6,
+		AddedSugars:   2,
+		Proteins:      22,
+		TotalFat:      19,
+		SaturatedFat:  7,
+		TransFat:      0.2,
+		DietaryFiber:  3,
+		Sodium:        780,
+	}
+}
+
+func TestNewNutritionFacts_DefaultsToGrams(t *testing.T) {
+	facts, err := NewNutritionFacts(validNutritionFacts())
+	require.NoError(t, err)
+	require.Equal(t, ServingUnitGrams, facts.ServingUnit)
+}
+
+func TestNewNutritionFacts_Invalid(t *testing.T) {
+	cases := map[string]func(*NutritionFacts){
+		"nutrition.serving_size must be greater than 0":                  func(f *NutritionFacts) { f.ServingSize = 0 },
+		"nutrition.serving_unit must be g or ml":                         func(f *NutritionFacts) { f.ServingUnit = "oz" },
+		"nutrition.proteins_g must not be negative":                      func(f *NutritionFacts) { f.Proteins = -1 },
+		"nutrition.added_sugars_g must not exceed total_sugars_g":        func(f *NutritionFacts) { f.AddedSugars = 7 },
+		"nutrition.total_sugars_g must not exceed carbohydrates_g":       func(f *NutritionFacts) { f.TotalSugars = 40; f.AddedSugars = 0 },
+		"nutrition: saturated and trans fat must not exceed total_fat_g": func(f *NutritionFacts) { f.SaturatedFat = 19 },
+		"nutrition: nutrients must not exceed serving_size":              func(f *NutritionFacts) { f.ServingSize = 50 },
+	}
+
+	for message, change := range cases {
+		facts := validNutritionFacts()
+		change(&facts)
+
+		_, err := NewNutritionFacts(facts)
+		require.EqualError(t, err, message)
+		require.IsType(t, &exceptions.InvalidNutritionFactsException{}, err)
+	}
+}
+
+func TestNewNutritionFacts_MillilitersSkipServingCheck(t *testing.T) {
+	facts := NutritionFacts{ServingSize: 200, ServingUnit: ServingUnitMilliliters, EnergyKcal: 85, Carbohydrates: 21, TotalSugars: 21, AddedSugars: 21}
+	_, err := NewNutritionFacts(facts)
+	require.NoError(t, err)
+}
@@ -0,0 +1,81 @@
+package value_objects
+
+import (
+	"math"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+const (
+	PriceAdjustmentPercentage = "percentage"
+	PriceAdjustmentFixed      = "fixed"
+
+	PriceRoundingCents      = "cents"
+	PriceRoundingTenCents   = "ten_cents"
+	PriceRoundingWhole      = "whole"
+	PriceRoundingNinetyNine = "ninety_nine"
+)
+
+// PriceAdjustment reajusta preços por percentual (10 = +10%) ou por valor
+// fixo, arredondando o resultado conforme a regra escolhida
+type PriceAdjustment struct {
+	mode     string
+	value    float64
+	rounding string
+}
+
+func NewPriceAdjustment(mode string, value float64, rounding string) (PriceAdjustment, error) {
+	if mode != PriceAdjustmentPercentage && mode != PriceAdjustmentFixed {
+		return PriceAdjustment{}, &exceptions.InvalidProductDataException{
+			Message: "price adjustment mode must be percentage or fixed",
+		}
+	}
+
+	if value == 0 {
+		return PriceAdjustment{}, &exceptions.InvalidProductDataException{
+			Message: "price adjustment value must not be zero",
+		}
+	}
+
+	if mode == PriceAdjustmentPercentage && value <= -100 {
+		return PriceAdjustment{}, &exceptions.InvalidProductDataException{
+			Message: "price adjustment percentage must be greater than -100",
+		}
+	}
+
+	if rounding == "" {
+		rounding = PriceRoundingCents
+	}
+
+	switch rounding {
+	case PriceRoundingCents, PriceRoundingTenCents, PriceRoundingWhole, PriceRoundingNinetyNine:
+	default:
+		return PriceAdjustment{}, &exceptions.InvalidProductDataException{
+			Message: "price rounding must be cents, ten_cents, whole or ninety_nine",
+		}
+	}
+
+	return PriceAdjustment{mode: mode, value: value, rounding: rounding}, nil
+}
+
+func (a *PriceAdjustment) Apply(price float64) float64 {
+	adjusted := price + a.value
+	if a.mode == PriceAdjustmentPercentage {
+		adjusted = price * (1 + a.value/100)
+	}
+
+	return roundPrice(adjusted, a.rounding)
+}
+
+func roundPrice(price float64, rounding string) float64 {
+	switch rounding {
+	case PriceRoundingTenCents:
+		return math.Round(price*10) / 10
+	case PriceRoundingWhole:
+		return math.Round(price)
+	case PriceRoundingNinetyNine:
+		// Preço "psicológico": 21.37 vira 21.99
+		return math.Floor(price) + 0.99
+	}
+
+	return math.Round(price*100) / 100
+}
@@ -0,0 +1,42 @@
+package value_objects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPriceAdjustment_Invalid(t *testing.T) {
+	_, err := NewPriceAdjustment("double", 10, "")
+	require.EqualError(t, err, "price adjustment mode must be percentage or fixed")
+	_, err = NewPriceAdjustment(PriceAdjustmentFixed, 0, "")
+	require.EqualError(t, err, "price adjustment value must not be zero")
+	_, err = NewPriceAdjustment(PriceAdjustmentPercentage, -100, "")
+	require.EqualError(t, err, "price adjustment percentage must be greater than -100")
+	_, err = NewPriceAdjustment(PriceAdjustmentPercentage, 10, "floor")
+	require.EqualError(t, err, "price rounding must be cents, ten_cents, whole or ninety_nine")
+}
+
+func TestPriceAdjustment_Apply(t *testing.T) {
+	cases := []struct {
+		mode     string
+		value    float64
+		rounding string
+		price    float64
+		expected float64
+	}{
+		{PriceAdjustmentPercentage, 10, "", 20.5, 22.55},
+		{PriceAdjustmentPercentage, -15, PriceRoundingCents, 9.99, 8.49},
+		{PriceAdjustmentPercentage, 10, PriceRoundingTenCents, 20.5, 22.6},
+		{PriceAdjustmentPercentage, 10, PriceRoundingWhole, 20.5, 23},
+		{PriceAdjustmentPercentage, 5, PriceRoundingNinetyNine, 20.5, 21.99},
+		{PriceAdjustmentFixed, 2, "", 5.99, 7.99},
+		{PriceAdjustmentFixed, -1.5, "", 5.99, 4.49},
+	}
+
+	for _, c := range cases {
+		adjustment, err := NewPriceAdjustment(c.mode, c.value, c.rounding)
+		require.NoError(t, err)
+		require.InDelta(t, c.expected, adjustment.Apply(c.price), 0.0001)
+	}
+}
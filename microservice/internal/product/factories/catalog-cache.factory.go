@@ -0,0 +1,17 @@
+package factories
+
+import (
+	"tech_challenge/internal/product/infra/database/data_sources"
+	"tech_challenge/internal/product/interfaces"
+	"tech_challenge/internal/shared/infra/cache_provider"
+)
+
+// NewCatalogCache devolve nil com o cache desligado, e aí a limpeza feita
+// pelos casos de uso não faz nada
+func NewCatalogCache() interfaces.ICatalogCache {
+	if cache := cache_provider.GetProvider(); cache != nil {
+		return data_sources.NewCatalogCache(cache)
+	}
+
+	return nil
+}
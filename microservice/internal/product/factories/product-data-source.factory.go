@@ -3,9 +3,16 @@ package factories
 import (
 	"tech_challenge/internal/product/infra/database/data_sources"
 	"tech_challenge/internal/product/interfaces"
+	"tech_challenge/internal/shared/infra/cache_provider"
 	"tech_challenge/internal/shared/infra/database"
 )
 
 func NewProductDataSource() interfaces.IProductDataSource {
-	return data_sources.NewProductDataSource(database.GetDB())
+	dataSource := data_sources.NewProductDataSource(database.GetDB())
+
+	if cache := cache_provider.GetProvider(); cache != nil {
+		return data_sources.NewCachedProductDataSource(dataSource, cache)
+	}
+
+	return dataSource
 }
@@ -2,11 +2,24 @@ package factories
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/infra/database/data_sources"
+	"tech_challenge/internal/shared/infra/cache_provider"
 )
 
 func TestNewProductDataSource_ReturnsIProductDataSource(t *testing.T) {
 	ds := NewProductDataSource()
 	require.NotNil(t, ds)
 }
+
+func TestNewProductDataSource_WrapsWithCacheWhenEnabled(t *testing.T) {
+	require.IsType(t, &data_sources.GormProductDataSource{}, NewProductDataSource())
+
+	cache_provider.SetProvider(cache_provider.NewMemoryCacheProvider(time.Minute, 10, 1024))
+	t.Cleanup(func() { cache_provider.SetProvider(nil) })
+
+	require.IsType(t, &data_sources.CachedProductDataSource{}, NewProductDataSource())
+}
@@ -0,0 +1,13 @@
+package factories
+
+import (
+	"tech_challenge/internal/product/infra/database/data_sources"
+	"tech_challenge/internal/product/interfaces"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+// NewStockDataSource não usa o cache de leituras: o estoque muda a cada
+// reserva e precisa ser lido sempre do banco
+func NewStockDataSource() interfaces.IStockDataSource {
+	return data_sources.NewStockDataSource(database.GetDB())
+}
@@ -0,0 +1,13 @@
+package factories
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/infra/database/data_sources"
+)
+
+func TestNewStockDataSource_IsNeverCached(t *testing.T) {
+	require.IsType(t, &data_sources.GormStockDataSource{}, NewStockDataSource())
+}
@@ -0,0 +1,20 @@
+package factories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/infra/database/data_sources"
+	"tech_challenge/internal/shared/infra/cache_provider"
+)
+
+func TestNewStoreDataSource_WrapsWithCacheWhenEnabled(t *testing.T) {
+	require.IsType(t, &data_sources.GormStoreDataSource{}, NewStoreDataSource())
+
+	cache_provider.SetProvider(cache_provider.NewMemoryCacheProvider(time.Minute, 10, 1024))
+	t.Cleanup(func() { cache_provider.SetProvider(nil) })
+
+	require.IsType(t, &data_sources.CachedStoreDataSource{}, NewStoreDataSource())
+}
@@ -0,0 +1,11 @@
+package factories
+
+import (
+	"tech_challenge/internal/product/infra/database/data_sources"
+	"tech_challenge/internal/product/interfaces"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+func NewTransactionManager() interfaces.ITransactionManager {
+	return data_sources.NewGormTransactionManager(database.GetDB())
+}
@@ -0,0 +1,12 @@
+package factories
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransactionManager_ReturnsITransactionManager(t *testing.T) {
+	tm := NewTransactionManager()
+	require.NotNil(t, tm)
+}
@@ -0,0 +1,18 @@
+package factories
+
+import (
+	"tech_challenge/internal/product/infra/database/data_sources"
+	"tech_challenge/internal/product/interfaces"
+	"tech_challenge/internal/shared/infra/cache_provider"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+func NewTranslationDataSource() interfaces.ITranslationDataSource {
+	dataSource := data_sources.NewTranslationDataSource(database.GetDB())
+
+	if cache := cache_provider.GetProvider(); cache != nil {
+		return data_sources.NewCachedTranslationDataSource(dataSource, cache)
+	}
+
+	return dataSource
+}
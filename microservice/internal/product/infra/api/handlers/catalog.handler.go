@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/factories"
+	"tech_challenge/internal/product/infra/api/schemas"
+	shared_factories "tech_challenge/internal/shared/factories"
+	"tech_challenge/internal/shared/infra/api/problems"
+)
+
+const (
+	catalogFormatJSON = "json"
+	catalogFormatCSV  = "csv"
+)
+
+type CatalogHandler struct {
+	catalogController controllers.CatalogController
+}
+
+func NewCatalogHandler() *CatalogHandler {
+	catalogController := controllers.NewCatalogController(
+		factories.NewProductDataSource(),
+		factories.NewCategoryDataSource(),
+		factories.NewStockDataSource(),
+		factories.NewTranslationDataSource(),
+		factories.NewStoreDataSource(),
+		factories.NewPromotionDataSource(),
+		factories.NewTransactionManager(),
+		shared_factories.NewFileProvider(),
+		factories.NewCatalogCache(),
+	)
+
+	return &CatalogHandler{
+		catalogController: *catalogController,
+	}
+}
+
+// @Summary Export the catalog
+// @Description Exports all categories and products as JSON or as a CSV spreadsheet
+// @Tags Catalog
+// @Produce json
+// @Produce text/csv
+// @Param format query string false "Export format" Enums(json, csv) default(json)
+// @Success 200 {object} schemas.CatalogSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /catalog/export [get]
+func (h *CatalogHandler) ExportCatalog(ctx *gin.Context) {
+	format := strings.ToLower(ctx.DefaultQuery("format", catalogFormatJSON))
+
+	if format != catalogFormatJSON && format != catalogFormatCSV {
+		_ = ctx.Error(problems.InvalidOptionError("format", catalogFormatJSON, catalogFormatCSV))
+		return
+	}
+
+	catalog, err := h.catalogController.Export()
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	if format == catalogFormatJSON {
+		ctx.JSON(http.StatusOK, schemas.ToCatalogSchema(catalog))
+		return
+	}
+
+	var content bytes.Buffer
+	if err := schemas.WriteCatalogCSV(&content, catalog); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="catalog.csv"`)
+	ctx.Data(http.StatusOK, "text/csv; charset=utf-8", content.Bytes())
+}
+
+// @Summary Import the catalog
+// @Description Validates every row and reports the errors. With dry_run=false the import is applied atomically, only when all rows are valid.
+// @Tags Catalog
+// @Accept json
+// @Accept text/csv
+// @Accept multipart/form-data
+// @Produce json
+// @Param catalog body schemas.ImportCatalogSchema false "Catalog in the same format of the JSON export"
+// @Param file formData file false "JSON or CSV catalog file"
+// @Param format query string false "Format of the body, inferred from the Content-Type or the file name when omitted" Enums(json, csv)
+// @Param dry_run query bool false "Only validate and report what would change" default(true)
+// @Param deactivate_missing query bool false "Deactivate categories and products missing from the file" default(false)
+// @Success 200 {object} schemas.ImportCatalogResultSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 422 {object} schemas.ImportCatalogResultSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /catalog/import [post]
+func (h *CatalogHandler) ImportCatalog(ctx *gin.Context) {
+	dryRun, err := strconv.ParseBool(ctx.DefaultQuery("dry_run", "true"))
+	if err != nil {
+		_ = ctx.Error(problems.InvalidBooleanError("dry_run"))
+		return
+	}
+
+	deactivateMissing, err := strconv.ParseBool(ctx.DefaultQuery("deactivate_missing", "false"))
+	if err != nil {
+		_ = ctx.Error(problems.InvalidBooleanError("deactivate_missing"))
+		return
+	}
+
+	catalog, err := readImportCatalogRequest(ctx)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	result, err := h.catalogController.Import(catalog.ToImportDTO(dryRun, deactivateMissing))
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	status := http.StatusOK
+	if !dryRun && len(result.Errors) > 0 {
+		status = http.StatusUnprocessableEntity
+	}
+
+	ctx.JSON(status, schemas.ToImportCatalogResultSchema(result))
+}
+
+// @Summary Quote order items
+// @Description Validates and prices the items of an order with the catalog rules: store overrides, availability schedules, stock and promotions. Items that cannot be ordered come with the reasons; totals only include the orderable items.
+// @Tags Catalog
+// @Accept json
+// @Produce json
+// @Param quote body schemas.QuoteRequestSchema true "Items to quote"
+// @Param store_id query string false "Apply the overrides of this store" format(uuid)
+// @Param X-Store-ID header string false "Store ID, used when store_id is not in the query" format(uuid)
+// @Success 200 {object} schemas.QuoteResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /catalog/quote [post]
+func (h *CatalogHandler) QuoteCatalog(ctx *gin.Context) {
+	storeID, ok := bindStoreID(ctx)
+	if !ok {
+		return
+	}
+
+	var body schemas.QuoteRequestSchema
+	if !bindJSON(ctx, &body) {
+		return
+	}
+
+	quote, err := h.catalogController.Quote(body.ToDTO(), storeID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToQuoteResponseSchema(quote))
+}
+
+func readImportCatalogRequest(ctx *gin.Context) (schemas.ImportCatalogSchema, error) {
+	format := strings.ToLower(ctx.Query("format"))
+	body := ctx.Request.Body
+
+	if strings.HasPrefix(ctx.ContentType(), "multipart/form-data") {
+		fileHeader, err := ctx.FormFile("file")
+		if err != nil {
+			return schemas.ImportCatalogSchema{}, problems.RequiredFieldError("file")
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			return schemas.ImportCatalogSchema{}, problems.MalformedRequestError(problems.Text{
+				EN:   "the uploaded file could not be opened",
+				PTBR: "o arquivo enviado não pôde ser aberto",
+			})
+		}
+		defer file.Close()
+
+		body = file
+		if format == "" && strings.EqualFold(filepath.Ext(fileHeader.Filename), ".csv") {
+			format = catalogFormatCSV
+		}
+	} else if format == "" && ctx.ContentType() == "text/csv" {
+		format = catalogFormatCSV
+	}
+
+	switch format {
+	case catalogFormatCSV:
+		catalog, err := schemas.ReadImportCatalogCSV(body)
+		if err != nil {
+			// As mensagens do leitor de CSV citam linha e coluna e só existem em inglês
+			return schemas.ImportCatalogSchema{}, problems.MalformedRequestError(problems.Text{
+				EN:   "invalid csv catalog: " + err.Error(),
+				PTBR: "catálogo csv inválido: " + err.Error(),
+			})
+		}
+		return catalog, nil
+	case "", catalogFormatJSON:
+		var catalog schemas.ImportCatalogSchema
+		if err := decodeStrictJSON(body, &catalog); err != nil {
+			return schemas.ImportCatalogSchema{}, problems.NewBindingError(err)
+		}
+		return catalog, nil
+	}
+
+	return schemas.ImportCatalogSchema{}, problems.InvalidOptionError("format", catalogFormatJSON, catalogFormatCSV)
+}
@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	testmocks "tech_challenge/internal/shared/test"
+)
+
+func setupCatalogTestEnv(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	h := setupCatalogHandlerWithFakeGateway(productDs, categoryDs)
+	r := newTestRouter()
+	r.GET("/catalog/export", h.ExportCatalog)
+	r.POST("/catalog/import", h.ImportCatalog)
+	r.POST("/catalog/quote", h.QuoteCatalog)
+	return r
+}
+
+func catalogDataSources() (*testmocks.MockProductDataSource, *testmocks.MockCategoryDataSource) {
+	categoryDs := &testmocks.MockCategoryDataSource{
+		FindAllFunc: func() ([]daos.CategoryDAO, error) {
+			return []daos.CategoryDAO{{ID: "2cb7f56d-89a1-4e60-b488-65dc4ffacbc6", ExternalKey: "lanches", Name: "Lanches", Active: true}}, nil
+		},
+	}
+	productDs := &testmocks.MockProductDataSource{
+		FindAllFunc: func() ([]daos.ProductDAO, error) {
+			return []daos.ProductDAO{{ID: "76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae", CategoryID: "2cb7f56d-89a1-4e60-b488-65dc4ffacbc6", Name: "X-Salada", Price: 20.5, Active: true}}, nil
+		},
+	}
+	return productDs, categoryDs
+}
+
+func TestExportCatalog_JSON(t *testing.T) {
+	r := setupCatalogTestEnv(catalogDataSources())
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/catalog/export", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp map[string][]map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, "lanches", resp["categories"][0]["external_key"])
+	require.Equal(t, "X-Salada", resp["products"][0]["name"])
+}
+
+func TestExportCatalog_CSV(t *testing.T) {
+	r := setupCatalogTestEnv(catalogDataSources())
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/catalog/export?format=csv", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	require.Contains(t, w.Header().Get("Content-Disposition"), "catalog.csv")
+	require.Contains(t, w.Body.String(), "product,76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae,,X-Salada,,20.50,true,2cb7f56d-89a1-4e60-b488-65dc4ffacbc6,lanches")
+}
+
+func TestExportCatalog_InvalidFormat(t *testing.T) {
+	r := setupCatalogTestEnv(catalogDataSources())
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/catalog/export?format=xml", nil))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestExportCatalog_Error(t *testing.T) {
+	categoryDs := &testmocks.MockCategoryDataSource{
+		FindAllFunc: func() ([]daos.CategoryDAO, error) { return nil, errors.New("db error") },
+	}
+	r := setupCatalogTestEnv(&testmocks.MockProductDataSource{}, categoryDs)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/catalog/export", nil))
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestImportCatalog_DryRunByDefault(t *testing.T) {
+	productDs, categoryDs := catalogDataSources()
+	categoryDs.InsertFunc = func(daos.CategoryDAO) error {
+		t.Fatal("dry run must not write")
+		return nil
+	}
+	r := setupCatalogTestEnv(productDs, categoryDs)
+
+	body := `{"categories":[{"external_key":"bebidas","name":"Bebidas"}],"products":[{"category_key":"bebidas","name":"Coca-Cola","price":5.99}]}`
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/catalog/import", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, true, resp["dry_run"])
+	require.Equal(t, false, resp["applied"])
+	require.Equal(t, float64(1), resp["categories_created"])
+	require.Equal(t, float64(1), resp["products_created"])
+}
+
+func TestImportCatalog_CSVApply(t *testing.T) {
+	productDs, categoryDs := catalogDataSources()
+	var updated daos.ProductDAO
+	productDs.UpdateFunc = func(dao daos.ProductDAO) error {
+		updated = dao
+		return nil
+	}
+	r := setupCatalogTestEnv(productDs, categoryDs)
+
+	body := "type,id,name,price,category_key\nproduct,76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae,X-Salada,\"22,00\",lanches\n"
+	req := httptest.NewRequest(http.MethodPost, "/catalog/import?dry_run=false", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"applied":true`)
+	require.Equal(t, 22.0, updated.Price)
+}
+
+func TestImportCatalog_MultipartFile(t *testing.T) {
+	r := setupCatalogTestEnv(catalogDataSources())
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "cardapio.csv")
+	require.NoError(t, err)
+	_, _ = part.Write([]byte("type,name,category_key,price\nproduct,Batata Frita,lanches,12\n"))
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/catalog/import", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"products_created":1`)
+}
+
+func TestImportCatalog_InvalidRowsReturnUnprocessableEntity(t *testing.T) {
+	r := setupCatalogTestEnv(catalogDataSources())
+
+	body := `{"products":[{"category_key":"lanches","name":"X-Tudo","price":-1}]}`
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/catalog/import?dry_run=false", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	var resp struct {
+		Applied bool `json:"applied"`
+		Errors  []struct {
+			Entity string `json:"entity"`
+			Row    int    `json:"row"`
+			Field  string `json:"field"`
+		} `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.False(t, resp.Applied)
+	require.Len(t, resp.Errors, 1)
+	require.Equal(t, "price", resp.Errors[0].Field)
+	require.Equal(t, 1, resp.Errors[0].Row)
+}
+
+func TestImportCatalog_BadRequest(t *testing.T) {
+	r := setupCatalogTestEnv(catalogDataSources())
+
+	for _, url := range []string{"/catalog/import?dry_run=maybe", "/catalog/import?deactivate_missing=maybe", "/catalog/import?format=xml", "/catalog/import"} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, url, strings.NewReader("")))
+		require.Equal(t, http.StatusBadRequest, w.Code, url)
+	}
+}
+
+func TestQuoteCatalog(t *testing.T) {
+	productDs, categoryDs := catalogDataSources()
+	stockDs := &testmocks.MockStockDataSource{
+		FindAllFunc: func() ([]daos.ProductStockDAO, error) {
+			return []daos.ProductStockDAO{{ProductID: "76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae", Tracked: true, Quantity: 5}}, nil
+		},
+	}
+	promotionDs := &testmocks.MockPromotionDataSource{Promotions: []daos.PromotionDAO{{
+		ID: "9a7c3f0e-4b1d-4e55-8a3e-6f2d1c0b9e77", Name: "R$2 off", DiscountType: "fixed", DiscountValue: 2,
+		ProductIDs: []string{"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"}, Active: true,
+	}}}
+	h := setupQuoteHandler(productDs, categoryDs, stockDs, promotionDs)
+	r := newTestRouter()
+	r.POST("/catalog/quote", h.QuoteCatalog)
+
+	body := `{"items":[
+		{"product_id":"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae","quantity":2},
+		{"product_id":"0b4c6a9e-2f0e-4a3c-9a52-3f4f2b1d7c11","quantity":1},
+		{"product_id":"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae","quantity":4,"options":["sem-cebola"]}
+	]}`
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/catalog/quote", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp struct {
+		Items []struct {
+			ProductID          string  `json:"product_id"`
+			Name               string  `json:"name"`
+			UnitPrice          float64 `json:"unit_price"`
+			EffectiveUnitPrice float64 `json:"effective_unit_price"`
+			Total              float64 `json:"total"`
+			AppliedPromotion   *struct {
+				Amount float64 `json:"amount"`
+			} `json:"applied_promotion"`
+			Orderable bool `json:"orderable"`
+			Issues    []struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"issues"`
+		} `json:"items"`
+		Subtotal  float64 `json:"subtotal"`
+		Discount  float64 `json:"discount"`
+		Total     float64 `json:"total"`
+		Orderable bool    `json:"orderable"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.False(t, resp.Orderable)
+	require.Len(t, resp.Items, 3)
+
+	// As duas linhas do X-Salada pedem 6 unidades e só há 5 em estoque
+	first := resp.Items[0]
+	require.Equal(t, "X-Salada", first.Name)
+	require.Equal(t, 20.5, first.UnitPrice)
+	require.Equal(t, 18.5, first.EffectiveUnitPrice)
+	require.Equal(t, 37.0, first.Total)
+	require.Equal(t, 2.0, first.AppliedPromotion.Amount)
+	require.False(t, first.Orderable)
+	require.Equal(t, "unavailable", first.Issues[0].Code)
+
+	require.Equal(t, "unknown", resp.Items[1].Issues[0].Code)
+	require.Empty(t, resp.Items[1].Name)
+
+	codes := []string{}
+	for _, issue := range resp.Items[2].Issues {
+		codes = append(codes, issue.Code)
+	}
+	require.Equal(t, []string{"unavailable", "invalid_selection"}, codes)
+
+	require.Zero(t, resp.Total)
+}
+
+func TestQuoteCatalog_Orderable(t *testing.T) {
+	r := setupCatalogTestEnv(catalogDataSources())
+
+	body := `{"items":[{"product_id":"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae","quantity":3}]}`
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/catalog/quote", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"items":[{"product_id":"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae","name":"X-Salada","quantity":3,"options":[],"unit_price":20.5,"effective_unit_price":20.5,"total":61.5,"orderable":true,"issues":[]}],
+		"subtotal":61.5,"discount":0,"total":61.5,"orderable":true
+	}`, w.Body.String())
+}
+
+func TestQuoteCatalog_BadRequest(t *testing.T) {
+	r := setupCatalogTestEnv(catalogDataSources())
+
+	for _, body := range []string{
+		`{"items":[]}`,
+		`{"items":[{"product_id":"not-a-uuid","quantity":1}]}`,
+		`{"items":[{"product_id":"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae","quantity":0}]}`,
+		`{"items":[{"product_id":"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae","quantity":1,"options":[""]}]}`,
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/catalog/quote", strings.NewReader(body)))
+		require.Equal(t, http.StatusBadRequest, w.Code, body)
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/catalog/quote?store_id=abc", strings.NewReader(`{"items":[{"product_id":"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae","quantity":1}]}`)))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, "INVALID_PARAMETER", decodeProblem(t, w).Code)
+}
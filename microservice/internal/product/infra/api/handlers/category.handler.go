@@ -3,61 +3,157 @@ package handlers
 import (
 	"net/http"
 	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/factories"
 	"tech_challenge/internal/product/infra/api/schemas"
+	"tech_challenge/internal/shared/config/env"
+	shared_factories "tech_challenge/internal/shared/factories"
 
 	"github.com/gin-gonic/gin"
 )
 
 type CategoryHandler struct {
 	categoryController controllers.CategoryController
+	requireIfMatch     bool
+	cacheControl       string
 }
 
 func NewCategoryHandler() *CategoryHandler {
-	categoryDataSource := factories.NewCategoryDataSource()
-	categoryController := controllers.NewCategoryController(categoryDataSource)
+	categoryController := controllers.NewCategoryController(
+		factories.NewCategoryDataSource(),
+		factories.NewTranslationDataSource(),
+		factories.NewStoreDataSource(),
+		factories.NewTransactionManager(),
+		shared_factories.NewFileProvider(),
+		factories.NewCatalogCache(),
+	)
 
 	return &CategoryHandler{
 		categoryController: *categoryController,
+		requireIfMatch:     env.GetConfig().APIRequireIfMatch,
+		cacheControl:       env.GetConfig().APICacheControl,
 	}
 }
 
 // @Summary List all categories
+// @Description Categories are returned sorted by position
 // @Tags Categories
 // @Produce json
+// @Param at query string false "Evaluate available_now at this instant instead of now (RFC 3339)" format(date-time)
+// @Param store_id query string false "Apply the overrides of this store" format(uuid)
+// @Param X-Store-ID header string false "Store ID, used when store_id is not in the query" format(uuid)
+// @Param If-None-Match header string false "ETag of the cached list"
 // @Success 200 {array} schemas.CategoryResponseSchema
-// @Failure 500 {object} schemas.ErrorMessageSchema
+// @Header 200 {string} ETag "Hash of the list"
+// @Header 200 {string} Cache-Control "Cache policy"
+// @Success 304 {object} nil
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
 // @Router /categories/ [get]
 func (h *CategoryHandler) FindAllCategories(ctx *gin.Context) {
-	categories, err := h.categoryController.FindAll()
+	at, ok := bindAt(ctx)
+	if !ok {
+		return
+	}
+
+	storeID, ok := bindStoreID(ctx)
+	if !ok {
+		return
+	}
+
+	categories, err := h.categoryController.FindAll(at, negotiateLocale(ctx), storeID)
 
 	if err != nil {
 		_ = ctx.Error(err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, schemas.ListToCategoryResponseSchema(categories))
+	renderCacheableJSON(ctx, h.cacheControl, schemas.ListToCategoryResponseSchema(categories))
+}
+
+// @Summary Get the category tree
+// @Description Root categories with their subcategories nested in children, each level sorted by position
+// @Tags Categories
+// @Produce json
+// @Param at query string false "Evaluate available_now at this instant instead of now (RFC 3339)" format(date-time)
+// @Param store_id query string false "Apply the overrides of this store" format(uuid)
+// @Param X-Store-ID header string false "Store ID, used when store_id is not in the query" format(uuid)
+// @Param If-None-Match header string false "ETag of the cached tree"
+// @Success 200 {array} schemas.CategoryTreeResponseSchema
+// @Header 200 {string} ETag "Hash of the tree"
+// @Header 200 {string} Cache-Control "Cache policy"
+// @Success 304 {object} nil
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /categories/tree [get]
+func (h *CategoryHandler) FindCategoryTree(ctx *gin.Context) {
+	at, ok := bindAt(ctx)
+	if !ok {
+		return
+	}
+
+	storeID, ok := bindStoreID(ctx)
+	if !ok {
+		return
+	}
+
+	tree, err := h.categoryController.FindTree(at, negotiateLocale(ctx), storeID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderCacheableJSON(ctx, h.cacheControl, schemas.ToCategoryTreeResponseSchema(tree))
 }
 
 // @Summary Get a Category by ID
 // @Tags Categories
 // @Produce json
-// @Param id path string true "Category ID"
+// @Param id path string true "Category ID" format(uuid)
+// @Param store_id query string false "Apply the overrides of this store" format(uuid)
+// @Param X-Store-ID header string false "Store ID, used when store_id is not in the query" format(uuid)
+// @Param If-None-Match header string false "ETag of the cached category"
+// @Param If-Modified-Since header string false "Last-Modified of the cached category"
 // @Success 200 {object} schemas.CategoryResponseSchema
-// @Failure 400 {object} schemas.InvalidCategoryDataErrorSchema
-// @Failure 404 {object} schemas.CategoryNotFoundErrorSchema
+// @Header 200 {string} ETag "Category version, or a hash of the body for translated and store reads and scheduled availability"
+// @Header 200 {string} Last-Modified "Category updated_at, only when the ETag is the version"
+// @Header 200 {string} Cache-Control "Cache policy"
+// @Success 304 {object} nil
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
 // @Router /categories/{id} [get]
 func (h *CategoryHandler) FindCategoryByID(ctx *gin.Context) {
-	categoryId := ctx.Param("id")
+	categoryId, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	storeID, ok := bindStoreID(ctx)
+	if !ok {
+		return
+	}
 
-	category, err := h.categoryController.FindByID(categoryId)
+	locale := negotiateLocale(ctx)
+	category, err := h.categoryController.FindByID(categoryId, locale, storeID)
 
 	if err != nil {
 		_ = ctx.Error(err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, schemas.ToCategoryResponseSchema(category))
+	// Traduções, overrides de loja e grades (available_now muda com o horário)
+	// não alteram a versão da categoria, então o ETag passa a ser o hash do corpo
+	if translatedLocale(locale) || storeID != "" || category.Scheduled {
+		renderCacheableJSON(ctx, h.cacheControl, schemas.ToCategoryResponseSchema(category))
+		return
+	}
+
+	renderVersionedJSON(ctx, h.cacheControl, category.Version, category.UpdatedAt, schemas.ToCategoryResponseSchema(category))
 }
 
 // @Summary CreateCategory a new category
@@ -66,14 +162,15 @@ func (h *CategoryHandler) FindCategoryByID(ctx *gin.Context) {
 // @Produce json
 // @Param category body schemas.CreateCategorySchema true "Category to create"
 // @Success 201 {object} schemas.CategoryResponseSchema
-// @Failure 400 {object} schemas.InvalidCategoryDataErrorSchema
-// @Failure 500 {object} schemas.ErrorMessageSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 409 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
 // @Router /categories/ [post]
 func (h *CategoryHandler) CreateCategory(ctx *gin.Context) {
 	var categoryRequestBody schemas.CreateCategorySchema
 
-	if err := ctx.ShouldBindJSON(&categoryRequestBody); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if !bindJSON(ctx, &categoryRequestBody) {
 		return
 	}
 
@@ -84,53 +181,245 @@ func (h *CategoryHandler) CreateCategory(ctx *gin.Context) {
 		return
 	}
 
+	setETag(ctx, category.Version)
 	ctx.JSON(http.StatusCreated, schemas.ToCategoryResponseSchema(category))
 }
 
+// @Summary Partially update a Category by ID
+// @Description JSON Merge Patch (RFC 7396) applied to the top-level fields: only the fields sent are changed. parent_id null turns the category into a root category, description null clears it and availability null removes the schedule; name and active cannot be null. availability is replaced as a whole, not merged field by field, so send the complete object.
+// @Tags Categories
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path string true "Category ID" format(uuid)
+// @Param If-Match header string false "ETag of the version being changed"
+// @Param category body schemas.PatchCategorySchema true "Fields to change"
+// @Success 200 {object} schemas.CategoryResponseSchema
+// @Header 200 {string} ETag "Category version"
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 409 {object} schemas.ProblemSchema
+// @Failure 412 {object} schemas.ProblemSchema
+// @Failure 415 {object} schemas.ProblemSchema
+// @Failure 428 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /categories/{id} [patch]
+func (h *CategoryHandler) PatchCategory(ctx *gin.Context) {
+	categoryId, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	precondition, ok := bindPrecondition(ctx, h.requireIfMatch)
+	if !ok {
+		return
+	}
+
+	var patchRequestBody schemas.PatchCategorySchema
+
+	if !bindMergePatch(ctx, &patchRequestBody) {
+		return
+	}
+
+	patchDTO := patchRequestBody.ToDTO(categoryId)
+	patchDTO.Precondition = precondition
+
+	category, err := h.categoryController.Patch(patchDTO)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	setETag(ctx, category.Version)
+	ctx.JSON(http.StatusOK, schemas.ToCategoryResponseSchema(category))
+}
+
 // @Summary UpdateCategory a Category by ID
 // @Tags Categories
 // @Accept json
 // @Produce json
-// @Param id path string true "Category ID"
+// @Param id path string true "Category ID" format(uuid)
+// @Param If-Match header string false "ETag of the version being changed"
 // @Param category body schemas.UpdateCategoryRequestBodySchema true "Updated Category data"
 // @Success 200 {object} schemas.CategoryResponseSchema
-// @Failure 400 {object} schemas.InvalidCategoryDataErrorSchema
-// @Failure 404 {object} schemas.CategoryNotFoundErrorSchema
-// @Failure 500 {object} schemas.ErrorMessageSchema
+// @Header 200 {string} ETag "Category version"
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 409 {object} schemas.ProblemSchema
+// @Failure 412 {object} schemas.ProblemSchema
+// @Failure 428 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
 // @Router /categories/{id} [put]
 func (h *CategoryHandler) UpdateCategory(ctx *gin.Context) {
-	categoryId := ctx.Param("id")
+	categoryId, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	precondition, ok := bindPrecondition(ctx, h.requireIfMatch)
+	if !ok {
+		return
+	}
 
 	var updateCategoryRequestBody schemas.UpdateCategoryRequestBodySchema
 
-	if err := ctx.ShouldBindJSON(&updateCategoryRequestBody); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if !bindJSON(ctx, &updateCategoryRequestBody) {
 		return
 	}
 
-	category, err := h.categoryController.Update(updateCategoryRequestBody.ToDTO(categoryId))
+	updateDTO := updateCategoryRequestBody.ToDTO(categoryId)
+	updateDTO.Precondition = precondition
+
+	category, err := h.categoryController.Update(updateDTO)
 
 	if err != nil {
 		_ = ctx.Error(err)
 		return
 	}
 
+	setETag(ctx, category.Version)
 	ctx.JSON(http.StatusOK, schemas.ToCategoryResponseSchema(category))
 
 }
 
 // @Summary DeleteCategory a Category by ID
+// @Description restrict (default) only deletes categories without products or subcategories; reassign moves the products to target_category_id before deleting; deactivate keeps the category and deactivates it, its subcategories and their products. Changes are applied in a single transaction.
 // @Tags Categories
 // @Produce json
-// @Param id path string true "Category Order ID"
-// @Success 204 {object} nil
-// @Failure 400 {object} schemas.InvalidCategoryDataErrorSchema
-// @Failure 500 {object} schemas.ErrorMessageSchema
+// @Param id path string true "Category Order ID" format(uuid)
+// @Param strategy query string false "Deletion strategy" Enums(restrict, reassign, deactivate) default(restrict)
+// @Param target_category_id query string false "Category that receives the products when strategy=reassign" format(uuid)
+// @Param If-Match header string false "ETag of the version being deleted"
+// @Success 200 {object} schemas.DeleteCategoryResultSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 412 {object} schemas.ProblemSchema
+// @Failure 428 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
 // @Router /categories/{id} [delete]
 func (h *CategoryHandler) DeleteCategory(ctx *gin.Context) {
-	categoryId := ctx.Param("id")
+	categoryId, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	query := schemas.DeleteCategoryQuerySchema{Strategy: "restrict"}
+
+	if !bindQuery(ctx, &query) {
+		return
+	}
+
+	precondition, ok := bindPrecondition(ctx, h.requireIfMatch)
+	if !ok {
+		return
+	}
+
+	deleteDTO := dtos.DeleteCategoryDTO{
+		ID:               categoryId,
+		Strategy:         query.Strategy,
+		TargetCategoryID: query.TargetCategoryID,
+		Precondition:     precondition,
+	}
+
+	result, err := h.categoryController.Delete(deleteDTO)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToDeleteCategoryResultSchema(result))
+}
+
+// @Summary Reorder categories
+// @Description The listed categories get positions 1..n in the given order; the others keep their relative order after them
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param order body schemas.ReorderCategoriesSchema true "Category IDs in the desired order"
+// @Success 200 {array} schemas.CategoryResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /categories/order [put]
+func (h *CategoryHandler) ReorderCategories(ctx *gin.Context) {
+	var reorderRequestBody schemas.ReorderCategoriesSchema
+
+	if !bindJSON(ctx, &reorderRequestBody) {
+		return
+	}
+
+	categories, err := h.categoryController.Reorder(reorderRequestBody.ToDTO())
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ListToCategoryResponseSchema(categories))
+}
+
+// @Summary Set the category icon image
+// @Description Replaces the current icon; the previous file is removed from the bucket
+// @Tags Categories
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Category ID" format(uuid)
+// @Param image formData file true "Image file"
+// @Success 200 {object} schemas.CategoryResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /categories/{id}/image [patch]
+func (h *CategoryHandler) UploadCategoryImage(ctx *gin.Context) {
+	categoryId, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	fileName, fileContent, err := readUploadedImage(ctx)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	category, err := h.categoryController.UploadImage(dtos.UploadCategoryImageDTO{
+		CategoryID:  categoryId,
+		FileName:    fileName,
+		FileContent: fileContent,
+	})
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	setETag(ctx, category.Version)
+	ctx.JSON(http.StatusOK, schemas.ToCategoryResponseSchema(category))
+}
+
+// @Summary Remove the category icon image
+// @Tags Categories
+// @Produce json
+// @Param id path string true "Category ID" format(uuid)
+// @Success 204 {object} nil
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /categories/{id}/image [delete]
+func (h *CategoryHandler) DeleteCategoryImage(ctx *gin.Context) {
+	categoryId, ok := bindID(ctx)
+	if !ok {
+		return
+	}
 
-	if err := h.categoryController.Delete(categoryId); err != nil {
+	if err := h.categoryController.DeleteImage(categoryId); err != nil {
 		_ = ctx.Error(err)
 		return
 	}
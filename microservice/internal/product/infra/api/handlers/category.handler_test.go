@@ -1,24 +1,31 @@
 package handlers
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/textproto"
 	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/infra/api/schemas"
+	"tech_challenge/internal/shared/infra/api/problems"
 	testmocks "tech_challenge/internal/shared/test"
 )
 
 func setupCategoryTestEnv(mockCategoryDs *testmocks.MockCategoryDataSource) (*gin.Engine, *httptest.ResponseRecorder, *CategoryHandler) {
 	gin.SetMode(gin.TestMode)
 	h := setupCategoryHandlerWithFakeGateway(mockCategoryDs)
-	r := gin.New()
+	r := newTestRouter()
 	w := httptest.NewRecorder()
 	return r, w, h
 }
@@ -54,12 +61,6 @@ func TestFindAllCategories_Error(t *testing.T) {
 
 	r, w, h := setupCategoryTestEnv(mockCategoryDs)
 
-	r.Use(func(c *gin.Context) {
-		c.Next()
-		if len(c.Errors) > 0 {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": c.Errors[0].Error()})
-		}
-	})
 	r.GET("/categories", h.FindAllCategories)
 
 	req := httptest.NewRequest(http.MethodGet, "/categories", nil)
@@ -68,6 +69,53 @@ func TestFindAllCategories_Error(t *testing.T) {
 	require.Equal(t, http.StatusInternalServerError, w.Code)
 }
 
+func TestFindCategoryTree_Success(t *testing.T) {
+	mockCategoryDs := &testmocks.MockCategoryDataSource{
+		FindAllFunc: func() ([]daos.CategoryDAO, error) {
+			return []daos.CategoryDAO{
+				{ID: "1", Name: "Bebidas", Position: 2, Active: true},
+				{ID: "2", ParentID: "1", Name: "Refrigerantes", Position: 3, Active: true},
+				{ID: "3", Name: "Lanches", Position: 1, Active: true},
+			}, nil
+		},
+	}
+	r, w, h := setupCategoryTestEnv(mockCategoryDs)
+
+	r.GET("/categories/tree", h.FindCategoryTree)
+
+	req := httptest.NewRequest(http.MethodGet, "/categories/tree", nil)
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp []map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+	require.Equal(t, "Lanches", resp[0]["name"])
+	require.Empty(t, resp[0]["children"])
+	require.Equal(t, "Bebidas", resp[1]["name"])
+	children := resp[1]["children"].([]interface{})
+	require.Len(t, children, 1)
+	require.Equal(t, "Refrigerantes", children[0].(map[string]interface{})["name"])
+	require.Equal(t, "1", children[0].(map[string]interface{})["parent_id"])
+}
+
+func TestFindCategoryTree_Error(t *testing.T) {
+	mockCategoryDs := &testmocks.MockCategoryDataSource{
+		FindAllFunc: func() ([]daos.CategoryDAO, error) {
+			return nil, errors.New("mock error")
+		},
+	}
+	r, w, h := setupCategoryTestEnv(mockCategoryDs)
+	r.GET("/categories/tree", h.FindCategoryTree)
+
+	req := httptest.NewRequest(http.MethodGet, "/categories/tree", nil)
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
 func TestFindCategoryByID_Success(t *testing.T) {
 	mockCategoryDs := &testmocks.MockCategoryDataSource{
 		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
@@ -78,7 +126,7 @@ func TestFindCategoryByID_Success(t *testing.T) {
 
 	r.GET("/categories/:id", h.FindCategoryByID)
 
-	req := httptest.NewRequest(http.MethodGet, "/categories/1", nil)
+	req := httptest.NewRequest(http.MethodGet, "/categories/"+testCategoryID, nil)
 	r.ServeHTTP(w, req)
 
 	require.Equal(t, http.StatusOK, w.Code)
@@ -92,20 +140,14 @@ func TestFindCategoryByID_Success(t *testing.T) {
 func TestFindCategoryByID_Error(t *testing.T) {
 	mockCategoryDs := &testmocks.MockCategoryDataSource{
 		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
-			return daos.CategoryDAO{}, errors.New("not found")
+			return daos.CategoryDAO{}, &exceptions.RecordNotFoundException{}
 		},
 	}
 	r, w, h := setupCategoryTestEnv(mockCategoryDs)
 
-	r.Use(func(c *gin.Context) {
-		c.Next()
-		if len(c.Errors) > 0 {
-			c.JSON(http.StatusNotFound, gin.H{"error": c.Errors[0].Error()})
-		}
-	})
 	r.GET("/categories/:id", h.FindCategoryByID)
 
-	req := httptest.NewRequest(http.MethodGet, "/categories/1", nil)
+	req := httptest.NewRequest(http.MethodGet, "/categories/"+testCategoryID, nil)
 	r.ServeHTTP(w, req)
 
 	require.Equal(t, http.StatusNotFound, w.Code)
@@ -148,7 +190,7 @@ func TestCreateCategory_BadRequest(t *testing.T) {
 	var resp map[string]interface{}
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	require.NoError(t, err)
-	require.Contains(t, resp["error"], "json")
+	require.Equal(t, problems.CodeValidationFailed, resp["code"])
 }
 
 func TestCreateCategory_Error(t *testing.T) {
@@ -157,12 +199,6 @@ func TestCreateCategory_Error(t *testing.T) {
 	}
 	r, w, h := setupCategoryTestEnv(mockCategoryDs)
 
-	r.Use(func(c *gin.Context) {
-		c.Next()
-		if len(c.Errors) > 0 {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": c.Errors[0].Error()})
-		}
-	})
 	r.POST("/categories", h.CreateCategory)
 
 	body := `{"name":"Bebidas","active":true}`
@@ -185,7 +221,7 @@ func TestUpdateCategory_Success(t *testing.T) {
 	r.PUT("/categories/:id", h.UpdateCategory)
 
 	body := `{"name":"Bebidas","active":true}`
-	req := httptest.NewRequest(http.MethodPut, "/categories/1", strings.NewReader(body))
+	req := httptest.NewRequest(http.MethodPut, "/categories/"+testCategoryID, strings.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
@@ -204,7 +240,7 @@ func TestUpdateCategory_BadRequest(t *testing.T) {
 	r.PUT("/categories/:id", h.UpdateCategory)
 
 	body := `{"name":1}`
-	req := httptest.NewRequest(http.MethodPut, "/categories/1", strings.NewReader(body))
+	req := httptest.NewRequest(http.MethodPut, "/categories/"+testCategoryID, strings.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
@@ -213,7 +249,7 @@ func TestUpdateCategory_BadRequest(t *testing.T) {
 	var resp map[string]interface{}
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	require.NoError(t, err)
-	require.Contains(t, resp["error"], "json")
+	require.Equal(t, problems.CodeValidationFailed, resp["code"])
 }
 
 func TestUpdateCategory_Error(t *testing.T) {
@@ -225,16 +261,10 @@ func TestUpdateCategory_Error(t *testing.T) {
 	}
 	r, w, h := setupCategoryTestEnv(mockCategoryDs)
 
-	r.Use(func(c *gin.Context) {
-		c.Next()
-		if len(c.Errors) > 0 {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": c.Errors[0].Error()})
-		}
-	})
 	r.PUT("/categories/:id", h.UpdateCategory)
 
 	body := `{"name":"Bebidas","active":true}`
-	req := httptest.NewRequest(http.MethodPut, "/categories/1", strings.NewReader(body))
+	req := httptest.NewRequest(http.MethodPut, "/categories/"+testCategoryID, strings.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
@@ -243,7 +273,7 @@ func TestUpdateCategory_Error(t *testing.T) {
 
 func TestDeleteCategory_Success(t *testing.T) {
 	mockCategoryDs := &testmocks.MockCategoryDataSource{
-		DeleteFunc: func(id string) error { return nil },
+		DeleteFunc: func(id string, version int64) error { return nil },
 		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
 			return daos.CategoryDAO{ID: id, Name: "Bebidas", Active: true}, nil
 		},
@@ -252,30 +282,73 @@ func TestDeleteCategory_Success(t *testing.T) {
 
 	r.DELETE("/categories/:id", h.DeleteCategory)
 
-	req := httptest.NewRequest(http.MethodDelete, "/categories/1", nil)
+	req := httptest.NewRequest(http.MethodDelete, "/categories/"+testCategoryID, nil)
 	r.ServeHTTP(w, req)
 
-	require.Equal(t, http.StatusNoContent, w.Code)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, "restrict", resp["strategy"])
+	require.Equal(t, true, resp["deleted"])
+}
+
+func TestDeleteCategory_Reassign(t *testing.T) {
+	categories := []daos.CategoryDAO{
+		{ID: testCategoryID, Name: "Bebidas", Active: true},
+		{ID: testOtherCategoryID, Name: "Refrigerantes", Active: true},
+	}
+	var deleted string
+	mockCategoryDs := &testmocks.MockCategoryDataSource{
+		FindAllFunc: func() ([]daos.CategoryDAO, error) { return categories, nil },
+		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
+			return categories[0], nil
+		},
+		DeleteFunc: func(id string, version int64) error {
+			deleted = id
+			return nil
+		},
+	}
+	mockProductDs := &testmocks.MockProductDataSource{
+		FindAllByCategoryIDFunc: func(categoryID string) ([]daos.ProductDAO, error) {
+			return []daos.ProductDAO{{ID: "p1", Name: "Coca-Cola", Price: 5.99, Active: true, CategoryID: categoryID}}, nil
+		},
+		UpdateFunc: func(product daos.ProductDAO) error {
+			require.Equal(t, testOtherCategoryID, product.CategoryID)
+			return nil
+		},
+	}
+	h := setupCategoryHandlerWithProducts(mockCategoryDs, mockProductDs)
+	r := newTestRouter()
+	w := httptest.NewRecorder()
+	r.DELETE("/categories/:id", h.DeleteCategory)
+
+	req := httptest.NewRequest(http.MethodDelete, "/categories/"+testCategoryID+"?strategy=reassign&target_category_id="+testOtherCategoryID, nil)
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, testCategoryID, deleted)
+
+	var resp schemas.DeleteCategoryResultSchema
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, "reassign", resp.Strategy)
+	require.Equal(t, testOtherCategoryID, resp.TargetCategoryID)
+	require.Equal(t, []string{"p1"}, resp.AffectedProducts)
+	require.Equal(t, 1, resp.AffectedProductsCount)
 }
 
 func TestDeleteCategory_Error(t *testing.T) {
 	mockCategoryDs := &testmocks.MockCategoryDataSource{
-		DeleteFunc: func(id string) error { return errors.New("delete error") },
+		DeleteFunc: func(id string, version int64) error { return errors.New("delete error") },
 		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
 			return daos.CategoryDAO{ID: id, Name: "Bebidas", Active: true}, nil
 		},
 	}
 	r, w, h := setupCategoryTestEnv(mockCategoryDs)
 
-	r.Use(func(c *gin.Context) {
-		c.Next()
-		if len(c.Errors) > 0 {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": c.Errors[0].Error()})
-		}
-	})
 	r.DELETE("/categories/:id", h.DeleteCategory)
 
-	req := httptest.NewRequest(http.MethodDelete, "/categories/1", nil)
+	req := httptest.NewRequest(http.MethodDelete, "/categories/"+testCategoryID, nil)
 	r.ServeHTTP(w, req)
 
 	require.Equal(t, http.StatusInternalServerError, w.Code)
@@ -286,3 +359,182 @@ func TestNewCategoryHandler(t *testing.T) {
 	require.NotNil(t, h)
 	require.NotNil(t, h.categoryController)
 }
+
+func TestReorderCategories_Success(t *testing.T) {
+	var positions []string
+	mockCategoryDs := &testmocks.MockCategoryDataSource{
+		FindAllFunc: func() ([]daos.CategoryDAO, error) {
+			return []daos.CategoryDAO{
+				{ID: testCategoryID, Name: "Lanches", Position: 1, Active: true},
+				{ID: testOtherCategoryID, Name: "Bebidas", Position: 2, Active: true},
+			}, nil
+		},
+		UpdatePositionsFunc: func(orderedIDs []string) error {
+			positions = orderedIDs
+			return nil
+		},
+	}
+	r, w, h := setupCategoryTestEnv(mockCategoryDs)
+
+	r.PUT("/categories/order", h.ReorderCategories)
+
+	req := httptest.NewRequest(http.MethodPut, "/categories/order", strings.NewReader(`{"category_ids":["`+testOtherCategoryID+`"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, []string{testOtherCategoryID, testCategoryID}, positions)
+
+	var resp []map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, "Bebidas", resp[0]["name"])
+	require.Equal(t, float64(1), resp[0]["position"])
+}
+
+func TestReorderCategories_BadRequest(t *testing.T) {
+	r, w, h := setupCategoryTestEnv(&testmocks.MockCategoryDataSource{})
+
+	r.PUT("/categories/order", h.ReorderCategories)
+
+	req := httptest.NewRequest(http.MethodPut, "/categories/order", strings.NewReader(`{"category_ids":[]}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func newImageUploadRequest(t *testing.T, url, contentType string) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="image"; filename="icon.png"`)
+	header.Set("Content-Type", contentType)
+	part, err := writer.CreatePart(header)
+	require.NoError(t, err)
+	_, _ = part.Write([]byte("png"))
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPatch, url, &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestUploadCategoryImage_Success(t *testing.T) {
+	mockCategoryDs := &testmocks.MockCategoryDataSource{
+		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
+			return daos.CategoryDAO{ID: id, Name: "Bebidas", Active: true}, nil
+		},
+	}
+	fileProvider := makeGomockFileProvider(t)
+	fileProvider.EXPECT().UploadFile(gomock.Any(), []byte("png")).Return(nil)
+	fileProvider.EXPECT().GetPresignedURL(gomock.Any()).Return("http://bucket/icon.png", nil)
+	h := setupCategoryHandlerWithFileProvider(mockCategoryDs, fileProvider)
+	r := newTestRouter()
+	w := httptest.NewRecorder()
+
+	r.PATCH("/categories/:id/image", h.UploadCategoryImage)
+	r.ServeHTTP(w, newImageUploadRequest(t, "/categories/"+testCategoryID+"/image", "image/png"))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, "http://bucket/icon.png", resp["image"].(map[string]interface{})["url"])
+}
+
+func TestUploadCategoryImage_InvalidType(t *testing.T) {
+	r, w, h := setupCategoryTestEnv(&testmocks.MockCategoryDataSource{})
+
+	r.PATCH("/categories/:id/image", h.UploadCategoryImage)
+	r.ServeHTTP(w, newImageUploadRequest(t, "/categories/"+testCategoryID+"/image", "application/pdf"))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestDeleteCategoryImage_Success(t *testing.T) {
+	mockCategoryDs := &testmocks.MockCategoryDataSource{
+		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
+			return daos.CategoryDAO{ID: id, Name: "Bebidas", ImageFileName: "icon.png"}, nil
+		},
+	}
+	fileProvider := makeGomockFileProvider(t)
+	fileProvider.EXPECT().DeleteFile("icon.png").Return(nil)
+	h := setupCategoryHandlerWithFileProvider(mockCategoryDs, fileProvider)
+	r := newTestRouter()
+	w := httptest.NewRecorder()
+
+	r.DELETE("/categories/:id/image", h.DeleteCategoryImage)
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/categories/"+testCategoryID+"/image", nil))
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestUpdateCategory_RequiresActive(t *testing.T) {
+	r, w, h := setupCategoryTestEnv(&testmocks.MockCategoryDataSource{})
+
+	r.PUT("/categories/:id", h.UpdateCategory)
+
+	req := httptest.NewRequest(http.MethodPut, "/categories/"+testCategoryID, strings.NewReader(`{"name":"Bebidas"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), `"field":"active"`)
+}
+
+func TestDeleteCategory_InvalidParams(t *testing.T) {
+	r, _, h := setupCategoryTestEnv(&testmocks.MockCategoryDataSource{})
+
+	r.DELETE("/categories/:id", h.DeleteCategory)
+
+	for _, c := range []struct{ url, field string }{
+		{"/categories/1", "id"},
+		{"/categories/" + testCategoryID + "?strategy=cascade", "strategy"},
+		{"/categories/" + testCategoryID + "?strategy=reassign&target_category_id=2", "target_category_id"},
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, c.url, nil))
+
+		require.Equal(t, http.StatusBadRequest, w.Code, c.url)
+		require.Contains(t, w.Body.String(), `"field":"`+c.field+`"`)
+	}
+}
+
+func TestPatchCategory_NullParentAndDescription(t *testing.T) {
+	var saved daos.CategoryDAO
+	mockCategoryDs := &testmocks.MockCategoryDataSource{
+		UpdateFunc: func(dao daos.CategoryDAO) error {
+			saved = dao
+			return nil
+		},
+		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
+			return daos.CategoryDAO{ID: id, ParentID: testOtherCategoryID, Name: "Latas", Description: "350ml", Active: true}, nil
+		},
+	}
+	r, w, h := setupCategoryTestEnv(mockCategoryDs)
+
+	r.PATCH("/categories/:id", h.PatchCategory)
+
+	req := httptest.NewRequest(http.MethodPatch, "/categories/"+testCategoryID, strings.NewReader(`{"parent_id":null,"description":null}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Empty(t, saved.ParentID)
+	require.Empty(t, saved.Description)
+	require.Equal(t, "Latas", saved.Name)
+	require.True(t, saved.Active)
+}
+
+func TestPatchCategory_NullActive(t *testing.T) {
+	r, w, h := setupCategoryTestEnv(&testmocks.MockCategoryDataSource{})
+
+	r.PATCH("/categories/:id", h.PatchCategory)
+
+	req := httptest.NewRequest(http.MethodPatch, "/categories/"+testCategoryID, strings.NewReader(`{"active":null}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), `"code":"not_nullable"`)
+}
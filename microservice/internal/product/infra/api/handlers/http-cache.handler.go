@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func versionETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// contentETag é o hash do corpo serializado, usado nas listagens, que não têm
+// uma versão própria
+func contentETag(payload []byte) string {
+	sum := sha256.Sum256(payload)
+	return strconv.Quote(hex.EncodeToString(sum[:16]))
+}
+
+// renderCacheableJSON responde uma listagem com ETag calculado sobre o corpo.
+// Listagens não têm Last-Modified: remover um item não altera o updated_at
+// dos demais, então só o ETag detecta a mudança
+func renderCacheableJSON(ctx *gin.Context, cacheControl string, body any) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderCacheable(ctx, cacheControl, contentETag(payload), time.Time{}, payload)
+}
+
+// renderVersionedJSON responde um registro com a versão como ETag, a mesma
+// aceita no If-Match, e o updated_at como Last-Modified
+func renderVersionedJSON(ctx *gin.Context, cacheControl string, version int64, updatedAt time.Time, body any) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderCacheable(ctx, cacheControl, versionETag(version), updatedAt, payload)
+}
+
+func renderCacheable(ctx *gin.Context, cacheControl, etag string, lastModified time.Time, payload []byte) {
+	ctx.Header("ETag", etag)
+	if !lastModified.IsZero() {
+		ctx.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+	if cacheControl != "" {
+		ctx.Header("Cache-Control", cacheControl)
+	}
+
+	if notModified(ctx.Request, etag, lastModified) {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+
+	ctx.Data(http.StatusOK, "application/json; charset=utf-8", payload)
+}
+
+// notModified avalia as condições na ordem do RFC 9110: com If-None-Match o
+// If-Modified-Since é ignorado. O If-None-Match usa comparação fraca, então
+// W/"x" casa com "x"
+func notModified(req *http.Request, etag string, lastModified time.Time) bool {
+	if header := req.Header.Get("If-None-Match"); header != "" {
+		for _, tag := range strings.Split(header, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == "*" || tag == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if lastModified.IsZero() {
+		return false
+	}
+
+	since, err := http.ParseTime(req.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+
+	// O Last-Modified tem precisão de segundos
+	return !lastModified.Truncate(time.Second).After(since)
+}
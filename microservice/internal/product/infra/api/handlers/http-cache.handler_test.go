@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	testmocks "tech_challenge/internal/shared/test"
+)
+
+func TestNotModified(t *testing.T) {
+	updatedAt := time.Date(2025, 1, 15, 13, 45, 30, 500, time.UTC)
+
+	cases := []struct {
+		name            string
+		ifNoneMatch     string
+		ifModifiedSince string
+		lastModified    time.Time
+		expected        bool
+	}{
+		{"no conditions", "", "", updatedAt, false},
+		{"same etag", `"4"`, "", updatedAt, true},
+		{"weak etag", `W/"4"`, "", updatedAt, true},
+		{"one of many", `"3", "4"`, "", updatedAt, true},
+		{"any", "*", "", updatedAt, true},
+		{"other etag", `"3"`, "", updatedAt, false},
+		{"not modified since", "", "Wed, 15 Jan 2025 13:45:30 GMT", updatedAt, true},
+		{"modified since", "", "Wed, 15 Jan 2025 13:45:29 GMT", updatedAt, false},
+		{"invalid date", "", "yesterday", updatedAt, false},
+		{"without last modified", "", "Wed, 15 Jan 2025 13:45:30 GMT", time.Time{}, false},
+		{"if-none-match wins", `"3"`, "Wed, 15 Jan 2025 13:45:30 GMT", updatedAt, false},
+	}
+
+	for _, c := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if c.ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", c.ifNoneMatch)
+		}
+		if c.ifModifiedSince != "" {
+			req.Header.Set("If-Modified-Since", c.ifModifiedSince)
+		}
+
+		require.Equal(t, c.expected, notModified(req, `"4"`, c.lastModified), c.name)
+	}
+}
+
+func TestFindAllProducts_ConditionalGet(t *testing.T) {
+	price := 10.0
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(&testmocks.MockProductDataSource{
+		FindAllFunc: func() ([]daos.ProductDAO, error) {
+			return []daos.ProductDAO{{ID: testProductID, Name: "prod", Description: "desc", Price: price, Active: true, CategoryID: testCategoryID, Version: 1}}, nil
+		},
+	})
+	r, _, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+	h.cacheControl = "public, max-age=30"
+	r.GET("/products", h.FindAllProducts)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "public, max-age=30", w.Header().Get("Cache-Control"))
+	require.Empty(t, w.Header().Get("Last-Modified"))
+	etag := w.Header().Get("ETag")
+	require.Regexp(t, `^"[0-9a-f]{32}"$`, etag)
+
+	req := httptest.NewRequest(http.MethodGet, "/products", nil)
+	req.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotModified, w.Code)
+	require.Empty(t, w.Body.String())
+	require.Equal(t, etag, w.Header().Get("ETag"))
+	require.Equal(t, "public, max-age=30", w.Header().Get("Cache-Control"))
+
+	price = 12.0
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotEqual(t, etag, w.Header().Get("ETag"))
+	require.Contains(t, w.Body.String(), `"price":12`)
+}
+
+func TestFindProductByID_ConditionalGet(t *testing.T) {
+	updatedAt := time.Date(2025, 1, 15, 13, 45, 30, 0, time.UTC)
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(&testmocks.MockProductDataSource{
+		FindByIDFunc: func(id string) (daos.ProductDAO, error) {
+			return daos.ProductDAO{ID: id, Name: "prod", Description: "desc", Price: 1.0, Active: true, CategoryID: testCategoryID, Version: 4, UpdatedAt: updatedAt}, nil
+		},
+	})
+	r, _, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+	r.GET("/products/:id", h.FindProductByID)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `"4"`, w.Header().Get("ETag"))
+	require.Equal(t, "Wed, 15 Jan 2025 13:45:30 GMT", w.Header().Get("Last-Modified"))
+	require.Contains(t, w.Body.String(), `"updated_at":"2025-01-15T13:45:30Z"`)
+
+	req := httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil)
+	req.Header.Set("If-None-Match", `"4"`)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotModified, w.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil)
+	req.Header.Set("If-Modified-Since", "Wed, 15 Jan 2025 13:45:30 GMT")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotModified, w.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil)
+	req.Header.Set("If-Modified-Since", "Tue, 14 Jan 2025 10:00:00 GMT")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestFindAllImagesProductById_ConditionalGet(t *testing.T) {
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(&testmocks.MockProductDataSource{
+		FindAllImagesProductByIdFunc: func(productID string) ([]daos.ProductImageDAO, error) {
+			return []daos.ProductImageDAO{{ID: "img", ProductID: productID, FileName: "img.png", Url: "http://bucket/img.png", IsDefault: true}}, nil
+		},
+	})
+	r, _, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+	r.GET("/products/:id/images", h.FindAllImagesProductById)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/"+testProductID+"/images", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	req := httptest.NewRequest(http.MethodGet, "/products/"+testProductID+"/images", nil)
+	req.Header.Set("If-None-Match", "W/"+etag)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotModified, w.Code)
+}
+
+func TestCategoryReads_ConditionalGet(t *testing.T) {
+	updatedAt := time.Date(2025, 1, 15, 13, 45, 30, 0, time.UTC)
+	category := daos.CategoryDAO{ID: testCategoryID, Name: "Bebidas", Active: true, Version: 2, UpdatedAt: updatedAt}
+	r, _, h := setupCategoryTestEnv(&testmocks.MockCategoryDataSource{
+		FindAllFunc: func() ([]daos.CategoryDAO, error) {
+			return []daos.CategoryDAO{category}, nil
+		},
+		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
+			return category, nil
+		},
+	})
+	h.cacheControl = "public, no-cache"
+	r.GET("/categories", h.FindAllCategories)
+	r.GET("/categories/tree", h.FindCategoryTree)
+	r.GET("/categories/:id", h.FindCategoryByID)
+
+	for _, path := range []string{"/categories", "/categories/tree", "/categories/" + testCategoryID} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, w.Code, path)
+		require.Equal(t, "public, no-cache", w.Header().Get("Cache-Control"), path)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("If-None-Match", w.Header().Get("ETag"))
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		require.Equal(t, http.StatusNotModified, w.Code, path)
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/categories/"+testCategoryID, nil))
+	require.Equal(t, `"2"`, w.Header().Get("ETag"))
+	require.Equal(t, "Wed, 15 Jan 2025 13:45:30 GMT", w.Header().Get("Last-Modified"))
+}
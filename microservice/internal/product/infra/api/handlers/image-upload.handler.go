@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"io"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/product/infra/api/schemas"
+	"tech_challenge/internal/shared/infra/api/problems"
+	"tech_challenge/internal/shared/utils"
+)
+
+// readUploadedImage lê o campo image do formulário multipart, usado tanto no
+// upload de imagens de produto quanto no ícone da categoria
+func readUploadedImage(ctx *gin.Context) (string, []byte, error) {
+	var fileUploaded schemas.UploadImageRequestSchema
+
+	if err := ctx.ShouldBind(&fileUploaded); err != nil {
+		return "", nil, problems.NewBindingError(err)
+	}
+
+	if !utils.FileIsImage(*fileUploaded.Image) {
+		return "", nil, problems.InvalidParameterError("image", problems.Text{
+			EN:   "invalid file type, only images are allowed",
+			PTBR: "tipo de arquivo inválido, apenas imagens são permitidas",
+		})
+	}
+
+	file, err := fileUploaded.Image.Open()
+	if err != nil {
+		return "", nil, problems.MalformedRequestError(problems.Text{
+			EN:   "failed to open the uploaded file",
+			PTBR: "não foi possível abrir o arquivo enviado",
+		})
+	}
+	defer file.Close()
+
+	fileContent, err := io.ReadAll(file)
+	if err != nil {
+		return "", nil, problems.MalformedRequestError(problems.Text{
+			EN:   "failed to read the uploaded file",
+			PTBR: "não foi possível ler o arquivo enviado",
+		})
+	}
+
+	return fileUploaded.Image.Filename, fileContent, nil
+}
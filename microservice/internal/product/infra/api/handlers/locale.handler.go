@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+
+	"tech_challenge/internal/product/application/controllers"
+)
+
+// contentLocales começa pelo idioma padrão, que o matcher usa quando o
+// Accept-Language não casa com nenhum idioma traduzido
+var (
+	contentLocales = controllers.ContentLocales()
+	contentMatcher = language.NewMatcher(contentLanguageTags(contentLocales))
+)
+
+func contentLanguageTags(locales []string) []language.Tag {
+	tags := make([]language.Tag, 0, len(locales))
+	for _, locale := range locales {
+		tags = append(tags, language.MustParse(locale))
+	}
+	return tags
+}
+
+// negotiateLocale escolhe o idioma dos textos do catálogo pelo Accept-Language
+// e o informa no Content-Language. O Vary impede que caches intermediários
+// sirvam a resposta de um idioma a clientes de outro
+func negotiateLocale(ctx *gin.Context) string {
+	ctx.Writer.Header().Add("Vary", "Accept-Language")
+
+	locale := contentLocales[0]
+	if tags, _, err := language.ParseAcceptLanguage(ctx.GetHeader("Accept-Language")); err == nil && len(tags) > 0 {
+		if _, index, confidence := contentMatcher.Match(tags...); confidence != language.No {
+			locale = contentLocales[index]
+		}
+	}
+
+	ctx.Header("Content-Language", locale)
+	return locale
+}
+
+// translatedLocale indica se o idioma negociado não é o padrão. A versão do
+// registro é a mesma em todos os idiomas, então respostas traduzidas não podem
+// usá-la como ETag: um cliente que troca de idioma receberia um 304 errado
+func translatedLocale(locale string) bool {
+	return locale != contentLocales[0]
+}
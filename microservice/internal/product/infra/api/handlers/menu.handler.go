@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/factories"
+	"tech_challenge/internal/product/infra/api/schemas"
+	"tech_challenge/internal/shared/config/env"
+	shared_factories "tech_challenge/internal/shared/factories"
+	"tech_challenge/internal/shared/infra/api/problems"
+)
+
+type MenuHandler struct {
+	catalogController controllers.CatalogController
+	cacheControl      string
+}
+
+func NewMenuHandler() *MenuHandler {
+	catalogController := controllers.NewCatalogController(
+		factories.NewProductDataSource(),
+		factories.NewCategoryDataSource(),
+		factories.NewStockDataSource(),
+		factories.NewTranslationDataSource(),
+		factories.NewStoreDataSource(),
+		factories.NewPromotionDataSource(),
+		factories.NewTransactionManager(),
+		shared_factories.NewFileProvider(),
+		factories.NewCatalogCache(),
+	)
+
+	return &MenuHandler{
+		catalogController: *catalogController,
+		cacheControl:      env.GetConfig().APICacheControl,
+	}
+}
+
+// @Summary Kiosk menu
+// @Description Categories in display order with their subcategories and products nested, each product with its default image. Only active items within their availability schedules are listed. Compact mode omits descriptions.
+// @Tags Menu
+// @Produce json
+// @Param compact query bool false "Omit category and product descriptions" default(false)
+// @Param at query string false "Evaluate availability at this instant instead of now (RFC 3339)" format(date-time)
+// @Param store_id query string false "Apply the overrides of this store" format(uuid)
+// @Param X-Store-ID header string false "Store ID, used when store_id is not in the query" format(uuid)
+// @Param If-None-Match header string false "ETag of the cached menu"
+// @Success 200 {object} schemas.MenuResponseSchema
+// @Header 200 {string} ETag "Hash of the menu"
+// @Header 200 {string} Cache-Control "Cache policy"
+// @Success 304 {object} nil
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /menu [get]
+func (h *MenuHandler) FindMenu(ctx *gin.Context) {
+	compact, err := strconv.ParseBool(ctx.DefaultQuery("compact", "false"))
+	if err != nil {
+		_ = ctx.Error(problems.InvalidBooleanError("compact"))
+		return
+	}
+
+	at, ok := bindAt(ctx)
+	if !ok {
+		return
+	}
+
+	storeID, ok := bindStoreID(ctx)
+	if !ok {
+		return
+	}
+
+	menu, err := h.catalogController.FindMenu(at, negotiateLocale(ctx), storeID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderCacheableJSON(ctx, h.cacheControl, schemas.ToMenuResponseSchema(menu, compact))
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	testmocks "tech_challenge/internal/shared/test"
+)
+
+func menuDataSources() (*testmocks.MockProductDataSource, *testmocks.MockCategoryDataSource) {
+	categoryDs := &testmocks.MockCategoryDataSource{
+		FindAllFunc: func() ([]daos.CategoryDAO, error) {
+			return []daos.CategoryDAO{
+				{ID: testOtherCategoryID, Name: "Bebidas", Description: "Geladas", Position: 2, Active: true},
+				{ID: testCategoryID, Name: "Lanches", Description: "Na chapa", Position: 1, Active: true},
+				{ID: "cat-inativa", Name: "Sobremesas", Position: 3, Active: false},
+			}, nil
+		},
+	}
+	productDs := &testmocks.MockProductDataSource{
+		FindAllFunc: func() ([]daos.ProductDAO, error) {
+			panic("menu must not list every product")
+		},
+		FindAllActiveFunc: func() ([]daos.ProductDAO, error) {
+			return []daos.ProductDAO{
+				{ID: testProductID, CategoryID: testCategoryID, Name: "X-Salada", Description: "Lanche com carne", Price: 20.5, Active: true,
+					Images: []daos.ProductImageDAO{{ID: "img", FileName: "x-salada.png", Url: "http://bucket/x-salada.png", IsDefault: true}}},
+				{ID: "p-pudim", CategoryID: "cat-inativa", Name: "Pudim", Description: "Doce", Price: 8, Active: true},
+			}, nil
+		},
+	}
+	return productDs, categoryDs
+}
+
+func TestFindMenu_Success(t *testing.T) {
+	h := setupMenuHandlerWithFakeGateway(menuDataSources())
+	r := newTestRouter()
+	r.GET("/menu", h.FindMenu)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/menu", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotEmpty(t, w.Header().Get("ETag"))
+	require.JSONEq(t, `{"categories":[
+		{"id":"`+testCategoryID+`","name":"Lanches","description":"Na chapa","subcategories":[],"products":[
+			{"id":"`+testProductID+`","name":"X-Salada","description":"Lanche com carne","price":20.5,"original_price":20.5,"effective_price":20.5,"image":{"file_name":"x-salada.png","url":"http://bucket/x-salada.png"},"sold_out":false}
+		]},
+		{"id":"`+testOtherCategoryID+`","name":"Bebidas","description":"Geladas","subcategories":[],"products":[]}
+	]}`, w.Body.String())
+}
+
+func TestFindMenu_Compact(t *testing.T) {
+	h := setupMenuHandlerWithFakeGateway(menuDataSources())
+	r := newTestRouter()
+	r.GET("/menu", h.FindMenu)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/menu?compact=true", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotContains(t, w.Body.String(), "description")
+	require.Contains(t, w.Body.String(), `"name":"X-Salada"`)
+}
+
+func TestFindMenu_InvalidCompact(t *testing.T) {
+	h := setupMenuHandlerWithFakeGateway(menuDataSources())
+	r := newTestRouter()
+	r.GET("/menu", h.FindMenu)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/menu?compact=talvez", nil))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "compact")
+}
+
+func TestFindMenu_Error(t *testing.T) {
+	productDs, categoryDs := menuDataSources()
+	productDs.FindAllActiveFunc = func() ([]daos.ProductDAO, error) { return nil, errors.New("db down") }
+	h := setupMenuHandlerWithFakeGateway(productDs, categoryDs)
+	r := newTestRouter()
+	r.GET("/menu", h.FindMenu)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/menu", nil))
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/shared/infra/api/problems"
+)
+
+// O ETag de produtos e categorias é a versão do registro, que avança a cada
+// gravação
+func setETag(ctx *gin.Context, version int64) {
+	ctx.Header("ETag", versionETag(version))
+}
+
+// bindPrecondition lê o If-Match das alterações. A comparação é forte
+// (RFC 9110): ETags fracos ou que não são versões nunca casam, e a alteração
+// termina em 412. Sem o header, retorna 428 quando required.
+func bindPrecondition(ctx *gin.Context, required bool) (dtos.Precondition, bool) {
+	header := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if header == "" {
+		if required {
+			_ = ctx.Error(problems.IfMatchRequiredError())
+			return dtos.Precondition{}, false
+		}
+		return dtos.Precondition{}, true
+	}
+
+	precondition := dtos.Precondition{IfMatch: true}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+
+		if tag == "*" {
+			precondition.Any = true
+			continue
+		}
+
+		value, err := strconv.Unquote(tag)
+		if err != nil || !strings.HasPrefix(tag, `"`) {
+			continue
+		}
+
+		if version, err := strconv.ParseInt(value, 10, 64); err == nil {
+			precondition.Versions = append(precondition.Versions, version)
+		}
+	}
+
+	return precondition, true
+}
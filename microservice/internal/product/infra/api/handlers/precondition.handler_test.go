@@ -0,0 +1,299 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/infra/api/http_errors"
+	"tech_challenge/internal/shared/config/env"
+	"tech_challenge/internal/shared/infra/api/problems"
+	testmocks "tech_challenge/internal/shared/test"
+)
+
+func TestBindPrecondition_ParsesIfMatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cases := []struct {
+		header   string
+		version  int64
+		expected bool
+	}{
+		{"", 7, true},
+		{"*", 7, true},
+		{`"7"`, 7, true},
+		{`"3", "7"`, 7, true},
+		{`"6"`, 7, false},
+		{`W/"7"`, 7, false},
+		{`"abc"`, 7, false},
+		{`7`, 7, false},
+	}
+
+	for _, c := range cases {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodPut, "/", nil)
+		if c.header != "" {
+			ctx.Request.Header.Set("If-Match", c.header)
+		}
+
+		precondition, ok := bindPrecondition(ctx, false)
+		require.True(t, ok, c.header)
+		require.Equal(t, c.expected, precondition.Matches(c.version), c.header)
+	}
+}
+
+func versionedProductDataSource(version int64, saved *daos.ProductDAO) *testmocks.MockProductDataSource {
+	return &testmocks.MockProductDataSource{
+		FindByIDFunc: func(id string) (daos.ProductDAO, error) {
+			return daos.ProductDAO{ID: id, Name: "prod", Description: "desc", Price: 1.0, Active: true, CategoryID: testCategoryID, Version: version}, nil
+		},
+		UpdateFunc: func(dao daos.ProductDAO) error {
+			if saved != nil {
+				*saved = dao
+			}
+			return nil
+		},
+	}
+}
+
+func TestFindProductByID_ReturnsETag(t *testing.T) {
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(versionedProductDataSource(4, nil))
+	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+
+	r.GET("/products/:id", h.FindProductByID)
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `"4"`, w.Header().Get("ETag"))
+	require.Contains(t, w.Body.String(), `"version":4`)
+}
+
+// firstHalfOfDay libera o item das 00:00 às 12:00 de todos os dias
+var firstHalfOfDay = &daos.AvailabilityDAO{Weekly: []daos.WeeklyAvailabilityDAO{{
+	Weekdays:     []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"},
+	TimeRangeDAO: daos.TimeRangeDAO{From: "00:00", To: "12:00"},
+}}}
+
+// inTimeZone recria a configuração no fuso informado. O mesmo instante em
+// fusos 12 horas distantes cai em metades opostas do dia, como uma nova
+// requisição feita depois da virada do turno
+func inTimeZone(t *testing.T, timeZone string) {
+	t.Setenv("APP_TIME_ZONE", timeZone)
+	env.ResetConfig()
+	t.Cleanup(env.ResetConfig)
+}
+
+func TestFindProductByID_ScheduledAcrossDayparts(t *testing.T) {
+	productDs := &testmocks.MockProductDataSource{
+		FindByIDFunc: func(id string) (daos.ProductDAO, error) {
+			return daos.ProductDAO{ID: id, Name: "prod", Description: "desc", Price: 1.0, Active: true, CategoryID: testCategoryID, Availability: firstHalfOfDay, Version: 4}, nil
+		},
+	}
+	find := func(timeZone string, header http.Header) *httptest.ResponseRecorder {
+		inTimeZone(t, timeZone)
+		r, w, h := setupProductTestEnv(makeDefaultMocks(productDs))
+		r.GET("/products/:id", h.FindProductByID)
+		req := httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil)
+		req.Header = header
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	before := find("Etc/GMT", http.Header{})
+	require.Equal(t, http.StatusOK, before.Code)
+	require.NotEqual(t, `"4"`, before.Header().Get("ETag"))
+	require.Empty(t, before.Header().Get("Last-Modified"))
+
+	after := find("Etc/GMT-12", http.Header{
+		"If-None-Match":     {before.Header().Get("ETag")},
+		"If-Modified-Since": {time.Now().UTC().Format(http.TimeFormat)},
+	})
+	require.Equal(t, http.StatusOK, after.Code)
+	require.NotEqual(t, strings.Contains(before.Body.String(), `"available_now":true`), strings.Contains(after.Body.String(), `"available_now":true`))
+}
+
+func TestFindCategoryByID_ScheduledAcrossDayparts(t *testing.T) {
+	categoryDs := &testmocks.MockCategoryDataSource{
+		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
+			return daos.CategoryDAO{ID: id, Name: "Café da manhã", Active: true, Availability: firstHalfOfDay, Version: 2}, nil
+		},
+	}
+	find := func(timeZone string, header http.Header) *httptest.ResponseRecorder {
+		inTimeZone(t, timeZone)
+		r, w, h := setupCategoryTestEnv(categoryDs)
+		r.GET("/categories/:id", h.FindCategoryByID)
+		req := httptest.NewRequest(http.MethodGet, "/categories/"+testCategoryID, nil)
+		req.Header = header
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	before := find("Etc/GMT", http.Header{})
+	require.Equal(t, http.StatusOK, before.Code)
+	require.NotEqual(t, `"2"`, before.Header().Get("ETag"))
+	require.Empty(t, before.Header().Get("Last-Modified"))
+
+	after := find("Etc/GMT-12", http.Header{"If-None-Match": {before.Header().Get("ETag")}})
+	require.Equal(t, http.StatusOK, after.Code)
+	require.NotEqual(t, before.Header().Get("ETag"), after.Header().Get("ETag"))
+}
+
+func TestFindProductByID_SoldOutChangesETag(t *testing.T) {
+	soldOut := false
+	stockDs := &testmocks.MockStockDataSource{
+		FindByProductIDFunc: func(productID string) (daos.ProductStockDAO, error) {
+			return daos.ProductStockDAO{ProductID: productID, Tracked: true, Quantity: 1, SoldOut: soldOut}, nil
+		},
+	}
+	find := func(header http.Header) *httptest.ResponseRecorder {
+		gin.SetMode(gin.TestMode)
+		productDs, categoryDs, _ := makeDefaultMocks(versionedProductDataSource(4, nil))
+		transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs}
+		ctrl := controllers.NewProductController(productDs, categoryDs, stockDs, &testmocks.MockTranslationDataSource{}, &testmocks.MockStoreDataSource{}, &testmocks.MockPromotionDataSource{}, transactionManager, nil, nil)
+		h := &ProductHandler{productController: *ctrl}
+		r, w := newTestRouter(), httptest.NewRecorder()
+		r.GET("/products/:id", h.FindProductByID)
+		req := httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil)
+		req.Header = header
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	available := find(http.Header{})
+	require.Equal(t, `"4"`, available.Header().Get("ETag"))
+	require.Contains(t, available.Body.String(), `"sold_out":false`)
+
+	// Esgotar não muda a versão do produto, mas muda a resposta
+	soldOut = true
+	after := find(http.Header{
+		"If-None-Match":     {`"4"`},
+		"If-Modified-Since": {available.Header().Get("Last-Modified")},
+	})
+	require.Equal(t, http.StatusOK, after.Code)
+	require.NotEqual(t, `"4"`, after.Header().Get("ETag"))
+	require.Contains(t, after.Body.String(), `"available_now":false`)
+	require.Contains(t, after.Body.String(), `"sold_out":true`)
+}
+
+func TestUpdateProduct_IfMatch(t *testing.T) {
+	body := `{"category_id":"` + testCategoryID + `","name":"prod","description":"nova","price":1.0,"active":true}`
+
+	t.Run("current version", func(t *testing.T) {
+		var saved daos.ProductDAO
+		mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(versionedProductDataSource(4, &saved))
+		r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+		r.PUT("/products/:id", h.UpdateProduct)
+
+		req := httptest.NewRequest(http.MethodPut, "/products/"+testProductID, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"4"`)
+		r.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, int64(4), saved.Version)
+		require.Equal(t, `"5"`, w.Header().Get("ETag"))
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(versionedProductDataSource(5, nil))
+		mockProductDs.UpdateFunc = func(dao daos.ProductDAO) error {
+			t.Fatal("a stale If-Match must not be saved")
+			return nil
+		}
+		r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+		r.PUT("/products/:id", h.UpdateProduct)
+
+		req := httptest.NewRequest(http.MethodPut, "/products/"+testProductID, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"4"`)
+		r.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusPreconditionFailed, w.Code)
+		require.Equal(t, http_errors.CodePreconditionFailed, decodeProblem(t, w).Code)
+	})
+
+	t.Run("missing when required", func(t *testing.T) {
+		mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(versionedProductDataSource(4, nil))
+		r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+		h.requireIfMatch = true
+		r.PUT("/products/:id", h.UpdateProduct)
+
+		req := httptest.NewRequest(http.MethodPut, "/products/"+testProductID, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusPreconditionRequired, w.Code)
+		require.Equal(t, problems.CodeIfMatchRequired, decodeProblem(t, w).Code)
+	})
+}
+
+func TestPatchProduct_StaleIfMatch(t *testing.T) {
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(versionedProductDataSource(2, nil))
+	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+	r.PATCH("/products/:id", h.PatchProduct)
+
+	req := httptest.NewRequest(http.MethodPatch, "/products/"+testProductID, strings.NewReader(`{"price":2}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	req.Header.Set("If-Match", `W/"2"`)
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusPreconditionFailed, w.Code)
+}
+
+func TestDeleteProduct_RequiresIfMatch(t *testing.T) {
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(versionedProductDataSource(2, nil))
+	mockProductDs.DeleteFunc = func(id string, version int64) error {
+		t.Fatal("delete without If-Match must not reach the data source")
+		return nil
+	}
+	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+	h.requireIfMatch = true
+	r.DELETE("/products/:id", h.DeleteProduct)
+
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/products/"+testProductID, nil))
+
+	require.Equal(t, http.StatusPreconditionRequired, w.Code)
+}
+
+func TestUpdateCategory_IfMatch(t *testing.T) {
+	var saved daos.CategoryDAO
+	mockCategoryDs := &testmocks.MockCategoryDataSource{
+		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
+			return daos.CategoryDAO{ID: id, Name: "Bebidas", Active: true, Version: 9}, nil
+		},
+		UpdateFunc: func(dao daos.CategoryDAO) error {
+			saved = dao
+			return nil
+		},
+	}
+	r, _, h := setupCategoryTestEnv(mockCategoryDs)
+	r.PUT("/categories/:id", h.UpdateCategory)
+
+	for _, c := range []struct {
+		ifMatch string
+		status  int
+	}{
+		{`"8"`, http.StatusPreconditionFailed},
+		{`"9"`, http.StatusOK},
+		{`*`, http.StatusOK},
+	} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPut, "/categories/"+testCategoryID, strings.NewReader(`{"name":"Bebidas","active":true}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", c.ifMatch)
+		r.ServeHTTP(w, req)
+
+		require.Equal(t, c.status, w.Code, c.ifMatch)
+		if c.status == http.StatusOK {
+			require.Equal(t, int64(9), saved.Version)
+			require.Equal(t, `"10"`, w.Header().Get("ETag"))
+		}
+	}
+}
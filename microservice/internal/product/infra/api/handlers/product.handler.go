@@ -1,33 +1,47 @@
 package handlers
 
 import (
-	"io"
 	"net/http"
-	"strings"
+	"strconv"
+	"time"
+
 	"tech_challenge/internal/product/application/controllers"
 	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/factories"
 	"tech_challenge/internal/product/infra/api/schemas"
-	"tech_challenge/internal/product/infra/database/data_sources"
+	"tech_challenge/internal/shared/config/env"
 	shared_factories "tech_challenge/internal/shared/factories"
-	"tech_challenge/internal/shared/infra/database"
-	"tech_challenge/internal/shared/utils"
+	"tech_challenge/internal/shared/infra/api/problems"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
 
 	"github.com/gin-gonic/gin"
 )
 
 type ProductHandler struct {
 	productController controllers.ProductController
+	requireIfMatch    bool
+	cacheControl      string
+	batchMaxIDs       int
 }
 
 func NewProductHandler() *ProductHandler {
-	productDataSource := data_sources.NewProductDataSource(database.GetDB())
-	categoryDataSource := data_sources.NewGormCategoryDataSource(database.GetDB())
-	fileProvider := shared_factories.NewFileProvider()
-
-	productController := controllers.NewProductController(productDataSource, categoryDataSource, fileProvider)
+	productController := controllers.NewProductController(
+		factories.NewProductDataSource(),
+		factories.NewCategoryDataSource(),
+		factories.NewStockDataSource(),
+		factories.NewTranslationDataSource(),
+		factories.NewStoreDataSource(),
+		factories.NewPromotionDataSource(),
+		factories.NewTransactionManager(),
+		shared_factories.NewFileProvider(),
+		factories.NewCatalogCache(),
+	)
 
 	return &ProductHandler{
 		productController: *productController,
+		requireIfMatch:    env.GetConfig().APIRequireIfMatch,
+		cacheControl:      env.GetConfig().APICacheControl,
+		batchMaxIDs:       env.GetConfig().APIBatchMaxIDs,
 	}
 }
 
@@ -37,14 +51,15 @@ func NewProductHandler() *ProductHandler {
 // @Produce json
 // @Param product body schemas.CreateProductSchema true "Product to create"
 // @Success 201 {object} schemas.ProductResponseSchema
-// @Failure 400 {object} schemas.InvalidProductDataErrorSchema
-// @Failure 500 {object} schemas.ErrorMessageSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 409 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
 // @Router /products/ [post]
 func (h *ProductHandler) CreateProduct(ctx *gin.Context) {
 	var productRequestBody schemas.CreateProductSchema
 
-	if err := ctx.ShouldBindJSON(&productRequestBody); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if !bindJSON(ctx, &productRequestBody) {
 		return
 	}
 
@@ -53,55 +68,265 @@ func (h *ProductHandler) CreateProduct(ctx *gin.Context) {
 		_ = ctx.Error(err)
 		return
 	}
+	setETag(ctx, productCreated.Version)
 	ctx.JSON(http.StatusCreated, schemas.ToProductResponseSchema(productCreated))
 }
 
 // @Summary List all products
+// @Description With ids, returns the batch read response of POST /products/batch-get (products and missing_ids) instead of the list; ids cannot be combined with the filters.
 // @Tags Products
 // @Produce json
-// @Param category_id query string false "Filter by category ID"
+// @Param ids query string false "Comma separated product IDs to read in a single batch (up to API_BATCH_MAX_IDS)"
+// @Param category_id query string false "Filter by category ID" format(uuid)
+// @Param exclude_allergens query string false "Comma separated allergens to exclude (gluten, lactose, milk, eggs, fish, crustaceans, peanuts, tree_nuts, soy, latex). Products without declared allergens are also excluded"
+// @Param at query string false "Evaluate available_now at this instant instead of now (RFC 3339)" format(date-time)
+// @Param store_id query string false "Apply the overrides of this store" format(uuid)
+// @Param X-Store-ID header string false "Store ID, used when store_id is not in the query" format(uuid)
+// @Param If-None-Match header string false "ETag of the cached list"
 // @Success 200 {array} schemas.ProductResponseSchema
-// @Failure 500 {object} schemas.ErrorMessageSchema
+// @Header 200 {string} ETag "Hash of the list"
+// @Header 200 {string} Cache-Control "Cache policy"
+// @Success 304 {object} nil
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
 // @Router /products/ [get]
 func (h *ProductHandler) FindAllProducts(ctx *gin.Context) {
-	categoryIdQuery := ctx.Query("category_id")
+	var query schemas.ListProductsQuerySchema
+
+	if !bindQuery(ctx, &query) {
+		return
+	}
+
+	at, ok := bindAt(ctx)
+	if !ok {
+		return
+	}
+
+	storeID, ok := bindStoreID(ctx)
+	if !ok {
+		return
+	}
+
+	if query.IDs != "" {
+		h.findProductsByIDs(ctx, query, at, storeID)
+		return
+	}
+
+	products, err := h.productController.FindAll(query.ToDTO(), at, negotiateLocale(ctx), storeID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderCacheableJSON(ctx, h.cacheControl, schemas.ListProductsResponseSchema(products))
+}
+
+func (h *ProductHandler) findProductsByIDs(ctx *gin.Context, query schemas.ListProductsQuerySchema, at *time.Time, storeID string) {
+	if query.CategoryID != "" || query.ExcludeAllergens != "" {
+		_ = ctx.Error(problems.InvalidParameterError("ids", problems.Text{
+			EN:   "ids cannot be combined with category_id or exclude_allergens",
+			PTBR: "ids não pode ser combinado com category_id ou exclude_allergens",
+		}))
+		return
+	}
+
+	ids := query.BatchIDs()
+
+	if len(ids) > h.batchMaxIDs {
+		_ = ctx.Error(problems.TooManyValuesError("ids", h.batchMaxIDs))
+		return
+	}
+
+	for _, id := range ids {
+		if identity_manager.IsNotValidUUID(id) {
+			_ = ctx.Error(problems.InvalidUUIDError("ids"))
+			return
+		}
+	}
+
+	result, err := h.productController.FindByIDs(ids, at, negotiateLocale(ctx), storeID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderCacheableJSON(ctx, h.cacheControl, schemas.ToProductBatchResponseSchema(result))
+}
+
+// @Summary Read products in batch
+// @Description Reads up to API_BATCH_MAX_IDS products in a single query, in the requested order and without duplicates, and lists the IDs that do not exist. Prices, promotions and available_now follow the product list.
+// @Tags Products
+// @Accept json
+// @Produce json
+// @Param batch body schemas.BatchGetProductsSchema true "Product IDs"
+// @Param store_id query string false "Apply the overrides of this store" format(uuid)
+// @Param X-Store-ID header string false "Store ID, used when store_id is not in the query" format(uuid)
+// @Success 200 {object} schemas.ProductBatchResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /products/batch-get [post]
+func (h *ProductHandler) BatchGetProducts(ctx *gin.Context) {
+	storeID, ok := bindStoreID(ctx)
+	if !ok {
+		return
+	}
+
+	var body schemas.BatchGetProductsSchema
+	if !bindJSON(ctx, &body) {
+		return
+	}
+
+	if len(body.IDs) > h.batchMaxIDs {
+		_ = ctx.Error(problems.TooManyItemsError("ids", h.batchMaxIDs))
+		return
+	}
+
+	result, err := h.productController.FindByIDs(body.IDs, nil, negotiateLocale(ctx), storeID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToProductBatchResponseSchema(result))
+}
+
+// @Summary Bulk update products
+// @Description Applies one operation (activate, deactivate, move_category or adjust_price) to every product matching the filter. With dry_run=true (default) only previews the changes; otherwise applies them in a single transaction.
+// @Tags Products
+// @Accept json
+// @Produce json
+// @Param bulk body schemas.BulkUpdateProductsSchema true "Filter and operation"
+// @Param dry_run query bool false "Only preview the affected products and resulting values" default(true)
+// @Success 200 {object} schemas.BulkUpdateProductsResultSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /products/bulk [post]
+func (h *ProductHandler) BulkUpdateProducts(ctx *gin.Context) {
+	dryRun, err := strconv.ParseBool(ctx.DefaultQuery("dry_run", "true"))
+	if err != nil {
+		_ = ctx.Error(problems.InvalidBooleanError("dry_run"))
+		return
+	}
 
-	var categoryId *string
+	var bulkRequestBody schemas.BulkUpdateProductsSchema
 
-	if categoryIdQuery != "" {
-		categoryId = &categoryIdQuery
-	} else {
-		categoryId = nil
+	if !bindJSON(ctx, &bulkRequestBody) {
+		return
 	}
 
-	products, err := h.productController.FindAll(categoryId)
+	result, err := h.productController.BulkUpdate(bulkRequestBody.ToDTO(dryRun))
 
 	if err != nil {
 		_ = ctx.Error(err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, schemas.ListProductsResponseSchema(products))
+	ctx.JSON(http.StatusOK, schemas.ToBulkUpdateProductsResultSchema(result))
 }
 
 // @Summary Get a product by ID
 // @Tags Products
 // @Produce json
-// @Param id path string true "Product ID"
+// @Param id path string true "Product ID" format(uuid)
+// @Param store_id query string false "Apply the overrides of this store" format(uuid)
+// @Param X-Store-ID header string false "Store ID, used when store_id is not in the query" format(uuid)
+// @Param If-None-Match header string false "ETag of the cached product"
+// @Param If-Modified-Since header string false "Last-Modified of the cached product"
 // @Success 200 {object} schemas.ProductResponseSchema
-// @Failure 400 {object} schemas.InvalidProductDataErrorSchema
-// @Failure 404 {object} schemas.ProductNotFoundErrorSchema
+// @Header 200 {string} ETag "Product version, or a hash of the body for translated and store reads, promoted prices, scheduled availability and sold out products"
+// @Header 200 {string} Last-Modified "Product updated_at, only when the ETag is the version"
+// @Header 200 {string} Cache-Control "Cache policy"
+// @Success 304 {object} nil
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
 // @Router /products/{id} [get]
 func (h *ProductHandler) FindProductByID(ctx *gin.Context) {
-	productId := ctx.Param("id")
+	productId, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	storeID, ok := bindStoreID(ctx)
+	if !ok {
+		return
+	}
+
+	locale := negotiateLocale(ctx)
+	product, err := h.productController.FindByID(productId, locale, storeID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	// Traduções, overrides de loja, promoções, grades (available_now muda com o
+	// horário) e o esgotamento não alteram a versão do produto, então o ETag
+	// passa a ser o hash do corpo
+	if translatedLocale(locale) || storeID != "" || len(product.AppliedPromotions) > 0 || product.Scheduled || product.SoldOut {
+		renderCacheableJSON(ctx, h.cacheControl, schemas.ToProductResponseSchema(product))
+		return
+	}
+
+	renderVersionedJSON(ctx, h.cacheControl, product.Version, product.UpdatedAt, schemas.ToProductResponseSchema(product))
+}
 
-	product, err := h.productController.FindByID(productId)
+// @Summary Partially update a product by ID
+// @Description JSON Merge Patch (RFC 7396) applied to the top-level fields: only the fields sent are changed; the others keep their current values. description null clears it, availability, nutrition and allergens null remove them; category_id, name, price and active cannot be null. availability and nutrition are replaced as a whole, not merged field by field, so send the complete object.
+// @Tags Products
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path string true "Product ID" format(uuid)
+// @Param If-Match header string false "ETag of the version being changed"
+// @Param product body schemas.PatchProductSchema true "Fields to change"
+// @Success 200 {object} schemas.ProductResponseSchema
+// @Header 200 {string} ETag "Product version"
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 409 {object} schemas.ProblemSchema
+// @Failure 412 {object} schemas.ProblemSchema
+// @Failure 415 {object} schemas.ProblemSchema
+// @Failure 428 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /products/{id} [patch]
+func (h *ProductHandler) PatchProduct(ctx *gin.Context) {
+	productId, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	precondition, ok := bindPrecondition(ctx, h.requireIfMatch)
+	if !ok {
+		return
+	}
+
+	var patchRequestBody schemas.PatchProductSchema
+
+	if !bindMergePatch(ctx, &patchRequestBody) {
+		return
+	}
+
+	patchDTO := patchRequestBody.ToDTO(productId)
+	patchDTO.Precondition = precondition
+
+	product, err := h.productController.Patch(patchDTO)
 
 	if err != nil {
 		_ = ctx.Error(err)
 		return
 	}
 
+	setETag(ctx, product.Version)
 	ctx.JSON(http.StatusOK, schemas.ToProductResponseSchema(product))
 }
 
@@ -109,33 +334,47 @@ func (h *ProductHandler) FindProductByID(ctx *gin.Context) {
 // @Tags Products
 // @Accept json
 // @Produce json
-// @Param id path string true "Product ID"
+// @Param id path string true "Product ID" format(uuid)
+// @Param If-Match header string false "ETag of the version being changed"
 // @Param product body schemas.UpdateProductRequestBodySchema true "Updated product data"
 // @Success 200 {object} schemas.ProductResponseSchema
-// @Failure 400 {object} schemas.InvalidProductDataErrorSchema
-// @Failure 404 {object} schemas.ProductNotFoundErrorSchema
-// @Failure 500 {object} schemas.ErrorMessageSchema
+// @Header 200 {string} ETag "Product version"
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 409 {object} schemas.ProblemSchema
+// @Failure 412 {object} schemas.ProblemSchema
+// @Failure 428 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
 // @Router /products/{id} [put]
 func (h *ProductHandler) UpdateProduct(ctx *gin.Context) {
-	productId := ctx.Param("id")
+	productId, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	precondition, ok := bindPrecondition(ctx, h.requireIfMatch)
+	if !ok {
+		return
+	}
 
 	var productBodyRequest schemas.UpdateProductRequestBodySchema
 
-	if err := ctx.ShouldBindJSON(&productBodyRequest); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Invalid request body",
-			"details": err.Error(),
-		})
+	if !bindJSON(ctx, &productBodyRequest) {
 		return
 	}
 
-	product, err := h.productController.Update(productBodyRequest.ToDTO(productId))
+	updateDTO := productBodyRequest.ToDTO(productId)
+	updateDTO.Precondition = precondition
+
+	product, err := h.productController.Update(updateDTO)
 
 	if err != nil {
 		_ = ctx.Error(err)
 		return
 	}
 
+	setETag(ctx, product.Version)
 	ctx.JSON(http.StatusOK, schemas.ToProductResponseSchema(product))
 }
 
@@ -143,50 +382,23 @@ func (h *ProductHandler) UpdateProduct(ctx *gin.Context) {
 // @Tags         Products
 // @Accept       multipart/form-data
 // @Produce      json
-// @Param 		 id path string true "Product ID"
+// @Param 		 id path string true "Product ID" format(uuid)
 // @Param        image formData  file true "Image file"
 // @Success 	 204   {object}  nil
-// @Failure      400   {object}  schemas.InvalidProductDataErrorSchema
-// @Failure      404   {object}  schemas.ProductNotFoundErrorSchema
-// @Failure      500   {object}  schemas.ErrorMessageSchema
+// @Failure      400   {object}  schemas.ProblemSchema
+// @Failure      404   {object}  schemas.ProblemSchema
+// @Failure      500   {object}  schemas.ProblemSchema
+// @Failure      503   {object}  schemas.ProblemSchema
 // @Router       /products/{id}/images [patch]
 func (h *ProductHandler) UploadProductImage(ctx *gin.Context) {
-	productId := ctx.Param("id")
-
-	var fileUploaded schemas.UploadImageRequestSchema
-
-	if err := ctx.ShouldBind(&fileUploaded); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid file upload",
-		})
+	productId, ok := bindID(ctx)
+	if !ok {
 		return
 	}
 
-	file, err := fileUploaded.Image.Open()
-
+	fileName, fileContent, err := readUploadedImage(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": "Failed to open file",
-		})
-		return
-	}
-
-	defer file.Close()
-
-	if !utils.FileIsImage(*fileUploaded.Image) {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid file type. Only images are allowed.",
-		})
-		return
-	}
-
-	fileName := fileUploaded.Image.Filename
-	fileContent, err := io.ReadAll(file)
-
-	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": "Failed to read file content",
-		})
+		_ = ctx.Error(err)
 		return
 	}
 
@@ -197,13 +409,7 @@ func (h *ProductHandler) UploadProductImage(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		// Retorna erro 404 se for bucket inexistente ou inválido
-		if strings.Contains(err.Error(), "NoSuchBucket") || strings.Contains(err.Error(), "InvalidBucketName") {
-			ctx.JSON(404, gin.H{"error": err.Error()})
-			return
-		}
-		// Sempre retorna a mensagem real do erro
-		ctx.JSON(400, gin.H{"error": err.Error()})
+		_ = ctx.Error(err)
 		return
 	}
 
@@ -214,27 +420,26 @@ func (h *ProductHandler) UploadProductImage(ctx *gin.Context) {
 // @Tags         Products
 // @Accept       json
 // @Produce      json
-// @Param 		 id 			 path 	   string 					true "Product ID"
+// @Param 		 id 			 path 	   string 					true "Product ID" format(uuid)
 // @Param        image_file_name path      string                   true  "Nome do arquivo da imagem"
 // @Success 	 204   {object}  nil
-// @Failure      400   {object}  schemas.InvalidProductDataErrorSchema
-// @Failure      404   {object}  schemas.ProductNotFoundErrorSchema
-// @Failure      500   {object}  schemas.ErrorMessageSchema
+// @Failure      400   {object}  schemas.ProblemSchema
+// @Failure      404   {object}  schemas.ProblemSchema
+// @Failure      409   {object}  schemas.ProblemSchema
+// @Failure      500   {object}  schemas.ProblemSchema
+// @Failure      503   {object}  schemas.ProblemSchema
 // @Router       /products/{id}/images/{image_file_name} [delete]
 func (h *ProductHandler) DeleteProductImage(ctx *gin.Context) {
-	productId := ctx.Param("id")
-	imageFileName := ctx.Param("image_file_name")
+	var uri schemas.ProductImageURISchema
 
-	err := h.productController.DeleteImage(productId, imageFileName)
+	if !bindURI(ctx, &uri) {
+		return
+	}
+
+	err := h.productController.DeleteImage(uri.ID, uri.ImageFileName)
 
 	if err != nil {
-		// Se for erro de imagem não pode ser removida por ser a última, retorna 409 (conflito)
-		if strings.Contains(err.Error(), "cannot be empty") || strings.Contains(err.Error(), "só possui uma imagem") {
-			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
-			return
-		}
-		// Retorna a mensagem de erro específica para o client
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = ctx.Error(err)
 		return
 	}
 
@@ -244,15 +449,27 @@ func (h *ProductHandler) DeleteProductImage(ctx *gin.Context) {
 // @Summary Delete a product by ID
 // @Tags Products
 // @Produce json
-// @Param id path string true "Product ID"
+// @Param id path string true "Product ID" format(uuid)
+// @Param If-Match header string false "ETag of the version being deleted"
 // @Success 204 {object} nil
-// @Failure 400 {object} schemas.InvalidProductDataErrorSchema
-// @Failure 500 {object} schemas.ErrorMessageSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 412 {object} schemas.ProblemSchema
+// @Failure 428 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
 // @Router /products/{id} [delete]
 func (h *ProductHandler) DeleteProduct(ctx *gin.Context) {
-	productId := ctx.Param("id")
+	productId, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	precondition, ok := bindPrecondition(ctx, h.requireIfMatch)
+	if !ok {
+		return
+	}
 
-	err := h.productController.Delete(productId)
+	err := h.productController.Delete(dtos.DeleteProductDTO{ID: productId, Precondition: precondition})
 
 	if err != nil {
 		_ = ctx.Error(err)
@@ -265,17 +482,24 @@ func (h *ProductHandler) DeleteProduct(ctx *gin.Context) {
 // @Summary List all images of a product
 // @Tags Products
 // @Produce json
-// @Param id path string true "Product ID"
+// @Param id path string true "Product ID" format(uuid)
+// @Param If-None-Match header string false "ETag of the cached list"
 // @Success 200 {array} schemas.ProductImageResponseSchema
-// @Failure 404 {object} schemas.ErrorMessageSchema
+// @Header 200 {string} ETag "Hash of the list"
+// @Header 200 {string} Cache-Control "Cache policy"
+// @Success 304 {object} nil
+// @Failure 404 {object} schemas.ProblemSchema
 // @Router /products/{id}/images [get]
 func (h *ProductHandler) FindAllImagesProductById(ctx *gin.Context) {
-	productId := ctx.Param("id")
+	productId, ok := bindID(ctx)
+	if !ok {
+		return
+	}
 	images, err := h.productController.FindAllImagesProductById(productId)
 	if err != nil {
-		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		_ = ctx.Error(err)
 		return
 	}
 	// Retorna apenas o array de imagens
-	ctx.JSON(http.StatusOK, images)
+	renderCacheableJSON(ctx, h.cacheControl, images)
 }
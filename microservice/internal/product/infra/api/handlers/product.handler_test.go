@@ -13,14 +13,17 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/infra/api/http_errors"
 	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	"tech_challenge/internal/shared/infra/api/problems"
 	testmocks "tech_challenge/internal/shared/test"
 )
 
 func setupProductTestEnv(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource, fileProvider *mock_interfaces.MockIFileProvider) (*gin.Engine, *httptest.ResponseRecorder, *ProductHandler) {
 	gin.SetMode(gin.TestMode)
 	h := setupProductHandlerWithFakeGateway(productDs, categoryDs, fileProvider)
-	r := gin.New()
+	r := newTestRouter()
 	w := httptest.NewRecorder()
 	return r, w, h
 }
@@ -66,16 +69,23 @@ func TestFindAllProducts_Success(t *testing.T) {
 
 func TestFindAllProducts_WithCategoryID(t *testing.T) {
 	mockProductDs := &testmocks.MockProductDataSource{
-		FindAllFunc: func() ([]daos.ProductDAO, error) {
-			return []daos.ProductDAO{{ID: "2", Name: "prodcat", Description: "desc", Price: 2.0, Active: true, CategoryID: "catid2"}}, nil
+		FindAllByCategoryIDsFunc: func(categoryIDs []string) ([]daos.ProductDAO, error) {
+			require.Equal(t, []string{testCategoryID, "subcatid"}, categoryIDs)
+			return []daos.ProductDAO{{ID: "2", Name: "prodcat", Description: "desc", Price: 2.0, Active: true, CategoryID: "subcatid"}}, nil
 		},
 	}
 	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
+	mockCategoryDs.FindAllFunc = func() ([]daos.CategoryDAO, error) {
+		return []daos.CategoryDAO{
+			{ID: testCategoryID, Name: "Bebidas", Active: true},
+			{ID: "subcatid", ParentID: testCategoryID, Name: "Refrigerantes", Active: true},
+		}, nil
+	}
 	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
 
 	r.GET("/products", h.FindAllProducts)
 
-	req := httptest.NewRequest(http.MethodGet, "/products?category_id=catid2", nil)
+	req := httptest.NewRequest(http.MethodGet, "/products?category_id="+testCategoryID, nil)
 	r.ServeHTTP(w, req)
 
 	require.Equal(t, http.StatusOK, w.Code)
@@ -83,9 +93,8 @@ func TestFindAllProducts_WithCategoryID(t *testing.T) {
 	var resp []map[string]interface{}
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	require.NoError(t, err)
-	if len(resp) == 0 {
-		t.Logf("Body: %s", w.Body.String())
-	}
+	require.Len(t, resp, 1)
+	require.Equal(t, "subcatid", resp[0]["category_id"])
 }
 
 func TestFindAllProducts_WithoutCategoryID(t *testing.T) {
@@ -111,6 +120,50 @@ func TestFindAllProducts_WithoutCategoryID(t *testing.T) {
 	require.Equal(t, "prodsemcat", resp[0]["name"])
 }
 
+func TestFindAllProducts_AvailableAt(t *testing.T) {
+	breakfast := &daos.AvailabilityDAO{Weekly: []daos.WeeklyAvailabilityDAO{{
+		Weekdays:     []string{"mon", "tue", "wed", "thu", "fri"},
+		TimeRangeDAO: daos.TimeRangeDAO{From: "06:00", To: "10:30"},
+	}}}
+	mockProductDs := &testmocks.MockProductDataSource{
+		FindAllFunc: func() ([]daos.ProductDAO, error) {
+			return []daos.ProductDAO{{ID: "4", Name: "Panqueca", Description: "desc", Price: 9.0, Active: true, CategoryID: "catid", Availability: breakfast}}, nil
+		},
+	}
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
+	r, _, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+	r.GET("/products", h.FindAllProducts)
+
+	availableAt := func(at string) bool {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products?at="+at, nil))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resp []map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Len(t, resp, 1)
+		require.NotNil(t, resp[0]["availability"])
+		return resp[0]["available_now"].(bool)
+	}
+
+	// O horário é avaliado no fuso padrão, America/Sao_Paulo
+	require.True(t, availableAt("2025-01-15T08:00:00-03:00"))
+	require.True(t, availableAt("2025-01-15T11:00:00Z"))
+	require.False(t, availableAt("2025-01-15T15:00:00-03:00"))
+	require.False(t, availableAt("2025-01-18T08:00:00-03:00"))
+}
+
+func TestFindAllProducts_InvalidAt(t *testing.T) {
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(&testmocks.MockProductDataSource{})
+	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+	r.GET("/products", h.FindAllProducts)
+
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products?at=amanha", nil))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, problems.CodeInvalidParameter, decodeProblem(t, w).Code)
+}
+
 func TestFindAllProducts_Error(t *testing.T) {
 	mockProductDs := &testmocks.MockProductDataSource{
 		FindAllFunc: func() ([]daos.ProductDAO, error) {
@@ -120,12 +173,6 @@ func TestFindAllProducts_Error(t *testing.T) {
 	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
 	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
 
-	r.Use(func(c *gin.Context) {
-		c.Next()
-		if len(c.Errors) > 0 {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": c.Errors[0].Error()})
-		}
-	})
 	r.GET("/products", h.FindAllProducts)
 
 	req := httptest.NewRequest(http.MethodGet, "/products", nil)
@@ -145,7 +192,7 @@ func TestFindAllImagesProductById_Success(t *testing.T) {
 
 	r.GET("/products/:id/images", h.FindAllImagesProductById)
 
-	req := httptest.NewRequest(http.MethodGet, "/products/1/images", nil)
+	req := httptest.NewRequest(http.MethodGet, "/products/"+testProductID+"/images", nil)
 	r.ServeHTTP(w, req)
 
 	require.Equal(t, http.StatusOK, w.Code)
@@ -162,7 +209,7 @@ func TestFindAllImagesProductById_Success(t *testing.T) {
 func TestFindAllImagesProductById_Error(t *testing.T) {
 	mockProductDs := &testmocks.MockProductDataSource{
 		FindAllImagesProductByIdFunc: func(productID string) ([]daos.ProductImageDAO, error) {
-			return nil, errors.New("not found")
+			return nil, &exceptions.RecordNotFoundException{}
 		},
 	}
 	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
@@ -170,7 +217,7 @@ func TestFindAllImagesProductById_Error(t *testing.T) {
 
 	r.GET("/products/:id/images", h.FindAllImagesProductById)
 
-	req := httptest.NewRequest(http.MethodGet, "/products/1/images", nil)
+	req := httptest.NewRequest(http.MethodGet, "/products/"+testProductID+"/images", nil)
 	r.ServeHTTP(w, req)
 
 	require.Equal(t, http.StatusNotFound, w.Code)
@@ -178,12 +225,12 @@ func TestFindAllImagesProductById_Error(t *testing.T) {
 	var resp map[string]interface{}
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	require.NoError(t, err)
-	require.Contains(t, resp["error"], "not found")
+	require.Equal(t, http_errors.CodeRecordNotFound, resp["code"])
 }
 
 func TestDeleteProduct_Success(t *testing.T) {
 	mockProductDs := &testmocks.MockProductDataSource{
-		DeleteFunc: func(id string) error { return nil },
+		DeleteFunc: func(id string, version int64) error { return nil },
 	}
 	mockProductDs, mockCategoryDs, _ := makeDefaultMocks(mockProductDs)
 	mockFileProvider := makeGomockFileProvider(t)
@@ -195,7 +242,7 @@ func TestDeleteProduct_Success(t *testing.T) {
 		c.Status(http.StatusNoContent)
 	})
 
-	req := httptest.NewRequest(http.MethodDelete, "/products/1", nil)
+	req := httptest.NewRequest(http.MethodDelete, "/products/"+testProductID, nil)
 	r.ServeHTTP(w, req)
 
 	require.Equal(t, http.StatusNoContent, w.Code)
@@ -203,22 +250,16 @@ func TestDeleteProduct_Success(t *testing.T) {
 
 func TestDeleteProduct_Error(t *testing.T) {
 	mockProductDs := &testmocks.MockProductDataSource{
-		DeleteFunc: func(id string) error { return errors.New("delete error") },
+		DeleteFunc: func(id string, version int64) error { return errors.New("delete error") },
 	}
 	mockProductDs, mockCategoryDs, _ := makeDefaultMocks(mockProductDs)
 	mockFileProvider := makeGomockFileProvider(t)
 	mockFileProvider.EXPECT().DeleteFiles(gomock.Any()).Return(nil).AnyTimes()
 	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
 
-	r.Use(func(c *gin.Context) {
-		c.Next()
-		if len(c.Errors) > 0 {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": c.Errors[0].Error()})
-		}
-	})
 	r.DELETE("/products/:id", h.DeleteProduct)
 
-	req := httptest.NewRequest(http.MethodDelete, "/products/1", nil)
+	req := httptest.NewRequest(http.MethodDelete, "/products/"+testProductID, nil)
 	r.ServeHTTP(w, req)
 
 	require.Equal(t, http.StatusInternalServerError, w.Code)
@@ -246,8 +287,8 @@ func TestUpdateProduct_Success(t *testing.T) {
 
 	r.PUT("/products/:id", h.UpdateProduct)
 
-	body := `{"category_id":"catid","name":"prod","description":"desc","price":1.0,"active":true}`
-	req := httptest.NewRequest(http.MethodPut, "/products/1", strings.NewReader(body))
+	body := `{"category_id":"` + testCategoryID + `","name":"prod","description":"desc","price":1.0,"active":true}`
+	req := httptest.NewRequest(http.MethodPut, "/products/"+testProductID, strings.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
@@ -267,7 +308,7 @@ func TestUpdateProduct_BadRequest(t *testing.T) {
 	r.PUT("/products/:id", h.UpdateProduct)
 
 	body := `{"name":1}`
-	req := httptest.NewRequest(http.MethodPut, "/products/1", strings.NewReader(body))
+	req := httptest.NewRequest(http.MethodPut, "/products/"+testProductID, strings.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
@@ -276,7 +317,8 @@ func TestUpdateProduct_BadRequest(t *testing.T) {
 	var resp map[string]interface{}
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	require.NoError(t, err)
-	require.Equal(t, "Invalid request body", resp["error"])
+	require.Equal(t, problems.CodeValidationFailed, resp["code"])
+	require.Equal(t, "name", resp["errors"].([]interface{})[0].(map[string]interface{})["field"])
 }
 
 func TestUpdateProduct_Error(t *testing.T) {
@@ -299,16 +341,10 @@ func TestUpdateProduct_Error(t *testing.T) {
 	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
 	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
 
-	r.Use(func(c *gin.Context) {
-		c.Next()
-		if len(c.Errors) > 0 {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": c.Errors[0].Error()})
-		}
-	})
 	r.PUT("/products/:id", h.UpdateProduct)
 
-	body := `{"category_id":"catid","name":"prod","description":"desc","price":1.0,"active":true}`
-	req := httptest.NewRequest(http.MethodPut, "/products/1", strings.NewReader(body))
+	body := `{"category_id":"` + testCategoryID + `","name":"prod","description":"desc","price":1.0,"active":true}`
+	req := httptest.NewRequest(http.MethodPut, "/products/"+testProductID, strings.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
@@ -336,7 +372,7 @@ func TestFindProductByID_Success(t *testing.T) {
 
 	r.GET("/products/:id", h.FindProductByID)
 
-	req := httptest.NewRequest(http.MethodGet, "/products/1", nil)
+	req := httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil)
 	r.ServeHTTP(w, req)
 
 	require.Equal(t, http.StatusOK, w.Code)
@@ -350,21 +386,15 @@ func TestFindProductByID_Success(t *testing.T) {
 func TestFindProductByID_Error(t *testing.T) {
 	mockProductDs := &testmocks.MockProductDataSource{
 		FindByIDFunc: func(id string) (daos.ProductDAO, error) {
-			return daos.ProductDAO{}, errors.New("not found")
+			return daos.ProductDAO{}, &exceptions.RecordNotFoundException{}
 		},
 	}
 	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
 	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
 
-	r.Use(func(c *gin.Context) {
-		c.Next()
-		if len(c.Errors) > 0 {
-			c.JSON(http.StatusNotFound, gin.H{"error": c.Errors[0].Error()})
-		}
-	})
 	r.GET("/products/:id", h.FindProductByID)
 
-	req := httptest.NewRequest(http.MethodGet, "/products/1", nil)
+	req := httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil)
 	r.ServeHTTP(w, req)
 
 	require.Equal(t, http.StatusNotFound, w.Code)
@@ -379,7 +409,7 @@ func TestCreateProduct_Success(t *testing.T) {
 
 	r.POST("/products", h.CreateProduct)
 
-	body := `{"category_id":"catid","name":"prod","description":"desc","price":1.0,"active":true}`
+	body := `{"category_id":"` + testCategoryID + `","name":"prod","description":"desc","price":1.0,"active":true}`
 	req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
@@ -409,7 +439,7 @@ func TestCreateProduct_BadRequest(t *testing.T) {
 	var resp map[string]interface{}
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	require.NoError(t, err)
-	require.Contains(t, resp["error"], "json")
+	require.Equal(t, problems.CodeValidationFailed, resp["code"])
 }
 
 func TestCreateProduct_Error(t *testing.T) {
@@ -419,15 +449,9 @@ func TestCreateProduct_Error(t *testing.T) {
 	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
 	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
 
-	r.Use(func(c *gin.Context) {
-		c.Next()
-		if len(c.Errors) > 0 {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": c.Errors[0].Error()})
-		}
-	})
 	r.POST("/products", h.CreateProduct)
 
-	body := `{"category_id":"catid","name":"prod","description":"desc","price":1.0,"active":true}`
+	body := `{"category_id":"` + testCategoryID + `","name":"prod","description":"desc","price":1.0,"active":true}`
 	req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
@@ -474,7 +498,7 @@ func TestDeleteProductImage_Success(t *testing.T) {
 
 	r.DELETE("/products/:id/images/:image_file_name", h.DeleteProductImage)
 
-	req := httptest.NewRequest(http.MethodDelete, "/products/1/images/img.jpg", nil)
+	req := httptest.NewRequest(http.MethodDelete, "/products/"+testProductID+"/images/img.jpg", nil)
 	r.ServeHTTP(w, req)
 
 	if w.Code != http.StatusNoContent {
@@ -508,17 +532,11 @@ func TestDeleteProductImage_BadRequest(t *testing.T) {
 
 	r.DELETE("/products/:id/images/:image_file_name", h.DeleteProductImage)
 
-	req := httptest.NewRequest(http.MethodDelete, "/products/1/images/img.jpg", nil)
+	req := httptest.NewRequest(http.MethodDelete, "/products/"+testProductID+"/images/img.jpg", nil)
 	r.ServeHTTP(w, req)
 
-	if w.Code == http.StatusConflict {
-		var resp map[string]interface{}
-		err := json.Unmarshal(w.Body.Bytes(), &resp)
-		require.NoError(t, err)
-		require.Contains(t, resp["error"], "cannot be empty")
-	} else {
-		require.Equal(t, http.StatusBadRequest, w.Code)
-	}
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.Equal(t, problems.ContentType, w.Header().Get("Content-Type"))
 }
 
 func TestDeleteProductImage_Conflict(t *testing.T) {
@@ -550,19 +568,20 @@ func TestDeleteProductImage_Conflict(t *testing.T) {
 
 	r.DELETE("/products/:id/images/:image_file_name", h.DeleteProductImage)
 
-	req := httptest.NewRequest(http.MethodDelete, "/products/1/images/img.jpg", nil)
+	req := httptest.NewRequest(http.MethodDelete, "/products/"+testProductID+"/images/img.jpg", nil)
 	r.ServeHTTP(w, req)
 
 	require.Equal(t, http.StatusConflict, w.Code)
 	var resp map[string]interface{}
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	require.NoError(t, err)
-	require.Contains(t, resp["error"], "Product image cannot be empty")
+	require.Equal(t, http_errors.CodeProductImageRequired, resp["code"])
+	require.Contains(t, resp["detail"], "Product image cannot be empty")
 }
 
 func TestDeleteProduct_ReturnsNoContent(t *testing.T) {
 	mockProductDs := &testmocks.MockProductDataSource{
-		DeleteFunc: func(id string) error { return nil },
+		DeleteFunc: func(id string, version int64) error { return nil },
 	}
 	mockProductDs, mockCategoryDs, _ := makeDefaultMocks(mockProductDs)
 	mockFileProvider := makeGomockFileProvider(t)
@@ -574,7 +593,7 @@ func TestDeleteProduct_ReturnsNoContent(t *testing.T) {
 		c.Status(http.StatusNoContent)
 	})
 
-	req := httptest.NewRequest(http.MethodDelete, "/products/1", nil)
+	req := httptest.NewRequest(http.MethodDelete, "/products/"+testProductID, nil)
 	r.ServeHTTP(w, req)
 
 	require.Equal(t, http.StatusNoContent, w.Code)
@@ -588,8 +607,509 @@ func TestUploadProductImage_BadRequest_BindError(t *testing.T) {
 
 	r.POST("/products/:id/images", h.UploadProductImage)
 
-	req := httptest.NewRequest(http.MethodPost, "/products/1/images", nil)
+	req := httptest.NewRequest(http.MethodPost, "/products/"+testProductID+"/images", nil)
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestBulkUpdateProducts_PreviewByDefault(t *testing.T) {
+	mockProductDs := &testmocks.MockProductDataSource{
+		FindAllByCategoryIDFunc: func(categoryID string) ([]daos.ProductDAO, error) {
+			return []daos.ProductDAO{{ID: "1", Name: "X-Salada", Price: 20.0, Active: true, CategoryID: categoryID}}, nil
+		},
+		UpdateFunc: func(dao daos.ProductDAO) error {
+			t.Fatal("preview must not write")
+			return nil
+		},
+	}
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
+	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+
+	r.POST("/products/bulk", h.BulkUpdateProducts)
+
+	body := `{"filter":{"category_id":"` + testCategoryID + `"},"operation":{"type":"adjust_price","price":{"mode":"percentage","value":10,"rounding":"ninety_nine"}}}`
+	req := httptest.NewRequest(http.MethodPost, "/products/bulk", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, true, resp["dry_run"])
+	require.Equal(t, false, resp["applied"])
+	change := resp["changes"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, 20.0, change["previous_price"])
+	require.Equal(t, 22.99, change["price"])
+}
+
+func TestBulkUpdateProducts_Apply(t *testing.T) {
+	var updated []daos.ProductDAO
+	mockProductDs := &testmocks.MockProductDataSource{
+		FindAllFunc: func() ([]daos.ProductDAO, error) {
+			return []daos.ProductDAO{
+				{ID: "1", Name: "X-Salada", Price: 20.0, Active: true, CategoryID: "catid"},
+				{ID: "2", Name: "X-Bacon", Price: 22.0, Active: false, CategoryID: "catid"},
+			}, nil
+		},
+		UpdateFunc: func(dao daos.ProductDAO) error {
+			updated = append(updated, dao)
+			return nil
+		},
+	}
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
+	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+
+	r.POST("/products/bulk", h.BulkUpdateProducts)
+
+	body := `{"filter":{"active":true},"operation":{"type":"deactivate"}}`
+	req := httptest.NewRequest(http.MethodPost, "/products/bulk?dry_run=false", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Len(t, updated, 1)
+	require.Equal(t, "1", updated[0].ID)
+	require.False(t, updated[0].Active)
+	require.Contains(t, w.Body.String(), `"applied":true`)
+}
+
+func TestBulkUpdateProducts_BadRequest(t *testing.T) {
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(&testmocks.MockProductDataSource{})
+	r, _, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+
+	r.POST("/products/bulk", h.BulkUpdateProducts)
+
+	for _, c := range []struct{ url, body string }{
+		{"/products/bulk?dry_run=maybe", `{"filter":{"active":true},"operation":{"type":"activate"}}`},
+		{"/products/bulk", `{"filter":{"active":true}}`},
+	} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, c.url, strings.NewReader(c.body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	}
+}
+
+func decodeProblem(t *testing.T, w *httptest.ResponseRecorder) problems.Problem {
+	var problem problems.Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	return problem
+}
+
+func TestCreateProduct_RequiresEveryField(t *testing.T) {
+	mockProductDs := &testmocks.MockProductDataSource{
+		InsertFunc: func(dao daos.ProductDAO) error {
+			t.Fatal("invalid requests must not reach the controller")
+			return nil
+		},
+	}
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
+	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+
+	r.POST("/products", h.CreateProduct)
+
+	// Sem active o produto era criado inativo em silêncio
+	body := `{"category_id":"` + testCategoryID + `","name":"prod","description":"desc","price":1.0}`
+	req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	problem := decodeProblem(t, w)
+	require.Equal(t, problems.CodeValidationFailed, problem.Code)
+	require.Equal(t, []problems.FieldError{{Field: "active", Code: "required", Message: "active is required"}}, problem.Errors)
+}
+
+func TestCreateProduct_RejectsUnknownFieldsAndBounds(t *testing.T) {
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(&testmocks.MockProductDataSource{})
+	r, _, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+
+	r.POST("/products", h.CreateProduct)
+
+	cases := []struct {
+		body  string
+		field string
+		code  string
+	}{
+		{`{"category_id":"` + testCategoryID + `","name":"prod","description":"desc","price":1.0,"active":true,"stock":3}`, "stock", "unknown_field"},
+		{`{"category_id":"catid","name":"prod","description":"desc","price":1.0,"active":true}`, "category_id", "uuid"},
+		{`{"category_id":"` + testCategoryID + `","name":"pr","description":"desc","price":1.0,"active":true}`, "name", "min"},
+		{`{"category_id":"` + testCategoryID + `","name":"prod","description":"desc","price":-1,"active":true}`, "price", "gt"},
+	}
+
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(c.body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code, c.body)
+		problem := decodeProblem(t, w)
+		require.Len(t, problem.Errors, 1, c.body)
+		require.Equal(t, c.field, problem.Errors[0].Field)
+		require.Equal(t, c.code, problem.Errors[0].Code)
+	}
+}
+
+func TestProductRoutes_InvalidPathAndQueryParams(t *testing.T) {
+	mockProductDs := &testmocks.MockProductDataSource{
+		FindByIDFunc: func(id string) (daos.ProductDAO, error) {
+			t.Fatal("invalid ids must not reach the controller")
+			return daos.ProductDAO{}, nil
+		},
+	}
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
+	r, _, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+
+	r.GET("/products", h.FindAllProducts)
+	r.GET("/products/:id", h.FindProductByID)
+	r.DELETE("/products/:id/images/:image_file_name", h.DeleteProductImage)
+
+	cases := []struct {
+		method, url, field string
+	}{
+		{http.MethodGet, "/products/1", "id"},
+		{http.MethodGet, "/products?category_id=bebidas", "category_id"},
+		{http.MethodDelete, "/products/1/images/img.jpg", "id"},
+	}
+
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(c.method, c.url, nil))
+
+		require.Equal(t, http.StatusBadRequest, w.Code, c.url)
+		problem := decodeProblem(t, w)
+		require.Equal(t, problems.CodeInvalidParameter, problem.Code)
+		require.Equal(t, c.field, problem.Errors[0].Field)
+		require.Equal(t, "uuid", problem.Errors[0].Code)
+	}
+}
+
+func TestPatchProduct_KeepsOmittedFields(t *testing.T) {
+	var saved daos.ProductDAO
+	mockProductDs := &testmocks.MockProductDataSource{
+		UpdateFunc: func(dao daos.ProductDAO) error {
+			saved = dao
+			return nil
+		},
+		FindByIDFunc: func(id string) (daos.ProductDAO, error) {
+			return daos.ProductDAO{ID: id, Name: "prod", Description: "desc", Price: 1.0, Active: true, CategoryID: testCategoryID}, nil
+		},
+	}
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
+	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+
+	r.PATCH("/products/:id", h.PatchProduct)
+
+	req := httptest.NewRequest(http.MethodPatch, "/products/"+testProductID, strings.NewReader(`{"description":"nova descrição"}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "nova descrição", saved.Description)
+	require.Equal(t, "prod", saved.Name)
+	require.Equal(t, 1.0, saved.Price)
+	require.True(t, saved.Active)
+
+	// null limpa a descrição
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPatch, "/products/"+testProductID, strings.NewReader(`{"description":null}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Empty(t, saved.Description)
+	require.Equal(t, "prod", saved.Name)
+}
+
+func TestPatchProduct_Availability(t *testing.T) {
+	var saved daos.ProductDAO
+	mockProductDs := &testmocks.MockProductDataSource{
+		UpdateFunc: func(dao daos.ProductDAO) error {
+			saved = dao
+			return nil
+		},
+		FindByIDFunc: func(id string) (daos.ProductDAO, error) {
+			return daos.ProductDAO{ID: id, Name: "prod", Description: "desc", Price: 1.0, Active: true, CategoryID: testCategoryID,
+				Availability: &daos.AvailabilityDAO{StartDate: "2025-01-01"}}, nil
+		},
+	}
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
+	r, _, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+	r.PATCH("/products/:id", h.PatchProduct)
+
+	patch := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPatch, "/products/"+testProductID, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := patch(`{"availability":{"weekly":[{"weekdays":["sat","sun"],"from":"12:00","to":"24:00"}]}}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, []string{"sat", "sun"}, saved.Availability.Weekly[0].Weekdays)
+	require.Empty(t, saved.Availability.StartDate)
+
+	w = patch(`{"availability":null}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Nil(t, saved.Availability)
+
+	w = patch(`{"availability":{"weekly":[{"weekdays":["mon"],"from":"10:30","to":"06:00"}]}}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	problem := decodeProblem(t, w)
+	require.Equal(t, http_errors.CodeInvalidAvailability, problem.Code)
+	require.Equal(t, "weekly[0]: to must be after from", problem.Detail)
+}
+
+func TestPatchProduct_InvalidRequests(t *testing.T) {
+	mockProductDs := &testmocks.MockProductDataSource{
+		UpdateFunc: func(dao daos.ProductDAO) error {
+			t.Fatal("invalid requests must not reach the controller")
+			return nil
+		},
+	}
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
+	r, _, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+
+	r.PATCH("/products/:id", h.PatchProduct)
+
+	for _, c := range []struct {
+		name, contentType, body string
+		status                  int
+		code                    string
+	}{
+		{"null name", "application/merge-patch+json", `{"name":null}`, http.StatusBadRequest, "not_nullable"},
+		{"unknown weekday", "application/merge-patch+json", `{"availability":{"weekly":[{"weekdays":["funday"],"from":"06:00","to":"10:30"}]}}`, http.StatusBadRequest, "oneof"},
+		{"short name", "application/merge-patch+json", `{"name":"ab"}`, http.StatusBadRequest, "min"},
+		{"unknown field", "application/merge-patch+json", `{"stock":3}`, http.StatusBadRequest, "unknown_field"},
+		{"not an object", "application/merge-patch+json", `[]`, http.StatusBadRequest, ""},
+		{"wrong media type", "text/plain", `{"name":"prod"}`, http.StatusUnsupportedMediaType, ""},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPatch, "/products/"+testProductID, strings.NewReader(c.body))
+			req.Header.Set("Content-Type", c.contentType)
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, c.status, w.Code)
+			problem := decodeProblem(t, w)
+			if c.code != "" {
+				require.Equal(t, c.code, problem.Errors[0].Code)
+			}
+		})
+	}
+}
+
+const testNutritionJSON = `{"serving_size":180,"energy_kcal":420,"carbohydrates_g":38,"total_sugars_g":6,"added_sugars_g":2,"proteins_g":22,` +
+	`"total_fat_g":19,"saturated_fat_g":7,"trans_fat_g":0.2,"dietary_fiber_g":3,"sodium_mg":780}`
+
+func TestCreateProduct_NutritionAndAllergens(t *testing.T) {
+	var inserted daos.ProductDAO
+	mockProductDs := &testmocks.MockProductDataSource{
+		InsertFunc: func(dao daos.ProductDAO) error {
+			inserted = dao
+			return nil
+		},
+	}
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
+	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+	r.POST("/products", h.CreateProduct)
+
+	body := `{"category_id":"` + testCategoryID + `","name":"X-Salada","description":"desc","price":20.5,"active":true,` +
+		`"nutrition":` + testNutritionJSON + `,"allergens":["milk","gluten","milk"]}`
+	req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, []string{"gluten", "milk"}, inserted.Allergens)
+	require.Equal(t, "g", inserted.Nutrition.ServingUnit)
+	require.Equal(t, 780.0, inserted.Nutrition.Sodium)
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, []interface{}{"gluten", "milk"}, resp["allergens"])
+	require.Equal(t, 420.0, resp["nutrition"].(map[string]interface{})["energy_kcal"])
+}
+
+func TestCreateProduct_InvalidNutrition(t *testing.T) {
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(&testmocks.MockProductDataSource{})
+	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+	r.POST("/products", h.CreateProduct)
+
+	nutrition := strings.Replace(testNutritionJSON, `"added_sugars_g":2`, `"added_sugars_g":9`, 1)
+	body := `{"category_id":"` + testCategoryID + `","name":"X-Salada","description":"desc","price":20.5,"active":true,"nutrition":` + nutrition + `}`
+	req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
 	r.ServeHTTP(w, req)
 
 	require.Equal(t, http.StatusBadRequest, w.Code)
+	problem := decodeProblem(t, w)
+	require.Equal(t, http_errors.CodeInvalidNutritionFacts, problem.Code)
+	require.Equal(t, "nutrition.added_sugars_g must not exceed total_sugars_g", problem.Detail)
+}
+
+func TestCreateProduct_UnknownAllergen(t *testing.T) {
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(&testmocks.MockProductDataSource{})
+	r, w, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+	r.POST("/products", h.CreateProduct)
+
+	body := `{"category_id":"` + testCategoryID + `","name":"X-Salada","description":"desc","price":20.5,"active":true,"allergens":["mustard"]}`
+	req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, problems.CodeValidationFailed, decodeProblem(t, w).Code)
+}
+
+func TestFindAllProducts_ExcludeAllergens(t *testing.T) {
+	mockProductDs := &testmocks.MockProductDataSource{
+		FindAllFunc: func() ([]daos.ProductDAO, error) {
+			return []daos.ProductDAO{
+				{ID: "1", Name: "X-Salada", Description: "desc", Price: 20.5, Active: true, CategoryID: "catid", Allergens: []string{"gluten", "milk"}},
+				{ID: "2", Name: "Salada", Description: "desc", Price: 15, Active: true, CategoryID: "catid", Allergens: []string{}},
+				{ID: "3", Name: "Pudim", Description: "desc", Price: 8, Active: true, CategoryID: "catid"},
+			}, nil
+		},
+	}
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
+	r, _, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+	r.GET("/products", h.FindAllProducts)
+
+	list := func(query string) []map[string]interface{} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products"+query, nil))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resp []map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp
+	}
+
+	all := list("")
+	require.Len(t, all, 3)
+	require.Nil(t, all[2]["allergens"])
+
+	// Pudim não declarou alérgenos e também sai
+	safe := list("?exclude_allergens=gluten,%20lactose")
+	require.Len(t, safe, 1)
+	require.Equal(t, "Salada", safe[0]["name"])
+	require.Equal(t, []interface{}{}, safe[0]["allergens"])
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products?exclude_allergens=mustard", nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, http_errors.CodeInvalidAllergen, decodeProblem(t, w).Code)
+}
+
+func TestPatchProduct_NutritionAndAllergens(t *testing.T) {
+	var saved daos.ProductDAO
+	mockProductDs := &testmocks.MockProductDataSource{
+		UpdateFunc: func(dao daos.ProductDAO) error {
+			saved = dao
+			return nil
+		},
+		FindByIDFunc: func(id string) (daos.ProductDAO, error) {
+			return daos.ProductDAO{ID: id, Name: "prod", Description: "desc", Price: 1.0, Active: true, CategoryID: testCategoryID,
+				Nutrition: &daos.NutritionFactsDAO{ServingSize: 100, ServingUnit: "g"}, Allergens: []string{"soy"}}, nil
+		},
+	}
+	mockProductDs, mockCategoryDs, mockFileProvider := makeDefaultMocks(mockProductDs)
+	r, _, h := setupProductTestEnv(mockProductDs, mockCategoryDs, mockFileProvider)
+	r.PATCH("/products/:id", h.PatchProduct)
+
+	patch := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPatch, "/products/"+testProductID, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := patch(`{"description":"nova"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, []string{"soy"}, saved.Allergens)
+	require.NotNil(t, saved.Nutrition)
+
+	w = patch(`{"allergens":[]}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, saved.Allergens)
+	require.Empty(t, saved.Allergens)
+
+	w = patch(`{"allergens":null,"nutrition":null}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Nil(t, saved.Allergens)
+	require.Nil(t, saved.Nutrition)
+}
+
+func setupBatchProductRouter(batchMaxIDs int, findAllByIDs func([]string) ([]daos.ProductDAO, error)) *gin.Engine {
+	productDs, categoryDs := catalogDataSources()
+	productDs.FindAllByIDsFunc = findAllByIDs
+	h := setupBatchProductHandler(productDs, categoryDs, batchMaxIDs)
+	r := newTestRouter()
+	r.GET("/products", h.FindAllProducts)
+	r.POST("/products/batch-get", h.BatchGetProducts)
+	return r
+}
+
+func TestBatchGetProducts(t *testing.T) {
+	var requested [][]string
+	r := setupBatchProductRouter(3, func(ids []string) ([]daos.ProductDAO, error) {
+		requested = append(requested, ids)
+		return []daos.ProductDAO{{ID: "76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae", CategoryID: "2cb7f56d-89a1-4e60-b488-65dc4ffacbc6", Name: "X-Salada", Price: 20.5, Active: true}}, nil
+	})
+	ids := []string{"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae", "0b4c6a9e-2f0e-4a3c-9a52-3f4f2b1d7c11", "76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"}
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/products?ids="+strings.Join(ids, ","), nil),
+		httptest.NewRequest(http.MethodPost, "/products/batch-get", strings.NewReader(`{"ids":["`+strings.Join(ids, `","`)+`"]}`)),
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code, req.Method)
+		var resp struct {
+			Products   []map[string]interface{} `json:"products"`
+			MissingIDs []string                 `json:"missing_ids"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Len(t, resp.Products, 1)
+		require.Equal(t, "X-Salada", resp.Products[0]["name"])
+		require.Equal(t, true, resp.Products[0]["available_now"])
+		require.Equal(t, []string{"0b4c6a9e-2f0e-4a3c-9a52-3f4f2b1d7c11"}, resp.MissingIDs)
+	}
+
+	// Uma única consulta por requisição, já sem os IDs repetidos
+	require.Equal(t, [][]string{ids[:2], ids[:2]}, requested)
+}
+
+func TestBatchGetProducts_BadRequest(t *testing.T) {
+	r := setupBatchProductRouter(2, func(ids []string) ([]daos.ProductDAO, error) {
+		t.Fatal("batch read should not run for an invalid request")
+		return nil, nil
+	})
+
+	cases := []struct {
+		req  *http.Request
+		code string
+	}{
+		{httptest.NewRequest(http.MethodGet, "/products?ids=1,2", nil), "INVALID_PARAMETER"},
+		{httptest.NewRequest(http.MethodGet, "/products?ids=76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae&category_id=2cb7f56d-89a1-4e60-b488-65dc4ffacbc6", nil), "INVALID_PARAMETER"},
+		{httptest.NewRequest(http.MethodGet, "/products?ids=76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae,0b4c6a9e-2f0e-4a3c-9a52-3f4f2b1d7c11,2cb7f56d-89a1-4e60-b488-65dc4ffacbc6", nil), "INVALID_PARAMETER"},
+		{httptest.NewRequest(http.MethodPost, "/products/batch-get", strings.NewReader(`{"ids":[]}`)), "VALIDATION_FAILED"},
+		{httptest.NewRequest(http.MethodPost, "/products/batch-get", strings.NewReader(`{"ids":["abc"]}`)), "VALIDATION_FAILED"},
+		{httptest.NewRequest(http.MethodPost, "/products/batch-get", strings.NewReader(`{"ids":["76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae","0b4c6a9e-2f0e-4a3c-9a52-3f4f2b1d7c11","2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"]}`)), "VALIDATION_FAILED"},
+	}
+
+	for _, tc := range cases {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, tc.req)
+		require.Equal(t, http.StatusBadRequest, w.Code, tc.req.URL.String())
+		require.Equal(t, tc.code, decodeProblem(t, w).Code, tc.req.URL.String())
+	}
 }
@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/factories"
+	"tech_challenge/internal/product/infra/api/schemas"
+)
+
+// PromotionHandler mantém as promoções. O preço cadastrado dos produtos não
+// muda: as leituras de produtos e do menu calculam effective_price com as
+// promoções que valem no horário avaliado
+type PromotionHandler struct {
+	promotionController controllers.PromotionController
+}
+
+func NewPromotionHandler() *PromotionHandler {
+	promotionController := controllers.NewPromotionController(
+		factories.NewPromotionDataSource(),
+		factories.NewProductDataSource(),
+		factories.NewCategoryDataSource(),
+		factories.NewCatalogCache(),
+	)
+
+	return &PromotionHandler{
+		promotionController: *promotionController,
+	}
+}
+
+// @Summary List all promotions
+// @Description Sorted by priority, highest first, in the order they are applied
+// @Tags Promotions
+// @Produce json
+// @Success 200 {array} schemas.PromotionResponseSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /promotions/ [get]
+func (h *PromotionHandler) FindAllPromotions(ctx *gin.Context) {
+	promotions, err := h.promotionController.FindAll()
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ListToPromotionResponseSchema(promotions))
+}
+
+// @Summary Get a promotion by ID
+// @Tags Promotions
+// @Produce json
+// @Param id path string true "Promotion ID" format(uuid)
+// @Success 200 {object} schemas.PromotionResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /promotions/{id} [get]
+func (h *PromotionHandler) FindPromotionByID(ctx *gin.Context) {
+	promotionID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	promotion, err := h.promotionController.FindByID(promotionID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToPromotionResponseSchema(promotion))
+}
+
+// @Summary Create a promotion
+// @Description Targets products and/or categories (subcategories included). The schedule limits the validity period, weekdays and hours. Among the promotions that apply to a product, the highest priority wins; stackable promotions are applied on top of each other.
+// @Tags Promotions
+// @Accept json
+// @Produce json
+// @Param promotion body schemas.SavePromotionSchema true "Promotion to create"
+// @Success 201 {object} schemas.PromotionResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /promotions/ [post]
+func (h *PromotionHandler) CreatePromotion(ctx *gin.Context) {
+	var promotionRequestBody schemas.SavePromotionSchema
+
+	if !bindJSON(ctx, &promotionRequestBody) {
+		return
+	}
+
+	promotion, err := h.promotionController.Create(promotionRequestBody.ToDTO())
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, schemas.ToPromotionResponseSchema(promotion))
+}
+
+// @Summary Replace a promotion by ID
+// @Tags Promotions
+// @Accept json
+// @Produce json
+// @Param id path string true "Promotion ID" format(uuid)
+// @Param promotion body schemas.SavePromotionSchema true "Updated promotion"
+// @Success 200 {object} schemas.PromotionResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /promotions/{id} [put]
+func (h *PromotionHandler) UpdatePromotion(ctx *gin.Context) {
+	promotionID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	var promotionRequestBody schemas.SavePromotionSchema
+
+	if !bindJSON(ctx, &promotionRequestBody) {
+		return
+	}
+
+	promotion, err := h.promotionController.Update(promotionRequestBody.ToUpdateDTO(promotionID))
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToPromotionResponseSchema(promotion))
+}
+
+// @Summary Delete a promotion by ID
+// @Tags Promotions
+// @Param id path string true "Promotion ID" format(uuid)
+// @Success 204
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /promotions/{id} [delete]
+func (h *PromotionHandler) DeletePromotion(ctx *gin.Context) {
+	promotionID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.promotionController.Delete(promotionID); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/infra/api/http_errors"
+	testmocks "tech_challenge/internal/shared/test"
+)
+
+const testPromotionID = "9a7c3f0e-4b1d-4e55-8a3e-6f2d1c0b9e77"
+
+func promotionDs(promotions ...daos.PromotionDAO) *testmocks.MockPromotionDataSource {
+	return &testmocks.MockPromotionDataSource{Promotions: promotions}
+}
+
+func TestCreatePromotion(t *testing.T) {
+	ds := promotionDs()
+	h := setupPromotionHandlerWithFakeGateway(ds, translationProductDs(), translationCategoryDs())
+	r := newTestRouter()
+	r.POST("/promotions", h.CreatePromotion)
+
+	body := `{"name":"Terça dos lanches","discount_type":"percentage","discount_value":20,"category_ids":["` + testCategoryID + `"],
+		"schedule":{"weekly":[{"weekdays":["tue"],"from":"00:00","to":"24:00"}]},"priority":10}`
+	req := httptest.NewRequest(http.MethodPost, "/promotions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.NotEmpty(t, resp["id"])
+	require.Equal(t, "percentage", resp["discount_type"])
+	require.Equal(t, []any{}, resp["product_ids"])
+	require.Equal(t, []any{testCategoryID}, resp["category_ids"])
+	// active fica true quando omitido
+	require.Equal(t, true, resp["active"])
+	require.Len(t, ds.Promotions, 1)
+	require.Equal(t, 10, ds.Promotions[0].Priority)
+}
+
+func TestCreatePromotion_Invalid(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		code string
+	}{
+		{"percentage above 100", `{"name":"Metade","discount_type":"percentage","discount_value":150,"product_ids":["` + testProductID + `"]}`, http_errors.CodeInvalidPromotion},
+		{"without targets", `{"name":"Sem alvo","discount_type":"fixed","discount_value":5}`, http_errors.CodeInvalidPromotion},
+		{"unknown product", `{"name":"Combo","discount_type":"fixed","discount_value":5,"product_ids":["5f0c7a52-8d8e-4c4a-9d37-2b0b9a1f6e11"]}`, http_errors.CodeProductNotFound},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ds := promotionDs()
+			productDs := translationProductDs()
+			h := setupPromotionHandlerWithFakeGateway(ds, productDs, translationCategoryDs())
+			r := newTestRouter()
+			r.POST("/promotions", h.CreatePromotion)
+
+			req := httptest.NewRequest(http.MethodPost, "/promotions", strings.NewReader(c.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, c.code, decodeProblem(t, w).Code)
+			require.Empty(t, ds.Promotions)
+		})
+	}
+}
+
+func TestUpdatePromotion(t *testing.T) {
+	ds := promotionDs(daos.PromotionDAO{ID: testPromotionID, Name: "Combo", DiscountType: "fixed", DiscountValue: 5, ProductIDs: []string{testProductID}, Active: true})
+	h := setupPromotionHandlerWithFakeGateway(ds, translationProductDs(), translationCategoryDs())
+	r := newTestRouter()
+	r.PUT("/promotions/:id", h.UpdatePromotion)
+
+	body := `{"name":"Combo","discount_type":"fixed","discount_value":7,"product_ids":["` + testProductID + `"],"stackable":true,"active":false}`
+	req := httptest.NewRequest(http.MethodPut, "/promotions/"+testPromotionID, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, 7.0, ds.Promotions[0].DiscountValue)
+	require.True(t, ds.Promotions[0].Stackable)
+	require.False(t, ds.Promotions[0].Active)
+}
+
+func TestFindPromotionByID_NotFound(t *testing.T) {
+	h := setupPromotionHandlerWithFakeGateway(promotionDs(), translationProductDs(), translationCategoryDs())
+	r := newTestRouter()
+	r.GET("/promotions/:id", h.FindPromotionByID)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/promotions/"+testPromotionID, nil))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.Equal(t, http_errors.CodePromotionNotFound, decodeProblem(t, w).Code)
+}
+
+func TestDeletePromotion(t *testing.T) {
+	ds := promotionDs(daos.PromotionDAO{ID: testPromotionID, Name: "Combo", DiscountType: "fixed", DiscountValue: 5, ProductIDs: []string{testProductID}})
+	h := setupPromotionHandlerWithFakeGateway(ds, translationProductDs(), translationCategoryDs())
+	r := newTestRouter()
+	r.DELETE("/promotions/:id", h.DeletePromotion)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/promotions/"+testPromotionID, nil))
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+	require.Empty(t, ds.Promotions)
+}
+
+func TestFindProductByID_AppliesPromotions(t *testing.T) {
+	ds := promotionDs(
+		daos.PromotionDAO{ID: "p-lanches", Name: "Lanches 10%", DiscountType: "percentage", DiscountValue: 10, CategoryIDs: []string{testCategoryID}, Priority: 10, Stackable: true, Active: true},
+		daos.PromotionDAO{ID: "p-xsalada", Name: "R$2 off", DiscountType: "fixed", DiscountValue: 2, ProductIDs: []string{testProductID}, Priority: 5, Stackable: true, Active: true},
+		daos.PromotionDAO{ID: "p-inativa", Name: "Inativa", DiscountType: "fixed", DiscountValue: 20, ProductIDs: []string{testProductID}, Priority: 100, Active: false},
+	)
+	h := setupPromotedProductHandler(translationProductDs(), translationCategoryDs(), ds)
+	r := newTestRouter()
+	r.GET("/products/:id", h.FindProductByID)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	// O preço promocional não muda a versão, então o ETag não pode ser a versão
+	require.NotEqual(t, `"3"`, w.Header().Get("ETag"))
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 25.0, resp["price"])
+	require.Equal(t, 25.0, resp["original_price"])
+	require.Equal(t, 20.5, resp["effective_price"])
+	require.Equal(t, map[string]any{"id": "p-lanches", "name": "Lanches 10%", "discount_type": "percentage", "discount_value": 10.0, "amount": 2.5}, resp["applied_promotion"])
+	require.Equal(t, []any{map[string]any{"id": "p-xsalada", "name": "R$2 off", "discount_type": "fixed", "discount_value": 2.0, "amount": 2.0}}, resp["stacked_promotions"])
+}
+
+func TestFindProductByID_WithoutPromotions(t *testing.T) {
+	h := setupPromotedProductHandler(translationProductDs(), translationCategoryDs(), promotionDs())
+	r := newTestRouter()
+	r.GET("/products/:id", h.FindProductByID)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 25.0, resp["effective_price"])
+	require.NotContains(t, resp, "applied_promotion")
+	require.NotContains(t, resp, "stacked_promotions")
+}
+
+func TestFindMenu_AppliesPromotions(t *testing.T) {
+	productDs, categoryDs := menuDataSources()
+	ds := promotionDs(daos.PromotionDAO{ID: "p-xsalada", Name: "R$5 off", DiscountType: "fixed", DiscountValue: 5, ProductIDs: []string{testProductID}, Active: true})
+	h := setupPromotedMenuHandler(productDs, categoryDs, ds)
+	r := newTestRouter()
+	r.GET("/menu", h.FindMenu)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/menu?compact=true", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"categories":[
+		{"id":"`+testCategoryID+`","name":"Lanches","subcategories":[],"products":[
+			{"id":"`+testProductID+`","name":"X-Salada","price":20.5,"original_price":20.5,"effective_price":15.5,
+			 "applied_promotion":{"id":"p-xsalada","name":"R$5 off","discount_type":"fixed","discount_value":5,"amount":5},
+			 "image":{"file_name":"x-salada.png","url":"http://bucket/x-salada.png"},"sold_out":false}
+		]},
+		{"id":"`+testOtherCategoryID+`","name":"Bebidas","subcategories":[],"products":[]}
+	]}`, w.Body.String())
+}
@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"tech_challenge/internal/product/infra/api/schemas"
+	"tech_challenge/internal/shared/infra/api/problems"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+// strictJSONBinding é o binding.JSON do gin sem aceitar campos desconhecidos
+// nem conteúdo depois do objeto, sem depender da flag global do gin
+type strictJSONBinding struct{}
+
+var strictJSON binding.BindingBody = strictJSONBinding{}
+
+func (strictJSONBinding) Name() string {
+	return "json"
+}
+
+func (b strictJSONBinding) Bind(req *http.Request, obj any) error {
+	if req == nil || req.Body == nil {
+		return io.EOF
+	}
+	return decodeStrictJSON(req.Body, obj)
+}
+
+func (b strictJSONBinding) BindBody(body []byte, obj any) error {
+	return decodeStrictJSON(bytes.NewReader(body), obj)
+}
+
+func decodeStrictJSON(body io.Reader, obj any) error {
+	decoder := json.NewDecoder(body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(obj); err != nil {
+		return err
+	}
+
+	if decoder.More() {
+		return problems.ErrTrailingData
+	}
+
+	return binding.Validator.ValidateStruct(obj)
+}
+
+// bindJSON, bindURI e bindQuery validam a requisição antes dos controllers;
+// em caso de erro ele já fica registrado no contexto para o middleware
+func bindJSON(ctx *gin.Context, obj any) bool {
+	if err := ctx.ShouldBindWith(obj, strictJSON); err != nil {
+		_ = ctx.Error(problems.NewBindingError(err))
+		return false
+	}
+	return true
+}
+
+func bindURI(ctx *gin.Context, obj any) bool {
+	if err := ctx.ShouldBindUri(obj); err != nil {
+		_ = ctx.Error(problems.NewParameterBindingError(err))
+		return false
+	}
+	return true
+}
+
+func bindQuery(ctx *gin.Context, obj any) bool {
+	if err := ctx.ShouldBindQuery(obj); err != nil {
+		_ = ctx.Error(problems.NewParameterBindingError(err))
+		return false
+	}
+	return true
+}
+
+// bindAt lê o parâmetro opcional at (RFC 3339), usado para prever a
+// disponibilidade em outro horário; ausente, devolve nil e vale o horário atual
+func bindAt(ctx *gin.Context) (*time.Time, bool) {
+	value := ctx.Query("at")
+	if value == "" {
+		return nil, true
+	}
+
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		_ = ctx.Error(problems.InvalidDateTimeError("at"))
+		return nil, false
+	}
+	return &at, true
+}
+
+// storeIDHeader identifica a loja quando o cliente (o totem, por exemplo) não
+// quer repetir store_id em cada URL
+const storeIDHeader = "X-Store-ID"
+
+// bindStoreID lê a loja da leitura em store_id ou, sem ele, no header
+// X-Store-ID; ausente, devolve "" e vale o catálogo sem ajustes. O Vary
+// impede que caches intermediários sirvam a resposta de uma loja a outra
+func bindStoreID(ctx *gin.Context) (string, bool) {
+	ctx.Writer.Header().Add("Vary", storeIDHeader)
+
+	field, storeID := "store_id", ctx.Query("store_id")
+	if storeID == "" {
+		field, storeID = storeIDHeader, ctx.GetHeader(storeIDHeader)
+	}
+
+	if storeID != "" && identity_manager.IsNotValidUUID(storeID) {
+		_ = ctx.Error(problems.InvalidUUIDError(field))
+		return "", false
+	}
+	return storeID, true
+}
+
+// bindID valida o :id da rota e devolve o UUID
+func bindID(ctx *gin.Context) (string, bool) {
+	var uri schemas.IDURISchema
+	if !bindURI(ctx, &uri) {
+		return "", false
+	}
+	return uri.ID, true
+}
+
+const mergePatchContentType = "application/merge-patch+json"
+
+type mergePatchSchema interface {
+	NullableFields() []string
+	SetNullFields(fields []string)
+}
+
+// bindMergePatch lê um JSON Merge Patch (RFC 7396): o corpo precisa ser um
+// objeto, campos ausentes mantêm o valor atual e null só é aceito nos campos
+// que podem ser removidos
+func bindMergePatch(ctx *gin.Context, obj mergePatchSchema) bool {
+	if contentType := ctx.ContentType(); contentType != mergePatchContentType && contentType != binding.MIMEJSON {
+		_ = ctx.Error(problems.UnsupportedMediaTypeError(contentType, mergePatchContentType, binding.MIMEJSON))
+		return false
+	}
+
+	body, err := ctx.GetRawData()
+	if err != nil {
+		_ = ctx.Error(problems.NewBindingError(err))
+		return false
+	}
+
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		_ = ctx.Error(problems.NewBindingError(io.EOF))
+		return false
+	}
+
+	// Um patch que não é objeto substituiria o recurso inteiro
+	if body[0] != '{' {
+		_ = ctx.Error(problems.MalformedRequestError(problems.Text{
+			EN:   "merge patch must be a JSON object",
+			PTBR: "o merge patch deve ser um objeto JSON",
+		}))
+		return false
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		_ = ctx.Error(problems.NewBindingError(err))
+		return false
+	}
+
+	if err := strictJSON.BindBody(body, obj); err != nil {
+		_ = ctx.Error(problems.NewBindingError(err))
+		return false
+	}
+
+	var nullFields, notNullable []string
+	for field, value := range fields {
+		if string(bytes.TrimSpace(value)) != "null" {
+			continue
+		}
+		if slices.Contains(obj.NullableFields(), field) {
+			nullFields = append(nullFields, field)
+		} else {
+			notNullable = append(notNullable, field)
+		}
+	}
+
+	if len(notNullable) > 0 {
+		sort.Strings(notNullable)
+		_ = ctx.Error(problems.NotNullableError(notNullable...))
+		return false
+	}
+
+	obj.SetNullFields(nullFields)
+	return true
+}
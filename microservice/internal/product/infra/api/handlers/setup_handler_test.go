@@ -1,9 +1,11 @@
 package handlers
 
 import (
+	"github.com/gin-gonic/gin"
 	"os"
 	"tech_challenge/internal/product/application/controllers"
 	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	"tech_challenge/internal/shared/infra/api/middlewares"
 	testmocks "tech_challenge/internal/shared/test"
 
 	"testing"
@@ -15,11 +17,107 @@ func TestMain(m *testing.M) {
 	os.Exit(code)
 }
 
+const (
+	testCategoryID      = "2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"
+	testOtherCategoryID = "9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d"
+	testProductID       = "76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"
+)
+
+// Os testes usam os mesmos middlewares do servidor para validar o corpo
+// problem+json das respostas de erro
+func newTestRouter() *gin.Engine {
+	r := gin.New()
+	r.Use(middlewares.RequestIDMiddleware())
+	r.Use(middlewares.ErrorHandlerMiddleware())
+	return r
+}
+
 func setupProductHandlerWithFakeGateway(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource, fileProvider *mock_interfaces.MockIFileProvider) *ProductHandler {
-	ctrl := controllers.NewProductController(productDs, categoryDs, fileProvider)
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs}
+	ctrl := controllers.NewProductController(productDs, categoryDs, &testmocks.MockStockDataSource{}, &testmocks.MockTranslationDataSource{}, &testmocks.MockStoreDataSource{}, &testmocks.MockPromotionDataSource{}, transactionManager, fileProvider, nil)
 	return &ProductHandler{productController: *ctrl}
 }
+func setupBatchProductHandler(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource, batchMaxIDs int) *ProductHandler {
+	handler := setupProductHandlerWithFakeGateway(productDs, categoryDs, nil)
+	handler.batchMaxIDs = batchMaxIDs
+	return handler
+}
 func setupCategoryHandlerWithFakeGateway(categoryDs *testmocks.MockCategoryDataSource) *CategoryHandler {
-	ctrl := controllers.NewCategoryController(categoryDs)
+	transactionManager := &testmocks.MockTransactionManager{CategoryDataSource: categoryDs}
+	ctrl := controllers.NewCategoryController(categoryDs, &testmocks.MockTranslationDataSource{}, &testmocks.MockStoreDataSource{}, transactionManager, nil, nil)
+	return &CategoryHandler{categoryController: *ctrl}
+}
+func setupCategoryHandlerWithProducts(categoryDs *testmocks.MockCategoryDataSource, productDs *testmocks.MockProductDataSource) *CategoryHandler {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs}
+	ctrl := controllers.NewCategoryController(categoryDs, &testmocks.MockTranslationDataSource{}, &testmocks.MockStoreDataSource{}, transactionManager, nil, nil)
 	return &CategoryHandler{categoryController: *ctrl}
 }
+func setupCategoryHandlerWithFileProvider(categoryDs *testmocks.MockCategoryDataSource, fileProvider *mock_interfaces.MockIFileProvider) *CategoryHandler {
+	transactionManager := &testmocks.MockTransactionManager{CategoryDataSource: categoryDs}
+	ctrl := controllers.NewCategoryController(categoryDs, &testmocks.MockTranslationDataSource{}, &testmocks.MockStoreDataSource{}, transactionManager, fileProvider, nil)
+	return &CategoryHandler{categoryController: *ctrl}
+}
+func setupCatalogHandlerWithFakeGateway(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource) *CatalogHandler {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs}
+	ctrl := controllers.NewCatalogController(productDs, categoryDs, &testmocks.MockStockDataSource{}, &testmocks.MockTranslationDataSource{}, &testmocks.MockStoreDataSource{}, &testmocks.MockPromotionDataSource{}, transactionManager, nil, nil)
+	return &CatalogHandler{catalogController: *ctrl}
+}
+func setupQuoteHandler(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource, stockDs *testmocks.MockStockDataSource, promotionDs *testmocks.MockPromotionDataSource) *CatalogHandler {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs}
+	ctrl := controllers.NewCatalogController(productDs, categoryDs, stockDs, &testmocks.MockTranslationDataSource{}, &testmocks.MockStoreDataSource{}, promotionDs, transactionManager, nil, nil)
+	return &CatalogHandler{catalogController: *ctrl}
+}
+func setupMenuHandlerWithFakeGateway(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource) *MenuHandler {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs}
+	ctrl := controllers.NewCatalogController(productDs, categoryDs, &testmocks.MockStockDataSource{}, &testmocks.MockTranslationDataSource{}, &testmocks.MockStoreDataSource{}, &testmocks.MockPromotionDataSource{}, transactionManager, nil, nil)
+	return &MenuHandler{catalogController: *ctrl}
+}
+func setupStockHandlerWithFakeGateway(productDs *testmocks.MockProductDataSource, stockDs *testmocks.MockStockDataSource, publisher *testmocks.MockEventPublisher) *StockHandler {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, StockDataSource: stockDs}
+	ctrl := controllers.NewStockController(productDs, stockDs, transactionManager, publisher)
+	return &StockHandler{stockController: *ctrl}
+}
+func setupTranslationHandlerWithFakeGateway(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource, translationDs *testmocks.MockTranslationDataSource) *TranslationHandler {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs, TranslationDataSource: translationDs}
+	ctrl := controllers.NewTranslationController(productDs, categoryDs, translationDs, transactionManager, nil)
+	return &TranslationHandler{translationController: *ctrl}
+}
+func setupLocalizedProductHandler(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource, translationDs *testmocks.MockTranslationDataSource) *ProductHandler {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs, TranslationDataSource: translationDs}
+	ctrl := controllers.NewProductController(productDs, categoryDs, &testmocks.MockStockDataSource{}, translationDs, &testmocks.MockStoreDataSource{}, &testmocks.MockPromotionDataSource{}, transactionManager, nil, nil)
+	return &ProductHandler{productController: *ctrl}
+}
+func setupLocalizedMenuHandler(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource, translationDs *testmocks.MockTranslationDataSource) *MenuHandler {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs, TranslationDataSource: translationDs}
+	ctrl := controllers.NewCatalogController(productDs, categoryDs, &testmocks.MockStockDataSource{}, translationDs, &testmocks.MockStoreDataSource{}, &testmocks.MockPromotionDataSource{}, transactionManager, nil, nil)
+	return &MenuHandler{catalogController: *ctrl}
+}
+func setupStoreHandlerWithFakeGateway(storeDs *testmocks.MockStoreDataSource, productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource) *StoreHandler {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs, StoreDataSource: storeDs}
+	ctrl := controllers.NewStoreController(storeDs, productDs, categoryDs, transactionManager, nil)
+	return &StoreHandler{storeController: *ctrl}
+}
+func setupStoreScopedProductHandler(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource, storeDs *testmocks.MockStoreDataSource) *ProductHandler {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs, StoreDataSource: storeDs}
+	ctrl := controllers.NewProductController(productDs, categoryDs, &testmocks.MockStockDataSource{}, &testmocks.MockTranslationDataSource{}, storeDs, &testmocks.MockPromotionDataSource{}, transactionManager, nil, nil)
+	return &ProductHandler{productController: *ctrl}
+}
+func setupStoreScopedMenuHandler(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource, storeDs *testmocks.MockStoreDataSource) *MenuHandler {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs, StoreDataSource: storeDs}
+	ctrl := controllers.NewCatalogController(productDs, categoryDs, &testmocks.MockStockDataSource{}, &testmocks.MockTranslationDataSource{}, storeDs, &testmocks.MockPromotionDataSource{}, transactionManager, nil, nil)
+	return &MenuHandler{catalogController: *ctrl}
+}
+func setupPromotionHandlerWithFakeGateway(promotionDs *testmocks.MockPromotionDataSource, productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource) *PromotionHandler {
+	ctrl := controllers.NewPromotionController(promotionDs, productDs, categoryDs, nil)
+	return &PromotionHandler{promotionController: *ctrl}
+}
+func setupPromotedProductHandler(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource, promotionDs *testmocks.MockPromotionDataSource) *ProductHandler {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs}
+	ctrl := controllers.NewProductController(productDs, categoryDs, &testmocks.MockStockDataSource{}, &testmocks.MockTranslationDataSource{}, &testmocks.MockStoreDataSource{}, promotionDs, transactionManager, nil, nil)
+	return &ProductHandler{productController: *ctrl}
+}
+func setupPromotedMenuHandler(productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource, promotionDs *testmocks.MockPromotionDataSource) *MenuHandler {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs}
+	ctrl := controllers.NewCatalogController(productDs, categoryDs, &testmocks.MockStockDataSource{}, &testmocks.MockTranslationDataSource{}, &testmocks.MockStoreDataSource{}, promotionDs, transactionManager, nil, nil)
+	return &MenuHandler{catalogController: *ctrl}
+}
@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/factories"
+	"tech_challenge/internal/product/infra/api/schemas"
+	shared_factories "tech_challenge/internal/shared/factories"
+)
+
+// StockHandler atende o back-office (estoque de cada produto) e o serviço de
+// pedidos (reservas). As respostas não usam cache HTTP: o estoque muda a cada
+// reserva
+type StockHandler struct {
+	stockController controllers.StockController
+}
+
+func NewStockHandler() *StockHandler {
+	stockController := controllers.NewStockController(
+		factories.NewProductDataSource(),
+		factories.NewStockDataSource(),
+		factories.NewTransactionManager(),
+		shared_factories.NewEventPublisher(),
+	)
+
+	return &StockHandler{
+		stockController: *stockController,
+	}
+}
+
+// @Summary Get the stock of a product
+// @Description Products without stock settings do not track quantity and are available
+// @Tags Stock
+// @Produce json
+// @Param id path string true "Product ID" format(uuid)
+// @Success 200 {object} schemas.ProductStockResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /products/{id}/stock [get]
+func (h *StockHandler) FindProductStock(ctx *gin.Context) {
+	productID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	stock, err := h.stockController.FindByProductID(productID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToProductStockResponseSchema(stock))
+}
+
+// @Summary Replace the stock of a product
+// @Description Sets quantity tracking, the quantity free for sale and the manual sold out flag. A tracked product with quantity 0 is sold out automatically. Changes in availability are notified to EVENTS_WEBHOOK_URL.
+// @Tags Stock
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID" format(uuid)
+// @Param stock body schemas.UpdateProductStockSchema true "Stock settings"
+// @Success 200 {object} schemas.ProductStockResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /products/{id}/stock [put]
+func (h *StockHandler) UpdateProductStock(ctx *gin.Context) {
+	productID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	var stockRequestBody schemas.UpdateProductStockSchema
+
+	if !bindJSON(ctx, &stockRequestBody) {
+		return
+	}
+
+	stock, err := h.stockController.Update(stockRequestBody.ToDTO(productID))
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToProductStockResponseSchema(stock))
+}
+
+// @Summary Reserve stock for an order
+// @Description Reserves all items or none. Tracked quantities are decremented atomically; the reservation holds them until it is confirmed, released or expires.
+// @Tags Stock
+// @Accept json
+// @Produce json
+// @Param reservation body schemas.ReserveStockSchema true "Items to reserve"
+// @Success 201 {object} schemas.StockReservationResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 409 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /stock/reservations [post]
+func (h *StockHandler) Reserve(ctx *gin.Context) {
+	var reserveRequestBody schemas.ReserveStockSchema
+
+	if !bindJSON(ctx, &reserveRequestBody) {
+		return
+	}
+
+	reservation, err := h.stockController.Reserve(reserveRequestBody.ToDTO())
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, schemas.ToStockReservationResponseSchema(reservation))
+}
+
+// @Summary Get a stock reservation
+// @Tags Stock
+// @Produce json
+// @Param id path string true "Reservation ID" format(uuid)
+// @Success 200 {object} schemas.StockReservationResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /stock/reservations/{id} [get]
+func (h *StockHandler) FindReservation(ctx *gin.Context) {
+	reservationID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	reservation, err := h.stockController.FindReservation(reservationID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToStockReservationResponseSchema(reservation))
+}
+
+// @Summary Confirm a stock reservation
+// @Description Consumes the reserved units. Confirming again is a no-op; an expired reservation is released and cannot be confirmed.
+// @Tags Stock
+// @Produce json
+// @Param id path string true "Reservation ID" format(uuid)
+// @Success 200 {object} schemas.StockReservationResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 409 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /stock/reservations/{id}/confirm [post]
+func (h *StockHandler) ConfirmReservation(ctx *gin.Context) {
+	reservationID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	reservation, err := h.stockController.ConfirmReservation(reservationID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToStockReservationResponseSchema(reservation))
+}
+
+// @Summary Release a stock reservation
+// @Description Returns the reserved units to stock. Releasing again is a no-op; a confirmed reservation cannot be released.
+// @Tags Stock
+// @Produce json
+// @Param id path string true "Reservation ID" format(uuid)
+// @Success 200 {object} schemas.StockReservationResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 409 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /stock/reservations/{id}/release [post]
+func (h *StockHandler) ReleaseReservation(ctx *gin.Context) {
+	reservationID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	reservation, err := h.stockController.ReleaseReservation(reservationID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToStockReservationResponseSchema(reservation))
+}
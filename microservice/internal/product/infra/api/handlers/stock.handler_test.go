@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/infra/api/http_errors"
+	testmocks "tech_challenge/internal/shared/test"
+)
+
+const testReservationID = "0b4c6a9e-2f0e-4a3c-9a52-3f4f2b1d7c11"
+
+func TestFindProductStock_WithoutSettings(t *testing.T) {
+	h := setupStockHandlerWithFakeGateway(&testmocks.MockProductDataSource{}, &testmocks.MockStockDataSource{}, &testmocks.MockEventPublisher{})
+	r := newTestRouter()
+	r.GET("/products/:id/stock", h.FindProductStock)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/"+testProductID+"/stock", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"track_quantity":false`)
+	require.Contains(t, w.Body.String(), `"available":true`)
+}
+
+func TestUpdateProductStock_PublishesWhenSoldOut(t *testing.T) {
+	var saved daos.ProductStockDAO
+	stockDs := &testmocks.MockStockDataSource{
+		SaveFunc: func(stock daos.ProductStockDAO) error {
+			saved = stock
+			return nil
+		},
+	}
+	publisher := &testmocks.MockEventPublisher{}
+	h := setupStockHandlerWithFakeGateway(&testmocks.MockProductDataSource{}, stockDs, publisher)
+	r := newTestRouter()
+	r.PUT("/products/:id/stock", h.UpdateProductStock)
+
+	w := httptest.NewRecorder()
+	body := `{"track_quantity":true,"quantity":0}`
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/products/"+testProductID+"/stock", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"available":false`)
+	require.True(t, saved.Tracked)
+	require.Len(t, publisher.Events, 1)
+	require.Equal(t, "product.stock.availability_changed", publisher.Events[0].Type)
+}
+
+func TestUpdateProductStock_QuantityWithoutTracking(t *testing.T) {
+	h := setupStockHandlerWithFakeGateway(&testmocks.MockProductDataSource{}, &testmocks.MockStockDataSource{}, &testmocks.MockEventPublisher{})
+	r := newTestRouter()
+	r.PUT("/products/:id/stock", h.UpdateProductStock)
+
+	w := httptest.NewRecorder()
+	body := `{"track_quantity":false,"quantity":3}`
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/products/"+testProductID+"/stock", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, http_errors.CodeInvalidStockData, decodeProblem(t, w).Code)
+}
+
+func TestReserveStock_Created(t *testing.T) {
+	var inserted daos.StockReservationDAO
+	stockDs := &testmocks.MockStockDataSource{
+		InsertReservationFunc: func(reservation daos.StockReservationDAO) error {
+			inserted = reservation
+			return nil
+		},
+	}
+	h := setupStockHandlerWithFakeGateway(&testmocks.MockProductDataSource{}, stockDs, &testmocks.MockEventPublisher{})
+	r := newTestRouter()
+	r.POST("/stock/reservations", h.Reserve)
+
+	w := httptest.NewRecorder()
+	body := `{"items":[{"product_id":"` + testProductID + `","quantity":2}],"ttl_seconds":60}`
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/stock/reservations", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Contains(t, w.Body.String(), `"status":"pending"`)
+	require.Equal(t, time.Minute, inserted.ExpiresAt.Sub(inserted.CreatedAt))
+}
+
+func TestReserveStock_SoldOut(t *testing.T) {
+	stockDs := &testmocks.MockStockDataSource{
+		FindByProductIDFunc: func(productID string) (daos.ProductStockDAO, error) {
+			return daos.ProductStockDAO{ProductID: productID, SoldOut: true}, nil
+		},
+	}
+	h := setupStockHandlerWithFakeGateway(&testmocks.MockProductDataSource{}, stockDs, &testmocks.MockEventPublisher{})
+	r := newTestRouter()
+	r.POST("/stock/reservations", h.Reserve)
+
+	w := httptest.NewRecorder()
+	body := `{"items":[{"product_id":"` + testProductID + `","quantity":1}]}`
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/stock/reservations", strings.NewReader(body)))
+
+	require.Equal(t, http.StatusConflict, w.Code)
+	require.Equal(t, http_errors.CodeProductSoldOut, decodeProblem(t, w).Code)
+}
+
+func TestConfirmReservation_NotFound(t *testing.T) {
+	h := setupStockHandlerWithFakeGateway(&testmocks.MockProductDataSource{}, &testmocks.MockStockDataSource{}, &testmocks.MockEventPublisher{})
+	r := newTestRouter()
+	r.POST("/stock/reservations/:id/confirm", h.ConfirmReservation)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/stock/reservations/"+testReservationID+"/confirm", nil))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.Equal(t, http_errors.CodeStockReservationNotFound, decodeProblem(t, w).Code)
+}
+
+func TestReleaseReservation_Confirmed(t *testing.T) {
+	stockDs := &testmocks.MockStockDataSource{
+		FindReservationByIDFunc: func(id string) (daos.StockReservationDAO, error) {
+			return daos.StockReservationDAO{ID: id, Status: "confirmed", ExpiresAt: time.Now().Add(time.Minute)}, nil
+		},
+		UpdateReservationStatusFunc: func(string, string, string, time.Time) error {
+			return &exceptions.VersionConflictException{}
+		},
+	}
+	h := setupStockHandlerWithFakeGateway(&testmocks.MockProductDataSource{}, stockDs, &testmocks.MockEventPublisher{})
+	r := newTestRouter()
+	r.POST("/stock/reservations/:id/release", h.ReleaseReservation)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/stock/reservations/"+testReservationID+"/release", nil))
+
+	require.Equal(t, http.StatusConflict, w.Code)
+	require.Equal(t, http_errors.CodeInvalidStockReservationState, decodeProblem(t, w).Code)
+}
@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/factories"
+	"tech_challenge/internal/product/infra/api/schemas"
+)
+
+// StoreHandler mantém as lojas e os ajustes de cada uma sobre o catálogo
+// central (preço, ativação, horários e visibilidade de categorias). As
+// leituras de produtos, categorias e menu aplicam esses ajustes quando
+// recebem store_id ou X-Store-ID
+type StoreHandler struct {
+	storeController controllers.StoreController
+}
+
+func NewStoreHandler() *StoreHandler {
+	storeController := controllers.NewStoreController(
+		factories.NewStoreDataSource(),
+		factories.NewProductDataSource(),
+		factories.NewCategoryDataSource(),
+		factories.NewTransactionManager(),
+		factories.NewCatalogCache(),
+	)
+
+	return &StoreHandler{
+		storeController: *storeController,
+	}
+}
+
+// @Summary List all stores
+// @Tags Stores
+// @Produce json
+// @Success 200 {array} schemas.StoreResponseSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /stores/ [get]
+func (h *StoreHandler) FindAllStores(ctx *gin.Context) {
+	stores, err := h.storeController.FindAll()
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ListToStoreResponseSchema(stores))
+}
+
+// @Summary Get a store by ID
+// @Tags Stores
+// @Produce json
+// @Param id path string true "Store ID" format(uuid)
+// @Success 200 {object} schemas.StoreResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /stores/{id} [get]
+func (h *StoreHandler) FindStoreByID(ctx *gin.Context) {
+	storeID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	store, err := h.storeController.FindByID(storeID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToStoreResponseSchema(store))
+}
+
+// @Summary Create a store
+// @Tags Stores
+// @Accept json
+// @Produce json
+// @Param store body schemas.CreateStoreSchema true "Store to create"
+// @Success 201 {object} schemas.StoreResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /stores/ [post]
+func (h *StoreHandler) CreateStore(ctx *gin.Context) {
+	var storeRequestBody schemas.CreateStoreSchema
+
+	if !bindJSON(ctx, &storeRequestBody) {
+		return
+	}
+
+	store, err := h.storeController.Create(storeRequestBody.ToDTO())
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, schemas.ToStoreResponseSchema(store))
+}
+
+// @Summary Update a store by ID
+// @Tags Stores
+// @Accept json
+// @Produce json
+// @Param id path string true "Store ID" format(uuid)
+// @Param store body schemas.UpdateStoreSchema true "Updated store data"
+// @Success 200 {object} schemas.StoreResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /stores/{id} [put]
+func (h *StoreHandler) UpdateStore(ctx *gin.Context) {
+	storeID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	var storeRequestBody schemas.UpdateStoreSchema
+
+	if !bindJSON(ctx, &storeRequestBody) {
+		return
+	}
+
+	store, err := h.storeController.Update(storeRequestBody.ToDTO(storeID))
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToStoreResponseSchema(store))
+}
+
+// @Summary Delete a store by ID
+// @Description The overrides of the store are deleted with it
+// @Tags Stores
+// @Param id path string true "Store ID" format(uuid)
+// @Success 204
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /stores/{id} [delete]
+func (h *StoreHandler) DeleteStore(ctx *gin.Context) {
+	storeID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.storeController.Delete(storeID); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// @Summary List the overrides of a store
+// @Tags Stores
+// @Produce json
+// @Param id path string true "Store ID" format(uuid)
+// @Success 200 {object} schemas.StoreOverridesResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /stores/{id}/overrides [get]
+func (h *StoreHandler) FindStoreOverrides(ctx *gin.Context) {
+	storeID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	overrides, err := h.storeController.FindOverrides(storeID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToStoreOverridesResponseSchema(overrides))
+}
+
+// @Summary Create or replace overrides of a store in bulk
+// @Description Each item replaces the override of its product or category; items not sent are kept. Fields left out inherit the catalog value, and an item with no fields removes the override. Everything is validated before anything is written, in a single transaction.
+// @Tags Stores
+// @Accept json
+// @Produce json
+// @Param id path string true "Store ID" format(uuid)
+// @Param overrides body schemas.SaveStoreOverridesSchema true "Product and category overrides"
+// @Success 200 {object} schemas.StoreOverridesResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /stores/{id}/overrides [patch]
+func (h *StoreHandler) SaveStoreOverrides(ctx *gin.Context) {
+	storeID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	var overridesRequestBody schemas.SaveStoreOverridesSchema
+
+	if !bindJSON(ctx, &overridesRequestBody) {
+		return
+	}
+
+	overrides, err := h.storeController.SaveOverrides(overridesRequestBody.ToDTO(storeID))
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToStoreOverridesResponseSchema(overrides))
+}
+
+// @Summary Delete all overrides of a store
+// @Description The store falls back to the central catalog
+// @Tags Stores
+// @Param id path string true "Store ID" format(uuid)
+// @Success 204
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /stores/{id}/overrides [delete]
+func (h *StoreHandler) ClearStoreOverrides(ctx *gin.Context) {
+	storeID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.storeController.ClearOverrides(storeID); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,276 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/infra/api/http_errors"
+	testmocks "tech_challenge/internal/shared/test"
+)
+
+const testStoreID = "5f0c7a52-8d8e-4c4a-9d37-2b0b9a1f6e11"
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func storeDs() *testmocks.MockStoreDataSource {
+	return &testmocks.MockStoreDataSource{Stores: []daos.StoreDAO{{ID: testStoreID, Name: "Loja Paulista"}}}
+}
+
+func TestCreateStore(t *testing.T) {
+	ds := &testmocks.MockStoreDataSource{}
+	h := setupStoreHandlerWithFakeGateway(ds, translationProductDs(), translationCategoryDs())
+	r := newTestRouter()
+	r.POST("/stores", h.CreateStore)
+
+	req := httptest.NewRequest(http.MethodPost, "/stores", strings.NewReader(`{"name":"  Loja Paulista "}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, "Loja Paulista", resp["name"])
+	require.NotEmpty(t, resp["id"])
+	require.Len(t, ds.Stores, 1)
+}
+
+func TestCreateStore_InvalidName(t *testing.T) {
+	h := setupStoreHandlerWithFakeGateway(&testmocks.MockStoreDataSource{}, translationProductDs(), translationCategoryDs())
+	r := newTestRouter()
+	r.POST("/stores", h.CreateStore)
+
+	req := httptest.NewRequest(http.MethodPost, "/stores", strings.NewReader(`{"name":"ab"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestFindStoreByID_NotFound(t *testing.T) {
+	h := setupStoreHandlerWithFakeGateway(&testmocks.MockStoreDataSource{}, translationProductDs(), translationCategoryDs())
+	r := newTestRouter()
+	r.GET("/stores/:id", h.FindStoreByID)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/stores/"+testStoreID, nil))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.Equal(t, http_errors.CodeStoreNotFound, decodeProblem(t, w).Code)
+}
+
+func TestUpdateStore(t *testing.T) {
+	ds := storeDs()
+	h := setupStoreHandlerWithFakeGateway(ds, translationProductDs(), translationCategoryDs())
+	r := newTestRouter()
+	r.PUT("/stores/:id", h.UpdateStore)
+
+	req := httptest.NewRequest(http.MethodPut, "/stores/"+testStoreID, strings.NewReader(`{"name":"Loja Centro"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "Loja Centro", ds.Stores[0].Name)
+}
+
+func TestDeleteStore_RemovesOverrides(t *testing.T) {
+	ds := storeDs()
+	ds.ProductOverrides = []daos.ProductStoreOverrideDAO{{StoreID: testStoreID, ProductID: testProductID, Price: ptr(30.0)}}
+	h := setupStoreHandlerWithFakeGateway(ds, translationProductDs(), translationCategoryDs())
+	r := newTestRouter()
+	r.DELETE("/stores/:id", h.DeleteStore)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/stores/"+testStoreID, nil))
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+	require.Empty(t, ds.Stores)
+	require.Empty(t, ds.ProductOverrides)
+}
+
+func TestSaveStoreOverrides(t *testing.T) {
+	ds := storeDs()
+	ds.CategoryOverrides = []daos.CategoryStoreOverrideDAO{{StoreID: testStoreID, CategoryID: testOtherCategoryID, Visible: ptr(false)}}
+	h := setupStoreHandlerWithFakeGateway(ds, translationProductDs(), translationCategoryDs())
+	r := newTestRouter()
+	r.PATCH("/stores/:id/overrides", h.SaveStoreOverrides)
+
+	body := `{
+		"products":[{"product_id":"` + testProductID + `","price":22.9,"active":false}],
+		"categories":[{"category_id":"` + testCategoryID + `","visible":false}]
+	}`
+	req := httptest.NewRequest(http.MethodPatch, "/stores/"+testStoreID+"/overrides", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp struct {
+		StoreID  string `json:"store_id"`
+		Products []struct {
+			ProductID string   `json:"product_id"`
+			Price     *float64 `json:"price"`
+			Active    *bool    `json:"active"`
+		} `json:"products"`
+		Categories []struct {
+			CategoryID string `json:"category_id"`
+		} `json:"categories"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, testStoreID, resp.StoreID)
+	require.Len(t, resp.Products, 1)
+	require.Equal(t, 22.9, *resp.Products[0].Price)
+	require.False(t, *resp.Products[0].Active)
+	// Os ajustes não enviados continuam valendo
+	require.Len(t, resp.Categories, 2)
+}
+
+func TestSaveStoreOverrides_EmptyItemRemovesOverride(t *testing.T) {
+	ds := storeDs()
+	ds.ProductOverrides = []daos.ProductStoreOverrideDAO{{StoreID: testStoreID, ProductID: testProductID, Price: ptr(30.0)}}
+	h := setupStoreHandlerWithFakeGateway(ds, translationProductDs(), translationCategoryDs())
+	r := newTestRouter()
+	r.PATCH("/stores/:id/overrides", h.SaveStoreOverrides)
+
+	req := httptest.NewRequest(http.MethodPatch, "/stores/"+testStoreID+"/overrides", strings.NewReader(`{"products":[{"product_id":"`+testProductID+`"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Empty(t, ds.ProductOverrides)
+}
+
+func TestSaveStoreOverrides_UnknownProductWritesNothing(t *testing.T) {
+	ds := storeDs()
+	productDs := translationProductDs()
+	productDs.FindByIDFunc = func(id string) (daos.ProductDAO, error) {
+		if id == testProductID {
+			return daos.ProductDAO{ID: id, Name: "X-Salada", Price: 25, Active: true, CategoryID: testCategoryID}, nil
+		}
+		return daos.ProductDAO{}, &exceptions.RecordNotFoundException{}
+	}
+	h := setupStoreHandlerWithFakeGateway(ds, productDs, translationCategoryDs())
+	r := newTestRouter()
+	r.PATCH("/stores/:id/overrides", h.SaveStoreOverrides)
+
+	body := `{"products":[
+		{"product_id":"` + testProductID + `","price":22.9},
+		{"product_id":"` + testOtherCategoryID + `","price":10}
+	]}`
+	req := httptest.NewRequest(http.MethodPatch, "/stores/"+testStoreID+"/overrides", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", "pt-BR")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	problem := decodeProblem(t, w)
+	require.Equal(t, http_errors.CodeProductNotFound, problem.Code)
+	require.Equal(t, "products[1]: produto "+testOtherCategoryID+" não encontrado", problem.Detail)
+	require.Empty(t, ds.ProductOverrides)
+}
+
+func TestClearStoreOverrides(t *testing.T) {
+	ds := storeDs()
+	ds.ProductOverrides = []daos.ProductStoreOverrideDAO{{StoreID: testStoreID, ProductID: testProductID, Price: ptr(30.0)}}
+	ds.CategoryOverrides = []daos.CategoryStoreOverrideDAO{{StoreID: testStoreID, CategoryID: testCategoryID, Visible: ptr(false)}}
+	h := setupStoreHandlerWithFakeGateway(ds, translationProductDs(), translationCategoryDs())
+	r := newTestRouter()
+	r.DELETE("/stores/:id/overrides", h.ClearStoreOverrides)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/stores/"+testStoreID+"/overrides", nil))
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+	require.Empty(t, ds.ProductOverrides)
+	require.Empty(t, ds.CategoryOverrides)
+}
+
+func TestFindProductByID_StoreScoped(t *testing.T) {
+	ds := storeDs()
+	ds.ProductOverrides = []daos.ProductStoreOverrideDAO{{StoreID: testStoreID, ProductID: testProductID, Price: ptr(30.0)}}
+	h := setupStoreScopedProductHandler(translationProductDs(), translationCategoryDs(), ds)
+	r := newTestRouter()
+	r.GET("/products/:id", h.FindProductByID)
+
+	req := httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil)
+	req.Header.Set("X-Store-ID", testStoreID)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Header().Values("Vary"), "X-Store-ID")
+	// O ETag não pode ser a versão do produto, que não muda com os ajustes da loja
+	require.NotEqual(t, `"3"`, w.Header().Get("ETag"))
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 30.0, resp["price"])
+
+	// Sem loja, vale o preço do catálogo
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil))
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 25.0, resp["price"])
+}
+
+func TestFindProductByID_InvalidStoreID(t *testing.T) {
+	h := setupStoreScopedProductHandler(translationProductDs(), translationCategoryDs(), storeDs())
+	r := newTestRouter()
+	r.GET("/products/:id", h.FindProductByID)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/"+testProductID+"?store_id=loja-1", nil))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestFindProductByID_UnknownStore(t *testing.T) {
+	h := setupStoreScopedProductHandler(translationProductDs(), translationCategoryDs(), &testmocks.MockStoreDataSource{})
+	r := newTestRouter()
+	r.GET("/products/:id", h.FindProductByID)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/"+testProductID+"?store_id="+testStoreID, nil))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.Equal(t, http_errors.CodeStoreNotFound, decodeProblem(t, w).Code)
+}
+
+func TestFindMenu_StoreScoped(t *testing.T) {
+	productDs, categoryDs := menuDataSources()
+	// Com um produto ativado pela loja, o menu precisa ler também os inativos
+	productDs.FindAllFunc = func() ([]daos.ProductDAO, error) {
+		return []daos.ProductDAO{
+			{ID: testProductID, CategoryID: testCategoryID, Name: "X-Salada", Price: 20.5, Active: true},
+			{ID: "p-suco", CategoryID: testOtherCategoryID, Name: "Suco", Price: 7, Active: false},
+		}, nil
+	}
+	ds := storeDs()
+	ds.ProductOverrides = []daos.ProductStoreOverrideDAO{{StoreID: testStoreID, ProductID: "p-suco", Active: ptr(true), Price: ptr(8.5)}}
+	ds.CategoryOverrides = []daos.CategoryStoreOverrideDAO{{StoreID: testStoreID, CategoryID: testCategoryID, Visible: ptr(false)}}
+	h := setupStoreScopedMenuHandler(productDs, categoryDs, ds)
+	r := newTestRouter()
+	r.GET("/menu", h.FindMenu)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/menu?compact=true&store_id="+testStoreID, nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"categories":[
+		{"id":"`+testOtherCategoryID+`","name":"Bebidas","subcategories":[],"products":[
+			{"id":"p-suco","name":"Suco","price":8.5,"original_price":8.5,"effective_price":8.5,"sold_out":false}
+		]}
+	]}`, w.Body.String())
+}
@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/factories"
+	"tech_challenge/internal/product/infra/api/schemas"
+)
+
+// TranslationHandler mantém os textos de produtos e categorias nos idiomas
+// além do padrão (pt-BR). Gravar ou remover uma tradução avança a versão do
+// item, invalidando o ETag das leituras
+type TranslationHandler struct {
+	translationController controllers.TranslationController
+}
+
+func NewTranslationHandler() *TranslationHandler {
+	translationController := controllers.NewTranslationController(
+		factories.NewProductDataSource(),
+		factories.NewCategoryDataSource(),
+		factories.NewTranslationDataSource(),
+		factories.NewTransactionManager(),
+		factories.NewCatalogCache(),
+	)
+
+	return &TranslationHandler{
+		translationController: *translationController,
+	}
+}
+
+// @Summary List the translations of a product
+// @Tags Translations
+// @Produce json
+// @Param id path string true "Product ID" format(uuid)
+// @Success 200 {array} schemas.TranslationResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /products/{id}/translations [get]
+func (h *TranslationHandler) FindProductTranslations(ctx *gin.Context) {
+	h.findTranslations(ctx, dtos.ProductTranslationTarget)
+}
+
+// @Summary Create or replace the translation of a product
+// @Description Name and description in a locale other than pt-BR. An empty description keeps the pt-BR one.
+// @Tags Translations
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID" format(uuid)
+// @Param locale path string true "Locale" Enums(en, es)
+// @Param translation body schemas.SaveTranslationSchema true "Translated texts"
+// @Success 200 {object} schemas.TranslationResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 412 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /products/{id}/translations/{locale} [put]
+func (h *TranslationHandler) SaveProductTranslation(ctx *gin.Context) {
+	h.saveTranslation(ctx, dtos.ProductTranslationTarget)
+}
+
+// @Summary Delete the translation of a product
+// @Description The product falls back to pt-BR in this locale
+// @Tags Translations
+// @Param id path string true "Product ID" format(uuid)
+// @Param locale path string true "Locale" Enums(en, es)
+// @Success 204
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /products/{id}/translations/{locale} [delete]
+func (h *TranslationHandler) DeleteProductTranslation(ctx *gin.Context) {
+	h.deleteTranslation(ctx, dtos.ProductTranslationTarget)
+}
+
+// @Summary List the translations of a category
+// @Tags Translations
+// @Produce json
+// @Param id path string true "Category ID" format(uuid)
+// @Success 200 {array} schemas.TranslationResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /categories/{id}/translations [get]
+func (h *TranslationHandler) FindCategoryTranslations(ctx *gin.Context) {
+	h.findTranslations(ctx, dtos.CategoryTranslationTarget)
+}
+
+// @Summary Create or replace the translation of a category
+// @Description Name and description in a locale other than pt-BR. An empty description keeps the pt-BR one.
+// @Tags Translations
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID" format(uuid)
+// @Param locale path string true "Locale" Enums(en, es)
+// @Param translation body schemas.SaveTranslationSchema true "Translated texts"
+// @Success 200 {object} schemas.TranslationResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 412 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /categories/{id}/translations/{locale} [put]
+func (h *TranslationHandler) SaveCategoryTranslation(ctx *gin.Context) {
+	h.saveTranslation(ctx, dtos.CategoryTranslationTarget)
+}
+
+// @Summary Delete the translation of a category
+// @Description The category falls back to pt-BR in this locale
+// @Tags Translations
+// @Param id path string true "Category ID" format(uuid)
+// @Param locale path string true "Locale" Enums(en, es)
+// @Success 204
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 404 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /categories/{id}/translations/{locale} [delete]
+func (h *TranslationHandler) DeleteCategoryTranslation(ctx *gin.Context) {
+	h.deleteTranslation(ctx, dtos.CategoryTranslationTarget)
+}
+
+// @Summary Report missing translations
+// @Description Per locale, products and categories without a translation or with the description still untranslated. Inactive items are included.
+// @Tags Translations
+// @Produce json
+// @Param locale query string false "Report only this locale" Enums(en, es)
+// @Success 200 {array} schemas.MissingTranslationsResponseSchema
+// @Failure 400 {object} schemas.ProblemSchema
+// @Failure 500 {object} schemas.ProblemSchema
+// @Failure 503 {object} schemas.ProblemSchema
+// @Router /translations/missing [get]
+func (h *TranslationHandler) FindMissingTranslations(ctx *gin.Context) {
+	var query schemas.MissingTranslationsQuerySchema
+
+	if !bindQuery(ctx, &query) {
+		return
+	}
+
+	reports, err := h.translationController.FindMissing(query.Locale)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToMissingTranslationsResponseSchema(reports))
+}
+
+func (h *TranslationHandler) findTranslations(ctx *gin.Context, target string) {
+	entityID, ok := bindID(ctx)
+	if !ok {
+		return
+	}
+
+	translations, err := h.translationController.FindAll(target, entityID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ListToTranslationResponseSchema(translations))
+}
+
+func (h *TranslationHandler) saveTranslation(ctx *gin.Context, target string) {
+	var uri schemas.TranslationURISchema
+
+	if !bindURI(ctx, &uri) {
+		return
+	}
+
+	var translationRequestBody schemas.SaveTranslationSchema
+
+	if !bindJSON(ctx, &translationRequestBody) {
+		return
+	}
+
+	translation, err := h.translationController.Save(translationRequestBody.ToDTO(target, uri))
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schemas.ToTranslationResponseSchema(translation))
+}
+
+func (h *TranslationHandler) deleteTranslation(ctx *gin.Context, target string) {
+	var uri schemas.TranslationURISchema
+
+	if !bindURI(ctx, &uri) {
+		return
+	}
+
+	err := h.translationController.Delete(dtos.DeleteTranslationDTO{
+		Target:   target,
+		EntityID: uri.ID,
+		Locale:   uri.Locale,
+	})
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/infra/api/http_errors"
+	testmocks "tech_challenge/internal/shared/test"
+)
+
+func translationProductDs() *testmocks.MockProductDataSource {
+	return &testmocks.MockProductDataSource{
+		FindByIDFunc: func(id string) (daos.ProductDAO, error) {
+			return daos.ProductDAO{ID: id, Name: "X-Salada", Description: "Pão, carne e queijo", Price: 25, Active: true, CategoryID: testCategoryID, Version: 3}, nil
+		},
+		FindAllFunc: func() ([]daos.ProductDAO, error) {
+			return []daos.ProductDAO{
+				{ID: testProductID, Name: "X-Salada", Description: "Pão, carne e queijo", Price: 25, Active: true, CategoryID: testCategoryID},
+				{ID: "p2", Name: "Pudim", Description: "Pudim de leite", Price: 9, Active: true, CategoryID: testCategoryID},
+			}, nil
+		},
+	}
+}
+
+func translationCategoryDs() *testmocks.MockCategoryDataSource {
+	return &testmocks.MockCategoryDataSource{
+		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
+			return daos.CategoryDAO{ID: id, Name: "Lanches", Active: true}, nil
+		},
+		FindAllFunc: func() ([]daos.CategoryDAO, error) {
+			return []daos.CategoryDAO{{ID: testCategoryID, Name: "Lanches", Active: true}}, nil
+		},
+	}
+}
+
+func TestSaveProductTranslation(t *testing.T) {
+	var updatedVersion int64
+	productDs := translationProductDs()
+	productDs.UpdateFunc = func(dao daos.ProductDAO) error {
+		updatedVersion = dao.Version
+		return nil
+	}
+	translationDs := &testmocks.MockTranslationDataSource{}
+	h := setupTranslationHandlerWithFakeGateway(productDs, translationCategoryDs(), translationDs)
+	r := newTestRouter()
+	r.PUT("/products/:id/translations/:locale", h.SaveProductTranslation)
+
+	body := `{"name":"Cheeseburger","description":"Beef and cheese"}`
+	req := httptest.NewRequest(http.MethodPut, "/products/"+testProductID+"/translations/en", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, "en", resp["locale"])
+	require.Equal(t, "Cheeseburger", resp["name"])
+
+	// A gravação da tradução passa pela versão do produto
+	require.Equal(t, int64(3), updatedVersion)
+	require.Len(t, translationDs.Translations, 1)
+	require.Equal(t, "product", translationDs.Translations[0].Target)
+}
+
+func TestSaveProductTranslation_InvalidLocale(t *testing.T) {
+	h := setupTranslationHandlerWithFakeGateway(translationProductDs(), translationCategoryDs(), &testmocks.MockTranslationDataSource{})
+	r := newTestRouter()
+	r.PUT("/products/:id/translations/:locale", h.SaveProductTranslation)
+
+	req := httptest.NewRequest(http.MethodPut, "/products/"+testProductID+"/translations/fr", strings.NewReader(`{"name":"Croque"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", "pt-BR")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	problem := decodeProblem(t, w)
+	require.Equal(t, http_errors.CodeInvalidLocale, problem.Code)
+	require.Equal(t, `idioma "fr" não suportado`, problem.Detail)
+}
+
+func TestFindCategoryTranslations(t *testing.T) {
+	translationDs := &testmocks.MockTranslationDataSource{Translations: []daos.TranslationDAO{
+		{Target: "category", EntityID: testCategoryID, Locale: "en", Name: "Sandwiches"},
+		{Target: "category", EntityID: testCategoryID, Locale: "es", Name: "Bocadillos"},
+		{Target: "product", EntityID: testCategoryID, Locale: "en", Name: "Other target"},
+	}}
+	h := setupTranslationHandlerWithFakeGateway(translationProductDs(), translationCategoryDs(), translationDs)
+	r := newTestRouter()
+	r.GET("/categories/:id/translations", h.FindCategoryTranslations)
+
+	req := httptest.NewRequest(http.MethodGet, "/categories/"+testCategoryID+"/translations", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp []map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 2)
+	require.Equal(t, "Sandwiches", resp[0]["name"])
+	require.Equal(t, "es", resp[1]["locale"])
+}
+
+func TestDeleteProductTranslation_NotFound(t *testing.T) {
+	productDs := translationProductDs()
+	productDs.UpdateFunc = func(daos.ProductDAO) error { return nil }
+	h := setupTranslationHandlerWithFakeGateway(productDs, translationCategoryDs(), &testmocks.MockTranslationDataSource{})
+	r := newTestRouter()
+	r.DELETE("/products/:id/translations/:locale", h.DeleteProductTranslation)
+
+	req := httptest.NewRequest(http.MethodDelete, "/products/"+testProductID+"/translations/es", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.Equal(t, http_errors.CodeTranslationNotFound, decodeProblem(t, w).Code)
+}
+
+func TestFindMissingTranslations(t *testing.T) {
+	translationDs := &testmocks.MockTranslationDataSource{Translations: []daos.TranslationDAO{
+		{Target: "product", EntityID: testProductID, Locale: "en", Name: "Cheeseburger", Description: "Beef and cheese"},
+		{Target: "category", EntityID: testCategoryID, Locale: "en", Name: "Sandwiches"},
+	}}
+	h := setupTranslationHandlerWithFakeGateway(translationProductDs(), translationCategoryDs(), translationDs)
+	r := newTestRouter()
+	r.GET("/translations/missing", h.FindMissingTranslations)
+
+	req := httptest.NewRequest(http.MethodGet, "/translations/missing?locale=en", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[{
+		"locale": "en",
+		"products": {"total": 2, "complete": 1, "missing": [{"id": "p2", "name": "Pudim", "missing_fields": ["name", "description"]}]},
+		"categories": {"total": 1, "complete": 1, "missing": []}
+	}]`, w.Body.String())
+}
+
+func TestFindProductByID_Localized(t *testing.T) {
+	translationDs := &testmocks.MockTranslationDataSource{Translations: []daos.TranslationDAO{
+		{Target: "product", EntityID: testProductID, Locale: "en", Name: "Cheeseburger"},
+	}}
+	h := setupLocalizedProductHandler(translationProductDs(), translationCategoryDs(), translationDs)
+	r := newTestRouter()
+	r.GET("/products/:id", h.FindProductByID)
+
+	cases := []struct {
+		acceptLanguage  string
+		contentLanguage string
+		name            string
+	}{
+		{"en-US,en;q=0.9", "en", "Cheeseburger"},
+		// Sem tradução em espanhol, o nome fica no idioma padrão
+		{"es", "es", "X-Salada"},
+		{"fr-FR", "pt-BR", "X-Salada"},
+		{"", "pt-BR", "X-Salada"},
+	}
+	for _, c := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil)
+		if c.acceptLanguage != "" {
+			req.Header.Set("Accept-Language", c.acceptLanguage)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, c.contentLanguage, w.Header().Get("Content-Language"))
+		require.Contains(t, w.Header().Values("Vary"), "Accept-Language")
+
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Equal(t, c.name, resp["name"], c.acceptLanguage)
+		// A descrição sem tradução fica no idioma padrão
+		require.Equal(t, "Pão, carne e queijo", resp["description"])
+	}
+}
+
+func TestFindProductByID_LocalizedETag(t *testing.T) {
+	translationDs := &testmocks.MockTranslationDataSource{Translations: []daos.TranslationDAO{
+		{Target: "product", EntityID: testProductID, Locale: "en", Name: "Cheeseburger"},
+	}}
+	h := setupLocalizedProductHandler(translationProductDs(), translationCategoryDs(), translationDs)
+	r := newTestRouter()
+	r.GET("/products/:id", h.FindProductByID)
+	find := func(header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/products/"+testProductID, nil)
+		req.Header = header
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	original := find(http.Header{})
+	require.Equal(t, `"3"`, original.Header().Get("ETag"))
+
+	// A cópia em pt-BR não vale para quem passa a pedir em inglês
+	translated := find(http.Header{
+		"Accept-Language":   {"en"},
+		"If-None-Match":     {`"3"`},
+		"If-Modified-Since": {time.Now().UTC().Format(http.TimeFormat)},
+	})
+	require.Equal(t, http.StatusOK, translated.Code)
+	require.Contains(t, translated.Body.String(), `"name":"Cheeseburger"`)
+	require.NotEqual(t, `"3"`, translated.Header().Get("ETag"))
+	require.Empty(t, translated.Header().Get("Last-Modified"))
+
+	revalidated := find(http.Header{"Accept-Language": {"en"}, "If-None-Match": {translated.Header().Get("ETag")}})
+	require.Equal(t, http.StatusNotModified, revalidated.Code)
+
+	back := find(http.Header{"If-None-Match": {translated.Header().Get("ETag")}})
+	require.Equal(t, http.StatusOK, back.Code)
+	require.Contains(t, back.Body.String(), `"name":"X-Salada"`)
+}
+
+func TestFindCategoryByID_LocalizedETag(t *testing.T) {
+	categoryDs := &testmocks.MockCategoryDataSource{
+		FindByIDFunc: func(id string) (daos.CategoryDAO, error) {
+			return daos.CategoryDAO{ID: id, Name: "Lanches", Active: true, Version: 2}, nil
+		},
+	}
+	r, _, h := setupCategoryTestEnv(categoryDs)
+	r.GET("/categories/:id", h.FindCategoryByID)
+	find := func(header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/categories/"+testCategoryID, nil)
+		req.Header = header
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	require.Equal(t, `"2"`, find(http.Header{}).Header().Get("ETag"))
+
+	translated := find(http.Header{"Accept-Language": {"es"}, "If-None-Match": {`"2"`}})
+	require.Equal(t, http.StatusOK, translated.Code)
+	require.NotEqual(t, `"2"`, translated.Header().Get("ETag"))
+}
+
+func TestFindMenu_Localized(t *testing.T) {
+	translationDs := &testmocks.MockTranslationDataSource{Translations: []daos.TranslationDAO{
+		{Target: "product", EntityID: testProductID, Locale: "es", Name: "Ensalada X"},
+		{Target: "category", EntityID: testCategoryID, Locale: "es", Name: "Bocadillos", Description: "A la plancha"},
+	}}
+	productDs, categoryDs := menuDataSources()
+	h := setupLocalizedMenuHandler(productDs, categoryDs, translationDs)
+	r := newTestRouter()
+	r.GET("/menu", h.FindMenu)
+
+	req := httptest.NewRequest(http.MethodGet, "/menu", nil)
+	req.Header.Set("Accept-Language", "es-ES")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "es", w.Header().Get("Content-Language"))
+	require.JSONEq(t, `{"categories":[
+		{"id":"`+testCategoryID+`","name":"Bocadillos","description":"A la plancha","subcategories":[],"products":[
+			{"id":"`+testProductID+`","name":"Ensalada X","description":"Lanche com carne","price":20.5,"original_price":20.5,"effective_price":20.5,"image":{"file_name":"x-salada.png","url":"http://bucket/x-salada.png"},"sold_out":false}
+		]},
+		{"id":"`+testOtherCategoryID+`","name":"Bebidas","description":"Geladas","subcategories":[],"products":[]}
+	]}`, w.Body.String())
+}
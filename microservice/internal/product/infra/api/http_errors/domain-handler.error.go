@@ -1,47 +1,255 @@
 package http_errors
 
 import (
+	"errors"
+	"log"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
 	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/shared/infra/api/problems"
+)
+
+// Códigos estáveis de cada exceção do domínio; os clientes devem tratar os
+// erros por eles, nunca pelo texto do detail
+const (
+	CodeProductNotFound       = "PRODUCT_NOT_FOUND"
+	CodeProductAlreadyExists  = "PRODUCT_ALREADY_EXISTS"
+	CodeInvalidProductData    = "INVALID_PRODUCT_DATA"
+	CodeInvalidProductImage   = "INVALID_PRODUCT_IMAGE"
+	CodeImageNotFound         = "IMAGE_NOT_FOUND"
+	CodeProductImagesNotFound = "PRODUCT_IMAGES_NOT_FOUND"
+	CodeProductImageRequired  = "PRODUCT_IMAGE_REQUIRED"
+	CodeCategoryNotFound      = "CATEGORY_NOT_FOUND"
+	CodeCategoryAlreadyExists = "CATEGORY_ALREADY_EXISTS"
+	CodeInvalidCategoryData   = "INVALID_CATEGORY_DATA"
+	CodeCategoryHasProducts   = "CATEGORY_HAS_PRODUCTS"
+	CodeCategoryHasChildren   = "CATEGORY_HAS_CHILDREN"
+	CodeInvalidAvailability   = "INVALID_AVAILABILITY"
+	CodeRecordNotFound        = "RECORD_NOT_FOUND"
+	CodeRecordConflict        = "RECORD_CONFLICT"
+	CodeForeignKeyViolation   = "FOREIGN_KEY_VIOLATION"
+	CodePreconditionFailed    = "PRECONDITION_FAILED"
+	CodeDatabaseTimeout       = "DATABASE_TIMEOUT"
+	CodeDatabaseUnavailable   = "DATABASE_UNAVAILABLE"
+	CodeStorageDeleteFailed   = "STORAGE_DELETE_FAILED"
+	CodeBucketNotFound        = "BUCKET_NOT_FOUND"
+)
+
+// Estoque e reservas
+const (
+	CodeInvalidStockData             = "INVALID_STOCK_DATA"
+	CodeInsufficientStock            = "INSUFFICIENT_STOCK"
+	CodeProductSoldOut               = "PRODUCT_SOLD_OUT"
+	CodeStockReservationNotFound     = "STOCK_RESERVATION_NOT_FOUND"
+	CodeStockReservationExpired      = "STOCK_RESERVATION_EXPIRED"
+	CodeInvalidStockReservationState = "INVALID_STOCK_RESERVATION_STATE"
+)
+
+// Informação nutricional
+const (
+	CodeInvalidNutritionFacts = "INVALID_NUTRITION_FACTS"
+	CodeInvalidAllergen       = "INVALID_ALLERGEN"
+)
+
+// Traduções
+const (
+	CodeInvalidLocale       = "INVALID_LOCALE"
+	CodeInvalidTranslation  = "INVALID_TRANSLATION"
+	CodeTranslationNotFound = "TRANSLATION_NOT_FOUND"
+)
+
+// Lojas
+const (
+	CodeStoreNotFound        = "STORE_NOT_FOUND"
+	CodeInvalidStoreData     = "INVALID_STORE_DATA"
+	CodeInvalidStoreOverride = "INVALID_STORE_OVERRIDE"
+)
+
+// Promoções
+const (
+	CodePromotionNotFound = "PROMOTION_NOT_FOUND"
+	CodeInvalidPromotion  = "INVALID_PROMOTION"
+)
+
+func definition(status int, code, titleEN, titlePTBR string) problems.Definition {
+	return problems.Definition{Status: status, Code: code, Title: problems.Text{EN: titleEN, PTBR: titlePTBR}}
+}
+
+var (
+	productNotFound       = definition(http.StatusNotFound, CodeProductNotFound, "Product not found", "Produto não encontrado")
+	productAlreadyExists  = definition(http.StatusConflict, CodeProductAlreadyExists, "Product already exists", "Produto já existe")
+	invalidProductData    = definition(http.StatusBadRequest, CodeInvalidProductData, "Invalid product data", "Dados do produto inválidos")
+	invalidProductImage   = definition(http.StatusBadRequest, CodeInvalidProductImage, "Invalid product image", "Imagem do produto inválida")
+	imageNotFound         = definition(http.StatusNotFound, CodeImageNotFound, "Image not found", "Imagem não encontrada")
+	productImagesNotFound = definition(http.StatusNotFound, CodeProductImagesNotFound, "No images found for this product", "Nenhuma imagem encontrada para este produto")
+	productImageRequired  = definition(http.StatusConflict, CodeProductImageRequired, "Product must keep at least one image", "O produto deve manter ao menos uma imagem")
+	categoryNotFound      = definition(http.StatusNotFound, CodeCategoryNotFound, "Category not found", "Categoria não encontrada")
+	categoryAlreadyExists = definition(http.StatusConflict, CodeCategoryAlreadyExists, "Category already exists", "Categoria já existe")
+	invalidCategoryData   = definition(http.StatusBadRequest, CodeInvalidCategoryData, "Invalid category data", "Dados da categoria inválidos")
+	categoryHasProducts   = definition(http.StatusBadRequest, CodeCategoryHasProducts, "Category has products", "Categoria possui produtos")
+	categoryHasChildren   = definition(http.StatusBadRequest, CodeCategoryHasChildren, "Category has subcategories", "Categoria possui subcategorias")
+	invalidAvailability   = definition(http.StatusBadRequest, CodeInvalidAvailability, "Invalid availability schedule", "Horário de disponibilidade inválido")
+	recordNotFound        = definition(http.StatusNotFound, CodeRecordNotFound, "Record not found", "Registro não encontrado")
+	recordConflict        = definition(http.StatusConflict, CodeRecordConflict, "Record conflict", "Conflito de registro")
+	foreignKeyViolation   = definition(http.StatusConflict, CodeForeignKeyViolation, "Related record conflict", "Conflito com registro relacionado")
+	preconditionFailed    = definition(http.StatusPreconditionFailed, CodePreconditionFailed, "Precondition failed", "Pré-condição não atendida")
+	databaseTimeout       = definition(http.StatusServiceUnavailable, CodeDatabaseTimeout, "Database timeout", "Tempo esgotado no banco de dados")
+	databaseUnavailable   = definition(http.StatusServiceUnavailable, CodeDatabaseUnavailable, "Database unavailable", "Banco de dados indisponível")
+	storageDeleteFailed   = definition(http.StatusInternalServerError, CodeStorageDeleteFailed, "Storage failure", "Falha no armazenamento")
+	bucketNotFound        = definition(http.StatusNotFound, CodeBucketNotFound, "Storage bucket not found", "Bucket de armazenamento não encontrado")
+)
+
+var (
+	invalidStockData             = definition(http.StatusBadRequest, CodeInvalidStockData, "Invalid stock data", "Dados de estoque inválidos")
+	insufficientStock            = definition(http.StatusConflict, CodeInsufficientStock, "Insufficient stock", "Estoque insuficiente")
+	productSoldOut               = definition(http.StatusConflict, CodeProductSoldOut, "Product is sold out", "Produto esgotado")
+	stockReservationNotFound     = definition(http.StatusNotFound, CodeStockReservationNotFound, "Stock reservation not found", "Reserva de estoque não encontrada")
+	stockReservationExpired      = definition(http.StatusConflict, CodeStockReservationExpired, "Stock reservation has expired", "A reserva de estoque expirou")
+	invalidStockReservationState = definition(http.StatusConflict, CodeInvalidStockReservationState, "Invalid stock reservation state", "Estado da reserva de estoque inválido")
+)
+
+var (
+	invalidNutritionFacts = definition(http.StatusBadRequest, CodeInvalidNutritionFacts, "Invalid nutrition facts", "Informação nutricional inválida")
+	invalidAllergen       = definition(http.StatusBadRequest, CodeInvalidAllergen, "Invalid allergen", "Alérgeno inválido")
+)
+
+var (
+	invalidLocale       = definition(http.StatusBadRequest, CodeInvalidLocale, "Invalid locale", "Idioma inválido")
+	invalidTranslation  = definition(http.StatusBadRequest, CodeInvalidTranslation, "Invalid translation", "Tradução inválida")
+	translationNotFound = definition(http.StatusNotFound, CodeTranslationNotFound, "Translation not found", "Tradução não encontrada")
+)
+
+var (
+	storeNotFound        = definition(http.StatusNotFound, CodeStoreNotFound, "Store not found", "Loja não encontrada")
+	invalidStoreData     = definition(http.StatusBadRequest, CodeInvalidStoreData, "Invalid store data", "Dados da loja inválidos")
+	invalidStoreOverride = definition(http.StatusBadRequest, CodeInvalidStoreOverride, "Invalid store override", "Ajuste da loja inválido")
+)
+
+var (
+	promotionNotFound = definition(http.StatusNotFound, CodePromotionNotFound, "Promotion not found", "Promoção não encontrada")
+	invalidPromotion  = definition(http.StatusBadRequest, CodeInvalidPromotion, "Invalid promotion", "Promoção inválida")
 )
 
 func HandleDomainErrors(err error, ctx *gin.Context) bool {
 	switch e := err.(type) {
 	case *exceptions.ProductNotFoundException:
-		ctx.JSON(http.StatusNotFound, gin.H{"error": e.Error()})
-		return true
-
+		writeDomainProblem(ctx, productNotFound, e)
+	case *exceptions.ProductAlreadyExistsException:
+		writeDomainProblem(ctx, productAlreadyExists, e)
 	case *exceptions.InvalidProductDataException:
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": e.Error()})
-		return true
-
+		writeDomainProblem(ctx, invalidProductData, e)
+	case *exceptions.InvalidProductImageException:
+		writeDomainProblem(ctx, invalidProductImage, e)
+	case *exceptions.ImageNotFoundException:
+		writeDomainProblem(ctx, imageNotFound, e)
+	case *exceptions.ProductImagesNotFoundException:
+		writeDomainProblem(ctx, productImagesNotFound, e)
+	case *exceptions.ProductImageCannotBeEmptyException:
+		writeDomainProblem(ctx, productImageRequired, e)
+	case *exceptions.CategoryNotFoundException:
+		writeDomainProblem(ctx, categoryNotFound, e)
+	case *exceptions.CategoryAlreadyExistsException:
+		writeDomainProblem(ctx, categoryAlreadyExists, e)
 	case *exceptions.InvalidCategoryDataException:
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": e.Error()})
-		return true
+		writeDomainProblem(ctx, invalidCategoryData, e)
+	case *exceptions.CategoryHasProductsException:
+		writeDomainProblem(ctx, categoryHasProducts, e)
+	case *exceptions.CategoryHasChildrenException:
+		writeDomainProblem(ctx, categoryHasChildren, e)
+	case *exceptions.InvalidAvailabilityException:
+		writeDomainProblem(ctx, invalidAvailability, e)
+	case *exceptions.InvalidStockDataException:
+		writeDomainProblem(ctx, invalidStockData, e)
+	case *exceptions.InsufficientStockException:
+		writeDomainProblem(ctx, insufficientStock, e)
+	case *exceptions.ProductSoldOutException:
+		writeDomainProblem(ctx, productSoldOut, e)
+	case *exceptions.StockReservationNotFoundException:
+		writeDomainProblem(ctx, stockReservationNotFound, e)
+	case *exceptions.StockReservationExpiredException:
+		writeDomainProblem(ctx, stockReservationExpired, e)
+	case *exceptions.InvalidStockReservationStateException:
+		writeDomainProblem(ctx, invalidStockReservationState, e)
+	case *exceptions.InvalidNutritionFactsException:
+		writeDomainProblem(ctx, invalidNutritionFacts, e)
+	case *exceptions.InvalidAllergenException:
+		writeDomainProblem(ctx, invalidAllergen, e)
+	case *exceptions.InvalidLocaleException:
+		writeDomainProblem(ctx, invalidLocale, e)
+	case *exceptions.InvalidTranslationException:
+		writeDomainProblem(ctx, invalidTranslation, e)
+	case *exceptions.TranslationNotFoundException:
+		writeDomainProblem(ctx, translationNotFound, e)
+	case *exceptions.StoreNotFoundException:
+		writeDomainProblem(ctx, storeNotFound, e)
+	case *exceptions.InvalidStoreDataException:
+		writeDomainProblem(ctx, invalidStoreData, e)
+	case *exceptions.InvalidStoreOverrideException:
+		writeDomainProblem(ctx, invalidStoreOverride, e)
+	case *exceptions.PromotionNotFoundException:
+		writeDomainProblem(ctx, promotionNotFound, e)
+	case *exceptions.InvalidPromotionException:
+		writeDomainProblem(ctx, invalidPromotion, e)
+	case *exceptions.RecordNotFoundException:
+		writeDomainProblem(ctx, recordNotFound, e)
+	case *exceptions.RecordConflictException:
+		writeDomainProblem(ctx, recordConflict, e)
+	case *exceptions.ForeignKeyViolationException:
+		writeDomainProblem(ctx, foreignKeyViolation, e)
+	case *exceptions.VersionConflictException:
+		writeDomainProblem(ctx, preconditionFailed, e)
+	case *exceptions.RepositoryTimeoutException:
+		handleServiceUnavailable(ctx, databaseTimeout, e, e.RetryAfterSeconds())
+	case *exceptions.RepositoryUnavailableException:
+		handleServiceUnavailable(ctx, databaseUnavailable, e, e.RetryAfterSeconds())
+	case *exceptions.DeleteImagesStorageException:
+		writeDomainProblem(ctx, storageDeleteFailed, e)
+	case *exceptions.BucketNotFoundException:
+		// A mensagem padrão desta exceção já vem em português
+		problems.Write(ctx, problems.New(ctx, bucketNotFound, problems.Text{
+			EN:   "S3 bucket does not exist or is invalid",
+			PTBR: "Bucket S3 não existe ou é inválido",
+		}.In(problems.LanguageFromRequest(ctx))))
+	default:
+		return false
+	}
 
-	case *exceptions.CategoryAlreadyExistsException:
-		ctx.JSON(http.StatusConflict, gin.H{"error": e.Error()})
-		return true
+	return true
+}
 
-	case *exceptions.CategoryNotFoundException:
-		ctx.JSON(http.StatusNotFound, gin.H{"error": e.Error()})
-		return true
+func writeDomainProblem(ctx *gin.Context, definition problems.Definition, err error) {
+	problems.Write(ctx, problems.New(ctx, definition, localizedDetail(ctx, definition, err)))
+}
 
-	case *exceptions.InvalidProductImageException:
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": e.Error()})
-		return true
+// O detail é a mensagem da exceção no idioma do cliente; sem tradução
+// conhecida, usa o título do problema para não misturar idiomas
+func localizedDetail(ctx *gin.Context, definition problems.Definition, err error) string {
+	lang := problems.LanguageFromRequest(ctx)
 
-	case *exceptions.ImageNotFoundException:
-		ctx.JSON(http.StatusNotFound, gin.H{"error": e.Error()})
-		return true
+	var localizable exceptions.Localizable
+	if errors.As(err, &localizable) {
+		if detail, ok := translateDomainMessage(localizable, lang); ok {
+			return detail
+		}
+	}
 
-	case *exceptions.CategoryHasProductsException:
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": e.Error()})
-		return true
+	return definition.Title.In(lang)
+}
+
+// Falhas de infraestrutura são temporárias: o cliente recebe 503 e a sugestão
+// de quando tentar de novo, no header Retry-After e no corpo. O erro original
+// do banco só vai para o log.
+func handleServiceUnavailable(ctx *gin.Context, definition problems.Definition, err error, retryAfter int) {
+	if cause := errors.Unwrap(err); cause != nil {
+		log.Printf("infrastructure failure on %s %s: %v", ctx.Request.Method, ctx.Request.URL.Path, cause)
 	}
 
-	return false
+	problem := problems.New(ctx, definition, localizedDetail(ctx, definition, err))
+	problem.RetryAfter = retryAfter
+
+	ctx.Header("Retry-After", strconv.Itoa(retryAfter))
+	problems.Write(ctx, problem)
 }
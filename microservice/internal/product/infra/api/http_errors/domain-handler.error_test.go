@@ -1,52 +1,133 @@
 package http_errors
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
+	"net/http/httptest"
 	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/shared/infra/api/problems"
 	"testing"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
 )
 
+func newTestContext(acceptLanguage string) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/v1/products/1", nil)
+	if acceptLanguage != "" {
+		ctx.Request.Header.Set("Accept-Language", acceptLanguage)
+	}
+	return ctx, w
+}
+
 func TestHandleDomainErrors(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	cases := []struct {
 		err          error
 		expectedCode int
+		problemCode  string
 	}{
-		{&exceptions.ProductNotFoundException{}, http.StatusNotFound},
-		{&exceptions.InvalidProductDataException{}, http.StatusBadRequest},
-		{&exceptions.InvalidCategoryDataException{}, http.StatusBadRequest},
-		{&exceptions.CategoryAlreadyExistsException{}, http.StatusConflict},
-		{&exceptions.CategoryNotFoundException{}, http.StatusNotFound},
-		{&exceptions.InvalidProductImageException{}, http.StatusBadRequest},
-		{&exceptions.ImageNotFoundException{}, http.StatusNotFound},
-		{&exceptions.CategoryHasProductsException{}, http.StatusBadRequest},
+		{&exceptions.ProductNotFoundException{}, http.StatusNotFound, CodeProductNotFound},
+		{&exceptions.InvalidProductDataException{}, http.StatusBadRequest, CodeInvalidProductData},
+		{&exceptions.InvalidCategoryDataException{}, http.StatusBadRequest, CodeInvalidCategoryData},
+		{&exceptions.CategoryAlreadyExistsException{}, http.StatusConflict, CodeCategoryAlreadyExists},
+		{&exceptions.CategoryNotFoundException{}, http.StatusNotFound, CodeCategoryNotFound},
+		{&exceptions.InvalidProductImageException{}, http.StatusBadRequest, CodeInvalidProductImage},
+		{&exceptions.ImageNotFoundException{}, http.StatusNotFound, CodeImageNotFound},
+		{&exceptions.CategoryHasProductsException{}, http.StatusBadRequest, CodeCategoryHasProducts},
+		{&exceptions.CategoryHasChildrenException{}, http.StatusBadRequest, CodeCategoryHasChildren},
+		{&exceptions.InvalidAvailabilityException{}, http.StatusBadRequest, CodeInvalidAvailability},
+		{&exceptions.InvalidStockDataException{}, http.StatusBadRequest, CodeInvalidStockData},
+		{&exceptions.InsufficientStockException{}, http.StatusConflict, CodeInsufficientStock},
+		{&exceptions.ProductSoldOutException{}, http.StatusConflict, CodeProductSoldOut},
+		{&exceptions.StockReservationNotFoundException{}, http.StatusNotFound, CodeStockReservationNotFound},
+		{&exceptions.StockReservationExpiredException{}, http.StatusConflict, CodeStockReservationExpired},
+		{&exceptions.InvalidStockReservationStateException{}, http.StatusConflict, CodeInvalidStockReservationState},
+		{&exceptions.InvalidNutritionFactsException{}, http.StatusBadRequest, CodeInvalidNutritionFacts},
+		{&exceptions.InvalidAllergenException{}, http.StatusBadRequest, CodeInvalidAllergen},
+		{&exceptions.InvalidLocaleException{}, http.StatusBadRequest, CodeInvalidLocale},
+		{&exceptions.InvalidTranslationException{}, http.StatusBadRequest, CodeInvalidTranslation},
+		{&exceptions.TranslationNotFoundException{}, http.StatusNotFound, CodeTranslationNotFound},
+		{&exceptions.StoreNotFoundException{}, http.StatusNotFound, CodeStoreNotFound},
+		{&exceptions.InvalidStoreDataException{}, http.StatusBadRequest, CodeInvalidStoreData},
+		{&exceptions.InvalidStoreOverrideException{}, http.StatusBadRequest, CodeInvalidStoreOverride},
+		{&exceptions.PromotionNotFoundException{}, http.StatusNotFound, CodePromotionNotFound},
+		{&exceptions.InvalidPromotionException{}, http.StatusBadRequest, CodeInvalidPromotion},
+		{&exceptions.ProductAlreadyExistsException{}, http.StatusConflict, CodeProductAlreadyExists},
+		{&exceptions.ProductImageCannotBeEmptyException{}, http.StatusConflict, CodeProductImageRequired},
+		{&exceptions.RecordNotFoundException{}, http.StatusNotFound, CodeRecordNotFound},
+		{&exceptions.RecordConflictException{}, http.StatusConflict, CodeRecordConflict},
+		{&exceptions.VersionConflictException{}, http.StatusPreconditionFailed, CodePreconditionFailed},
+		{&exceptions.ForeignKeyViolationException{}, http.StatusConflict, CodeForeignKeyViolation},
+		{&exceptions.RepositoryTimeoutException{}, http.StatusServiceUnavailable, CodeDatabaseTimeout},
+		{&exceptions.RepositoryUnavailableException{}, http.StatusServiceUnavailable, CodeDatabaseUnavailable},
+		{&exceptions.BucketNotFoundException{}, http.StatusNotFound, CodeBucketNotFound},
 	}
 
 	for _, c := range cases {
-		w := &testResponseWriter{}
-		ctx := gin.CreateTestContextOnly(w, gin.New())
+		ctx, w := newTestContext("")
 		result := HandleDomainErrors(c.err, ctx)
 		require.True(t, result)
-		require.Equal(t, c.expectedCode, w.status)
+		require.Equal(t, c.expectedCode, w.Code)
+		require.Equal(t, problems.ContentType, w.Header().Get("Content-Type"))
+
+		var problem problems.Problem
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+		require.Equal(t, c.problemCode, problem.Code)
+		require.Equal(t, c.expectedCode, problem.Status)
+		require.Equal(t, "/v1/products/1", problem.Instance)
 	}
 
 	// Test for error not handled
-	w := &testResponseWriter{}
-	ctx := gin.CreateTestContextOnly(w, gin.New())
+	ctx, _ := newTestContext("")
 	result := HandleDomainErrors(errors.New("other error"), ctx)
 	require.False(t, result)
 }
 
-// Helper to capture status code
+func TestHandleDomainErrors_LocalizesDetail(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctx, w := newTestContext("pt-BR,pt;q=0.9")
+	HandleDomainErrors(&exceptions.CategoryNotFoundException{Message: "Parent category %s not found", Args: []any{"42"}}, ctx)
+
+	var problem problems.Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	require.Equal(t, "Categoria não encontrada", problem.Title)
+	require.Equal(t, "Categoria pai 42 não encontrada", problem.Detail)
+
+	ctx, w = newTestContext("en-US")
+	HandleDomainErrors(&exceptions.CategoryNotFoundException{Message: "Parent category %s not found", Args: []any{"42"}}, ctx)
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	require.Equal(t, "Category not found", problem.Title)
+	require.Equal(t, "Parent category 42 not found", problem.Detail)
+}
+
+func TestHandleDomainErrors_UnknownMessageFallsBackToTitle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
 
-type testResponseWriter struct {
-	status int
+	ctx, w := newTestContext("pt-BR")
+	HandleDomainErrors(&exceptions.InvalidProductDataException{Message: "something nobody translated"}, ctx)
+
+	var problem problems.Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	require.Equal(t, CodeInvalidProductData, problem.Code)
+	require.Equal(t, "Dados do produto inválidos", problem.Detail)
 }
 
-func (w *testResponseWriter) Header() http.Header         { return http.Header{} }
-func (w *testResponseWriter) Write(b []byte) (int, error) { return len(b), nil }
-func (w *testResponseWriter) WriteHeader(statusCode int)  { w.status = statusCode }
+func TestHandleDomainErrors_ServiceUnavailable(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctx, w := newTestContext("")
+	HandleDomainErrors(&exceptions.RepositoryTimeoutException{RetryAfter: 3}, ctx)
+
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Equal(t, "3", w.Header().Get("Retry-After"))
+
+	var problem problems.Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	require.Equal(t, 3, problem.RetryAfter)
+}
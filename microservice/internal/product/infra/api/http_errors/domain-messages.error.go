@@ -0,0 +1,170 @@
+package http_errors
+
+import (
+	"errors"
+	"fmt"
+
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/shared/infra/api/problems"
+)
+
+// Traduções para pt-BR das mensagens do domínio e dos casos de uso, indexadas
+// pelo formato em inglês de cada exception (Message ou a mensagem padrão). Os
+// argumentos são repassados na mesma ordem. TestDomainMessages falha quando um
+// formato usado no código não tem tradução ou quando sobra uma tradução
+var domainMessages = map[string]string{
+	// Mensagens padrão das exceções
+	"Product not found":                                                     "Produto não encontrado",
+	"Product already exists":                                                "Produto já existe",
+	"Invalid product data":                                                  "Dados do produto inválidos",
+	"Invalid product image":                                                 "Imagem do produto inválida",
+	"Image not found":                                                       "Imagem não encontrada",
+	"No images found for this product.":                                     "Nenhuma imagem encontrada para este produto.",
+	"Category not found":                                                    "Categoria não encontrada",
+	"Category already exists":                                               "Categoria já existe",
+	"Invalid category data":                                                 "Dados da categoria inválidos",
+	"Record not found":                                                      "Registro não encontrado",
+	"Record conflicts with an existing one":                                 "O registro conflita com um já existente",
+	"Record was modified by another request":                                "O registro foi alterado por outra requisição",
+	"Database operation timed out":                                          "A operação no banco de dados excedeu o tempo limite",
+	"Database is unavailable":                                               "O banco de dados está indisponível",
+	"Failed to delete file(s) from storage":                                 "Falha ao remover arquivo(s) do armazenamento",
+	"Bucket S3 não existe ou é inválido":                                    "Bucket S3 não existe ou é inválido",
+	"Cannot delete category because there are products linked to it.":       "Não é possível remover a categoria porque há produtos vinculados a ela.",
+	"Cannot delete category because it has subcategories.":                  "Não é possível remover a categoria porque ela possui subcategorias.",
+	"Product image cannot be empty, at least one image is required":         "A imagem do produto não pode ficar vazia, ao menos uma imagem é obrigatória",
+	"Record references or is referenced by another record":                  "O registro referencia ou é referenciado por outro registro",
+	"A category with this name already exists":                              "Já existe uma categoria com este nome",
+	"A product with this name already exists in this category":              "Já existe um produto com este nome nesta categoria",
+	"Failed to delete image from database":                                  "Falha ao remover a imagem do banco de dados",
+	"Category %q already exists":                                            "Categoria %q já existe",
+	"Product %q already exists in this category":                            "Produto %q já existe nesta categoria",
+	"Category %s not found":                                                 "Categoria %s não encontrada",
+	"Parent category %s not found":                                          "Categoria pai %s não encontrada",
+	"Target category %s not found":                                          "Categoria de destino %s não encontrada",
+	"category %s appears more than once":                                    "a categoria %s aparece mais de uma vez",
+	"product id %q must be a valid UUID":                                    "o id de produto %q deve ser um UUID válido",
+	"product %s would have price %.2f: %s":                                  "o produto %s ficaria com preço %.2f: %s",
+	"strategy must be %s, %s or %s":                                         "strategy deve ser %s, %s ou %s",
+	"categories can be nested at most %d levels deep":                       "categorias podem ser aninhadas em no máximo %d níveis",
+	"Image file name '%s' exceeds the maximum length of 255 characters":     "O nome do arquivo de imagem '%s' excede o tamanho máximo de 255 caracteres",
+	"Image file name and URL are required":                                  "O nome do arquivo e a URL da imagem são obrigatórios",
+	"Image file name cannot be empty":                                       "O nome do arquivo de imagem não pode ser vazio",
+	"Image file name is required":                                           "O nome do arquivo de imagem é obrigatório",
+	"category cannot be its own parent":                                     "a categoria não pode ser pai de si mesma",
+	"category cannot be moved under one of its subcategories":               "a categoria não pode ser movida para dentro de uma de suas subcategorias",
+	"category description must have at most 255 characters":                 "a descrição da categoria deve ter no máximo 255 caracteres",
+	"category name must have at least 3 characters":                         "o nome da categoria deve ter ao menos 3 caracteres",
+	"category name must have at most 100 characters":                        "o nome da categoria deve ter no máximo 100 caracteres",
+	"category position must not be negative":                                "a posição da categoria não pode ser negativa",
+	"category_id is required to move products":                              "category_id é obrigatório para mover produtos",
+	"category_ids must not be empty":                                        "category_ids não pode ser vazio",
+	"filter must have at least one of category_id, ids or active":           "o filtro deve ter ao menos um de category_id, ids ou active",
+	"name must be at least 3 characters long":                               "o nome deve ter ao menos 3 caracteres",
+	"name must be at most 100 characters long":                              "o nome deve ter no máximo 100 caracteres",
+	"operation must be activate, deactivate, move_category or adjust_price": "operation deve ser activate, deactivate, move_category ou adjust_price",
+	"price adjustment mode must be percentage or fixed":                     "o modo do ajuste de preço deve ser percentage ou fixed",
+	"price adjustment percentage must be greater than -100":                 "o percentual do ajuste de preço deve ser maior que -100",
+	"price adjustment value must not be zero":                               "o valor do ajuste de preço não pode ser zero",
+	"price must be greater than 0":                                          "o preço deve ser maior que 0",
+	"price rounding must be cents, ten_cents, whole or ninety_nine":         "o arredondamento do preço deve ser cents, ten_cents, whole ou ninety_nine",
+	"subcategory cannot be active while its parent category is inactive":    "a subcategoria não pode estar ativa enquanto a categoria pai estiver inativa",
+	"target_category_id is only allowed with the reassign strategy":         "target_category_id só é permitido com a strategy reassign",
+	"target_category_id is required for the reassign strategy":              "target_category_id é obrigatório para a strategy reassign",
+	"target_category_id must be different from the deleted category":        "target_category_id deve ser diferente da categoria removida",
+	"Invalid availability schedule":                                         "Horário de disponibilidade inválido",
+	"weekly[%d]: at least one weekday is required":                          "weekly[%d]: informe ao menos um dia da semana",
+	"weekly[%d]: unknown weekday %q":                                        "weekly[%d]: dia da semana %q desconhecido",
+	"%s: from must be a time between 00:00 and 23:59":                       "%s: from deve ser um horário entre 00:00 e 23:59",
+	"%s: to must be a time between 00:01 and 24:00":                         "%s: to deve ser um horário entre 00:01 e 24:00",
+	"%s: to must be after from":                                             "%s: to deve ser depois de from",
+	"%s: date is required":                                                  "%s: date é obrigatório",
+	"%s must be a date in the format YYYY-MM-DD":                            "%s deve ser uma data no formato AAAA-MM-DD",
+	"end_date must not be before start_date":                                "end_date não pode ser anterior a start_date",
+	"Invalid stock data":                                                    "Dados de estoque inválidos",
+	"Insufficient stock":                                                    "Estoque insuficiente",
+	"Product is sold out":                                                   "Produto esgotado",
+	"Stock reservation not found":                                           "Reserva de estoque não encontrada",
+	"Stock reservation has expired":                                         "A reserva de estoque expirou",
+	"Invalid stock reservation state":                                       "Estado da reserva de estoque inválido",
+	"quantity must not be negative":                                         "a quantidade não pode ser negativa",
+	"quantity requires track_quantity":                                      "quantity exige track_quantity",
+	"at least one item is required":                                         "informe ao menos um item",
+	"reservation ttl must be positive":                                      "a validade da reserva deve ser positiva",
+	"items[%d]: product_id is required":                                     "items[%d]: product_id é obrigatório",
+	"items[%d]: quantity must be greater than 0":                            "items[%d]: quantity deve ser maior que 0",
+	"product %s not found":                                                  "produto %s não encontrado",
+	"product %s is sold out":                                                "o produto %s está esgotado",
+	"insufficient stock for product %s":                                     "estoque insuficiente para o produto %s",
+	"reservation is %s and cannot be %s":                                    "a reserva está com status %s e não pode ser marcada como %s",
+	"reservation was changed by another request":                            "a reserva foi alterada por outra requisição",
+	"Invalid nutrition facts":                                               "Informação nutricional inválida",
+	"Invalid allergen":                                                      "Alérgeno inválido",
+	"nutrition.serving_size must be greater than 0":                         "nutrition.serving_size deve ser maior que 0",
+	"nutrition.serving_unit must be g or ml":                                "nutrition.serving_unit deve ser g ou ml",
+	"nutrition.%s must not be negative":                                     "nutrition.%s não pode ser negativo",
+	"nutrition.added_sugars_g must not exceed total_sugars_g":               "nutrition.added_sugars_g não pode ser maior que total_sugars_g",
+	"nutrition.total_sugars_g must not exceed carbohydrates_g":              "nutrition.total_sugars_g não pode ser maior que carbohydrates_g",
+	"nutrition: saturated and trans fat must not exceed total_fat_g":        "nutrition: gorduras saturadas e trans não podem passar de total_fat_g",
+	"nutrition: nutrients must not exceed serving_size":                     "nutrition: os nutrientes não podem somar mais que serving_size",
+	"allergens[%d]: unknown allergen %q":                                    "allergens[%d]: alérgeno %q desconhecido",
+	"exclude_allergens: unknown allergen %q":                                "exclude_allergens: alérgeno %q desconhecido",
+	"Invalid locale":                                                        "Idioma inválido",
+	"Invalid translation":                                                   "Tradução inválida",
+	"Translation not found":                                                 "Tradução não encontrada",
+	"pt-BR is the default locale and is edited on the item itself":          "pt-BR é o idioma padrão e é editado no próprio item",
+	"unsupported locale %q":                                                 "idioma %q não suportado",
+	"Store not found":                                                       "Loja não encontrada",
+	"Invalid store data":                                                    "Dados da loja inválidos",
+	"Invalid store override":                                                "Ajuste da loja inválido",
+	"%s: product %s not found":                                              "%s: produto %s não encontrado",
+	"%s: product %s is repeated":                                            "%s: o produto %s aparece mais de uma vez",
+	"%s: category %s not found":                                             "%s: categoria %s não encontrada",
+	"%s: category %s is repeated":                                           "%s: a categoria %s aparece mais de uma vez",
+	"%s: %s":                                                                "%s: %s",
+	"%s":                                                                    "%s",
+	"%s.availability: %s":                                                   "%s.availability: %s",
+	"Promotion not found":                                                   "Promoção não encontrada",
+	"Invalid promotion":                                                     "Promoção inválida",
+	"product_ids[%d]: product %s not found":                                 "product_ids[%d]: produto %s não encontrado",
+	"category_ids[%d]: category %s not found":                               "category_ids[%d]: categoria %s não encontrada",
+	"percentage discount must be greater than 0 and at most 100":            "o desconto percentual deve ser maior que 0 e no máximo 100",
+	"fixed discount must be greater than 0 and less than 1000000":           "o desconto fixo deve ser maior que 0 e menor que 1000000",
+	"discount type must be percentage or fixed":                             "o tipo de desconto deve ser percentage ou fixed",
+	"promotion must target at least one product or category":                "a promoção deve alcançar ao menos um produto ou categoria",
+	"priority must be between 0 and 1000":                                   "a prioridade deve estar entre 0 e 1000",
+	"schedule: %s":                                                          "schedule: %s",
+}
+
+// translateDomainMessage traduz a mensagem de uma exception a partir do
+// formato e dos argumentos dela, nunca do texto já montado. Argumentos que são
+// exceptions são traduzidos também; ok é falso quando falta alguma tradução
+func translateDomainMessage(err exceptions.Localizable, lang problems.Language) (string, bool) {
+	if lang != problems.BrazilianPortuguese {
+		return err.Error(), true
+	}
+
+	format, args := err.Text()
+	target, ok := domainMessages[format]
+	if !ok {
+		return "", false
+	}
+
+	if len(args) == 0 {
+		return target, true
+	}
+
+	translated := make([]any, len(args))
+	for i, arg := range args {
+		translated[i] = arg
+
+		var nested exceptions.Localizable
+		if argErr, isErr := arg.(error); isErr && errors.As(argErr, &nested) {
+			if translated[i], ok = translateDomainMessage(nested, lang); !ok {
+				return "", false
+			}
+		}
+	}
+
+	return fmt.Sprintf(target, translated...), true
+}
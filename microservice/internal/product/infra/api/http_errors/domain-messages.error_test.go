@@ -0,0 +1,136 @@
+package http_errors
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/shared/infra/api/problems"
+)
+
+var formatVerbRegex = regexp.MustCompile(`%(?:\.\d+)?[sqdvf]`)
+
+// Funções que repassam o formato recebido como Message de uma exception
+var messageHelpers = map[string]bool{
+	"invalidAvailability": true,
+	"invalidNutrition":    true,
+}
+
+// exceptionFormats percorre o código do produto e devolve os formatos de
+// mensagem das exceptions: os literais em Message, os passados aos helpers e
+// as mensagens padrão
+func exceptionFormats(t *testing.T) map[string]string {
+	formats := map[string]string{}
+
+	root := filepath.Join("..", "..", "..")
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		add := func(expr ast.Expr) {
+			if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				value, err := strconv.Unquote(lit.Value)
+				require.NoError(t, err)
+				formats[value] = fset.Position(lit.Pos()).String()
+			}
+		}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch n := node.(type) {
+			case *ast.CompositeLit:
+				if !isExceptionType(n.Type) {
+					return true
+				}
+				for _, elt := range n.Elts {
+					if kv, ok := elt.(*ast.KeyValueExpr); ok {
+						if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Message" {
+							add(kv.Value)
+						}
+					}
+				}
+			case *ast.CallExpr:
+				fn, ok := n.Fun.(*ast.Ident)
+				switch {
+				case ok && messageHelpers[fn.Name] && len(n.Args) > 0:
+					add(n.Args[0])
+				case ok && fn.Name == "text" && file.Name.Name == "exceptions" && len(n.Args) == 3:
+					add(n.Args[2])
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	require.NoError(t, err)
+
+	return formats
+}
+
+func isExceptionType(expr ast.Expr) bool {
+	switch typ := expr.(type) {
+	case *ast.SelectorExpr:
+		pkg, ok := typ.X.(*ast.Ident)
+		return ok && pkg.Name == "exceptions"
+	case *ast.Ident:
+		return strings.HasSuffix(typ.Name, "Exception")
+	}
+	return false
+}
+
+func TestDomainMessages(t *testing.T) {
+	formats := exceptionFormats(t)
+	require.NotEmpty(t, formats)
+
+	for format, position := range formats {
+		target, ok := domainMessages[format]
+		if !ok {
+			t.Errorf("%s: missing pt-BR translation for %q", position, format)
+			continue
+		}
+		require.Equal(t, len(formatVerbRegex.FindAllString(format, -1)), len(formatVerbRegex.FindAllString(target, -1)), format)
+	}
+
+	for format := range domainMessages {
+		if _, ok := formats[format]; !ok {
+			t.Errorf("translation for %q is not used by any exception", format)
+		}
+	}
+}
+
+func TestTranslateDomainMessage(t *testing.T) {
+	price := &exceptions.InvalidProductDataException{Message: "price must be greater than 0"}
+	err := &exceptions.InvalidProductDataException{Message: "product %s would have price %.2f: %s", Args: []any{"p1", -1.0, price}}
+
+	translated, ok := translateDomainMessage(err, problems.BrazilianPortuguese)
+	require.True(t, ok)
+	require.Equal(t, "o produto p1 ficaria com preço -1.00: o preço deve ser maior que 0", translated)
+
+	translated, ok = translateDomainMessage(err, problems.English)
+	require.True(t, ok)
+	require.Equal(t, "product p1 would have price -1.00: price must be greater than 0", translated)
+
+	translated, ok = translateDomainMessage(&exceptions.CategoryAlreadyExistsException{Message: "Category %q already exists", Args: []any{"Bebidas"}}, problems.BrazilianPortuguese)
+	require.True(t, ok)
+	require.Equal(t, `Categoria "Bebidas" já existe`, translated)
+
+	// Uma exception sem tradução usada como argumento não gera texto misto
+	nested := &exceptions.InvalidStoreOverrideException{Message: "%s: %s", Args: []any{"products[0]", &exceptions.InvalidProductDataException{Message: "untranslated"}}}
+	_, ok = translateDomainMessage(nested, problems.BrazilianPortuguese)
+	require.False(t, ok)
+}
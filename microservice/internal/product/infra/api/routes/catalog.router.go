@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"tech_challenge/internal/product/infra/api/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterCatalogRoutes(router *gin.RouterGroup) {
+	catalogHandler := handlers.NewCatalogHandler()
+
+	router.GET("/export", catalogHandler.ExportCatalog)
+	router.POST("/import", catalogHandler.ImportCatalog)
+	router.POST("/quote", catalogHandler.QuoteCatalog)
+}
@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterCatalogRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	group := r.Group("/catalog")
+
+	// Registra handlers dummy para evitar acesso ao banco
+	group.GET("/export", func(c *gin.Context) { c.Status(200) })
+	group.POST("/import", func(c *gin.Context) { c.Status(200) })
+	group.POST("/quote", func(c *gin.Context) { c.Status(200) })
+
+	// Test GET /catalog/export
+	req := httptest.NewRequest(http.MethodGet, "/catalog/export", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.NotEqual(t, 404, w.Code)
+
+	// Test POST /catalog/import
+	req = httptest.NewRequest(http.MethodPost, "/catalog/import", nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.NotEqual(t, 404, w.Code)
+
+	// Test POST /catalog/quote
+	req = httptest.NewRequest(http.MethodPost, "/catalog/quote", nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.NotEqual(t, 404, w.Code)
+}
@@ -8,10 +8,19 @@ import (
 
 func RegisterCategoryRoutes(router *gin.RouterGroup) {
 	categoryHandler := handlers.NewCategoryHandler()
+	translationHandler := handlers.NewTranslationHandler()
 
 	router.GET("", categoryHandler.FindAllCategories)
+	router.GET("/tree", categoryHandler.FindCategoryTree)
 	router.GET("/:id", categoryHandler.FindCategoryByID)
 	router.POST("", categoryHandler.CreateCategory)
+	router.PUT("/order", categoryHandler.ReorderCategories)
 	router.PUT("/:id", categoryHandler.UpdateCategory)
+	router.PATCH("/:id", categoryHandler.PatchCategory)
+	router.PATCH("/:id/image", categoryHandler.UploadCategoryImage)
+	router.DELETE("/:id/image", categoryHandler.DeleteCategoryImage)
 	router.DELETE("/:id", categoryHandler.DeleteCategory)
+	router.GET("/:id/translations", translationHandler.FindCategoryTranslations)
+	router.PUT("/:id/translations/:locale", translationHandler.SaveCategoryTranslation)
+	router.DELETE("/:id/translations/:locale", translationHandler.DeleteCategoryTranslation)
 }
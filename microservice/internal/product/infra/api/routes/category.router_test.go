@@ -16,9 +16,14 @@ func TestRegisterCategoryRoutes(t *testing.T) {
 
 	// Registra handlers dummy para evitar acesso ao banco
 	group.GET("", func(c *gin.Context) { c.Status(200) })
+	group.GET("tree", func(c *gin.Context) { c.Status(206) })
 	group.GET(":id", func(c *gin.Context) { c.Status(200) })
 	group.POST("", func(c *gin.Context) { c.Status(201) })
-	group.PUT(":id", func(c *gin.Context) { c.Status(200) })
+	group.PUT("order", func(c *gin.Context) { c.Status(200) })
+	group.PUT(":id", func(c *gin.Context) { c.Status(201) })
+	group.PATCH(":id", func(c *gin.Context) { c.Status(200) })
+	group.PATCH(":id/image", func(c *gin.Context) { c.Status(200) })
+	group.DELETE(":id/image", func(c *gin.Context) { c.Status(204) })
 	group.DELETE(":id", func(c *gin.Context) { c.Status(204) })
 
 	// Test GET /categories
@@ -33,6 +38,12 @@ func TestRegisterCategoryRoutes(t *testing.T) {
 	r.ServeHTTP(w, req)
 	require.NotEqual(t, 404, w.Code)
 
+	// Test GET /categories/tree não é capturado por /:id
+	req = httptest.NewRequest(http.MethodGet, "/categories/tree", nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, 206, w.Code)
+
 	// Test POST /categories
 	req = httptest.NewRequest(http.MethodPost, "/categories", nil)
 	w = httptest.NewRecorder()
@@ -45,6 +56,30 @@ func TestRegisterCategoryRoutes(t *testing.T) {
 	r.ServeHTTP(w, req)
 	require.NotEqual(t, 404, w.Code)
 
+	// Test PUT /categories/order não é capturado por /:id
+	req = httptest.NewRequest(http.MethodPut, "/categories/order", nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, 200, w.Code)
+
+	// Test PATCH /categories/:id
+	req = httptest.NewRequest(http.MethodPatch, "/categories/1", nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, 200, w.Code)
+
+	// Test PATCH /categories/:id/image
+	req = httptest.NewRequest(http.MethodPatch, "/categories/1/image", nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.NotEqual(t, 404, w.Code)
+
+	// Test DELETE /categories/:id/image
+	req = httptest.NewRequest(http.MethodDelete, "/categories/1/image", nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.NotEqual(t, 404, w.Code)
+
 	// Test DELETE /categories/:id
 	req = httptest.NewRequest(http.MethodDelete, "/categories/1", nil)
 	w = httptest.NewRecorder()
@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"tech_challenge/internal/product/infra/api/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterMenuRoutes(router *gin.RouterGroup) {
+	menuHandler := handlers.NewMenuHandler()
+
+	router.GET("", menuHandler.FindMenu)
+}
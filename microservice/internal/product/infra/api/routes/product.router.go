@@ -8,13 +8,23 @@ import (
 
 func RegisterProductRoutes(router *gin.RouterGroup) {
 	productHandler := handlers.NewProductHandler()
+	stockHandler := handlers.NewStockHandler()
+	translationHandler := handlers.NewTranslationHandler()
 
 	router.POST("", productHandler.CreateProduct)
 	router.GET("", productHandler.FindAllProducts)
+	router.POST("/bulk", productHandler.BulkUpdateProducts)
+	router.POST("/batch-get", productHandler.BatchGetProducts)
 	router.GET("/:id", productHandler.FindProductByID)
 	router.GET("/:id/images", productHandler.FindAllImagesProductById)
 	router.PUT("/:id", productHandler.UpdateProduct)
+	router.PATCH("/:id", productHandler.PatchProduct)
 	router.PATCH("/:id/images", productHandler.UploadProductImage)
 	router.DELETE("/:id/images/:image_file_name", productHandler.DeleteProductImage)
 	router.DELETE("/:id", productHandler.DeleteProduct)
+	router.GET("/:id/stock", stockHandler.FindProductStock)
+	router.PUT("/:id/stock", stockHandler.UpdateProductStock)
+	router.GET("/:id/translations", translationHandler.FindProductTranslations)
+	router.PUT("/:id/translations/:locale", translationHandler.SaveProductTranslation)
+	router.DELETE("/:id/translations/:locale", translationHandler.DeleteProductTranslation)
 }
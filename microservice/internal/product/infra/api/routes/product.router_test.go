@@ -14,12 +14,17 @@ func setupProductTestRouter() *gin.Engine {
 	group := r.Group("/products")
 	group.POST("", func(c *gin.Context) { c.Status(201) })
 	group.GET("", func(c *gin.Context) { c.Status(200) })
+	group.POST("/bulk", func(c *gin.Context) { c.Status(200) })
+	group.POST("/batch-get", func(c *gin.Context) { c.Status(200) })
 	group.GET(":id", func(c *gin.Context) { c.Status(200) })
 	group.GET(":id/images", func(c *gin.Context) { c.Status(200) })
 	group.PUT(":id", func(c *gin.Context) { c.Status(200) })
+	group.PATCH(":id", func(c *gin.Context) { c.Status(200) })
 	group.PATCH(":id/images", func(c *gin.Context) { c.Status(200) })
 	group.DELETE(":id/images/:image_file_name", func(c *gin.Context) { c.Status(204) })
 	group.DELETE(":id", func(c *gin.Context) { c.Status(204) })
+	group.GET(":id/stock", func(c *gin.Context) { c.Status(200) })
+	group.PUT(":id/stock", func(c *gin.Context) { c.Status(200) })
 	return r
 }
 
@@ -32,12 +37,17 @@ func TestRegisterProductRoutes(t *testing.T) {
 	}{
 		{"POST", "/products", 201},
 		{"GET", "/products", 200},
+		{"POST", "/products/bulk", 200},
+		{"POST", "/products/batch-get", 200},
 		{"GET", "/products/1", 200},
 		{"GET", "/products/1/images", 200},
 		{"PUT", "/products/1", 200},
+		{"PATCH", "/products/1", 200},
 		{"PATCH", "/products/1/images", 200},
 		{"DELETE", "/products/1/images/img.jpg", 204},
 		{"DELETE", "/products/1", 204},
+		{"GET", "/products/1/stock", 200},
+		{"PUT", "/products/1/stock", 200},
 	}
 	for _, ep := range endpoints {
 		req := httptest.NewRequest(ep.method, ep.path, nil)
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"tech_challenge/internal/product/infra/api/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterPromotionRoutes(router *gin.RouterGroup) {
+	promotionHandler := handlers.NewPromotionHandler()
+
+	router.GET("", promotionHandler.FindAllPromotions)
+	router.GET("/:id", promotionHandler.FindPromotionByID)
+	router.POST("", promotionHandler.CreatePromotion)
+	router.PUT("/:id", promotionHandler.UpdatePromotion)
+	router.DELETE("/:id", promotionHandler.DeletePromotion)
+}
@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterPromotionRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	group := r.Group("/promotions")
+
+	// Registra handlers dummy para evitar acesso ao banco
+	group.GET("", func(c *gin.Context) { c.Status(200) })
+	group.GET("/:id", func(c *gin.Context) { c.Status(200) })
+	group.POST("", func(c *gin.Context) { c.Status(201) })
+	group.PUT("/:id", func(c *gin.Context) { c.Status(200) })
+	group.DELETE("/:id", func(c *gin.Context) { c.Status(204) })
+
+	endpoints := []struct {
+		method string
+		path   string
+		want   int
+	}{
+		{"GET", "/promotions", 200},
+		{"GET", "/promotions/1", 200},
+		{"POST", "/promotions", 201},
+		{"PUT", "/promotions/1", 200},
+		{"DELETE", "/promotions/1", 204},
+	}
+	for _, ep := range endpoints {
+		req := httptest.NewRequest(ep.method, ep.path, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		require.Equal(t, ep.want, w.Code)
+	}
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"tech_challenge/internal/product/infra/api/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterStockRoutes(router *gin.RouterGroup) {
+	stockHandler := handlers.NewStockHandler()
+
+	router.POST("/reservations", stockHandler.Reserve)
+	router.GET("/reservations/:id", stockHandler.FindReservation)
+	router.POST("/reservations/:id/confirm", stockHandler.ConfirmReservation)
+	router.POST("/reservations/:id/release", stockHandler.ReleaseReservation)
+}
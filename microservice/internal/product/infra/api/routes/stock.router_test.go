@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterStockRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	group := r.Group("/stock")
+
+	// Registra handlers dummy para evitar acesso ao banco
+	group.POST("/reservations", func(c *gin.Context) { c.Status(201) })
+	group.GET("/reservations/:id", func(c *gin.Context) { c.Status(200) })
+	group.POST("/reservations/:id/confirm", func(c *gin.Context) { c.Status(200) })
+	group.POST("/reservations/:id/release", func(c *gin.Context) { c.Status(200) })
+
+	endpoints := []struct {
+		method string
+		path   string
+		want   int
+	}{
+		{"POST", "/stock/reservations", 201},
+		{"GET", "/stock/reservations/1", 200},
+		{"POST", "/stock/reservations/1/confirm", 200},
+		{"POST", "/stock/reservations/1/release", 200},
+	}
+	for _, ep := range endpoints {
+		req := httptest.NewRequest(ep.method, ep.path, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		require.Equal(t, ep.want, w.Code)
+	}
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"tech_challenge/internal/product/infra/api/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterStoreRoutes(router *gin.RouterGroup) {
+	storeHandler := handlers.NewStoreHandler()
+
+	router.GET("", storeHandler.FindAllStores)
+	router.GET("/:id", storeHandler.FindStoreByID)
+	router.POST("", storeHandler.CreateStore)
+	router.PUT("/:id", storeHandler.UpdateStore)
+	router.DELETE("/:id", storeHandler.DeleteStore)
+	router.GET("/:id/overrides", storeHandler.FindStoreOverrides)
+	router.PATCH("/:id/overrides", storeHandler.SaveStoreOverrides)
+	router.DELETE("/:id/overrides", storeHandler.ClearStoreOverrides)
+}
@@ -0,0 +1,46 @@
+package routes
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterStoreRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	group := r.Group("/stores")
+
+	// Registra handlers dummy para evitar acesso ao banco
+	group.GET("", func(c *gin.Context) { c.Status(200) })
+	group.GET("/:id", func(c *gin.Context) { c.Status(200) })
+	group.POST("", func(c *gin.Context) { c.Status(201) })
+	group.PUT("/:id", func(c *gin.Context) { c.Status(200) })
+	group.DELETE("/:id", func(c *gin.Context) { c.Status(204) })
+	group.GET("/:id/overrides", func(c *gin.Context) { c.Status(200) })
+	group.PATCH("/:id/overrides", func(c *gin.Context) { c.Status(200) })
+	group.DELETE("/:id/overrides", func(c *gin.Context) { c.Status(204) })
+
+	endpoints := []struct {
+		method string
+		path   string
+		want   int
+	}{
+		{"GET", "/stores", 200},
+		{"GET", "/stores/1", 200},
+		{"POST", "/stores", 201},
+		{"PUT", "/stores/1", 200},
+		{"DELETE", "/stores/1", 204},
+		{"GET", "/stores/1/overrides", 200},
+		{"PATCH", "/stores/1/overrides", 200},
+		{"DELETE", "/stores/1/overrides", 204},
+	}
+	for _, ep := range endpoints {
+		req := httptest.NewRequest(ep.method, ep.path, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		require.Equal(t, ep.want, w.Code)
+	}
+}
@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"tech_challenge/internal/product/infra/api/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterTranslationRoutes(router *gin.RouterGroup) {
+	translationHandler := handlers.NewTranslationHandler()
+
+	router.GET("/missing", translationHandler.FindMissingTranslations)
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterTranslationRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	group := r.Group("/translations")
+
+	// Registra handlers dummy para evitar acesso ao banco
+	group.GET("/missing", func(c *gin.Context) { c.Status(200) })
+
+	// Test GET /translations/missing
+	req := httptest.NewRequest(http.MethodGet, "/translations/missing?locale=en", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.NotEqual(t, 404, w.Code)
+}
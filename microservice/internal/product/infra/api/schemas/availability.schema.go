@@ -0,0 +1,79 @@
+package schemas
+
+import "tech_challenge/internal/product/application/dtos"
+
+type WeeklyAvailabilitySchema struct {
+	Weekdays []string `json:"weekdays" binding:"required,min=1,max=7,dive,oneof=sun mon tue wed thu fri sat" example:"mon,tue,wed,thu,fri"`
+	From     string   `json:"from" binding:"required" example:"06:00"`
+	To       string   `json:"to" binding:"required" example:"10:30"`
+}
+
+// AvailabilityExceptionSchema sem from/to deixa o item indisponível na data
+type AvailabilityExceptionSchema struct {
+	Date string `json:"date" binding:"required" example:"2025-12-25"`
+	From string `json:"from,omitempty" example:"10:00"`
+	To   string `json:"to,omitempty" example:"14:00"`
+}
+
+// AvailabilitySchema define quando um item ativo aparece, no fuso de
+// APP_TIME_ZONE. Horários em "HH:MM", datas em "YYYY-MM-DD"
+type AvailabilitySchema struct {
+	Weekly     []WeeklyAvailabilitySchema    `json:"weekly,omitempty" binding:"omitempty,max=50,dive"`
+	StartDate  string                        `json:"start_date,omitempty" example:"2025-01-01"`
+	EndDate    string                        `json:"end_date,omitempty" example:"2025-03-31"`
+	Exceptions []AvailabilityExceptionSchema `json:"exceptions,omitempty" binding:"omitempty,max=100,dive"`
+}
+
+func (s *AvailabilitySchema) ToDTO() *dtos.AvailabilityDTO {
+	if s == nil {
+		return nil
+	}
+
+	dto := &dtos.AvailabilityDTO{
+		StartDate: s.StartDate,
+		EndDate:   s.EndDate,
+	}
+	for _, window := range s.Weekly {
+		dto.Weekly = append(dto.Weekly, dtos.WeeklyAvailabilityDTO{
+			Weekdays: window.Weekdays,
+			From:     window.From,
+			To:       window.To,
+		})
+	}
+	for _, exception := range s.Exceptions {
+		dto.Exceptions = append(dto.Exceptions, dtos.AvailabilityExceptionDTO{
+			Date: exception.Date,
+			From: exception.From,
+			To:   exception.To,
+		})
+	}
+
+	return dto
+}
+
+func toAvailabilitySchema(dto *dtos.AvailabilityDTO) *AvailabilitySchema {
+	if dto == nil {
+		return nil
+	}
+
+	schema := &AvailabilitySchema{
+		StartDate: dto.StartDate,
+		EndDate:   dto.EndDate,
+	}
+	for _, window := range dto.Weekly {
+		schema.Weekly = append(schema.Weekly, WeeklyAvailabilitySchema{
+			Weekdays: window.Weekdays,
+			From:     window.From,
+			To:       window.To,
+		})
+	}
+	for _, exception := range dto.Exceptions {
+		schema.Exceptions = append(schema.Exceptions, AvailabilityExceptionSchema{
+			Date: exception.Date,
+			From: exception.From,
+			To:   exception.To,
+		})
+	}
+
+	return schema
+}
@@ -0,0 +1,209 @@
+package schemas
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"tech_challenge/internal/product/application/dtos"
+)
+
+const (
+	csvTypeCategory = "category"
+	csvTypeProduct  = "product"
+)
+
+// Uma planilha única com categorias e produtos, diferenciados pela coluna type
+var CatalogCSVHeader = []string{
+	"type",
+	"id",
+	"external_key",
+	"name",
+	"description",
+	"price",
+	"active",
+	"category_id",
+	"category_key",
+}
+
+func WriteCatalogCSV(w io.Writer, catalog dtos.CatalogDTO) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(CatalogCSVHeader); err != nil {
+		return err
+	}
+
+	categoryKeys := make(map[string]string, len(catalog.Categories))
+	for _, category := range catalog.Categories {
+		categoryKeys[category.ID] = category.ExternalKey
+
+		err := writer.Write([]string{
+			csvTypeCategory,
+			category.ID,
+			category.ExternalKey,
+			category.Name,
+			"",
+			"",
+			strconv.FormatBool(category.Active),
+			"",
+			"",
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, product := range catalog.Products {
+		err := writer.Write([]string{
+			csvTypeProduct,
+			product.ID,
+			product.ExternalKey,
+			product.Name,
+			product.Description,
+			strconv.FormatFloat(product.Price, 'f', 2, 64),
+			strconv.FormatBool(product.Active),
+			product.CategoryID,
+			categoryKeys[product.CategoryID],
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ReadImportCatalogCSV só rejeita o arquivo por problemas de estrutura, como
+// cabeçalho ausente ou CSV ilegível; valores inválidos de uma linha vão para
+// RowErrors com o número da linha
+func ReadImportCatalogCSV(r io.Reader) (ImportCatalogSchema, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return ImportCatalogSchema{}, errors.New("csv file is empty")
+	}
+	if err != nil {
+		return ImportCatalogSchema{}, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))] = i
+	}
+
+	for _, required := range []string{"type", "name"} {
+		if _, ok := columns[required]; !ok {
+			return ImportCatalogSchema{}, fmt.Errorf("csv header must have the %q column", required)
+		}
+	}
+
+	catalog := ImportCatalogSchema{}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return ImportCatalogSchema{}, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		value := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		var rowErrors []ImportRowErrorSchema
+		rowError := func(entity, field, message string) {
+			rowErrors = append(rowErrors, ImportRowErrorSchema{Entity: entity, Row: line, Field: field, Message: message})
+		}
+
+		entity := strings.ToLower(value("type"))
+		if entity == "" {
+			continue
+		}
+		if entity != csvTypeCategory && entity != csvTypeProduct {
+			rowError(entity, "type", fmt.Sprintf("type must be %q or %q", csvTypeCategory, csvTypeProduct))
+		}
+
+		active, err := parseCSVBool(value("active"))
+		if err != nil {
+			rowError(entity, "active", fmt.Sprintf("invalid active value %q", value("active")))
+		}
+
+		var price float64
+		if entity == csvTypeProduct {
+			if price, err = parseCSVPrice(value("price")); err != nil {
+				rowError(entity, "price", fmt.Sprintf("invalid price value %q", value("price")))
+			}
+		}
+
+		// Uma linha com valores ilegíveis fica fora da importação e vai para o
+		// relatório de erros, que rejeita o arquivo sem interromper a leitura
+		if len(rowErrors) > 0 {
+			catalog.RowErrors = append(catalog.RowErrors, rowErrors...)
+			continue
+		}
+
+		if entity == csvTypeCategory {
+			catalog.Categories = append(catalog.Categories, ImportCategorySchema{
+				ID:          value("id"),
+				ExternalKey: value("external_key"),
+				Name:        value("name"),
+				Active:      active,
+				Row:         line,
+			})
+			continue
+		}
+
+		catalog.Products = append(catalog.Products, ImportProductSchema{
+			ID:          value("id"),
+			ExternalKey: value("external_key"),
+			CategoryID:  value("category_id"),
+			CategoryKey: value("category_key"),
+			Name:        value("name"),
+			Description: value("description"),
+			Price:       price,
+			Active:      active,
+			Row:         line,
+		})
+	}
+
+	return catalog, nil
+}
+
+func parseCSVBool(value string) (*bool, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	switch strings.ToLower(value) {
+	case "true", "1", "sim", "yes":
+		active := true
+		return &active, nil
+	case "false", "0", "nao", "não", "no":
+		active := false
+		return &active, nil
+	}
+
+	return nil, errors.New("invalid boolean")
+}
+
+// Planilhas em pt-BR costumam usar vírgula como separador decimal
+func parseCSVPrice(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(strings.Replace(value, ",", ".", 1), 64)
+}
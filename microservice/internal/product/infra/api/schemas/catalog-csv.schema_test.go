@@ -0,0 +1,98 @@
+package schemas
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/dtos"
+)
+
+func TestWriteCatalogCSV(t *testing.T) {
+	var content bytes.Buffer
+	err := WriteCatalogCSV(&content, dtos.CatalogDTO{
+		Categories: []dtos.CategoryResultDTO{{ID: "catid", ExternalKey: "lanches", Name: "Lanches", Active: true}},
+		Products:   []dtos.ProductResultDTO{{ID: "pid", Name: "X-Salada", Description: "Carne, queijo, alface", Price: 20.5, Active: true, CategoryID: "catid"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, strings.Join([]string{
+		"type,id,external_key,name,description,price,active,category_id,category_key",
+		"category,catid,lanches,Lanches,,,true,,",
+		`product,pid,,X-Salada,"Carne, queijo, alface",20.50,true,catid,lanches`,
+		"",
+	}, "\n"), content.String())
+}
+
+func TestReadImportCatalogCSV_RoundTrip(t *testing.T) {
+	var content bytes.Buffer
+	require.NoError(t, WriteCatalogCSV(&content, dtos.CatalogDTO{
+		Categories: []dtos.CategoryResultDTO{{ID: "catid", ExternalKey: "lanches", Name: "Lanches", Active: false}},
+		Products:   []dtos.ProductResultDTO{{ID: "pid", Name: "X-Salada", Price: 20.5, Active: true, CategoryID: "catid"}},
+	}))
+
+	catalog, err := ReadImportCatalogCSV(&content)
+	require.NoError(t, err)
+	require.Len(t, catalog.Categories, 1)
+	require.Equal(t, "lanches", catalog.Categories[0].ExternalKey)
+	require.False(t, *catalog.Categories[0].Active)
+	require.Equal(t, 2, catalog.Categories[0].Row)
+	require.Len(t, catalog.Products, 1)
+	require.Equal(t, 20.5, catalog.Products[0].Price)
+	require.Equal(t, "lanches", catalog.Products[0].CategoryKey)
+	require.Equal(t, 3, catalog.Products[0].Row)
+}
+
+func TestReadImportCatalogCSV_SpreadsheetConventions(t *testing.T) {
+	csvContent := "\ufeffType,Name,Price,Active,Category_Key\n" +
+		"product,Coca-Cola,\"5,99\",sim,bebidas\n" +
+		",,,,\n" +
+		"product,Suco,8.5,,bebidas\n"
+
+	catalog, err := ReadImportCatalogCSV(strings.NewReader(csvContent))
+	require.NoError(t, err)
+	require.Len(t, catalog.Products, 2)
+	require.Equal(t, 5.99, catalog.Products[0].Price)
+	require.True(t, *catalog.Products[0].Active)
+	require.Nil(t, catalog.Products[1].Active)
+	require.Equal(t, 4, catalog.Products[1].Row)
+}
+
+func TestReadImportCatalogCSV_Errors(t *testing.T) {
+	cases := map[string]string{
+		"":          "csv file is empty",
+		"id,name\n": `"type" column`,
+	}
+
+	for content, expected := range cases {
+		_, err := ReadImportCatalogCSV(strings.NewReader(content))
+		require.ErrorContains(t, err, expected)
+	}
+}
+
+func TestReadImportCatalogCSV_RowErrors(t *testing.T) {
+	catalog, err := ReadImportCatalogCSV(strings.NewReader(strings.Join([]string{
+		"type,name,price,active",
+		"store,Loja,,",
+		"product,X-Salada,abc,maybe",
+		"category,Lanches,,sim",
+		"product,X-Bacon,\"22,5\",",
+	}, "\n")))
+
+	require.NoError(t, err)
+	require.Equal(t, []ImportRowErrorSchema{
+		{Entity: "store", Row: 2, Field: "type", Message: `type must be "category" or "product"`},
+		{Entity: "product", Row: 3, Field: "active", Message: `invalid active value "maybe"`},
+		{Entity: "product", Row: 3, Field: "price", Message: `invalid price value "abc"`},
+	}, catalog.RowErrors)
+	// As linhas válidas seguem sendo lidas depois das inválidas
+	require.Len(t, catalog.Categories, 1)
+	require.Equal(t, 4, catalog.Categories[0].Row)
+	require.Len(t, catalog.Products, 1)
+	require.Equal(t, 22.5, catalog.Products[0].Price)
+
+	importDTO := catalog.ToImportDTO(false, false)
+	require.Len(t, importDTO.RowErrors, 3)
+	require.Equal(t, 3, importDTO.RowErrors[2].Row)
+}
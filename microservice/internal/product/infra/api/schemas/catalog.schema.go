@@ -0,0 +1,140 @@
+package schemas
+
+import "tech_challenge/internal/product/application/dtos"
+
+type CatalogSchema struct {
+	Categories []CategoryResponseSchema `json:"categories"`
+	Products   []ProductResponseSchema  `json:"products"`
+}
+
+func ToCatalogSchema(catalog dtos.CatalogDTO) CatalogSchema {
+	return CatalogSchema{
+		Categories: ListToCategoryResponseSchema(catalog.Categories),
+		Products:   ListProductsResponseSchema(catalog.Products),
+	}
+}
+
+type ImportCategorySchema struct {
+	ID          string `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ExternalKey string `json:"external_key" example:"bebidas"`
+	Name        string `json:"name" example:"Bebidas"`
+	Active      *bool  `json:"active" example:"true"`
+	Row         int    `json:"-"`
+}
+
+type ImportProductSchema struct {
+	ID          string  `json:"id" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	ExternalKey string  `json:"external_key" example:"x-salada"`
+	CategoryID  string  `json:"category_id" example:"2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
+	CategoryKey string  `json:"category_key" example:"lanches"`
+	Name        string  `json:"name" example:"X-Salada"`
+	Description string  `json:"description" example:"Lanche com carne, queijo, alface e tomate"`
+	Price       float64 `json:"price" example:"20.50"`
+	Active      *bool   `json:"active" example:"true"`
+	Row         int     `json:"-"`
+}
+
+// ImportCatalogSchema aceita o mesmo JSON gerado pela exportação. RowErrors
+// traz as linhas do CSV que não puderam ser lidas
+type ImportCatalogSchema struct {
+	Categories []ImportCategorySchema `json:"categories"`
+	Products   []ImportProductSchema  `json:"products"`
+	RowErrors  []ImportRowErrorSchema `json:"-"`
+}
+
+func (s *ImportCatalogSchema) ToImportDTO(dryRun, deactivateMissing bool) dtos.ImportCatalogDTO {
+	categories := make([]dtos.ImportCategoryDTO, len(s.Categories))
+	for i, category := range s.Categories {
+		categories[i] = dtos.ImportCategoryDTO{
+			Row:         rowNumber(category.Row, i),
+			ID:          category.ID,
+			ExternalKey: category.ExternalKey,
+			Name:        category.Name,
+			Active:      category.Active == nil || *category.Active,
+		}
+	}
+
+	products := make([]dtos.ImportProductDTO, len(s.Products))
+	for i, product := range s.Products {
+		products[i] = dtos.ImportProductDTO{
+			Row:         rowNumber(product.Row, i),
+			ID:          product.ID,
+			ExternalKey: product.ExternalKey,
+			CategoryID:  product.CategoryID,
+			CategoryKey: product.CategoryKey,
+			Name:        product.Name,
+			Description: product.Description,
+			Price:       product.Price,
+			Active:      product.Active == nil || *product.Active,
+		}
+	}
+
+	rowErrors := make([]dtos.ImportRowErrorDTO, len(s.RowErrors))
+	for i, rowError := range s.RowErrors {
+		rowErrors[i] = dtos.ImportRowErrorDTO{
+			Entity:  rowError.Entity,
+			Row:     rowError.Row,
+			Field:   rowError.Field,
+			Message: rowError.Message,
+		}
+	}
+
+	return dtos.ImportCatalogDTO{
+		Categories:        categories,
+		Products:          products,
+		RowErrors:         rowErrors,
+		DryRun:            dryRun,
+		DeactivateMissing: deactivateMissing,
+	}
+}
+
+// No JSON a linha é a posição do item na lista; no CSV, a linha do arquivo
+func rowNumber(row, index int) int {
+	if row > 0 {
+		return row
+	}
+	return index + 1
+}
+
+type ImportRowErrorSchema struct {
+	Entity  string `json:"entity" example:"product"`
+	Row     int    `json:"row" example:"3"`
+	Field   string `json:"field" example:"price"`
+	Message string `json:"message" example:"price must be greater than 0"`
+}
+
+type ImportCatalogResultSchema struct {
+	DryRun                bool                   `json:"dry_run" example:"true"`
+	Applied               bool                   `json:"applied" example:"false"`
+	CategoriesCreated     int                    `json:"categories_created" example:"2"`
+	CategoriesUpdated     int                    `json:"categories_updated" example:"1"`
+	CategoriesDeactivated int                    `json:"categories_deactivated" example:"0"`
+	ProductsCreated       int                    `json:"products_created" example:"10"`
+	ProductsUpdated       int                    `json:"products_updated" example:"3"`
+	ProductsDeactivated   int                    `json:"products_deactivated" example:"1"`
+	Errors                []ImportRowErrorSchema `json:"errors"`
+}
+
+func ToImportCatalogResultSchema(result dtos.ImportCatalogResultDTO) ImportCatalogResultSchema {
+	rowErrors := make([]ImportRowErrorSchema, len(result.Errors))
+	for i, rowError := range result.Errors {
+		rowErrors[i] = ImportRowErrorSchema{
+			Entity:  rowError.Entity,
+			Row:     rowError.Row,
+			Field:   rowError.Field,
+			Message: rowError.Message,
+		}
+	}
+
+	return ImportCatalogResultSchema{
+		DryRun:                result.DryRun,
+		Applied:               result.Applied,
+		CategoriesCreated:     result.CategoriesCreated,
+		CategoriesUpdated:     result.CategoriesUpdated,
+		CategoriesDeactivated: result.CategoriesDeactivated,
+		ProductsCreated:       result.ProductsCreated,
+		ProductsUpdated:       result.ProductsUpdated,
+		ProductsDeactivated:   result.ProductsDeactivated,
+		Errors:                rowErrors,
+	}
+}
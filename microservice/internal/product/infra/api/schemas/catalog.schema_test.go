@@ -0,0 +1,51 @@
+package schemas
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToCatalogSchema(t *testing.T) {
+	catalog := dtos.CatalogDTO{
+		Categories: []dtos.CategoryResultDTO{{ID: "catid", Name: "Bebidas", Active: true}},
+		Products:   []dtos.ProductResultDTO{{ID: "pid", Name: "Coca-Cola", Price: 5.99, CategoryID: "catid", Active: true}},
+	}
+	schema := ToCatalogSchema(catalog)
+	require.Len(t, schema.Categories, 1)
+	require.Len(t, schema.Products, 1)
+	require.Equal(t, "Bebidas", schema.Categories[0].Name)
+	require.Equal(t, "catid", schema.Products[0].CategoryID)
+}
+
+func TestImportCatalogSchema_ToImportDTO(t *testing.T) {
+	inactive := false
+	schema := ImportCatalogSchema{
+		Categories: []ImportCategorySchema{{ID: "catid", ExternalKey: "bebidas", Name: "Bebidas"}},
+		Products: []ImportProductSchema{
+			{ID: "pid", CategoryKey: "bebidas", Name: "Coca-Cola", Description: "Lata", Price: 5.99, Active: &inactive, Row: 7},
+		},
+	}
+	dto := schema.ToImportDTO(true, false)
+	require.True(t, dto.DryRun)
+	require.False(t, dto.DeactivateMissing)
+	require.Equal(t, dtos.ImportCategoryDTO{Row: 1, ID: "catid", ExternalKey: "bebidas", Name: "Bebidas", Active: true}, dto.Categories[0])
+	require.Equal(t, 7, dto.Products[0].Row)
+	require.Equal(t, "bebidas", dto.Products[0].CategoryKey)
+	require.Equal(t, 5.99, dto.Products[0].Price)
+	require.False(t, dto.Products[0].Active)
+}
+
+func TestToImportCatalogResultSchema(t *testing.T) {
+	resp := ToImportCatalogResultSchema(dtos.ImportCatalogResultDTO{
+		DryRun:            true,
+		CategoriesCreated: 1,
+		ProductsUpdated:   2,
+		Errors:            []dtos.ImportRowErrorDTO{{Entity: "product", Row: 3, Field: "price", Message: "price must be greater than 0"}},
+	})
+	require.True(t, resp.DryRun)
+	require.Equal(t, 1, resp.CategoriesCreated)
+	require.Equal(t, 2, resp.ProductsUpdated)
+	require.Equal(t, ImportRowErrorSchema{Entity: "product", Row: 3, Field: "price", Message: "price must be greater than 0"}, resp.Errors[0])
+}
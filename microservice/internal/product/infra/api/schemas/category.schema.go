@@ -1,44 +1,104 @@
 package schemas
 
-import "tech_challenge/internal/product/application/dtos"
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"time"
+)
 
 type CreateCategorySchema struct {
-	Name   string `json:"name" binding:"required"`
-	Active bool   `json:"active"`
+	ParentID    string  `json:"parent_id" binding:"omitempty,uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name        *string `json:"name" binding:"required,min=3,max=100" example:"Bebidas"`
+	Description string  `json:"description" binding:"max=255" example:"Refrigerantes, sucos e água"`
+	Active      *bool   `json:"active" binding:"required" example:"true"`
+	// Availability ausente ou null deixa a categoria sem restrição de horário
+	Availability *AvailabilitySchema `json:"availability"`
 }
 
 func (s *CreateCategorySchema) ToDTO() dtos.CreateCategoryDTO {
 	return dtos.CreateCategoryDTO{
-		Name:   s.Name,
-		Active: s.Active,
+		ParentID:     s.ParentID,
+		Name:         valueOf(s.Name),
+		Description:  s.Description,
+		Active:       valueOf(s.Active),
+		Availability: s.Availability.ToDTO(),
 	}
 }
 
 type UpdateCategoryRequestBodySchema struct {
-	Name   string `json:"name" binding:"required"`
-	Active *bool  `json:"active"`
+	ParentID    string  `json:"parent_id" binding:"omitempty,uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name        *string `json:"name" binding:"required,min=3,max=100" example:"Bebidas"`
+	Description string  `json:"description" binding:"max=255" example:"Refrigerantes, sucos e água"`
+	Active      *bool   `json:"active" binding:"required" example:"true"`
+	// Availability ausente ou null deixa a categoria sem restrição de horário
+	Availability *AvailabilitySchema `json:"availability"`
 }
 
 func (s *UpdateCategoryRequestBodySchema) ToDTO(categoryID string) dtos.UpdateCategoryDTO {
 	return dtos.UpdateCategoryDTO{
-		ID:     categoryID,
-		Name:   s.Name,
-		Active: *s.Active,
+		ID:           categoryID,
+		ParentID:     s.ParentID,
+		Name:         valueOf(s.Name),
+		Description:  s.Description,
+		Active:       valueOf(s.Active),
+		Availability: s.Availability.ToDTO(),
 	}
 }
 
+type ReorderCategoriesSchema struct {
+	CategoryIDs []string `json:"category_ids" binding:"required,min=1,max=1000,dive,uuid" example:"123e4567-e89b-12d3-a456-426614174000,2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
+}
+
+func (s *ReorderCategoriesSchema) ToDTO() dtos.ReorderCategoriesDTO {
+	return dtos.ReorderCategoriesDTO{
+		CategoryIDs: s.CategoryIDs,
+	}
+}
+
+type DeleteCategoryQuerySchema struct {
+	Strategy         string `form:"strategy" binding:"omitempty,oneof=restrict reassign deactivate"`
+	TargetCategoryID string `form:"target_category_id" binding:"omitempty,uuid"`
+}
+
 type CategoryResponseSchema struct {
-	ID     string `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Name   string `json:"name" example:"Bebidas"`
-	Active bool   `json:"active" example:"true"`
+	ID           string               `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ExternalKey  string               `json:"external_key,omitempty" example:"bebidas"`
+	ParentID     string               `json:"parent_id,omitempty" example:"2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
+	Name         string               `json:"name" example:"Bebidas"`
+	Description  string               `json:"description" example:"Refrigerantes, sucos e água"`
+	Position     int                  `json:"position" example:"1"`
+	Image        *ImageResponseSchema `json:"image,omitempty"`
+	Active       bool                 `json:"active" example:"true"`
+	Availability *AvailabilitySchema  `json:"availability,omitempty"`
+	// AvailableNow indica se a categoria e as categorias acima dela estão
+	// disponíveis no horário avaliado
+	AvailableNow bool      `json:"available_now" example:"true"`
+	Version      int64     `json:"version" example:"1"`
+	UpdatedAt    time.Time `json:"updated_at" example:"2025-01-15T13:45:00Z"`
 }
 
 func ToCategoryResponseSchema(dto dtos.CategoryResultDTO) CategoryResponseSchema {
-	return CategoryResponseSchema{
-		ID:     dto.ID,
-		Name:   dto.Name,
-		Active: dto.Active,
+	response := CategoryResponseSchema{
+		ID:           dto.ID,
+		ExternalKey:  dto.ExternalKey,
+		ParentID:     dto.ParentID,
+		Name:         dto.Name,
+		Description:  dto.Description,
+		Position:     dto.Position,
+		Active:       dto.Active,
+		Availability: toAvailabilitySchema(dto.Availability),
+		AvailableNow: dto.AvailableNow,
+		Version:      dto.Version,
+		UpdatedAt:    dto.UpdatedAt,
+	}
+
+	if dto.ImageFileName != "" {
+		response.Image = &ImageResponseSchema{
+			FileName: dto.ImageFileName,
+			Url:      dto.ImageUrl,
+		}
 	}
+
+	return response
 }
 
 func ListToCategoryResponseSchema(dtos []dtos.CategoryResultDTO) []CategoryResponseSchema {
@@ -49,10 +109,40 @@ func ListToCategoryResponseSchema(dtos []dtos.CategoryResultDTO) []CategoryRespo
 	return schemas
 }
 
-type InvalidCategoryDataErrorSchema struct {
-	Error string `json:"error" example:"Invalid category data"`
+type CategoryTreeResponseSchema struct {
+	CategoryResponseSchema
+	Children []CategoryTreeResponseSchema `json:"children"`
+}
+
+func ToCategoryTreeResponseSchema(tree []dtos.CategoryTreeDTO) []CategoryTreeResponseSchema {
+	schemas := make([]CategoryTreeResponseSchema, len(tree))
+	for i, node := range tree {
+		schemas[i] = CategoryTreeResponseSchema{
+			CategoryResponseSchema: ToCategoryResponseSchema(node.CategoryResultDTO),
+			Children:               ToCategoryTreeResponseSchema(node.Children),
+		}
+	}
+	return schemas
+}
+
+type DeleteCategoryResultSchema struct {
+	CategoryID            string   `json:"category_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Strategy              string   `json:"strategy" example:"reassign"`
+	TargetCategoryID      string   `json:"target_category_id,omitempty" example:"2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
+	Deleted               bool     `json:"deleted" example:"true"`
+	DeactivatedCategories []string `json:"deactivated_categories"`
+	AffectedProducts      []string `json:"affected_products" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	AffectedProductsCount int      `json:"affected_products_count" example:"1"`
 }
 
-type CategoryNotFoundErrorSchema struct {
-	Error string `json:"error" example:"Category not found"`
+func ToDeleteCategoryResultSchema(result dtos.DeleteCategoryResultDTO) DeleteCategoryResultSchema {
+	return DeleteCategoryResultSchema{
+		CategoryID:            result.CategoryID,
+		Strategy:              result.Strategy,
+		TargetCategoryID:      result.TargetCategoryID,
+		Deleted:               result.Deleted,
+		DeactivatedCategories: result.DeactivatedCategories,
+		AffectedProducts:      result.AffectedProducts,
+		AffectedProductsCount: len(result.AffectedProducts),
+	}
 }
@@ -8,18 +8,17 @@ import (
 )
 
 func TestCreateCategorySchema_ToDTO(t *testing.T) {
-	schema := CreateCategorySchema{Name: "Bebidas", Active: true}
+	schema := CreateCategorySchema{Name: ptr("Bebidas"), Active: ptr(true)}
 	dto := schema.ToDTO()
-	require.Equal(t, schema.Name, dto.Name)
-	require.Equal(t, schema.Active, dto.Active)
+	require.Equal(t, "Bebidas", dto.Name)
+	require.True(t, dto.Active)
 }
 
 func TestUpdateCategoryRequestBodySchema_ToDTO(t *testing.T) {
-	active := true
-	schema := UpdateCategoryRequestBodySchema{Name: "Bebidas", Active: &active}
+	schema := UpdateCategoryRequestBodySchema{Name: ptr("Bebidas"), Active: ptr(true)}
 	dto := schema.ToDTO("catid")
 	require.Equal(t, "catid", dto.ID)
-	require.Equal(t, schema.Name, dto.Name)
+	require.Equal(t, "Bebidas", dto.Name)
 	require.Equal(t, *schema.Active, dto.Active)
 }
 
@@ -0,0 +1,93 @@
+package schemas
+
+import "tech_challenge/internal/product/application/dtos"
+
+type MenuProductSchema struct {
+	ID          string  `json:"id" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	Name        string  `json:"name" example:"X-Salada"`
+	Description *string `json:"description,omitempty" example:"Lanche com carne, queijo, alface e tomate"`
+	Price       float64 `json:"price" example:"20.50"`
+	// EffectivePrice é o preço com as promoções que valem no horário do cardápio
+	OriginalPrice     float64                  `json:"original_price" example:"20.50"`
+	EffectivePrice    float64                  `json:"effective_price" example:"16.40"`
+	AppliedPromotion  *AppliedPromotionSchema  `json:"applied_promotion,omitempty"`
+	StackedPromotions []AppliedPromotionSchema `json:"stacked_promotions,omitempty"`
+	SoldOut           bool                     `json:"sold_out" example:"false"`
+	Image             *ImageResponseSchema     `json:"image,omitempty"`
+}
+
+type MenuCategorySchema struct {
+	ID            string               `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name          string               `json:"name" example:"Lanches"`
+	Description   *string              `json:"description,omitempty" example:"Lanches na chapa"`
+	Image         *ImageResponseSchema `json:"image,omitempty"`
+	Products      []MenuProductSchema  `json:"products"`
+	Subcategories []MenuCategorySchema `json:"subcategories"`
+}
+
+type MenuResponseSchema struct {
+	Categories []MenuCategorySchema `json:"categories"`
+}
+
+// ToMenuResponseSchema monta o cardápio; no modo compacto as descrições são
+// omitidas para reduzir o payload em totens com pouca banda
+func ToMenuResponseSchema(menu []dtos.MenuSectionDTO, compact bool) MenuResponseSchema {
+	return MenuResponseSchema{Categories: toMenuCategorySchemas(menu, compact)}
+}
+
+func toMenuCategorySchemas(sections []dtos.MenuSectionDTO, compact bool) []MenuCategorySchema {
+	categories := make([]MenuCategorySchema, len(sections))
+
+	for i, section := range sections {
+		category := MenuCategorySchema{
+			ID:            section.Category.ID,
+			Name:          section.Category.Name,
+			Products:      make([]MenuProductSchema, len(section.Products)),
+			Subcategories: toMenuCategorySchemas(section.Subcategories, compact),
+		}
+
+		if !compact {
+			category.Description = &section.Category.Description
+		}
+
+		if section.Category.ImageFileName != "" {
+			category.Image = &ImageResponseSchema{
+				FileName: section.Category.ImageFileName,
+				Url:      section.Category.ImageUrl,
+			}
+		}
+
+		for j, product := range section.Products {
+			category.Products[j] = toMenuProductSchema(product, compact)
+		}
+
+		categories[i] = category
+	}
+
+	return categories
+}
+
+func toMenuProductSchema(product dtos.MenuProductDTO, compact bool) MenuProductSchema {
+	schema := MenuProductSchema{
+		ID:             product.ID,
+		Name:           product.Name,
+		Price:          product.Price,
+		OriginalPrice:  product.OriginalPrice,
+		EffectivePrice: product.EffectivePrice,
+		SoldOut:        product.SoldOut,
+	}
+	schema.AppliedPromotion, schema.StackedPromotions = toAppliedPromotionSchemas(product.AppliedPromotions)
+
+	if !compact {
+		schema.Description = &product.Description
+	}
+
+	if len(product.Images) > 0 {
+		schema.Image = &ImageResponseSchema{
+			FileName: product.Images[0].FileName,
+			Url:      product.Images[0].Url,
+		}
+	}
+
+	return schema
+}
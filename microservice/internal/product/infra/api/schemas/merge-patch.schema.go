@@ -0,0 +1,112 @@
+package schemas
+
+import "tech_challenge/internal/product/application/dtos"
+
+// MergePatch guarda os campos enviados como null em um JSON Merge Patch
+// (RFC 7396): nos ponteiros, null e campo ausente viram igualmente nil. A
+// mescla vale só no primeiro nível: objetos aninhados substituem o valor atual
+type MergePatch struct {
+	nullFields map[string]bool
+}
+
+func (p *MergePatch) SetNullFields(fields []string) {
+	p.nullFields = make(map[string]bool, len(fields))
+	for _, field := range fields {
+		p.nullFields[field] = true
+	}
+}
+
+func (p *MergePatch) IsNull(field string) bool {
+	return p.nullFields[field]
+}
+
+type PatchProductSchema struct {
+	MergePatch
+	CategoryID   *string               `json:"category_id" binding:"omitempty,uuid" example:"2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
+	Name         *string               `json:"name" binding:"omitempty,min=3,max=100" example:"X-Salada"`
+	Description  *string               `json:"description" binding:"omitempty,min=1" example:"Lanche com carne, queijo, alface e tomate"`
+	Price        *float64              `json:"price" binding:"omitempty,gt=0,lt=1000000" example:"20.50"`
+	Active       *bool                 `json:"active" example:"true"`
+	Availability *AvailabilitySchema   `json:"availability"`
+	Nutrition    *NutritionFactsSchema `json:"nutrition"`
+	Allergens    []string              `json:"allergens" binding:"omitempty,max=10,dive,oneof=gluten lactose milk eggs fish crustaceans peanuts tree_nuts soy latex" example:"gluten,milk"`
+}
+
+// description null limpa a descrição e availability, nutrition e allergens
+// null removem a grade, a tabela nutricional e os alérgenos; os demais campos
+// do produto são obrigatórios. availability e nutrition são substituídos por
+// inteiro, não mesclados campo a campo
+func (s *PatchProductSchema) NullableFields() []string {
+	return []string{"description", "availability", "nutrition", "allergens"}
+}
+
+func (s *PatchProductSchema) ToDTO(productID string) dtos.PatchProductDTO {
+	dto := dtos.PatchProductDTO{
+		ID:           productID,
+		CategoryID:   s.CategoryID,
+		Name:         s.Name,
+		Description:  s.Description,
+		Price:        s.Price,
+		Active:       s.Active,
+		Availability: s.Availability.ToDTO(),
+		Nutrition:    s.Nutrition.ToDTO(),
+	}
+
+	if s.Allergens != nil {
+		dto.Allergens = &s.Allergens
+	}
+
+	if s.IsNull("description") {
+		dto.Description = new(string)
+	}
+	if s.IsNull("availability") {
+		dto.Availability = &dtos.AvailabilityDTO{}
+	}
+	if s.IsNull("nutrition") {
+		dto.Nutrition = &dtos.NutritionFactsDTO{}
+	}
+	if s.IsNull("allergens") {
+		dto.Allergens = new([]string)
+	}
+
+	return dto
+}
+
+type PatchCategorySchema struct {
+	MergePatch
+	ParentID     *string             `json:"parent_id" binding:"omitempty,uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name         *string             `json:"name" binding:"omitempty,min=3,max=100" example:"Bebidas"`
+	Description  *string             `json:"description" binding:"omitempty,max=255" example:"Refrigerantes, sucos e água"`
+	Active       *bool               `json:"active" example:"true"`
+	Availability *AvailabilitySchema `json:"availability"`
+}
+
+// parent_id null torna a categoria raiz, description null limpa a descrição e
+// availability null remove a grade, que também é substituída por inteiro
+func (s *PatchCategorySchema) NullableFields() []string {
+	return []string{"parent_id", "description", "availability"}
+}
+
+func (s *PatchCategorySchema) ToDTO(categoryID string) dtos.PatchCategoryDTO {
+	dto := dtos.PatchCategoryDTO{
+		ID:           categoryID,
+		ParentID:     s.ParentID,
+		Name:         s.Name,
+		Description:  s.Description,
+		Active:       s.Active,
+		Availability: s.Availability.ToDTO(),
+	}
+
+	empty := ""
+	if s.IsNull("parent_id") {
+		dto.ParentID = &empty
+	}
+	if s.IsNull("description") {
+		dto.Description = &empty
+	}
+	if s.IsNull("availability") {
+		dto.Availability = &dtos.AvailabilityDTO{}
+	}
+
+	return dto
+}
@@ -0,0 +1,62 @@
+package schemas
+
+import "tech_challenge/internal/product/application/dtos"
+
+// NutritionFactsSchema é a tabela nutricional por porção: energia em kcal,
+// sódio em mg e os demais nutrientes em gramas
+type NutritionFactsSchema struct {
+	ServingSize   *float64 `json:"serving_size" binding:"required,gt=0,lt=100000" example:"180"`
+	ServingUnit   string   `json:"serving_unit" binding:"omitempty,oneof=g ml" example:"g" enums:"g,ml"`
+	EnergyKcal    *float64 `json:"energy_kcal" binding:"required,min=0,lt=100000" example:"420"`
+	Carbohydrates *float64 `json:"carbohydrates_g" binding:"required,min=0,lt=100000" example:"38"`
+	TotalSugars   *float64 `json:"total_sugars_g" binding:"required,min=0,lt=100000" example:"6"`
+	AddedSugars   *float64 `json:"added_sugars_g" binding:"required,min=0,lt=100000" example:"2"`
+	Proteins      *float64 `json:"proteins_g" binding:"required,min=0,lt=100000" example:"22"`
+	TotalFat      *float64 `json:"total_fat_g" binding:"required,min=0,lt=100000" example:"19"`
+	SaturatedFat  *float64 `json:"saturated_fat_g" binding:"required,min=0,lt=100000" example:"7"`
+	TransFat      *float64 `json:"trans_fat_g" binding:"required,min=0,lt=100000" example:"0.2"`
+	DietaryFiber  *float64 `json:"dietary_fiber_g" binding:"required,min=0,lt=100000" example:"3"`
+	Sodium        *float64 `json:"sodium_mg" binding:"required,min=0,lt=1000000" example:"780"`
+}
+
+func (s *NutritionFactsSchema) ToDTO() *dtos.NutritionFactsDTO {
+	if s == nil {
+		return nil
+	}
+
+	return &dtos.NutritionFactsDTO{
+		ServingSize:   valueOf(s.ServingSize),
+		ServingUnit:   s.ServingUnit,
+		EnergyKcal:    valueOf(s.EnergyKcal),
+		Carbohydrates: valueOf(s.Carbohydrates),
+		TotalSugars:   valueOf(s.TotalSugars),
+		AddedSugars:   valueOf(s.AddedSugars),
+		Proteins:      valueOf(s.Proteins),
+		TotalFat:      valueOf(s.TotalFat),
+		SaturatedFat:  valueOf(s.SaturatedFat),
+		TransFat:      valueOf(s.TransFat),
+		DietaryFiber:  valueOf(s.DietaryFiber),
+		Sodium:        valueOf(s.Sodium),
+	}
+}
+
+func toNutritionFactsSchema(dto *dtos.NutritionFactsDTO) *NutritionFactsSchema {
+	if dto == nil {
+		return nil
+	}
+
+	return &NutritionFactsSchema{
+		ServingSize:   &dto.ServingSize,
+		ServingUnit:   dto.ServingUnit,
+		EnergyKcal:    &dto.EnergyKcal,
+		Carbohydrates: &dto.Carbohydrates,
+		TotalSugars:   &dto.TotalSugars,
+		AddedSugars:   &dto.AddedSugars,
+		Proteins:      &dto.Proteins,
+		TotalFat:      &dto.TotalFat,
+		SaturatedFat:  &dto.SaturatedFat,
+		TransFat:      &dto.TransFat,
+		DietaryFiber:  &dto.DietaryFiber,
+		Sodium:        &dto.Sodium,
+	}
+}
@@ -0,0 +1,21 @@
+package schemas
+
+// ProblemSchema documenta o corpo application/problem+json (RFC 7807) de
+// todas as respostas de erro
+type ProblemSchema struct {
+	Type       string               `json:"type" example:"/problems/product-not-found"`
+	Title      string               `json:"title" example:"Product not found"`
+	Status     int                  `json:"status" example:"404"`
+	Detail     string               `json:"detail" example:"Product not found"`
+	Instance   string               `json:"instance" example:"/v1/products/0b6f1f4e-6c1a-4a5e-9d0e-2f1a0c9b7e11"`
+	Code       string               `json:"code" example:"PRODUCT_NOT_FOUND"`
+	RequestID  string               `json:"request_id" example:"5f0c2f8e-2b7c-4d8e-9a51-6f3e1d2c4b7a"`
+	Errors     []ProblemFieldSchema `json:"errors,omitempty"`
+	RetryAfter int                  `json:"retry_after,omitempty" example:"5"`
+}
+
+type ProblemFieldSchema struct {
+	Field   string `json:"field" example:"name"`
+	Code    string `json:"code" example:"required"`
+	Message string `json:"message" example:"name is required"`
+}
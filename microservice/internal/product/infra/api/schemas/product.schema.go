@@ -2,46 +2,108 @@ package schemas
 
 import (
 	"mime/multipart"
+	"strings"
 	"tech_challenge/internal/product/application/dtos"
+	"time"
 )
 
 type CreateProductSchema struct {
-	CategoryID  string  `json:"category_id" binding:"required"`
-	Name        string  `json:"name" binding:"required"`
-	Description string  `json:"description" binding:"required"`
-	Price       float64 `json:"price"`
-	Active      bool    `json:"active"`
+	CategoryID  *string  `json:"category_id" binding:"required,uuid" example:"2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
+	Name        *string  `json:"name" binding:"required,min=3,max=100" example:"X-Salada"`
+	Description *string  `json:"description" binding:"required,min=1" example:"Lanche com carne, queijo, alface e tomate"`
+	Price       *float64 `json:"price" binding:"required,gt=0,lt=1000000" example:"20.50"`
+	Active      *bool    `json:"active" binding:"required" example:"true"`
+	// Availability ausente ou null deixa o produto sem restrição de horário
+	Availability *AvailabilitySchema `json:"availability"`
+	// Nutrition ausente ou null deixa o produto sem tabela nutricional
+	Nutrition *NutritionFactsSchema `json:"nutrition"`
+	// Allergens ausente ou null deixa os alérgenos como não declarados; []
+	// declara que o produto não contém nenhum
+	Allergens []string `json:"allergens" binding:"omitempty,max=10,dive,oneof=gluten lactose milk eggs fish crustaceans peanuts tree_nuts soy latex" example:"gluten,milk"`
 }
 
 func (s *CreateProductSchema) ToDTO() dtos.CreateProductDTO {
 	return dtos.CreateProductDTO{
-		CategoryID:  s.CategoryID,
-		Name:        s.Name,
-		Description: s.Description,
-		Price:       s.Price,
-		Active:      s.Active,
+		CategoryID:   valueOf(s.CategoryID),
+		Name:         valueOf(s.Name),
+		Description:  valueOf(s.Description),
+		Price:        valueOf(s.Price),
+		Active:       valueOf(s.Active),
+		Availability: s.Availability.ToDTO(),
+		Nutrition:    s.Nutrition.ToDTO(),
+		Allergens:    s.Allergens,
 	}
 }
 
 type UpdateProductRequestBodySchema struct {
-	CategoryID  string  `json:"category_id" binding:"required"`
-	Name        string  `json:"name" binding:"required"`
-	Description string  `json:"description" binding:"required"`
-	Price       float64 `json:"price"`
-	Active      bool    `json:"active"`
+	CategoryID  *string  `json:"category_id" binding:"required,uuid" example:"2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
+	Name        *string  `json:"name" binding:"required,min=3,max=100" example:"X-Salada"`
+	Description *string  `json:"description" binding:"required,min=1" example:"Lanche com carne, queijo, alface e tomate"`
+	Price       *float64 `json:"price" binding:"required,gt=0,lt=1000000" example:"20.50"`
+	Active      *bool    `json:"active" binding:"required" example:"true"`
+	// Availability ausente ou null deixa o produto sem restrição de horário
+	Availability *AvailabilitySchema `json:"availability"`
+	// Nutrition ausente ou null deixa o produto sem tabela nutricional
+	Nutrition *NutritionFactsSchema `json:"nutrition"`
+	// Allergens ausente ou null deixa os alérgenos como não declarados; []
+	// declara que o produto não contém nenhum
+	Allergens []string `json:"allergens" binding:"omitempty,max=10,dive,oneof=gluten lactose milk eggs fish crustaceans peanuts tree_nuts soy latex" example:"gluten,milk"`
 }
 
 func (s *UpdateProductRequestBodySchema) ToDTO(productID string) dtos.UpdateProductDTO {
 	return dtos.UpdateProductDTO{
-		ID:          productID,
-		CategoryID:  s.CategoryID,
-		Name:        s.Name,
-		Description: s.Description,
-		Price:       s.Price,
-		Active:      s.Active,
+		ID:           productID,
+		CategoryID:   valueOf(s.CategoryID),
+		Name:         valueOf(s.Name),
+		Description:  valueOf(s.Description),
+		Price:        valueOf(s.Price),
+		Active:       valueOf(s.Active),
+		Availability: s.Availability.ToDTO(),
+		Nutrition:    s.Nutrition.ToDTO(),
+		Allergens:    s.Allergens,
 	}
 }
 
+type ListProductsQuerySchema struct {
+	CategoryID string `form:"category_id" binding:"omitempty,uuid"`
+	// ExcludeAllergens é uma lista separada por vírgulas, ex.: gluten,lactose
+	ExcludeAllergens string `form:"exclude_allergens" binding:"omitempty,max=200"`
+	// IDs é uma lista separada por vírgulas que troca a listagem pela leitura em lote
+	IDs string `form:"ids" binding:"omitempty,max=10000"`
+}
+
+// BatchIDs separa os IDs de ids, ignorando os vazios
+func (s *ListProductsQuerySchema) BatchIDs() []string {
+	var ids []string
+	for _, id := range strings.Split(s.IDs, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (s *ListProductsQuerySchema) ToDTO() dtos.ProductFilterDTO {
+	filter := dtos.ProductFilterDTO{}
+
+	if s.CategoryID != "" {
+		filter.CategoryID = &s.CategoryID
+	}
+
+	for _, allergen := range strings.Split(s.ExcludeAllergens, ",") {
+		if allergen = strings.TrimSpace(allergen); allergen != "" {
+			filter.ExcludeAllergens = append(filter.ExcludeAllergens, allergen)
+		}
+	}
+
+	return filter
+}
+
+type ProductImageURISchema struct {
+	ID            string `uri:"id" binding:"required,uuid"`
+	ImageFileName string `uri:"image_file_name" binding:"required,max=255"`
+}
+
 type UploadImageRequestSchema struct {
 	Image *multipart.FileHeader `form:"image" binding:"required"`
 }
@@ -52,13 +114,30 @@ type ImageResponseSchema struct {
 }
 
 type ProductResponseSchema struct {
-	ID          string                `json:"id" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
-	Name        string                `json:"name" example:"X-Salada"`
-	Description string                `json:"description" example:"Lanche com carne, queijo, alface e tomate"`
-	Price       float64               `json:"price" example:"20.50"`
-	Active      bool                  `json:"active" example:"true"`
-	CategoryID  string                `json:"category_id" example:"2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
-	Images      []ImageResponseSchema `json:"images"`
+	ID           string                `json:"id" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	ExternalKey  string                `json:"external_key,omitempty" example:"x-salada"`
+	Name         string                `json:"name" example:"X-Salada"`
+	Description  string                `json:"description" example:"Lanche com carne, queijo, alface e tomate"`
+	Price        float64               `json:"price" example:"20.50"`
+	Active       bool                  `json:"active" example:"true"`
+	CategoryID   string                `json:"category_id" example:"2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
+	Images       []ImageResponseSchema `json:"images"`
+	Availability *AvailabilitySchema   `json:"availability,omitempty"`
+	Nutrition    *NutritionFactsSchema `json:"nutrition,omitempty"`
+	// Allergens é null enquanto o produto não declarar seus alérgenos
+	Allergens []string `json:"allergens" example:"gluten,milk"`
+	// AvailableNow indica se o produto está à venda no horário avaliado; um
+	// produto esgotado nunca está
+	AvailableNow bool `json:"available_now" example:"true"`
+	SoldOut      bool `json:"sold_out" example:"false"`
+	// EffectivePrice é o preço cobrado depois das promoções; sem promoção é
+	// igual a OriginalPrice
+	OriginalPrice     float64                  `json:"original_price" example:"32.90"`
+	EffectivePrice    float64                  `json:"effective_price" example:"27.90"`
+	AppliedPromotion  *AppliedPromotionSchema  `json:"applied_promotion,omitempty"`
+	StackedPromotions []AppliedPromotionSchema `json:"stacked_promotions,omitempty"`
+	Version           int64                    `json:"version" example:"1"`
+	UpdatedAt         time.Time                `json:"updated_at" example:"2025-01-15T13:45:00Z"`
 }
 
 func ToProductResponseSchema(product dtos.ProductResultDTO) ProductResponseSchema {
@@ -71,14 +150,28 @@ func ToProductResponseSchema(product dtos.ProductResultDTO) ProductResponseSchem
 		}
 	}
 
+	appliedPromotion, stackedPromotions := toAppliedPromotionSchemas(product.AppliedPromotions)
+
 	return ProductResponseSchema{
-		ID:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		Active:      product.Active,
-		CategoryID:  product.CategoryID,
-		Images:      images,
+		ID:                product.ID,
+		ExternalKey:       product.ExternalKey,
+		Name:              product.Name,
+		Description:       product.Description,
+		Price:             product.Price,
+		Active:            product.Active,
+		CategoryID:        product.CategoryID,
+		Images:            images,
+		Availability:      toAvailabilitySchema(product.Availability),
+		Nutrition:         toNutritionFactsSchema(product.Nutrition),
+		Allergens:         product.Allergens,
+		AvailableNow:      product.AvailableNow,
+		SoldOut:           product.SoldOut,
+		OriginalPrice:     product.OriginalPrice,
+		EffectivePrice:    product.EffectivePrice,
+		AppliedPromotion:  appliedPromotion,
+		StackedPromotions: stackedPromotions,
+		Version:           product.Version,
+		UpdatedAt:         product.UpdatedAt,
 	}
 }
 
@@ -92,18 +185,109 @@ func ListProductsResponseSchema(products []dtos.ProductResultDTO) []ProductRespo
 	return response
 }
 
-type ProductNotFoundErrorSchema struct {
-	Error string `json:"error" example:"Product not found"`
+type BatchGetProductsSchema struct {
+	IDs []string `json:"ids" binding:"required,min=1,dive,uuid" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+}
+
+// ProductBatchResponseSchema traz os produtos na ordem pedida, sem repetição,
+// e os IDs que não existem
+type ProductBatchResponseSchema struct {
+	Products   []ProductResponseSchema `json:"products"`
+	MissingIDs []string                `json:"missing_ids" example:"0b4c6a9e-2f0e-4a3c-9a52-3f4f2b1d7c11"`
 }
 
-type ProductAlreadyExistsErrorSchema struct {
-	Error string `json:"error" example:"Product already exists"`
+func ToProductBatchResponseSchema(result dtos.ProductBatchResultDTO) ProductBatchResponseSchema {
+	return ProductBatchResponseSchema{
+		Products:   ListProductsResponseSchema(result.Products),
+		MissingIDs: nonNil(result.MissingIDs),
+	}
+}
+
+type BulkProductFilterSchema struct {
+	CategoryID *string  `json:"category_id" binding:"omitempty,uuid" example:"2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
+	IDs        []string `json:"ids" binding:"omitempty,max=1000,dive,uuid" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	Active     *bool    `json:"active" example:"true"`
+}
+
+type BulkPriceAdjustmentSchema struct {
+	Mode     string  `json:"mode" binding:"omitempty,oneof=percentage fixed" example:"percentage" enums:"percentage,fixed"`
+	Value    float64 `json:"value" binding:"gt=-1000000,lt=1000000" example:"10"`
+	Rounding string  `json:"rounding" binding:"omitempty,oneof=cents ten_cents whole ninety_nine" example:"cents" enums:"cents,ten_cents,whole,ninety_nine"`
 }
 
-type InvalidProductDataErrorSchema struct {
-	Error string `json:"error" example:"Invalid product data"`
+type BulkProductOperationSchema struct {
+	Type       string                    `json:"type" binding:"required,oneof=activate deactivate move_category adjust_price" example:"adjust_price" enums:"activate,deactivate,move_category,adjust_price"`
+	CategoryID string                    `json:"category_id" binding:"omitempty,uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Price      BulkPriceAdjustmentSchema `json:"price"`
+}
+
+type BulkUpdateProductsSchema struct {
+	Filter    BulkProductFilterSchema    `json:"filter"`
+	Operation BulkProductOperationSchema `json:"operation" binding:"required"`
+}
+
+func (s *BulkUpdateProductsSchema) ToDTO(dryRun bool) dtos.BulkUpdateProductsDTO {
+	return dtos.BulkUpdateProductsDTO{
+		Filter: dtos.BulkProductFilterDTO{
+			CategoryID: s.Filter.CategoryID,
+			IDs:        s.Filter.IDs,
+			Active:     s.Filter.Active,
+		},
+		Operation: dtos.BulkProductOperationDTO{
+			Type:             s.Operation.Type,
+			TargetCategoryID: s.Operation.CategoryID,
+			PriceAdjustment: dtos.BulkPriceAdjustmentDTO{
+				Mode:     s.Operation.Price.Mode,
+				Value:    s.Operation.Price.Value,
+				Rounding: s.Operation.Price.Rounding,
+			},
+		},
+		DryRun: dryRun,
+	}
 }
 
-type ErrorMessageSchema struct {
-	Error string `json:"error" example:"Internal server error"`
+type BulkProductChangeSchema struct {
+	ProductID          string  `json:"product_id" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	Name               string  `json:"name" example:"X-Salada"`
+	PreviousActive     bool    `json:"previous_active" example:"true"`
+	Active             bool    `json:"active" example:"true"`
+	PreviousCategoryID string  `json:"previous_category_id" example:"2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
+	CategoryID         string  `json:"category_id" example:"2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
+	PreviousPrice      float64 `json:"previous_price" example:"20.50"`
+	Price              float64 `json:"price" example:"22.55"`
+}
+
+type BulkUpdateProductsResultSchema struct {
+	DryRun    bool                      `json:"dry_run" example:"true"`
+	Applied   bool                      `json:"applied" example:"false"`
+	Operation string                    `json:"operation" example:"adjust_price"`
+	Matched   int                       `json:"matched" example:"12"`
+	Changed   int                       `json:"changed" example:"12"`
+	Changes   []BulkProductChangeSchema `json:"changes"`
+}
+
+func ToBulkUpdateProductsResultSchema(result dtos.BulkUpdateProductsResultDTO) BulkUpdateProductsResultSchema {
+	changes := make([]BulkProductChangeSchema, len(result.Changes))
+
+	for i, change := range result.Changes {
+		changes[i] = BulkProductChangeSchema{
+			ProductID:          change.ProductID,
+			Name:               change.Name,
+			PreviousActive:     change.PreviousActive,
+			Active:             change.Active,
+			PreviousCategoryID: change.PreviousCategoryID,
+			CategoryID:         change.CategoryID,
+			PreviousPrice:      change.PreviousPrice,
+			Price:              change.Price,
+		}
+	}
+
+	return BulkUpdateProductsResultSchema{
+		DryRun:    result.DryRun,
+		Applied:   result.Applied,
+		Operation: result.Operation,
+		Matched:   result.Matched,
+		Changed:   result.Changed,
+		Changes:   changes,
+	}
 }
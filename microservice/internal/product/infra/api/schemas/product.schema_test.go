@@ -9,35 +9,35 @@ import (
 
 func TestCreateProductSchema_ToDTO(t *testing.T) {
 	schema := CreateProductSchema{
-		CategoryID:  "catid",
-		Name:        "Coca-Cola",
-		Description: "desc",
-		Price:       5.99,
-		Active:      true,
+		CategoryID:  ptr("catid"),
+		Name:        ptr("Coca-Cola"),
+		Description: ptr("desc"),
+		Price:       ptr(5.99),
+		Active:      ptr(true),
 	}
 	dto := schema.ToDTO()
-	require.Equal(t, schema.CategoryID, dto.CategoryID)
-	require.Equal(t, schema.Name, dto.Name)
-	require.Equal(t, schema.Description, dto.Description)
-	require.Equal(t, schema.Price, dto.Price)
-	require.Equal(t, schema.Active, dto.Active)
+	require.Equal(t, "catid", dto.CategoryID)
+	require.Equal(t, "Coca-Cola", dto.Name)
+	require.Equal(t, "desc", dto.Description)
+	require.Equal(t, 5.99, dto.Price)
+	require.True(t, dto.Active)
 }
 
 func TestUpdateProductRequestBodySchema_ToDTO(t *testing.T) {
 	schema := UpdateProductRequestBodySchema{
-		CategoryID:  "catid",
-		Name:        "Coca-Cola",
-		Description: "desc",
-		Price:       5.99,
-		Active:      true,
+		CategoryID:  ptr("catid"),
+		Name:        ptr("Coca-Cola"),
+		Description: ptr("desc"),
+		Price:       ptr(5.99),
+		Active:      ptr(true),
 	}
 	dto := schema.ToDTO("pid")
 	require.Equal(t, "pid", dto.ID)
-	require.Equal(t, schema.CategoryID, dto.CategoryID)
-	require.Equal(t, schema.Name, dto.Name)
-	require.Equal(t, schema.Description, dto.Description)
-	require.Equal(t, schema.Price, dto.Price)
-	require.Equal(t, schema.Active, dto.Active)
+	require.Equal(t, "catid", dto.CategoryID)
+	require.Equal(t, "Coca-Cola", dto.Name)
+	require.Equal(t, "desc", dto.Description)
+	require.Equal(t, 5.99, dto.Price)
+	require.True(t, dto.Active)
 }
 
 func TestToProductResponseSchema(t *testing.T) {
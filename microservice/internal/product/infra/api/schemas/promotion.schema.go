@@ -0,0 +1,128 @@
+package schemas
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/application/dtos"
+)
+
+// SavePromotionSchema cria ou substitui uma promoção inteira. discount_value é
+// o percentual (20 = 20%) ou o valor em reais, conforme discount_type
+type SavePromotionSchema struct {
+	Name          *string             `json:"name" binding:"required,min=3,max=100" example:"Terça das sobremesas"`
+	DiscountType  *string             `json:"discount_type" binding:"required,oneof=percentage fixed" example:"percentage"`
+	DiscountValue *float64            `json:"discount_value" binding:"required,gt=0" example:"20"`
+	ProductIDs    []string            `json:"product_ids" binding:"omitempty,max=1000,dive,uuid" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	CategoryIDs   []string            `json:"category_ids" binding:"omitempty,max=1000,dive,uuid" example:"2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
+	Schedule      *AvailabilitySchema `json:"schedule,omitempty"`
+	Priority      *int                `json:"priority" binding:"omitempty,min=0,max=1000" example:"10"`
+	Stackable     *bool               `json:"stackable" example:"false"`
+	Active        *bool               `json:"active" example:"true"`
+}
+
+func (s *SavePromotionSchema) ToDTO() dtos.CreatePromotionDTO {
+	active := true
+	if s.Active != nil {
+		active = *s.Active
+	}
+
+	return dtos.CreatePromotionDTO{
+		Name:          valueOf(s.Name),
+		DiscountType:  valueOf(s.DiscountType),
+		DiscountValue: valueOf(s.DiscountValue),
+		ProductIDs:    s.ProductIDs,
+		CategoryIDs:   s.CategoryIDs,
+		Schedule:      s.Schedule.ToDTO(),
+		Priority:      valueOf(s.Priority),
+		Stackable:     valueOf(s.Stackable),
+		Active:        active,
+	}
+}
+
+func (s *SavePromotionSchema) ToUpdateDTO(promotionID string) dtos.UpdatePromotionDTO {
+	return dtos.UpdatePromotionDTO{
+		ID:                 promotionID,
+		CreatePromotionDTO: s.ToDTO(),
+	}
+}
+
+type PromotionResponseSchema struct {
+	ID            string              `json:"id" example:"9a7c3f0e-4b1d-4e55-8a3e-6f2d1c0b9e77"`
+	Name          string              `json:"name" example:"Terça das sobremesas"`
+	DiscountType  string              `json:"discount_type" example:"percentage"`
+	DiscountValue float64             `json:"discount_value" example:"20"`
+	ProductIDs    []string            `json:"product_ids" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	CategoryIDs   []string            `json:"category_ids" example:"2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"`
+	Schedule      *AvailabilitySchema `json:"schedule,omitempty"`
+	Priority      int                 `json:"priority" example:"10"`
+	Stackable     bool                `json:"stackable" example:"false"`
+	Active        bool                `json:"active" example:"true"`
+	CreatedAt     time.Time           `json:"created_at" example:"2025-01-15T13:45:00Z"`
+	UpdatedAt     time.Time           `json:"updated_at" example:"2025-01-15T13:45:00Z"`
+}
+
+func ToPromotionResponseSchema(promotion dtos.PromotionResultDTO) PromotionResponseSchema {
+	return PromotionResponseSchema{
+		ID:            promotion.ID,
+		Name:          promotion.Name,
+		DiscountType:  promotion.DiscountType,
+		DiscountValue: promotion.DiscountValue,
+		ProductIDs:    nonNil(promotion.ProductIDs),
+		CategoryIDs:   nonNil(promotion.CategoryIDs),
+		Schedule:      toAvailabilitySchema(promotion.Schedule),
+		Priority:      promotion.Priority,
+		Stackable:     promotion.Stackable,
+		Active:        promotion.Active,
+		CreatedAt:     promotion.CreatedAt,
+		UpdatedAt:     promotion.UpdatedAt,
+	}
+}
+
+func ListToPromotionResponseSchema(promotions []dtos.PromotionResultDTO) []PromotionResponseSchema {
+	result := make([]PromotionResponseSchema, 0, len(promotions))
+	for _, promotion := range promotions {
+		result = append(result, ToPromotionResponseSchema(promotion))
+	}
+	return result
+}
+
+// AppliedPromotionSchema é uma promoção aplicada ao preço; amount é quanto ela
+// descontou
+type AppliedPromotionSchema struct {
+	ID            string  `json:"id" example:"9a7c3f0e-4b1d-4e55-8a3e-6f2d1c0b9e77"`
+	Name          string  `json:"name" example:"R$5 off no X-Bacon"`
+	DiscountType  string  `json:"discount_type" example:"fixed"`
+	DiscountValue float64 `json:"discount_value" example:"5"`
+	Amount        float64 `json:"amount" example:"5"`
+}
+
+// toAppliedPromotionSchemas separa a promoção principal, a de maior
+// prioridade, das acumuladas sobre ela
+func toAppliedPromotionSchemas(applied []dtos.AppliedPromotionDTO) (*AppliedPromotionSchema, []AppliedPromotionSchema) {
+	if len(applied) == 0 {
+		return nil, nil
+	}
+
+	result := make([]AppliedPromotionSchema, len(applied))
+	for i, promotion := range applied {
+		result[i] = AppliedPromotionSchema{
+			ID:            promotion.ID,
+			Name:          promotion.Name,
+			DiscountType:  promotion.DiscountType,
+			DiscountValue: promotion.DiscountValue,
+			Amount:        promotion.Amount,
+		}
+	}
+
+	if len(result) == 1 {
+		return &result[0], nil
+	}
+	return &result[0], result[1:]
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
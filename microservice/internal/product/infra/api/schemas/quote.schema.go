@@ -0,0 +1,88 @@
+package schemas
+
+import "tech_challenge/internal/product/application/dtos"
+
+type QuoteItemSchema struct {
+	ProductID string `json:"product_id" binding:"required,uuid" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	Quantity  int    `json:"quantity" binding:"required,min=1,max=1000" example:"2"`
+	// Options são as opções escolhidas para o produto
+	Options []string `json:"options" binding:"omitempty,max=50,dive,required,max=100" example:"sem-cebola"`
+}
+
+type QuoteRequestSchema struct {
+	Items []QuoteItemSchema `json:"items" binding:"required,min=1,max=100,dive"`
+}
+
+func (s *QuoteRequestSchema) ToDTO() []dtos.QuoteItemDTO {
+	items := make([]dtos.QuoteItemDTO, len(s.Items))
+	for i, item := range s.Items {
+		items[i] = dtos.QuoteItemDTO{ProductID: item.ProductID, Quantity: item.Quantity, Options: item.Options}
+	}
+	return items
+}
+
+type QuoteIssueSchema struct {
+	Code    string `json:"code" enums:"unknown,inactive,unavailable,invalid_selection" example:"unavailable"`
+	Message string `json:"message" example:"product 76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae is sold out"`
+}
+
+// QuoteLineSchema é um item cotado, na ordem do pedido. name e os preços vêm
+// vazios quando o produto não existe
+type QuoteLineSchema struct {
+	ProductID          string                   `json:"product_id" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	Name               string                   `json:"name,omitempty" example:"X-Bacon"`
+	Quantity           int                      `json:"quantity" example:"2"`
+	Options            []string                 `json:"options"`
+	UnitPrice          float64                  `json:"unit_price" example:"32.9"`
+	EffectiveUnitPrice float64                  `json:"effective_unit_price" example:"27.9"`
+	Total              float64                  `json:"total" example:"55.8"`
+	AppliedPromotion   *AppliedPromotionSchema  `json:"applied_promotion,omitempty"`
+	StackedPromotions  []AppliedPromotionSchema `json:"stacked_promotions,omitempty"`
+	Orderable          bool                     `json:"orderable" example:"true"`
+	Issues             []QuoteIssueSchema       `json:"issues"`
+}
+
+// QuoteResponseSchema traz os totais apenas das linhas que podem ser pedidas;
+// orderable indica se todas podem
+type QuoteResponseSchema struct {
+	Items     []QuoteLineSchema `json:"items"`
+	Subtotal  float64           `json:"subtotal" example:"65.8"`
+	Discount  float64           `json:"discount" example:"10"`
+	Total     float64           `json:"total" example:"55.8"`
+	Orderable bool              `json:"orderable" example:"true"`
+}
+
+func ToQuoteResponseSchema(quote dtos.QuoteResultDTO) QuoteResponseSchema {
+	items := make([]QuoteLineSchema, len(quote.Lines))
+
+	for i, line := range quote.Lines {
+		issues := make([]QuoteIssueSchema, len(line.Issues))
+		for j, issue := range line.Issues {
+			issues[j] = QuoteIssueSchema{Code: issue.Code, Message: issue.Message}
+		}
+
+		applied, stacked := toAppliedPromotionSchemas(line.AppliedPromotions)
+
+		items[i] = QuoteLineSchema{
+			ProductID:          line.ProductID,
+			Name:               line.Name,
+			Quantity:           line.Quantity,
+			Options:            nonNil(line.Options),
+			UnitPrice:          line.UnitPrice,
+			EffectiveUnitPrice: line.EffectiveUnitPrice,
+			Total:              line.Total,
+			AppliedPromotion:   applied,
+			StackedPromotions:  stacked,
+			Orderable:          line.Orderable,
+			Issues:             issues,
+		}
+	}
+
+	return QuoteResponseSchema{
+		Items:     items,
+		Subtotal:  quote.Subtotal,
+		Discount:  quote.Discount,
+		Total:     quote.Total,
+		Orderable: quote.Orderable,
+	}
+}
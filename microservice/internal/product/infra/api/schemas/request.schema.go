@@ -0,0 +1,16 @@
+package schemas
+
+// IDURISchema valida o :id das rotas de produto e categoria
+type IDURISchema struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// Campos obrigatórios são ponteiros para que a validação diferencie o campo
+// ausente do valor zero (ex.: active=false); depois de validados, nunca são nil
+func valueOf[T any](value *T) T {
+	var zero T
+	if value == nil {
+		return zero
+	}
+	return *value
+}
@@ -0,0 +1,81 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func failedTags(t *testing.T, obj any) map[string]string {
+	err := binding.Validator.ValidateStruct(obj)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	require.ErrorAs(t, err, &validationErrors)
+
+	tags := map[string]string{}
+	for _, fe := range validationErrors {
+		tags[fe.StructField()] = fe.Tag()
+	}
+	return tags
+}
+
+func TestCreateProductSchema_Validation(t *testing.T) {
+	valid := CreateProductSchema{
+		CategoryID:  ptr("2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"),
+		Name:        ptr("X-Salada"),
+		Description: ptr("Lanche"),
+		Price:       ptr(20.5),
+		Active:      ptr(false),
+	}
+	require.Empty(t, failedTags(t, &valid))
+
+	// Campos ausentes não viram o valor zero
+	require.Equal(t, map[string]string{
+		"CategoryID":  "required",
+		"Name":        "required",
+		"Description": "required",
+		"Price":       "required",
+		"Active":      "required",
+	}, failedTags(t, &CreateProductSchema{}))
+
+	invalid := valid
+	invalid.CategoryID = ptr("catid")
+	invalid.Name = ptr("ab")
+	invalid.Price = ptr(0.0)
+	require.Equal(t, map[string]string{
+		"CategoryID": "uuid",
+		"Name":       "min",
+		"Price":      "gt",
+	}, failedTags(t, &invalid))
+}
+
+func TestCategorySchemas_Validation(t *testing.T) {
+	require.Empty(t, failedTags(t, &CreateCategorySchema{Name: ptr("Bebidas"), Active: ptr(true)}))
+	require.Equal(t, map[string]string{"Active": "required"}, failedTags(t, &UpdateCategoryRequestBodySchema{Name: ptr("Bebidas")}))
+	require.Equal(t, map[string]string{"ParentID": "uuid"}, failedTags(t, &CreateCategorySchema{ParentID: "1", Name: ptr("Bebidas"), Active: ptr(true)}))
+
+	require.Equal(t, map[string]string{"CategoryIDs[0]": "uuid"}, failedTags(t, &ReorderCategoriesSchema{CategoryIDs: []string{"1"}}))
+	require.Equal(t, map[string]string{"Strategy": "oneof"}, failedTags(t, &DeleteCategoryQuerySchema{Strategy: "cascade"}))
+}
+
+func TestBulkUpdateProductsSchema_Validation(t *testing.T) {
+	schema := BulkUpdateProductsSchema{
+		Filter:    BulkProductFilterSchema{IDs: []string{"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae", "x"}},
+		Operation: BulkProductOperationSchema{Type: "delete", Price: BulkPriceAdjustmentSchema{Rounding: "up"}},
+	}
+
+	require.Equal(t, map[string]string{
+		"IDs[1]":   "uuid",
+		"Type":     "oneof",
+		"Rounding": "oneof",
+	}, failedTags(t, &schema))
+}
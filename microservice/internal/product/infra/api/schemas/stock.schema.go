@@ -0,0 +1,91 @@
+package schemas
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/application/dtos"
+)
+
+type UpdateProductStockSchema struct {
+	TrackQuantity *bool `json:"track_quantity" binding:"required" example:"true"`
+	// Quantity é o saldo livre para venda; só é aceita com track_quantity
+	Quantity int  `json:"quantity" binding:"min=0,max=1000000" example:"20"`
+	SoldOut  bool `json:"sold_out" example:"false"`
+}
+
+func (s *UpdateProductStockSchema) ToDTO(productID string) dtos.UpdateProductStockDTO {
+	return dtos.UpdateProductStockDTO{
+		ProductID: productID,
+		Tracked:   valueOf(s.TrackQuantity),
+		Quantity:  s.Quantity,
+		SoldOut:   s.SoldOut,
+	}
+}
+
+type ProductStockResponseSchema struct {
+	ProductID     string    `json:"product_id" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	TrackQuantity bool      `json:"track_quantity" example:"true"`
+	Quantity      int       `json:"quantity" example:"20"`
+	SoldOut       bool      `json:"sold_out" example:"false"`
+	Available     bool      `json:"available" example:"true"`
+	UpdatedAt     time.Time `json:"updated_at" example:"2025-01-15T13:45:00Z"`
+}
+
+func ToProductStockResponseSchema(stock dtos.ProductStockResultDTO) ProductStockResponseSchema {
+	return ProductStockResponseSchema{
+		ProductID:     stock.ProductID,
+		TrackQuantity: stock.Tracked,
+		Quantity:      stock.Quantity,
+		SoldOut:       stock.SoldOut,
+		Available:     stock.Available,
+		UpdatedAt:     stock.UpdatedAt,
+	}
+}
+
+type StockReservationItemSchema struct {
+	ProductID string `json:"product_id" binding:"required,uuid" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	Quantity  int    `json:"quantity" binding:"required,min=1,max=1000" example:"2"`
+}
+
+type ReserveStockSchema struct {
+	Items []StockReservationItemSchema `json:"items" binding:"required,min=1,max=100,dive"`
+	// TTLSeconds ausente usa a validade padrão de STOCK_RESERVATION_TTL
+	TTLSeconds int `json:"ttl_seconds" binding:"omitempty,min=1,max=86400" example:"900"`
+}
+
+func (s *ReserveStockSchema) ToDTO() dtos.ReserveStockDTO {
+	items := make([]dtos.StockReservationItemDTO, len(s.Items))
+	for i, item := range s.Items {
+		items[i] = dtos.StockReservationItemDTO{ProductID: item.ProductID, Quantity: item.Quantity}
+	}
+
+	return dtos.ReserveStockDTO{
+		Items: items,
+		TTL:   time.Duration(s.TTLSeconds) * time.Second,
+	}
+}
+
+type StockReservationResponseSchema struct {
+	ID        string                       `json:"id" example:"0b4c6a9e-2f0e-4a3c-9a52-3f4f2b1d7c11"`
+	Items     []StockReservationItemSchema `json:"items"`
+	Status    string                       `json:"status" enums:"pending,confirmed,released,expired" example:"pending"`
+	ExpiresAt time.Time                    `json:"expires_at" example:"2025-01-15T14:00:00Z"`
+	CreatedAt time.Time                    `json:"created_at" example:"2025-01-15T13:45:00Z"`
+	UpdatedAt time.Time                    `json:"updated_at" example:"2025-01-15T13:45:00Z"`
+}
+
+func ToStockReservationResponseSchema(reservation dtos.StockReservationResultDTO) StockReservationResponseSchema {
+	items := make([]StockReservationItemSchema, len(reservation.Items))
+	for i, item := range reservation.Items {
+		items[i] = StockReservationItemSchema{ProductID: item.ProductID, Quantity: item.Quantity}
+	}
+
+	return StockReservationResponseSchema{
+		ID:        reservation.ID,
+		Items:     items,
+		Status:    reservation.Status,
+		ExpiresAt: reservation.ExpiresAt,
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+}
@@ -0,0 +1,136 @@
+package schemas
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/application/dtos"
+)
+
+type CreateStoreSchema struct {
+	Name *string `json:"name" binding:"required,min=3,max=100" example:"Loja Paulista"`
+}
+
+func (s *CreateStoreSchema) ToDTO() dtos.CreateStoreDTO {
+	return dtos.CreateStoreDTO{
+		Name: valueOf(s.Name),
+	}
+}
+
+type UpdateStoreSchema struct {
+	Name *string `json:"name" binding:"required,min=3,max=100" example:"Loja Paulista"`
+}
+
+func (s *UpdateStoreSchema) ToDTO(storeID string) dtos.UpdateStoreDTO {
+	return dtos.UpdateStoreDTO{
+		ID:   storeID,
+		Name: valueOf(s.Name),
+	}
+}
+
+type StoreResponseSchema struct {
+	ID        string    `json:"id" example:"5f0c7a52-8d8e-4c4a-9d37-2b0b9a1f6e11"`
+	Name      string    `json:"name" example:"Loja Paulista"`
+	CreatedAt time.Time `json:"created_at" example:"2025-01-15T13:45:00Z"`
+	UpdatedAt time.Time `json:"updated_at" example:"2025-01-15T13:45:00Z"`
+}
+
+func ToStoreResponseSchema(store dtos.StoreResultDTO) StoreResponseSchema {
+	return StoreResponseSchema{
+		ID:        store.ID,
+		Name:      store.Name,
+		CreatedAt: store.CreatedAt,
+		UpdatedAt: store.UpdatedAt,
+	}
+}
+
+func ListToStoreResponseSchema(stores []dtos.StoreResultDTO) []StoreResponseSchema {
+	result := make([]StoreResponseSchema, 0, len(stores))
+	for _, store := range stores {
+		result = append(result, ToStoreResponseSchema(store))
+	}
+	return result
+}
+
+// ProductStoreOverrideSchema traz só os campos ajustados na loja; campos
+// ausentes herdam o valor do catálogo e sem nenhum campo o ajuste é removido
+type ProductStoreOverrideSchema struct {
+	ProductID    string              `json:"product_id" binding:"required,uuid" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	Price        *float64            `json:"price,omitempty" binding:"omitempty,gt=0,lt=1000000" example:"22.90"`
+	Active       *bool               `json:"active,omitempty" example:"false"`
+	Availability *AvailabilitySchema `json:"availability,omitempty"`
+}
+
+// CategoryStoreOverrideSchema sem visible remove o ajuste da categoria
+type CategoryStoreOverrideSchema struct {
+	CategoryID string `json:"category_id" binding:"required,uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Visible    *bool  `json:"visible,omitempty" example:"false"`
+}
+
+type SaveStoreOverridesSchema struct {
+	Products   []ProductStoreOverrideSchema  `json:"products" binding:"omitempty,max=1000,dive"`
+	Categories []CategoryStoreOverrideSchema `json:"categories" binding:"omitempty,max=1000,dive"`
+}
+
+func (s *SaveStoreOverridesSchema) ToDTO(storeID string) dtos.SaveStoreOverridesDTO {
+	dto := dtos.SaveStoreOverridesDTO{StoreID: storeID}
+	for _, product := range s.Products {
+		dto.Products = append(dto.Products, dtos.ProductStoreOverrideDTO{
+			ProductID:    product.ProductID,
+			Price:        product.Price,
+			Active:       product.Active,
+			Availability: product.Availability.ToDTO(),
+		})
+	}
+	for _, category := range s.Categories {
+		dto.Categories = append(dto.Categories, dtos.CategoryStoreOverrideDTO{
+			CategoryID: category.CategoryID,
+			Visible:    category.Visible,
+		})
+	}
+	return dto
+}
+
+type ProductStoreOverrideResponseSchema struct {
+	ProductID    string              `json:"product_id" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	Price        *float64            `json:"price,omitempty" example:"22.90"`
+	Active       *bool               `json:"active,omitempty" example:"false"`
+	Availability *AvailabilitySchema `json:"availability,omitempty"`
+	UpdatedAt    time.Time           `json:"updated_at" example:"2025-01-15T13:45:00Z"`
+}
+
+type CategoryStoreOverrideResponseSchema struct {
+	CategoryID string    `json:"category_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Visible    *bool     `json:"visible,omitempty" example:"false"`
+	UpdatedAt  time.Time `json:"updated_at" example:"2025-01-15T13:45:00Z"`
+}
+
+type StoreOverridesResponseSchema struct {
+	StoreID    string                                `json:"store_id" example:"5f0c7a52-8d8e-4c4a-9d37-2b0b9a1f6e11"`
+	Products   []ProductStoreOverrideResponseSchema  `json:"products"`
+	Categories []CategoryStoreOverrideResponseSchema `json:"categories"`
+}
+
+func ToStoreOverridesResponseSchema(overrides dtos.StoreOverridesResultDTO) StoreOverridesResponseSchema {
+	result := StoreOverridesResponseSchema{
+		StoreID:    overrides.StoreID,
+		Products:   make([]ProductStoreOverrideResponseSchema, 0, len(overrides.Products)),
+		Categories: make([]CategoryStoreOverrideResponseSchema, 0, len(overrides.Categories)),
+	}
+	for _, product := range overrides.Products {
+		result.Products = append(result.Products, ProductStoreOverrideResponseSchema{
+			ProductID:    product.ProductID,
+			Price:        product.Price,
+			Active:       product.Active,
+			Availability: toAvailabilitySchema(product.Availability),
+			UpdatedAt:    product.UpdatedAt,
+		})
+	}
+	for _, category := range overrides.Categories {
+		result.Categories = append(result.Categories, CategoryStoreOverrideResponseSchema{
+			CategoryID: category.CategoryID,
+			Visible:    category.Visible,
+			UpdatedAt:  category.UpdatedAt,
+		})
+	}
+	return result
+}
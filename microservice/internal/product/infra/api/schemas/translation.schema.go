@@ -0,0 +1,106 @@
+package schemas
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/application/dtos"
+)
+
+// TranslationURISchema valida o :id e lê o :locale das rotas de tradução; o
+// idioma é validado pelo domínio, que conhece os idiomas aceitos
+type TranslationURISchema struct {
+	ID     string `uri:"id" binding:"required,uuid"`
+	Locale string `uri:"locale" binding:"required"`
+}
+
+type SaveTranslationSchema struct {
+	Name string `json:"name" binding:"required" example:"Cheeseburger"`
+	// Description vazia mantém a descrição do idioma padrão
+	Description string `json:"description" example:"Beef burger with cheese, lettuce and tomato"`
+}
+
+func (s *SaveTranslationSchema) ToDTO(target string, uri TranslationURISchema) dtos.SaveTranslationDTO {
+	return dtos.SaveTranslationDTO{
+		Target:      target,
+		EntityID:    uri.ID,
+		Locale:      uri.Locale,
+		Name:        s.Name,
+		Description: s.Description,
+	}
+}
+
+type TranslationResponseSchema struct {
+	Locale      string    `json:"locale" example:"en"`
+	Name        string    `json:"name" example:"Cheeseburger"`
+	Description string    `json:"description" example:"Beef burger with cheese, lettuce and tomato"`
+	UpdatedAt   time.Time `json:"updated_at" example:"2025-01-15T13:45:00Z"`
+}
+
+func ToTranslationResponseSchema(translation dtos.TranslationResultDTO) TranslationResponseSchema {
+	return TranslationResponseSchema{
+		Locale:      translation.Locale,
+		Name:        translation.Name,
+		Description: translation.Description,
+		UpdatedAt:   translation.UpdatedAt,
+	}
+}
+
+func ListToTranslationResponseSchema(translations []dtos.TranslationResultDTO) []TranslationResponseSchema {
+	result := make([]TranslationResponseSchema, 0, len(translations))
+	for _, translation := range translations {
+		result = append(result, ToTranslationResponseSchema(translation))
+	}
+	return result
+}
+
+type MissingTranslationsQuerySchema struct {
+	// Locale ausente gera o relatório de todos os idiomas com tradução
+	Locale string `form:"locale"`
+}
+
+type MissingTranslationsResponseSchema struct {
+	Locale     string                    `json:"locale" example:"en"`
+	Products   TranslationCoverageSchema `json:"products"`
+	Categories TranslationCoverageSchema `json:"categories"`
+}
+
+type TranslationCoverageSchema struct {
+	Total    int                    `json:"total" example:"42"`
+	Complete int                    `json:"complete" example:"40"`
+	Missing  []TranslationGapSchema `json:"missing"`
+}
+
+type TranslationGapSchema struct {
+	ID            string   `json:"id" example:"76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"`
+	Name          string   `json:"name" example:"X-Salada"`
+	MissingFields []string `json:"missing_fields" example:"name,description"`
+}
+
+func ToMissingTranslationsResponseSchema(reports []dtos.MissingTranslationsDTO) []MissingTranslationsResponseSchema {
+	result := make([]MissingTranslationsResponseSchema, 0, len(reports))
+	for _, report := range reports {
+		result = append(result, MissingTranslationsResponseSchema{
+			Locale:     report.Locale,
+			Products:   toTranslationCoverageSchema(report.Products),
+			Categories: toTranslationCoverageSchema(report.Categories),
+		})
+	}
+	return result
+}
+
+func toTranslationCoverageSchema(coverage dtos.TranslationCoverageDTO) TranslationCoverageSchema {
+	missing := make([]TranslationGapSchema, 0, len(coverage.Missing))
+	for _, gap := range coverage.Missing {
+		missing = append(missing, TranslationGapSchema{
+			ID:            gap.ID,
+			Name:          gap.Name,
+			MissingFields: gap.MissingFields,
+		})
+	}
+
+	return TranslationCoverageSchema{
+		Total:    coverage.Total,
+		Complete: coverage.Complete,
+		Missing:  missing,
+	}
+}
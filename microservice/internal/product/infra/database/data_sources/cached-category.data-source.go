@@ -0,0 +1,54 @@
+package data_sources
+
+import (
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/interfaces"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+)
+
+// CachedCategoryDataSource guarda as leituras de categorias no cache. As
+// escritas passam direto: a limpeza fica com os casos de uso, via CatalogCache
+type CachedCategoryDataSource struct {
+	dataSource interfaces.ICategoryDataSource
+	cache      shared_interfaces.ICacheProvider
+}
+
+func NewCachedCategoryDataSource(dataSource interfaces.ICategoryDataSource, cache shared_interfaces.ICacheProvider) *CachedCategoryDataSource {
+	return &CachedCategoryDataSource{dataSource: dataSource, cache: cache}
+}
+
+func (r *CachedCategoryDataSource) Insert(category daos.CategoryDAO) error {
+	return r.dataSource.Insert(category)
+}
+
+func (r *CachedCategoryDataSource) FindByID(id string) (daos.CategoryDAO, error) {
+	return cachedRead(r.cache, categoryCacheNamespace+"id:"+id, func() (daos.CategoryDAO, error) {
+		return r.dataSource.FindByID(id)
+	})
+}
+
+func (r *CachedCategoryDataSource) FindByExternalKey(externalKey string) (daos.CategoryDAO, error) {
+	return r.dataSource.FindByExternalKey(externalKey)
+}
+
+func (r *CachedCategoryDataSource) FindAll() ([]daos.CategoryDAO, error) {
+	return cachedRead(r.cache, categoryCacheNamespace+"all", r.dataSource.FindAll)
+}
+
+func (r *CachedCategoryDataSource) FindAllWithAncestors(ids []string) ([]daos.CategoryDAO, error) {
+	return cachedRead(r.cache, categoryCacheNamespace+"ancestors:"+sortedKey(ids), func() ([]daos.CategoryDAO, error) {
+		return r.dataSource.FindAllWithAncestors(ids)
+	})
+}
+
+func (r *CachedCategoryDataSource) Update(category daos.CategoryDAO) error {
+	return r.dataSource.Update(category)
+}
+
+func (r *CachedCategoryDataSource) UpdatePositions(orderedIDs []string) error {
+	return r.dataSource.UpdatePositions(orderedIDs)
+}
+
+func (r *CachedCategoryDataSource) Delete(id string, version int64) error {
+	return r.dataSource.Delete(id, version)
+}
@@ -0,0 +1,247 @@
+package data_sources_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/infra/database/data_sources"
+	"tech_challenge/internal/shared/infra/cache_provider"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+	testenv "tech_challenge/internal/shared/test"
+)
+
+// failingCache simula um Redis fora do ar
+type failingCache struct{}
+
+func (failingCache) Get(string) ([]byte, bool, error) { return nil, false, errors.New("down") }
+func (failingCache) Set(string, []byte) error         { return errors.New("down") }
+func (failingCache) DeletePrefix(string) error        { return errors.New("down") }
+func (failingCache) Stats() shared_interfaces.CacheStats {
+	return shared_interfaces.CacheStats{}
+}
+
+func newTestCache() *cache_provider.MemoryCacheProvider {
+	return cache_provider.NewMemoryCacheProvider(time.Minute, 100, 1<<20)
+}
+
+func TestCachedProductDataSource_ReadsAreCachedUntilInvalidation(t *testing.T) {
+	calls := 0
+	updatedAt := time.Date(2025, 1, 15, 13, 45, 30, 0, time.UTC)
+	cache := newTestCache()
+	ds := data_sources.NewCachedProductDataSource(&testenv.MockProductDataSource{
+		FindByIDFunc: func(id string) (daos.ProductDAO, error) {
+			calls++
+			return daos.ProductDAO{ID: id, Name: "Coca", Price: 5, Version: int64(calls), UpdatedAt: updatedAt}, nil
+		},
+	}, cache)
+
+	first, err := ds.FindByID("p1")
+	require.NoError(t, err)
+	second, err := ds.FindByID("p1")
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+	require.Equal(t, 1, calls)
+
+	// A escrita passa direto; a limpeza é feita pelo caso de uso depois do commit
+	require.NoError(t, ds.Update(daos.ProductDAO{ID: "p1"}))
+	_, err = ds.FindByID("p1")
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+
+	require.NoError(t, data_sources.NewCatalogCache(cache).Invalidate())
+	third, err := ds.FindByID("p1")
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+	require.Equal(t, int64(2), third.Version)
+}
+
+func TestCachedProductDataSource_ListKeys(t *testing.T) {
+	var requested [][]string
+	cache := newTestCache()
+	ds := data_sources.NewCachedProductDataSource(&testenv.MockProductDataSource{
+		FindAllByCategoryIDsFunc: func(ids []string) ([]daos.ProductDAO, error) {
+			requested = append(requested, ids)
+			return []daos.ProductDAO{{ID: "p1"}}, nil
+		},
+		FindAllByIDsFunc: func(ids []string) ([]daos.ProductDAO, error) {
+			requested = append(requested, ids)
+			return []daos.ProductDAO{{ID: "p1"}}, nil
+		},
+		FindAllImagesProductByIdFunc: func(productID string) ([]daos.ProductImageDAO, error) {
+			return []daos.ProductImageDAO{{ID: "img", ProductID: productID}}, nil
+		},
+	}, cache)
+
+	_, err := ds.FindAllByCategoryIDs([]string{"b", "a"})
+	require.NoError(t, err)
+	_, err = ds.FindAllByCategoryIDs([]string{"a", "b"})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"b", "a"}}, requested)
+
+	// A chave do lote não depende da ordem nem de IDs repetidos
+	_, err = ds.FindAllByIDs([]string{"p2", "p1", "p2"})
+	require.NoError(t, err)
+	_, err = ds.FindAllByIDs([]string{"p1", "p2"})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"b", "a"}, {"p2", "p1", "p2"}}, requested)
+
+	_, err = ds.FindAllImagesProductById("p1")
+	require.NoError(t, err)
+	_, found, _ := cache.Get("product:images:p1")
+	require.True(t, found)
+}
+
+func TestCachedProductDataSource_ErrorsAreNotCached(t *testing.T) {
+	calls := 0
+	cache := newTestCache()
+	ds := data_sources.NewCachedProductDataSource(&testenv.MockProductDataSource{
+		FindByIDFunc: func(id string) (daos.ProductDAO, error) {
+			calls++
+			return daos.ProductDAO{}, &exceptions.ProductNotFoundException{}
+		},
+	}, cache)
+
+	_, err := ds.FindByID("p1")
+	require.Error(t, err)
+	_, err = ds.FindByID("p1")
+	require.Error(t, err)
+	require.Equal(t, 2, calls)
+	require.Zero(t, cache.Stats().Entries)
+}
+
+func TestCachedProductDataSource_WritesPassThrough(t *testing.T) {
+	cache := newTestCache()
+	ds := data_sources.NewCachedProductDataSource(&testenv.MockProductDataSource{
+		FindAllFunc: func() ([]daos.ProductDAO, error) { return []daos.ProductDAO{{ID: "p1"}}, nil },
+		DeleteFunc:  func(string, int64) error { return errors.New("boom") },
+	}, cache)
+
+	_, err := ds.FindAll()
+	require.NoError(t, err)
+	require.EqualError(t, ds.Delete("p1", 1), "boom")
+	require.NoError(t, ds.SetImageAsDefault("p1", "img"))
+	require.Equal(t, 1, cache.Stats().Entries)
+}
+
+func TestCachedProductDataSource_CacheFailureFallsBackToDatabase(t *testing.T) {
+	ds := data_sources.NewCachedProductDataSource(&testenv.MockProductDataSource{
+		FindAllFunc: func() ([]daos.ProductDAO, error) { return []daos.ProductDAO{{ID: "p1"}}, nil },
+	}, failingCache{})
+
+	products, err := ds.FindAll()
+	require.NoError(t, err)
+	require.Len(t, products, 1)
+	require.NoError(t, ds.Insert(daos.ProductDAO{ID: "p2"}))
+}
+
+func TestCachedCategoryDataSource_ReadsAreCached(t *testing.T) {
+	calls := 0
+	ds := data_sources.NewCachedCategoryDataSource(&testenv.MockCategoryDataSource{
+		FindAllFunc: func() ([]daos.CategoryDAO, error) {
+			calls++
+			return []daos.CategoryDAO{{ID: "c1", Name: "Bebidas"}}, nil
+		},
+	}, newTestCache())
+
+	_, err := ds.FindAll()
+	require.NoError(t, err)
+	require.NoError(t, ds.UpdatePositions([]string{"c1"}))
+	_, err = ds.FindAll()
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestCatalogCache_InvalidatesEveryCatalogNamespace(t *testing.T) {
+	cache := newTestCache()
+	require.NoError(t, cache.Set("product:all", []byte("[]")))
+	require.NoError(t, cache.Set("category:all", []byte("[]")))
+	require.NoError(t, cache.Set("translation:product:locale:en", []byte("[]")))
+	require.NoError(t, cache.Set("store:products:sid", []byte("[]")))
+	require.NoError(t, cache.Set("promotion:all", []byte("[]")))
+	require.NoError(t, cache.Set("session:abc", []byte("{}")))
+
+	require.NoError(t, data_sources.NewCatalogCache(cache).Invalidate())
+
+	require.Equal(t, 1, cache.Stats().Entries)
+	_, found, _ := cache.Get("session:abc")
+	require.True(t, found)
+
+	require.ErrorContains(t, data_sources.NewCatalogCache(failingCache{}).Invalidate(), "down")
+}
+
+func TestCachedTranslationDataSource_ReadsAreCachedUntilInvalidation(t *testing.T) {
+	calls := 0
+	cache := newTestCache()
+	ds := data_sources.NewCachedTranslationDataSource(&testenv.MockTranslationDataSource{
+		FindAllByLocaleFunc: func(target, locale string) ([]daos.TranslationDAO, error) {
+			calls++
+			return []daos.TranslationDAO{{Target: target, EntityID: "p1", Locale: locale, Name: "Cheeseburger"}}, nil
+		},
+	}, cache)
+
+	first, err := ds.FindAllByLocale("product", "en")
+	require.NoError(t, err)
+	_, err = ds.FindAllByLocale("product", "en")
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+	require.Equal(t, "Cheeseburger", first[0].Name)
+
+	// Cada target e idioma tem sua própria chave
+	_, err = ds.FindAllByLocale("product", "es")
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	require.NoError(t, data_sources.NewCatalogCache(cache).Invalidate())
+	_, err = ds.FindAllByLocale("product", "en")
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+}
+
+func TestCachedStoreDataSource_OverridesAreCachedUntilInvalidation(t *testing.T) {
+	price := 19.9
+	cache := newTestCache()
+	store := &testenv.MockStoreDataSource{ProductOverrides: []daos.ProductStoreOverrideDAO{{StoreID: "s1", ProductID: "p1", Price: &price}}}
+	ds := data_sources.NewCachedStoreDataSource(store, cache)
+
+	first, err := ds.FindProductOverrides("s1")
+	require.NoError(t, err)
+	require.Equal(t, 19.9, *first[0].Price)
+
+	// A leitura seguinte vem do cache, mesmo com o dado alterado por fora
+	store.ProductOverrides = nil
+	cached, err := ds.FindProductOverrides("s1")
+	require.NoError(t, err)
+	require.Len(t, cached, 1)
+
+	require.NoError(t, data_sources.NewCatalogCache(cache).Invalidate())
+	fresh, err := ds.FindProductOverrides("s1")
+	require.NoError(t, err)
+	require.Empty(t, fresh)
+}
+
+func TestCachedPromotionDataSource_ReadsAreCachedUntilInvalidation(t *testing.T) {
+	cache := newTestCache()
+	promotions := &testenv.MockPromotionDataSource{Promotions: []daos.PromotionDAO{{ID: "promo", Name: "Combo"}}}
+	ds := data_sources.NewCachedPromotionDataSource(promotions, cache)
+
+	first, err := ds.FindAll()
+	require.NoError(t, err)
+	require.Len(t, first, 1)
+
+	promotions.Promotions = nil
+	cached, err := ds.FindAll()
+	require.NoError(t, err)
+	require.Len(t, cached, 1)
+
+	require.NoError(t, ds.Insert(daos.PromotionDAO{ID: "other", Name: "Outra"}))
+	require.NoError(t, data_sources.NewCatalogCache(cache).Invalidate())
+	fresh, err := ds.FindAll()
+	require.NoError(t, err)
+	require.Len(t, fresh, 1)
+	require.Equal(t, "other", fresh[0].ID)
+}
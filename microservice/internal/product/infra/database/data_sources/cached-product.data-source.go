@@ -0,0 +1,94 @@
+package data_sources
+
+import (
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/interfaces"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+)
+
+// CachedProductDataSource guarda as leituras de produtos e imagens no cache.
+// As escritas passam direto: a limpeza fica com os casos de uso, via
+// CatalogCache, depois da confirmação da transação
+type CachedProductDataSource struct {
+	dataSource interfaces.IProductDataSource
+	cache      shared_interfaces.ICacheProvider
+}
+
+func NewCachedProductDataSource(dataSource interfaces.IProductDataSource, cache shared_interfaces.ICacheProvider) *CachedProductDataSource {
+	return &CachedProductDataSource{dataSource: dataSource, cache: cache}
+}
+
+func (r *CachedProductDataSource) Insert(product daos.ProductDAO) error {
+	return r.dataSource.Insert(product)
+}
+
+func (r *CachedProductDataSource) Update(product daos.ProductDAO) error {
+	return r.dataSource.Update(product)
+}
+
+func (r *CachedProductDataSource) Delete(id string, version int64) error {
+	return r.dataSource.Delete(id, version)
+}
+
+func (r *CachedProductDataSource) FindAll() ([]daos.ProductDAO, error) {
+	return cachedRead(r.cache, productCacheNamespace+"all", r.dataSource.FindAll)
+}
+
+func (r *CachedProductDataSource) FindAllActive() ([]daos.ProductDAO, error) {
+	return cachedRead(r.cache, productCacheNamespace+"active", r.dataSource.FindAllActive)
+}
+
+func (r *CachedProductDataSource) FindByID(id string) (daos.ProductDAO, error) {
+	return cachedRead(r.cache, productCacheNamespace+"id:"+id, func() (daos.ProductDAO, error) {
+		return r.dataSource.FindByID(id)
+	})
+}
+
+func (r *CachedProductDataSource) FindAllByIDs(ids []string) ([]daos.ProductDAO, error) {
+	return cachedRead(r.cache, productCacheNamespace+"ids:"+sortedKey(ids), func() ([]daos.ProductDAO, error) {
+		return r.dataSource.FindAllByIDs(ids)
+	})
+}
+
+func (r *CachedProductDataSource) FindByExternalKey(externalKey string) (daos.ProductDAO, error) {
+	return r.dataSource.FindByExternalKey(externalKey)
+}
+
+func (r *CachedProductDataSource) FindAllByCategoryID(categoryID string) ([]daos.ProductDAO, error) {
+	return cachedRead(r.cache, productCacheNamespace+"category:"+categoryID, func() ([]daos.ProductDAO, error) {
+		return r.dataSource.FindAllByCategoryID(categoryID)
+	})
+}
+
+func (r *CachedProductDataSource) FindAllByCategoryIDs(categoryIDs []string) ([]daos.ProductDAO, error) {
+	return cachedRead(r.cache, productCacheNamespace+"categories:"+sortedKey(categoryIDs), func() ([]daos.ProductDAO, error) {
+		return r.dataSource.FindAllByCategoryIDs(categoryIDs)
+	})
+}
+
+func (r *CachedProductDataSource) FindAllImagesProductById(productID string) ([]daos.ProductImageDAO, error) {
+	return cachedRead(r.cache, productCacheNamespace+"images:"+productID, func() ([]daos.ProductImageDAO, error) {
+		return r.dataSource.FindAllImagesProductById(productID)
+	})
+}
+
+func (r *CachedProductDataSource) AddProductImage(productImage daos.ProductImageDAO) error {
+	return r.dataSource.AddProductImage(productImage)
+}
+
+func (r *CachedProductDataSource) SetAllPreviousImagesAsNotDefault(productID, exceptImageID string) error {
+	return r.dataSource.SetAllPreviousImagesAsNotDefault(productID, exceptImageID)
+}
+
+func (r *CachedProductDataSource) SetImageAsDefault(productID, imageID string) error {
+	return r.dataSource.SetImageAsDefault(productID, imageID)
+}
+
+func (r *CachedProductDataSource) DeleteImage(imageFileName string) error {
+	return r.dataSource.DeleteImage(imageFileName)
+}
+
+// FindAllImageFileNames só é usado pela limpeza do bucket e sempre vai ao banco
+func (r *CachedProductDataSource) FindAllImageFileNames() ([]string, error) {
+	return r.dataSource.FindAllImageFileNames()
+}
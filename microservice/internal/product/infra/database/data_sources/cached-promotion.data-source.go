@@ -0,0 +1,46 @@
+package data_sources
+
+import (
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/interfaces"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+)
+
+// CachedPromotionDataSource guarda as promoções, lidas a cada resposta com
+// preço. As escritas passam direto e a limpeza fica com os casos de uso
+type CachedPromotionDataSource struct {
+	dataSource interfaces.IPromotionDataSource
+	cache      shared_interfaces.ICacheProvider
+}
+
+func NewCachedPromotionDataSource(dataSource interfaces.IPromotionDataSource, cache shared_interfaces.ICacheProvider) *CachedPromotionDataSource {
+	return &CachedPromotionDataSource{dataSource: dataSource, cache: cache}
+}
+
+func (r *CachedPromotionDataSource) Insert(promotion daos.PromotionDAO) error {
+	return r.dataSource.Insert(promotion)
+}
+
+func (r *CachedPromotionDataSource) FindAll() ([]daos.PromotionDAO, error) {
+	return cachedRead(r.cache, promotionCacheNamespace+"all", r.dataSource.FindAll)
+}
+
+func (r *CachedPromotionDataSource) FindAllByTargets(productIDs []string, categoryIDs []string) ([]daos.PromotionDAO, error) {
+	return cachedRead(r.cache, promotionCacheNamespace+"targets:"+sortedKey(productIDs)+"|"+sortedKey(categoryIDs), func() ([]daos.PromotionDAO, error) {
+		return r.dataSource.FindAllByTargets(productIDs, categoryIDs)
+	})
+}
+
+func (r *CachedPromotionDataSource) FindByID(id string) (daos.PromotionDAO, error) {
+	return cachedRead(r.cache, promotionCacheNamespace+"id:"+id, func() (daos.PromotionDAO, error) {
+		return r.dataSource.FindByID(id)
+	})
+}
+
+func (r *CachedPromotionDataSource) Update(promotion daos.PromotionDAO) error {
+	return r.dataSource.Update(promotion)
+}
+
+func (r *CachedPromotionDataSource) Delete(id string) error {
+	return r.dataSource.Delete(id)
+}
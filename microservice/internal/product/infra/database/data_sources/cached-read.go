@@ -0,0 +1,57 @@
+package data_sources
+
+import (
+	"encoding/json"
+	"log"
+	"slices"
+	"strings"
+
+	"tech_challenge/internal/shared/interfaces"
+)
+
+const (
+	productCacheNamespace     = "product:"
+	categoryCacheNamespace    = "category:"
+	translationCacheNamespace = "translation:"
+	storeCacheNamespace       = "store:"
+	promotionCacheNamespace   = "promotion:"
+)
+
+// cachedRead devolve o valor guardado em key ou o carrega com load e o guarda.
+// Falhas do cache nunca chegam ao chamador: a leitura segue para o banco
+func cachedRead[T any](cache interfaces.ICacheProvider, key string, load func() (T, error)) (T, error) {
+	cached, found, err := cache.Get(key)
+	if err != nil {
+		log.Printf("cache get %s failed: %v", key, err)
+	}
+
+	if found {
+		var value T
+		if err := json.Unmarshal(cached, &value); err == nil {
+			return value, nil
+		}
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+
+	encoded, err := json.Marshal(value)
+	if err == nil {
+		err = cache.Set(key, encoded)
+	}
+	if err != nil {
+		log.Printf("cache set %s failed: %v", key, err)
+	}
+
+	return value, nil
+}
+
+// sortedKey monta a parte da chave de uma leitura por vários IDs, sem depender
+// da ordem nem das repetições
+func sortedKey(ids []string) string {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	return strings.Join(slices.Compact(sorted), ",")
+}
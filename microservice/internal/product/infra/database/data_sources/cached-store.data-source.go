@@ -0,0 +1,72 @@
+package data_sources
+
+import (
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/interfaces"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+)
+
+// CachedStoreDataSource guarda as lojas e os ajustes lidos a cada resposta de
+// uma loja. As escritas passam direto e a limpeza fica com os casos de uso
+type CachedStoreDataSource struct {
+	dataSource interfaces.IStoreDataSource
+	cache      shared_interfaces.ICacheProvider
+}
+
+func NewCachedStoreDataSource(dataSource interfaces.IStoreDataSource, cache shared_interfaces.ICacheProvider) *CachedStoreDataSource {
+	return &CachedStoreDataSource{dataSource: dataSource, cache: cache}
+}
+
+func (r *CachedStoreDataSource) Insert(store daos.StoreDAO) error {
+	return r.dataSource.Insert(store)
+}
+
+func (r *CachedStoreDataSource) FindAll() ([]daos.StoreDAO, error) {
+	return cachedRead(r.cache, storeCacheNamespace+"all", r.dataSource.FindAll)
+}
+
+func (r *CachedStoreDataSource) FindByID(id string) (daos.StoreDAO, error) {
+	return cachedRead(r.cache, storeCacheNamespace+"id:"+id, func() (daos.StoreDAO, error) {
+		return r.dataSource.FindByID(id)
+	})
+}
+
+func (r *CachedStoreDataSource) Update(store daos.StoreDAO) error {
+	return r.dataSource.Update(store)
+}
+
+func (r *CachedStoreDataSource) Delete(id string) error {
+	return r.dataSource.Delete(id)
+}
+
+func (r *CachedStoreDataSource) FindProductOverrides(storeID string) ([]daos.ProductStoreOverrideDAO, error) {
+	return cachedRead(r.cache, storeCacheNamespace+"products:"+storeID, func() ([]daos.ProductStoreOverrideDAO, error) {
+		return r.dataSource.FindProductOverrides(storeID)
+	})
+}
+
+func (r *CachedStoreDataSource) FindCategoryOverrides(storeID string) ([]daos.CategoryStoreOverrideDAO, error) {
+	return cachedRead(r.cache, storeCacheNamespace+"categories:"+storeID, func() ([]daos.CategoryStoreOverrideDAO, error) {
+		return r.dataSource.FindCategoryOverrides(storeID)
+	})
+}
+
+func (r *CachedStoreDataSource) SaveProductOverride(override daos.ProductStoreOverrideDAO) error {
+	return r.dataSource.SaveProductOverride(override)
+}
+
+func (r *CachedStoreDataSource) SaveCategoryOverride(override daos.CategoryStoreOverrideDAO) error {
+	return r.dataSource.SaveCategoryOverride(override)
+}
+
+func (r *CachedStoreDataSource) DeleteProductOverride(storeID, productID string) error {
+	return r.dataSource.DeleteProductOverride(storeID, productID)
+}
+
+func (r *CachedStoreDataSource) DeleteCategoryOverride(storeID, categoryID string) error {
+	return r.dataSource.DeleteCategoryOverride(storeID, categoryID)
+}
+
+func (r *CachedStoreDataSource) DeleteOverrides(storeID string) error {
+	return r.dataSource.DeleteOverrides(storeID)
+}
@@ -0,0 +1,38 @@
+package data_sources
+
+import (
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/interfaces"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+)
+
+// CachedTranslationDataSource guarda as traduções lidas em cada resposta
+// localizada. As escritas passam direto e a limpeza fica com os casos de uso
+type CachedTranslationDataSource struct {
+	dataSource interfaces.ITranslationDataSource
+	cache      shared_interfaces.ICacheProvider
+}
+
+func NewCachedTranslationDataSource(dataSource interfaces.ITranslationDataSource, cache shared_interfaces.ICacheProvider) *CachedTranslationDataSource {
+	return &CachedTranslationDataSource{dataSource: dataSource, cache: cache}
+}
+
+func (r *CachedTranslationDataSource) FindByEntityID(target, entityID string) ([]daos.TranslationDAO, error) {
+	return cachedRead(r.cache, translationCacheNamespace+target+":id:"+entityID, func() ([]daos.TranslationDAO, error) {
+		return r.dataSource.FindByEntityID(target, entityID)
+	})
+}
+
+func (r *CachedTranslationDataSource) FindAllByLocale(target, locale string) ([]daos.TranslationDAO, error) {
+	return cachedRead(r.cache, translationCacheNamespace+target+":locale:"+locale, func() ([]daos.TranslationDAO, error) {
+		return r.dataSource.FindAllByLocale(target, locale)
+	})
+}
+
+func (r *CachedTranslationDataSource) Save(translation daos.TranslationDAO) error {
+	return r.dataSource.Save(translation)
+}
+
+func (r *CachedTranslationDataSource) Delete(target, entityID, locale string) error {
+	return r.dataSource.Delete(target, entityID, locale)
+}
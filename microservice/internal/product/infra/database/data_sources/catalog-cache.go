@@ -0,0 +1,34 @@
+package data_sources
+
+import (
+	"errors"
+
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+)
+
+// CatalogCache limpa todos os namespaces do catálogo de uma vez: remoções em
+// cascata alcançam imagens, traduções, ajustes de loja e promoções, e limpar
+// só o namespace da entidade gravada deixaria as demais com dados antigos
+type CatalogCache struct {
+	cache shared_interfaces.ICacheProvider
+}
+
+func NewCatalogCache(cache shared_interfaces.ICacheProvider) *CatalogCache {
+	return &CatalogCache{cache: cache}
+}
+
+func (c *CatalogCache) Invalidate() error {
+	var errs []error
+	for _, namespace := range []string{
+		productCacheNamespace,
+		categoryCacheNamespace,
+		translationCacheNamespace,
+		storeCacheNamespace,
+		promotionCacheNamespace,
+	} {
+		if err := c.cache.DeletePrefix(namespace); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
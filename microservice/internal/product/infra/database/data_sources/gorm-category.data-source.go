@@ -1,9 +1,12 @@
 package data_sources
 
 import (
+	"errors"
+
 	"gorm.io/gorm"
 
 	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
 	database_errors "tech_challenge/internal/product/infra/database/database_errors"
 	"tech_challenge/internal/product/infra/database/mappers"
 	"tech_challenge/internal/product/infra/database/models"
@@ -20,14 +23,37 @@ func NewGormCategoryDataSource(db *gorm.DB) *GormCategoryDataSource {
 func (r *GormCategoryDataSource) Insert(category daos.CategoryDAO) error {
 	categoryModel := mappers.FromCategoryDAOToCategoryModel(category)
 
-	return r.db.Model(&models.CategoryModel{}).Create(&categoryModel).Error
+	err := r.db.Model(&models.CategoryModel{}).Create(&categoryModel).Error
+	return database_errors.HandleDatabaseErrors(err)
 }
 
 func (r *GormCategoryDataSource) FindAll() ([]daos.CategoryDAO, error) {
 	var categories []*models.CategoryModel
 
-	if err := r.db.Find(&categories).Error; err != nil {
-		return nil, err
+	if err := r.db.Order("position ASC, name ASC").Find(&categories).Error; err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+
+	return mappers.ArrayFromCategoryModelToCategoryDAO(categories), nil
+}
+
+// FindAllWithAncestors busca as categorias informadas e todas as categorias
+// acima delas em uma única consulta recursiva. O UNION descarta as repetidas,
+// o que também encerra a recursão
+func (r *GormCategoryDataSource) FindAllWithAncestors(ids []string) ([]daos.CategoryDAO, error) {
+	if len(ids) == 0 {
+		return []daos.CategoryDAO{}, nil
+	}
+
+	var categories []*models.CategoryModel
+
+	err := r.db.Raw(`WITH RECURSIVE chain AS (
+		SELECT * FROM category WHERE id IN ?
+		UNION
+		SELECT category.* FROM category JOIN chain ON category.id = chain.parent_id
+	) SELECT * FROM chain ORDER BY position ASC, name ASC`, ids).Scan(&categories).Error
+	if err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
 	}
 
 	return mappers.ArrayFromCategoryModelToCategoryDAO(categories), nil
@@ -37,21 +63,75 @@ func (r *GormCategoryDataSource) FindByID(id string) (daos.CategoryDAO, error) {
 	var category *models.CategoryModel
 
 	if err := r.db.First(&category, "id = ?", id).Error; err != nil {
-		return daos.CategoryDAO{}, err
+		return daos.CategoryDAO{}, database_errors.HandleDatabaseErrors(err)
+	}
+
+	return mappers.FromCategoryModelToCategoryDAO(category), nil
+}
+
+func (r *GormCategoryDataSource) FindByExternalKey(externalKey string) (daos.CategoryDAO, error) {
+	var category *models.CategoryModel
+
+	if err := r.db.First(&category, "external_key = ?", externalKey).Error; err != nil {
+		return daos.CategoryDAO{}, database_errors.HandleDatabaseErrors(err)
 	}
 
 	return mappers.FromCategoryModelToCategoryDAO(category), nil
 }
 
 func (r *GormCategoryDataSource) Update(category daos.CategoryDAO) error {
-	return r.db.Save(mappers.FromCategoryDAOToCategoryModel(category)).Error
+	categoryModel := mappers.FromCategoryDAOToCategoryModel(category)
+
+	return conditionalUpdate(r.db, &models.CategoryModel{}, categoryModel.ID, categoryModel.Version, map[string]any{
+		"external_key":    categoryModel.ExternalKey,
+		"parent_id":       categoryModel.ParentID,
+		"name":            categoryModel.Name,
+		"name_key":        categoryModel.NameKey,
+		"description":     categoryModel.Description,
+		"position":        categoryModel.Position,
+		"image_file_name": categoryModel.ImageFileName,
+		"image_url":       categoryModel.ImageUrl,
+		"active":          categoryModel.Active,
+		"availability":    categoryModel.Availability,
+		"updated_at":      categoryModel.UpdatedAt,
+	})
+}
+
+// UpdatePositions grava a posição de cada categoria conforme a ordem da lista,
+// começando em 1, em uma única transação
+func (r *GormCategoryDataSource) UpdatePositions(orderedIDs []string) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for i, id := range orderedIDs {
+			err := tx.Model(&models.CategoryModel{}).Where("id = ?", id).Updates(map[string]any{
+				"position": i + 1,
+				"version":  gorm.Expr("version + 1"),
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return database_errors.HandleDatabaseErrors(err)
 }
 
-func (r *GormCategoryDataSource) Delete(id string) error {
-	result := r.db.Delete(&models.CategoryModel{}, "id = ?", id)
-	if result.Error != nil {
-		errTratado := database_errors.HandleDatabaseErrors(result.Error)
-		return errTratado
+// Nomes gerados pelo GORM para as chaves estrangeiras que apontam para category
+const (
+	productsCategoryConstraint = "fk_products_category"
+	categoryParentConstraint   = "fk_category_parent"
+)
+
+func (r *GormCategoryDataSource) Delete(id string, version int64) error {
+	err := conditionalDelete(r.db, &models.CategoryModel{}, id, version)
+	// A categoria ainda é referenciada por produtos ou subcategorias
+	var fkErr *exceptions.ForeignKeyViolationException
+	if errors.As(err, &fkErr) {
+		switch fkErr.Constraint {
+		case productsCategoryConstraint:
+			return &exceptions.CategoryHasProductsException{}
+		case categoryParentConstraint:
+			return &exceptions.CategoryHasChildrenException{}
+		}
 	}
-	return nil
+	return err
 }
@@ -10,6 +10,7 @@ import (
 	"gorm.io/gorm"
 
 	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
 	"tech_challenge/internal/product/infra/database/data_sources"
 )
 
@@ -24,18 +25,50 @@ func TestGormCategoryDataSource_Insert(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGormCategoryDataSource_Insert_DuplicatedName(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewGormCategoryDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT").WillReturnError(errors.New(`ERROR: duplicate key value violates unique constraint "idx_category_name_key" (SQLSTATE 23505)`))
+	mock.ExpectRollback()
+	err := ds.Insert(daos.CategoryDAO{ID: "cat1", Name: "Bebidas", Active: true})
+	require.IsType(t, &exceptions.CategoryAlreadyExistsException{}, err)
+}
+
 func TestGormCategoryDataSource_FindAll(t *testing.T) {
 	db, mock, cleanup := setupMockDB(t)
 	defer cleanup()
 	ds := data_sources.NewGormCategoryDataSource(db)
 	rows := sqlmock.NewRows([]string{"id", "name", "active"}).AddRow("cat1", "Bebidas", true)
-	mock.ExpectQuery("SELECT \\* FROM \\\"category\\\"").WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "category" ORDER BY position ASC, name ASC`)).WillReturnRows(rows)
 	categories, err := ds.FindAll()
 	require.NoError(t, err)
 	require.Len(t, categories, 1)
 	require.Equal(t, "cat1", categories[0].ID)
 }
 
+func TestGormCategoryDataSource_FindAllWithAncestors(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewGormCategoryDataSource(db)
+	rows := sqlmock.NewRows([]string{"id", "parent_id", "name", "active"}).
+		AddRow("root", nil, "Cardápio", true).
+		AddRow("cat1", "root", "Bebidas", true)
+	mock.ExpectQuery(`(?s)WITH RECURSIVE chain AS .*WHERE id IN \(\$1,\$2\).*JOIN chain ON category\.id = chain\.parent_id`).
+		WithArgs("cat1", "cat2").WillReturnRows(rows)
+	categories, err := ds.FindAllWithAncestors([]string{"cat1", "cat2"})
+	require.NoError(t, err)
+	require.Len(t, categories, 2)
+	require.Equal(t, "root", categories[1].ParentID)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Sem IDs nenhuma consulta é feita
+	categories, err = ds.FindAllWithAncestors(nil)
+	require.NoError(t, err)
+	require.Empty(t, categories)
+}
+
 func TestGormCategoryDataSource_FindByID(t *testing.T) {
 	db, mock, cleanup := setupMockDB(t)
 	defer cleanup()
@@ -53,7 +86,16 @@ func TestGormCategoryDataSource_FindByID_NotFound(t *testing.T) {
 	ds := data_sources.NewGormCategoryDataSource(db)
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "category" WHERE id = $1 ORDER BY "category"."id" LIMIT $2`)).WithArgs("cat404", 1).WillReturnError(gorm.ErrRecordNotFound)
 	_, err := ds.FindByID("cat404")
-	require.Error(t, err)
+	require.IsType(t, &exceptions.RecordNotFoundException{}, err)
+}
+
+func TestGormCategoryDataSource_FindByID_Unavailable(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewGormCategoryDataSource(db)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "category" WHERE id = $1 ORDER BY "category"."id" LIMIT $2`)).WithArgs("cat1", 1).WillReturnError(errors.New("FATAL: terminating connection due to administrator command (SQLSTATE 57P01)"))
+	_, err := ds.FindByID("cat1")
+	require.IsType(t, &exceptions.RepositoryUnavailableException{}, err)
 }
 
 func TestGormCategoryDataSource_Update(t *testing.T) {
@@ -67,24 +109,106 @@ func TestGormCategoryDataSource_Update(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGormCategoryDataSource_Update_StaleVersion(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewGormCategoryDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`WHERE id = $12 AND version = $13`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "category" WHERE id = $1`)).WithArgs("cat1").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	err := ds.Update(daos.CategoryDAO{ID: "cat1", Name: "Bebidas", Active: true, Version: 2})
+	require.IsType(t, &exceptions.VersionConflictException{}, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGormCategoryDataSource_Delete(t *testing.T) {
 	db, mock, cleanup := setupMockDB(t)
 	defer cleanup()
 	ds := data_sources.NewGormCategoryDataSource(db)
 	mock.ExpectBegin()
-	mock.ExpectExec("DELETE").WithArgs("cat1").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "category" WHERE id = $1 AND version = $2`)).WithArgs("cat1", int64(2)).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
-	err := ds.Delete("cat1")
+	err := ds.Delete("cat1", 2)
 	require.NoError(t, err)
 }
 
+func TestGormCategoryDataSource_Delete_StaleVersion(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewGormCategoryDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "category" WHERE id = $1 AND version = $2`)).WithArgs("cat1", int64(2)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "category" WHERE id = $1`)).WithArgs("cat1").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	err := ds.Delete("cat1", 2)
+	require.IsType(t, &exceptions.VersionConflictException{}, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGormCategoryDataSource_Delete_Error(t *testing.T) {
 	db, mock, cleanup := setupMockDB(t)
 	defer cleanup()
 	ds := data_sources.NewGormCategoryDataSource(db)
 	mock.ExpectBegin()
-	mock.ExpectExec("DELETE").WithArgs("cat1").WillReturnError(errors.New("delete error"))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "category" WHERE id = $1 AND version = $2`)).WithArgs("cat1", int64(2)).WillReturnError(errors.New("delete error"))
 	mock.ExpectRollback()
-	err := ds.Delete("cat1")
+	err := ds.Delete("cat1", 2)
 	require.Error(t, err)
 }
+
+func TestGormCategoryDataSource_Delete_ForeignKeyViolation(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewGormCategoryDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "category" WHERE id = $1 AND version = $2`)).WithArgs("cat1", int64(2)).WillReturnError(errors.New(`ERROR: update or delete on table "category" violates foreign key constraint "fk_products_category" (SQLSTATE 23503)`))
+	mock.ExpectRollback()
+	err := ds.Delete("cat1", 2)
+	require.IsType(t, &exceptions.CategoryHasProductsException{}, err)
+}
+
+func TestGormCategoryDataSource_Delete_HasSubcategories(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewGormCategoryDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "category" WHERE id = $1 AND version = $2`)).WithArgs("cat1", int64(2)).WillReturnError(errors.New(`ERROR: update or delete on table "category" violates foreign key constraint "fk_category_parent" on table "category" (SQLSTATE 23503)`))
+	mock.ExpectRollback()
+	err := ds.Delete("cat1", 2)
+	require.IsType(t, &exceptions.CategoryHasChildrenException{}, err)
+}
+
+func TestGormCategoryDataSource_Delete_OtherForeignKeyViolation(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewGormCategoryDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "category" WHERE id = $1 AND version = $2`)).WithArgs("cat1", int64(2)).WillReturnError(errors.New(`ERROR: update or delete on table "category" violates foreign key constraint "fk_menus_category" (SQLSTATE 23503)`))
+	mock.ExpectRollback()
+	err := ds.Delete("cat1", 2)
+	require.IsType(t, &exceptions.ForeignKeyViolationException{}, err)
+}
+
+func TestGormCategoryDataSource_UpdatePositions(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewGormCategoryDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "category" SET "position"=$1,"version"=version + 1,"updated_at"=$2 WHERE id = $3`)).WithArgs(1, sqlmock.AnyArg(), "cat2").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "category" SET "position"=$1,"version"=version + 1,"updated_at"=$2 WHERE id = $3`)).WithArgs(2, sqlmock.AnyArg(), "cat1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, ds.UpdatePositions([]string{"cat2", "cat1"}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGormCategoryDataSource_UpdatePositions_Rollback(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewGormCategoryDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE").WillReturnError(errors.New("update error"))
+	mock.ExpectRollback()
+	require.EqualError(t, ds.UpdatePositions([]string{"cat1"}), "update error")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -4,6 +4,7 @@ import (
 	"gorm.io/gorm"
 
 	"tech_challenge/internal/product/daos"
+	database_errors "tech_challenge/internal/product/infra/database/database_errors"
 	"tech_challenge/internal/product/infra/database/mappers"
 	"tech_challenge/internal/product/infra/database/models"
 )
@@ -20,7 +21,7 @@ func (r *GormProductDataSource) Insert(productDAO daos.ProductDAO) error {
 	productModel := mappers.FromProductDAOToProductModel(productDAO)
 	err := r.db.Create(&productModel).Error
 	if err != nil {
-		return err
+		return database_errors.HandleDatabaseErrors(err)
 	}
 
 	if len(productDAO.Images) > 0 {
@@ -39,7 +40,20 @@ func (r *GormProductDataSource) FindAll() ([]daos.ProductDAO, error) {
 		return db.Where("is_default = ?", true).Order("created_at desc")
 	}).Find(&products).Error
 	if err != nil {
-		return nil, err
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+	return mappers.ArrayFromProductModelToProductDAO(products)
+}
+
+// FindAllActive traz os produtos ativos já com a imagem padrão em duas
+// consultas fixas (produtos e imagens), qualquer que seja o tamanho do cardápio
+func (r *GormProductDataSource) FindAllActive() ([]daos.ProductDAO, error) {
+	var products []*models.ProductModel
+	err := r.db.Preload("Images", func(db *gorm.DB) *gorm.DB {
+		return db.Where("is_default = ?", true).Order("created_at desc")
+	}).Where("active = ?", true).Order("name ASC").Find(&products).Error
+	if err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
 	}
 	return mappers.ArrayFromProductModelToProductDAO(products)
 }
@@ -50,7 +64,18 @@ func (r *GormProductDataSource) FindAllByCategoryID(categoryID string) ([]daos.P
 		return db.Where("is_default = ?", true).Order("created_at desc")
 	}).Where("category_id = ?", categoryID).Find(&products).Error
 	if err != nil {
-		return nil, err
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+	return mappers.ArrayFromProductModelToProductDAO(products)
+}
+
+func (r *GormProductDataSource) FindAllByCategoryIDs(categoryIDs []string) ([]daos.ProductDAO, error) {
+	var products []*models.ProductModel
+	err := r.db.Preload("Images", func(db *gorm.DB) *gorm.DB {
+		return db.Where("is_default = ?", true).Order("created_at desc")
+	}).Where("category_id IN ?", categoryIDs).Find(&products).Error
+	if err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
 	}
 	return mappers.ArrayFromProductModelToProductDAO(products)
 }
@@ -59,35 +84,78 @@ func (r *GormProductDataSource) FindByID(id string) (daos.ProductDAO, error) {
 	var product *models.ProductModel
 
 	if err := r.db.Preload("Images", "is_default = ?", true).First(&product, "id = ?", id).Error; err != nil {
-		return daos.ProductDAO{}, err
+		return daos.ProductDAO{}, database_errors.HandleDatabaseErrors(err)
+	}
+
+	return mappers.FromProductModelToProductDAO(product)
+}
+
+// FindAllByIDs busca os produtos em uma única consulta WHERE id IN; IDs
+// inexistentes são ignorados e a ordem do resultado não é garantida
+func (r *GormProductDataSource) FindAllByIDs(ids []string) ([]daos.ProductDAO, error) {
+	if len(ids) == 0 {
+		return []daos.ProductDAO{}, nil
+	}
+
+	var products []*models.ProductModel
+	err := r.db.Preload("Images", func(db *gorm.DB) *gorm.DB {
+		return db.Where("is_default = ?", true).Order("created_at desc")
+	}).Where("id IN ?", ids).Find(&products).Error
+	if err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+	return mappers.ArrayFromProductModelToProductDAO(products)
+}
+
+func (r *GormProductDataSource) FindByExternalKey(externalKey string) (daos.ProductDAO, error) {
+	var product *models.ProductModel
+
+	if err := r.db.Preload("Images", "is_default = ?", true).First(&product, "external_key = ?", externalKey).Error; err != nil {
+		return daos.ProductDAO{}, database_errors.HandleDatabaseErrors(err)
 	}
 
 	return mappers.FromProductModelToProductDAO(product)
 }
 
 func (r *GormProductDataSource) Update(product daos.ProductDAO) error {
-	return r.db.Save(mappers.FromProductDAOToProductModel(product)).Error
+	productModel := mappers.FromProductDAOToProductModel(product)
+
+	return conditionalUpdate(r.db, &models.ProductModel{}, productModel.ID, productModel.Version, map[string]any{
+		"external_key": productModel.ExternalKey,
+		"category_id":  productModel.CategoryID,
+		"name":         productModel.Name,
+		"name_key":     productModel.NameKey,
+		"description":  productModel.Description,
+		"price":        productModel.Price,
+		"active":       productModel.Active,
+		"availability": productModel.Availability,
+		"nutrition":    productModel.Nutrition,
+		"allergens":    productModel.Allergens,
+		"updated_at":   productModel.UpdatedAt,
+	})
 }
 
-func (r *GormProductDataSource) Delete(id string) error {
-	return r.db.Delete(&models.ProductModel{}, "id = ?", id).Error
+func (r *GormProductDataSource) Delete(id string, version int64) error {
+	return conditionalDelete(r.db, &models.ProductModel{}, id, version)
 }
 
 func (r *GormProductDataSource) AddProductImage(productImage daos.ProductImageDAO) error {
-	return r.db.Create(&productImage).Error
+	err := r.db.Create(&productImage).Error
+	return database_errors.HandleDatabaseErrors(err)
 }
 
 func (r *GormProductDataSource) SetAllPreviousImagesAsNotDefault(productID, exceptImageID string) error {
-	return r.db.Model(&models.ProductImageModel{}).
+	err := r.db.Model(&models.ProductImageModel{}).
 		Where("product_id = ? AND id <> ?", productID, exceptImageID).
 		Update("is_default", false).Error
+	return database_errors.HandleDatabaseErrors(err)
 }
 
 func (r *GormProductDataSource) FindAllImagesProductById(productID string) ([]daos.ProductImageDAO, error) {
 	var images []models.ProductImageModel
 	err := r.db.Where("product_id = ?", productID).Order("created_at desc").Find(&images).Error
 	if err != nil {
-		return nil, err
+		return nil, database_errors.HandleDatabaseErrors(err)
 	}
 	var result []daos.ProductImageDAO
 	for _, img := range images {
@@ -98,6 +166,7 @@ func (r *GormProductDataSource) FindAllImagesProductById(productID string) ([]da
 			Url:       img.Url,
 			IsDefault: img.IsDefault,
 			CreatedAt: img.CreatedAt,
+			UpdatedAt: img.UpdatedAt,
 		})
 	}
 	return result, nil
@@ -109,14 +178,25 @@ func (r *GormProductDataSource) SetImageAsDefault(productID, imageID string) err
 		Where("product_id = ?", productID).
 		Update("is_default", false).Error
 	if err != nil {
-		return err
+		return database_errors.HandleDatabaseErrors(err)
 	}
 	// Agora, seta a imagem escolhida como default
-	return r.db.Model(&models.ProductImageModel{}).
+	err = r.db.Model(&models.ProductImageModel{}).
 		Where("product_id = ? AND id = ?", productID, imageID).
 		Update("is_default", true).Error
+	return database_errors.HandleDatabaseErrors(err)
 }
 
 func (r *GormProductDataSource) DeleteImage(imageFileName string) error {
-	return r.db.Where("file_name = ?", imageFileName).Delete(&models.ProductImageModel{}).Error
+	err := r.db.Where("file_name = ?", imageFileName).Delete(&models.ProductImageModel{}).Error
+	return database_errors.HandleDatabaseErrors(err)
+}
+
+func (r *GormProductDataSource) FindAllImageFileNames() ([]string, error) {
+	var fileNames []string
+	err := r.db.Model(&models.ProductImageModel{}).Distinct("file_name").Pluck("file_name", &fileNames).Error
+	if err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+	return fileNames, nil
 }
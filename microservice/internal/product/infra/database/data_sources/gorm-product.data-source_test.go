@@ -11,6 +11,7 @@ import (
 	"gorm.io/gorm"
 
 	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
 	"tech_challenge/internal/product/infra/database/data_sources"
 )
 
@@ -55,6 +56,17 @@ func TestGormProductDataSource_Insert_ErrorOnCreate(t *testing.T) {
 	require.Contains(t, err.Error(), "erro ao inserir produto")
 }
 
+func TestGormProductDataSource_Update_DuplicatedName(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewProductDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "products"`)).WillReturnError(errors.New(`ERROR: duplicate key value violates unique constraint "idx_products_category_name_key" (SQLSTATE 23505)`))
+	mock.ExpectRollback()
+	err := ds.Update(daos.ProductDAO{ID: "pid", Name: "Produto Teste", Description: "desc", Price: 10.0, CategoryID: "cat1", Active: true})
+	require.IsType(t, &exceptions.ProductAlreadyExistsException{}, err)
+}
+
 func TestGormProductDataSource_Insert_ErrorOnAddProductImage(t *testing.T) {
 	db, mock, cleanup := setupMockDB(t)
 	defer cleanup()
@@ -125,6 +137,58 @@ func TestGormProductDataSource_FindAllByCategoryID_ErrorOnQuery(t *testing.T) {
 	require.Contains(t, err.Error(), "erro ao buscar produtos por categoria")
 }
 
+func TestGormProductDataSource_FindAllByCategoryIDs(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewProductDataSource(db)
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "price", "category_id", "active"}).
+		AddRow("pid1", "Produto Teste", "desc", 10.0, "cat1", true).
+		AddRow("pid2", "Outro Produto", "desc", 12.0, "cat2", true)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE category_id IN ($1,$2)`)).WithArgs("cat1", "cat2").WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_images" WHERE "product_images"."product_id" IN ($1,$2) AND is_default = $3 ORDER BY created_at desc`)).WithArgs("pid1", "pid2", true).WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "file_name", "url", "is_default", "created_at"}))
+	products, err := ds.FindAllByCategoryIDs([]string{"cat1", "cat2"})
+	require.NoError(t, err)
+	require.Len(t, products, 2)
+	require.Equal(t, "cat2", products[1].CategoryID)
+}
+
+func TestGormProductDataSource_FindAllByIDs(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewProductDataSource(db)
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "price", "category_id", "active"}).
+		AddRow("pid2", "Outro Produto", "desc", 12.0, "cat2", true)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE id IN ($1,$2)`)).WithArgs("pid1", "pid2").WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_images" WHERE "product_images"."product_id" = $1 AND is_default = $2 ORDER BY created_at desc`)).WithArgs("pid2", true).WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "file_name", "url", "is_default", "created_at"}))
+	products, err := ds.FindAllByIDs([]string{"pid1", "pid2"})
+	require.NoError(t, err)
+	require.Len(t, products, 1)
+	require.Equal(t, "pid2", products[0].ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Sem IDs nenhuma consulta é feita
+	products, err = ds.FindAllByIDs(nil)
+	require.NoError(t, err)
+	require.Empty(t, products)
+}
+
+func TestGormProductDataSource_FindAllActive(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewProductDataSource(db)
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "price", "category_id", "active"}).
+		AddRow("pid1", "Coca-Cola", "desc", 5.0, "cat1", true).
+		AddRow("pid2", "X-Salada", "desc", 20.0, "cat2", true)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE active = $1 ORDER BY name ASC`)).WithArgs(true).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_images" WHERE "product_images"."product_id" IN ($1,$2) AND is_default = $3 ORDER BY created_at desc`)).WithArgs("pid1", "pid2", true).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "file_name", "url", "is_default"}).AddRow("img", "pid2", "x.png", "http://bucket/x.png", true))
+	products, err := ds.FindAllActive()
+	require.NoError(t, err)
+	require.Len(t, products, 2)
+	require.Len(t, products[1].Images, 1)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGormProductDataSource_FindByID(t *testing.T) {
 	db, mock, cleanup := setupMockDB(t)
 	defer cleanup()
@@ -144,7 +208,7 @@ func TestGormProductDataSource_FindByID_NotFound(t *testing.T) {
 	ds := data_sources.NewProductDataSource(db)
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE id = $1 ORDER BY "products"."id" LIMIT $2`)).WithArgs("pid404", 1).WillReturnError(gorm.ErrRecordNotFound)
 	_, err := ds.FindByID("pid404")
-	require.Error(t, err)
+	require.IsType(t, &exceptions.RecordNotFoundException{}, err)
 }
 
 func TestGormProductDataSource_Update(t *testing.T) {
@@ -158,6 +222,45 @@ func TestGormProductDataSource_Update(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGormProductDataSource_Update_IsConditional(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewProductDataSource(db)
+	updatedAt := time.Date(2026, 5, 4, 12, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`"version"=version + 1 WHERE id = $12 AND version = $13`)).
+		WithArgs(true, nil, nil, "cat1", "desc", nil, "Produto Atualizado", "produto atualizado", nil, 20.0, updatedAt, "pid", int64(4)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	err := ds.Update(daos.ProductDAO{ID: "pid", Name: "Produto Atualizado", Description: "desc", Price: 20.0, CategoryID: "cat1", Active: true, Version: 4, UpdatedAt: updatedAt})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGormProductDataSource_Update_StaleVersion(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewProductDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "products"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE id = $1`)).WithArgs("pid").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	err := ds.Update(daos.ProductDAO{ID: "pid", Name: "Produto Atualizado", Description: "desc", Price: 20.0, CategoryID: "cat1", Active: true, Version: 1})
+	require.IsType(t, &exceptions.VersionConflictException{}, err)
+}
+
+func TestGormProductDataSource_Update_Deleted(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewProductDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "products"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE id = $1`)).WithArgs("pid").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	err := ds.Update(daos.ProductDAO{ID: "pid", Name: "Produto Atualizado", Description: "desc", Price: 20.0, CategoryID: "cat1", Active: true, Version: 1})
+	require.IsType(t, &exceptions.RecordNotFoundException{}, err)
+}
+
 func TestGormProductDataSource_Update_Error(t *testing.T) {
 	db, mock, cleanup := setupMockDB(t)
 	defer cleanup()
@@ -175,20 +278,33 @@ func TestGormProductDataSource_Delete(t *testing.T) {
 	defer cleanup()
 	ds := data_sources.NewProductDataSource(db)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "products" WHERE id = $1`)).WithArgs("pid").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "products" WHERE id = $1 AND version = $2`)).WithArgs("pid", int64(4)).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
-	err := ds.Delete("pid")
+	err := ds.Delete("pid", 4)
 	require.NoError(t, err)
 }
 
+func TestGormProductDataSource_Delete_NotFound(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewProductDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "products" WHERE id = $1 AND version = $2`)).WithArgs("pid", int64(4)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE id = $1`)).WithArgs("pid").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	err := ds.Delete("pid", 4)
+	require.IsType(t, &exceptions.RecordNotFoundException{}, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGormProductDataSource_Delete_Error(t *testing.T) {
 	db, mock, cleanup := setupMockDB(t)
 	defer cleanup()
 	ds := data_sources.NewProductDataSource(db)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "products" WHERE id = $1`)).WithArgs("pid").WillReturnError(errors.New("erro ao deletar produto"))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "products" WHERE id = $1 AND version = $2`)).WithArgs("pid", int64(4)).WillReturnError(errors.New("erro ao deletar produto"))
 	mock.ExpectRollback()
-	err := ds.Delete("pid")
+	err := ds.Delete("pid", 4)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "erro ao deletar produto")
 }
@@ -198,7 +314,7 @@ func TestGormProductDataSource_SetAllPreviousImagesAsNotDefault(t *testing.T) {
 	defer cleanup()
 	ds := data_sources.NewProductDataSource(db)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_images" SET "is_default"=$1 WHERE product_id = $2 AND id <> $3`)).WithArgs(false, "pid", "imgid2").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_images" SET "is_default"=$1,"updated_at"=$2 WHERE product_id = $3 AND id <> $4`)).WithArgs(false, sqlmock.AnyArg(), "pid", "imgid2").WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	err := ds.SetAllPreviousImagesAsNotDefault("pid", "imgid2")
 	require.NoError(t, err)
@@ -209,7 +325,7 @@ func TestGormProductDataSource_SetAllPreviousImagesAsNotDefault_Error(t *testing
 	defer cleanup()
 	ds := data_sources.NewProductDataSource(db)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_images" SET "is_default"=$1 WHERE product_id = $2 AND id <> $3`)).WithArgs(false, "pid", "imgid2").WillReturnError(errors.New("erro ao atualizar imagens"))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_images" SET "is_default"=$1,"updated_at"=$2 WHERE product_id = $3 AND id <> $4`)).WithArgs(false, sqlmock.AnyArg(), "pid", "imgid2").WillReturnError(errors.New("erro ao atualizar imagens"))
 	mock.ExpectRollback()
 	err := ds.SetAllPreviousImagesAsNotDefault("pid", "imgid2")
 	require.Error(t, err)
@@ -263,3 +379,14 @@ func TestGormProductDataSource_DeleteImage_Error(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "erro ao deletar imagem")
 }
+
+func TestGormProductDataSource_FindAllImageFileNames(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewProductDataSource(db)
+	rows := sqlmock.NewRows([]string{"file_name"}).AddRow("img1.jpg").AddRow("img2.jpg")
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT "file_name" FROM "product_images"`)).WillReturnRows(rows)
+	fileNames, err := ds.FindAllImageFileNames()
+	require.NoError(t, err)
+	require.Equal(t, []string{"img1.jpg", "img2.jpg"}, fileNames)
+}
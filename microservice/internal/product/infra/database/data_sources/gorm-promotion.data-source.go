@@ -0,0 +1,137 @@
+package data_sources
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	database_errors "tech_challenge/internal/product/infra/database/database_errors"
+	"tech_challenge/internal/product/infra/database/mappers"
+	"tech_challenge/internal/product/infra/database/models"
+)
+
+type GormPromotionDataSource struct {
+	db *gorm.DB
+}
+
+func NewPromotionDataSource(db *gorm.DB) *GormPromotionDataSource {
+	return &GormPromotionDataSource{db: db}
+}
+
+func (r *GormPromotionDataSource) Insert(promotion daos.PromotionDAO) error {
+	model := mappers.FromPromotionDAOToModel(promotion)
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(&model).Error; err != nil {
+			return err
+		}
+		return insertPromotionTargets(tx, model)
+	})
+
+	return database_errors.HandleDatabaseErrors(err)
+}
+
+func (r *GormPromotionDataSource) FindAll() ([]daos.PromotionDAO, error) {
+	var promotions []models.PromotionModel
+
+	err := r.db.Preload("Products").Preload("Categories").Order("priority DESC, name ASC").Find(&promotions).Error
+	if err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+
+	result := make([]daos.PromotionDAO, 0, len(promotions))
+	for _, promotion := range promotions {
+		result = append(result, mappers.FromPromotionModelToDAO(promotion))
+	}
+	return result, nil
+}
+
+// FindAllByTargets busca só as promoções que alcançam algum dos produtos ou
+// das categorias informados
+func (r *GormPromotionDataSource) FindAllByTargets(productIDs []string, categoryIDs []string) ([]daos.PromotionDAO, error) {
+	if len(productIDs) == 0 && len(categoryIDs) == 0 {
+		return []daos.PromotionDAO{}, nil
+	}
+
+	var promotions []models.PromotionModel
+
+	err := r.db.Preload("Products").Preload("Categories").
+		Where("id IN (?) OR id IN (?)",
+			r.db.Model(&models.PromotionProductModel{}).Select("promotion_id").Where("product_id IN ?", productIDs),
+			r.db.Model(&models.PromotionCategoryModel{}).Select("promotion_id").Where("category_id IN ?", categoryIDs),
+		).
+		Order("priority DESC, name ASC").Find(&promotions).Error
+	if err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+
+	result := make([]daos.PromotionDAO, 0, len(promotions))
+	for _, promotion := range promotions {
+		result = append(result, mappers.FromPromotionModelToDAO(promotion))
+	}
+	return result, nil
+}
+
+func (r *GormPromotionDataSource) FindByID(id string) (daos.PromotionDAO, error) {
+	var promotion models.PromotionModel
+
+	if err := r.db.Preload("Products").Preload("Categories").First(&promotion, "id = ?", id).Error; err != nil {
+		return daos.PromotionDAO{}, database_errors.HandleDatabaseErrors(err)
+	}
+
+	return mappers.FromPromotionModelToDAO(promotion), nil
+}
+
+// Update substitui a regra e os alvos da promoção em uma única transação
+func (r *GormPromotionDataSource) Update(promotion daos.PromotionDAO) error {
+	model := mappers.FromPromotionDAOToModel(promotion)
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.PromotionModel{}).Where("id = ?", model.ID).Updates(map[string]any{
+			"name":           model.Name,
+			"discount_type":  model.DiscountType,
+			"discount_value": model.DiscountValue,
+			"schedule":       model.Schedule,
+			"priority":       model.Priority,
+			"stackable":      model.Stackable,
+			"active":         model.Active,
+			"updated_at":     model.UpdatedAt,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return &exceptions.RecordNotFoundException{}
+		}
+
+		if err := tx.Delete(&models.PromotionProductModel{}, "promotion_id = ?", model.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.PromotionCategoryModel{}, "promotion_id = ?", model.ID).Error; err != nil {
+			return err
+		}
+		return insertPromotionTargets(tx, model)
+	})
+
+	return database_errors.HandleDatabaseErrors(err)
+}
+
+// Delete remove a promoção; os alvos dela saem em cascata
+func (r *GormPromotionDataSource) Delete(id string) error {
+	return rowsAffectedOrNotFound(r.db.Delete(&models.PromotionModel{}, "id = ?", id))
+}
+
+func insertPromotionTargets(tx *gorm.DB, model models.PromotionModel) error {
+	if len(model.Products) > 0 {
+		if err := tx.Omit(clause.Associations).Create(&model.Products).Error; err != nil {
+			return err
+		}
+	}
+	if len(model.Categories) > 0 {
+		if err := tx.Omit(clause.Associations).Create(&model.Categories).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package data_sources_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/infra/database/data_sources"
+)
+
+func TestGormPromotionDataSource_Insert_WritesTargets(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewPromotionDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "promotions"`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "promotion_products" ("promotion_id","product_id") VALUES ($1,$2),($3,$4)`)).
+		WithArgs("promo", "p1", "promo", "p2").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "promotion_categories" ("promotion_id","category_id") VALUES ($1,$2)`)).
+		WithArgs("promo", "c1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := ds.Insert(daos.PromotionDAO{
+		ID:            "promo",
+		Name:          "Combo",
+		DiscountType:  "percentage",
+		DiscountValue: 10,
+		ProductIDs:    []string{"p1", "p2"},
+		CategoryIDs:   []string{"c1"},
+		Active:        true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGormPromotionDataSource_FindAllByTargets(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewPromotionDataSource(db)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "promotions" WHERE id IN (SELECT "promotion_id" FROM "promotion_products" WHERE product_id IN ($1,$2)) OR id IN (SELECT "promotion_id" FROM "promotion_categories" WHERE category_id IN ($3)) ORDER BY priority DESC, name ASC`)).
+		WithArgs("p1", "p2", "c1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "discount_type", "discount_value", "priority", "stackable", "active"}).
+			AddRow("promo", "Combo", "fixed", 5, 10, true, true))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "promotion_categories" WHERE "promotion_categories"."promotion_id" = $1`)).
+		WithArgs("promo").
+		WillReturnRows(sqlmock.NewRows([]string{"promotion_id", "category_id"}).AddRow("promo", "c1"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "promotion_products" WHERE "promotion_products"."promotion_id" = $1`)).
+		WithArgs("promo").
+		WillReturnRows(sqlmock.NewRows([]string{"promotion_id", "product_id"}))
+
+	promotions, err := ds.FindAllByTargets([]string{"p1", "p2"}, []string{"c1"})
+	require.NoError(t, err)
+	require.Len(t, promotions, 1)
+	require.Equal(t, []string{"c1"}, promotions[0].CategoryIDs)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Sem alvos nenhuma consulta é feita
+	promotions, err = ds.FindAllByTargets(nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, promotions)
+}
+
+func TestGormPromotionDataSource_FindByID_LoadsTargets(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewPromotionDataSource(db)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "promotions" WHERE id = $1 ORDER BY "promotions"."id" LIMIT $2`)).
+		WithArgs("promo", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "discount_type", "discount_value", "schedule", "priority", "stackable", "active", "created_at", "updated_at"}).
+			AddRow("promo", "Combo", "fixed", 5, `{"weekly":[{"weekdays":["tue"]}]}`, 10, true, true, time.Now(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "promotion_categories" WHERE "promotion_categories"."promotion_id" = $1`)).
+		WithArgs("promo").
+		WillReturnRows(sqlmock.NewRows([]string{"promotion_id", "category_id"}).AddRow("promo", "c1"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "promotion_products" WHERE "promotion_products"."promotion_id" = $1`)).
+		WithArgs("promo").
+		WillReturnRows(sqlmock.NewRows([]string{"promotion_id", "product_id"}).AddRow("promo", "p1"))
+
+	promotion, err := ds.FindByID("promo")
+	require.NoError(t, err)
+	require.Equal(t, "fixed", promotion.DiscountType)
+	require.Equal(t, []string{"p1"}, promotion.ProductIDs)
+	require.Equal(t, []string{"c1"}, promotion.CategoryIDs)
+	require.Equal(t, []string{"tue"}, promotion.Schedule.Weekly[0].Weekdays)
+	require.True(t, promotion.Stackable)
+}
+
+func TestGormPromotionDataSource_Update_NotFound(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewPromotionDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "promotions" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err := ds.Update(daos.PromotionDAO{ID: "promo", Name: "Combo", DiscountType: "fixed", DiscountValue: 5, UpdatedAt: time.Now()})
+	require.IsType(t, &exceptions.RecordNotFoundException{}, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,192 @@
+package data_sources
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	database_errors "tech_challenge/internal/product/infra/database/database_errors"
+	"tech_challenge/internal/product/infra/database/mappers"
+	"tech_challenge/internal/product/infra/database/models"
+)
+
+type GormStockDataSource struct {
+	db *gorm.DB
+}
+
+func NewStockDataSource(db *gorm.DB) *GormStockDataSource {
+	return &GormStockDataSource{db: db}
+}
+
+func (r *GormStockDataSource) FindByProductID(productID string) (daos.ProductStockDAO, error) {
+	var stock models.ProductStockModel
+
+	if err := r.db.First(&stock, "product_id = ?", productID).Error; err != nil {
+		return daos.ProductStockDAO{}, database_errors.HandleDatabaseErrors(err)
+	}
+
+	return mappers.FromProductStockModelToDAO(stock), nil
+}
+
+func (r *GormStockDataSource) FindAll() ([]daos.ProductStockDAO, error) {
+	var stocks []models.ProductStockModel
+
+	if err := r.db.Find(&stocks).Error; err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+
+	result := make([]daos.ProductStockDAO, 0, len(stocks))
+	for _, stock := range stocks {
+		result = append(result, mappers.FromProductStockModelToDAO(stock))
+	}
+	return result, nil
+}
+
+// FindAllByProductIDs busca o estoque dos produtos em uma única consulta;
+// produtos sem registro ficam de fora
+func (r *GormStockDataSource) FindAllByProductIDs(productIDs []string) ([]daos.ProductStockDAO, error) {
+	if len(productIDs) == 0 {
+		return []daos.ProductStockDAO{}, nil
+	}
+
+	var stocks []models.ProductStockModel
+
+	if err := r.db.Where("product_id IN ?", productIDs).Find(&stocks).Error; err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+
+	result := make([]daos.ProductStockDAO, 0, len(stocks))
+	for _, stock := range stocks {
+		result = append(result, mappers.FromProductStockModelToDAO(stock))
+	}
+	return result, nil
+}
+
+// FindByProductIDForUpdate lê o estoque bloqueando a linha até o fim da
+// transação. Sem registro, cria antes um sem controle de quantidade, que é o
+// mesmo que não ter registro, para que gravações simultâneas do primeiro
+// estoque do produto também esperem umas pelas outras
+func (r *GormStockDataSource) FindByProductIDForUpdate(productID string) (daos.ProductStockDAO, error) {
+	placeholder := models.ProductStockModel{ProductID: productID, UpdatedAt: time.Now()}
+
+	err := r.db.Omit(clause.Associations).Clauses(clause.OnConflict{DoNothing: true}).Create(&placeholder).Error
+	if err != nil {
+		return daos.ProductStockDAO{}, database_errors.HandleDatabaseErrors(err)
+	}
+
+	var stock models.ProductStockModel
+
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&stock, "product_id = ?", productID).Error; err != nil {
+		return daos.ProductStockDAO{}, database_errors.HandleDatabaseErrors(err)
+	}
+
+	return mappers.FromProductStockModelToDAO(stock), nil
+}
+
+// Save cria o registro de estoque do produto ou substitui o existente
+func (r *GormStockDataSource) Save(stock daos.ProductStockDAO) error {
+	model := mappers.FromProductStockDAOToModel(stock)
+
+	err := r.db.Omit(clause.Associations).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "product_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"tracked", "quantity", "sold_out", "updated_at"}),
+	}).Create(&model).Error
+
+	return database_errors.HandleDatabaseErrors(err)
+}
+
+// Decrement baixa quantity unidades só se houver saldo, na mesma instrução que
+// confere o saldo, e devolve o estoque resultante. Sem saldo, nenhuma linha é
+// alterada e o erro é de estoque insuficiente
+func (r *GormStockDataSource) Decrement(productID string, quantity int) (daos.ProductStockDAO, error) {
+	return r.adjust(
+		"product_id = ? AND tracked = ? AND quantity >= ?",
+		[]any{productID, true, quantity},
+		gorm.Expr("quantity - ?", quantity),
+		&exceptions.InsufficientStockException{},
+	)
+}
+
+// Increment devolve unidades ao estoque de um produto com controle de
+// quantidade; sem controle não há o que devolver e o erro é de registro
+// inexistente
+func (r *GormStockDataSource) Increment(productID string, quantity int) (daos.ProductStockDAO, error) {
+	return r.adjust(
+		"product_id = ? AND tracked = ?",
+		[]any{productID, true},
+		gorm.Expr("quantity + ?", quantity),
+		&exceptions.RecordNotFoundException{},
+	)
+}
+
+func (r *GormStockDataSource) adjust(condition string, args []any, quantity clause.Expr, notAffected error) (daos.ProductStockDAO, error) {
+	var stocks []models.ProductStockModel
+
+	result := r.db.Model(&stocks).Clauses(clause.Returning{}).
+		Where(condition, args...).
+		Updates(map[string]any{"quantity": quantity, "updated_at": time.Now()})
+
+	if result.Error != nil {
+		return daos.ProductStockDAO{}, database_errors.HandleDatabaseErrors(result.Error)
+	}
+
+	if result.RowsAffected == 0 || len(stocks) == 0 {
+		return daos.ProductStockDAO{}, notAffected
+	}
+
+	return mappers.FromProductStockModelToDAO(stocks[0]), nil
+}
+
+func (r *GormStockDataSource) InsertReservation(reservation daos.StockReservationDAO) error {
+	model := mappers.FromStockReservationDAOToModel(reservation)
+	return database_errors.HandleDatabaseErrors(r.db.Create(&model).Error)
+}
+
+func (r *GormStockDataSource) FindReservationByID(id string) (daos.StockReservationDAO, error) {
+	var reservation models.StockReservationModel
+
+	if err := r.db.First(&reservation, "id = ?", id).Error; err != nil {
+		return daos.StockReservationDAO{}, database_errors.HandleDatabaseErrors(err)
+	}
+
+	return mappers.FromStockReservationModelToDAO(reservation), nil
+}
+
+// UpdateReservationStatus só troca o status se ele ainda for fromStatus. Sem
+// linha afetada, outra requisição (ou a expiração) mudou a reserva antes
+func (r *GormStockDataSource) UpdateReservationStatus(id, fromStatus, toStatus string, updatedAt time.Time) error {
+	result := r.db.Model(&models.StockReservationModel{}).
+		Where("id = ? AND status = ?", id, fromStatus).
+		Updates(map[string]any{"status": toStatus, "updated_at": updatedAt})
+
+	if result.Error != nil {
+		return database_errors.HandleDatabaseErrors(result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return &exceptions.VersionConflictException{}
+	}
+
+	return nil
+}
+
+func (r *GormStockDataSource) FindExpiredReservations(now time.Time, limit int) ([]daos.StockReservationDAO, error) {
+	var reservations []models.StockReservationModel
+
+	err := r.db.Where("status = ? AND expires_at <= ?", "pending", now).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&reservations).Error
+	if err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+
+	result := make([]daos.StockReservationDAO, 0, len(reservations))
+	for _, reservation := range reservations {
+		result = append(result, mappers.FromStockReservationModelToDAO(reservation))
+	}
+	return result, nil
+}
@@ -0,0 +1,178 @@
+package data_sources_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/infra/database/data_sources"
+)
+
+var stockColumns = []string{"product_id", "tracked", "quantity", "sold_out", "updated_at"}
+
+func TestGormStockDataSource_FindByProductID(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStockDataSource(db)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_stocks" WHERE product_id = $1`)).
+		WithArgs("pid", 1).
+		WillReturnRows(sqlmock.NewRows(stockColumns).AddRow("pid", true, 3, false, time.Now()))
+
+	stock, err := ds.FindByProductID("pid")
+	require.NoError(t, err)
+	require.Equal(t, 3, stock.Quantity)
+	require.True(t, stock.Tracked)
+}
+
+func TestGormStockDataSource_FindByProductID_NotFound(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStockDataSource(db)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_stocks"`)).WillReturnRows(sqlmock.NewRows(stockColumns))
+
+	_, err := ds.FindByProductID("pid")
+	require.True(t, exceptions.IsRecordNotFound(err))
+}
+
+func TestGormStockDataSource_FindByProductIDForUpdate(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStockDataSource(db)
+	mock.ExpectBegin()
+	// O registro sem controle só é criado quando ainda não existe
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "product_stocks" ("product_id","tracked","quantity","sold_out","updated_at") VALUES ($1,$2,$3,$4,$5) ON CONFLICT DO NOTHING`)).
+		WithArgs("pid", false, 0, false, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_stocks" WHERE product_id = $1 ORDER BY "product_stocks"."product_id" LIMIT $2 FOR UPDATE`)).
+		WithArgs("pid", 1).
+		WillReturnRows(sqlmock.NewRows(stockColumns).AddRow("pid", true, 0, true, time.Now()))
+
+	stock, err := ds.FindByProductIDForUpdate("pid")
+	require.NoError(t, err)
+	require.True(t, stock.SoldOut)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGormStockDataSource_FindAllByProductIDs(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStockDataSource(db)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_stocks" WHERE product_id IN ($1,$2)`)).
+		WithArgs("p1", "p2").
+		WillReturnRows(sqlmock.NewRows(stockColumns).AddRow("p2", false, 0, true, time.Now()))
+
+	stocks, err := ds.FindAllByProductIDs([]string{"p1", "p2"})
+	require.NoError(t, err)
+	require.Len(t, stocks, 1)
+	require.True(t, stocks[0].SoldOut)
+
+	stocks, err = ds.FindAllByProductIDs(nil)
+	require.NoError(t, err)
+	require.Empty(t, stocks)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGormStockDataSource_Save_Upserts(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStockDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "product_stocks" ("product_id","tracked","quantity","sold_out","updated_at") VALUES ($1,$2,$3,$4,$5) ON CONFLICT ("product_id") DO UPDATE SET "tracked"="excluded"."tracked","quantity"="excluded"."quantity","sold_out"="excluded"."sold_out","updated_at"="excluded"."updated_at"`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := ds.Save(daos.ProductStockDAO{ProductID: "pid", Tracked: true, Quantity: 10})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGormStockDataSource_Decrement_IsAtomic(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStockDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "product_stocks" SET "quantity"=quantity - $1,"updated_at"=$2 WHERE product_id = $3 AND tracked = $4 AND quantity >= $5 RETURNING *`)).
+		WithArgs(2, sqlmock.AnyArg(), "pid", true, 2).
+		WillReturnRows(sqlmock.NewRows(stockColumns).AddRow("pid", true, 0, false, time.Now()))
+	mock.ExpectCommit()
+
+	stock, err := ds.Decrement("pid", 2)
+	require.NoError(t, err)
+	require.Equal(t, 0, stock.Quantity)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGormStockDataSource_Decrement_Insufficient(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStockDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "product_stocks"`)).WillReturnRows(sqlmock.NewRows(stockColumns))
+	mock.ExpectCommit()
+
+	_, err := ds.Decrement("pid", 5)
+	require.IsType(t, &exceptions.InsufficientStockException{}, err)
+}
+
+func TestGormStockDataSource_Increment(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStockDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "product_stocks" SET "quantity"=quantity + $1,"updated_at"=$2 WHERE product_id = $3 AND tracked = $4 RETURNING *`)).
+		WithArgs(2, sqlmock.AnyArg(), "pid", true).
+		WillReturnRows(sqlmock.NewRows(stockColumns))
+	mock.ExpectCommit()
+
+	_, err := ds.Increment("pid", 2)
+	require.True(t, exceptions.IsRecordNotFound(err))
+}
+
+func TestGormStockDataSource_UpdateReservationStatus(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStockDataSource(db)
+	updatedAt := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "stock_reservations" SET "status"=$1,"updated_at"=$2 WHERE id = $3 AND status = $4`)).
+		WithArgs("confirmed", updatedAt, "rid", "pending").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := ds.UpdateReservationStatus("rid", "pending", "confirmed", updatedAt)
+	require.IsType(t, &exceptions.VersionConflictException{}, err)
+}
+
+func TestGormStockDataSource_ReservationRoundTrip(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStockDataSource(db)
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "stock_reservations"`)).
+		WithArgs("rid", `[{"product_id":"pid","quantity":2}]`, "pending", now.Add(time.Minute), now, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "stock_reservations" WHERE status = $1 AND expires_at <= $2 ORDER BY expires_at ASC LIMIT $3`)).
+		WithArgs("pending", now, 50).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "items", "status", "expires_at", "created_at", "updated_at"}).
+			AddRow("rid", []byte(`[{"product_id":"pid","quantity":2}]`), "pending", now, now, now))
+
+	err := ds.InsertReservation(daos.StockReservationDAO{
+		ID: "rid", Items: []daos.StockReservationItemDAO{{ProductID: "pid", Quantity: 2}},
+		Status: "pending", ExpiresAt: now.Add(time.Minute), CreatedAt: now, UpdatedAt: now,
+	})
+	require.NoError(t, err)
+
+	expired, err := ds.FindExpiredReservations(now, 50)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	require.Equal(t, []daos.StockReservationItemDAO{{ProductID: "pid", Quantity: 2}}, expired[0].Items)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,148 @@
+package data_sources
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	database_errors "tech_challenge/internal/product/infra/database/database_errors"
+	"tech_challenge/internal/product/infra/database/mappers"
+	"tech_challenge/internal/product/infra/database/models"
+)
+
+type GormStoreDataSource struct {
+	db *gorm.DB
+}
+
+func NewStoreDataSource(db *gorm.DB) *GormStoreDataSource {
+	return &GormStoreDataSource{db: db}
+}
+
+func (r *GormStoreDataSource) Insert(store daos.StoreDAO) error {
+	model := mappers.FromStoreDAOToModel(store)
+
+	return database_errors.HandleDatabaseErrors(r.db.Create(&model).Error)
+}
+
+func (r *GormStoreDataSource) FindAll() ([]daos.StoreDAO, error) {
+	var stores []models.StoreModel
+
+	if err := r.db.Order("name ASC").Find(&stores).Error; err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+
+	result := make([]daos.StoreDAO, 0, len(stores))
+	for _, store := range stores {
+		result = append(result, mappers.FromStoreModelToDAO(store))
+	}
+	return result, nil
+}
+
+func (r *GormStoreDataSource) FindByID(id string) (daos.StoreDAO, error) {
+	var store models.StoreModel
+
+	if err := r.db.First(&store, "id = ?", id).Error; err != nil {
+		return daos.StoreDAO{}, database_errors.HandleDatabaseErrors(err)
+	}
+
+	return mappers.FromStoreModelToDAO(store), nil
+}
+
+func (r *GormStoreDataSource) Update(store daos.StoreDAO) error {
+	result := r.db.Model(&models.StoreModel{}).Where("id = ?", store.ID).Updates(map[string]any{
+		"name":       store.Name,
+		"updated_at": store.UpdatedAt,
+	})
+	return rowsAffectedOrNotFound(result)
+}
+
+// Delete remove a loja; os ajustes dela saem em cascata
+func (r *GormStoreDataSource) Delete(id string) error {
+	return rowsAffectedOrNotFound(r.db.Delete(&models.StoreModel{}, "id = ?", id))
+}
+
+func (r *GormStoreDataSource) FindProductOverrides(storeID string) ([]daos.ProductStoreOverrideDAO, error) {
+	var overrides []models.StoreProductOverrideModel
+
+	if err := r.db.Where("store_id = ?", storeID).Order("product_id").Find(&overrides).Error; err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+
+	result := make([]daos.ProductStoreOverrideDAO, 0, len(overrides))
+	for _, override := range overrides {
+		result = append(result, mappers.FromProductStoreOverrideModelToDAO(override))
+	}
+	return result, nil
+}
+
+func (r *GormStoreDataSource) FindCategoryOverrides(storeID string) ([]daos.CategoryStoreOverrideDAO, error) {
+	var overrides []models.StoreCategoryOverrideModel
+
+	if err := r.db.Where("store_id = ?", storeID).Order("category_id").Find(&overrides).Error; err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+
+	result := make([]daos.CategoryStoreOverrideDAO, 0, len(overrides))
+	for _, override := range overrides {
+		result = append(result, mappers.FromCategoryStoreOverrideModelToDAO(override))
+	}
+	return result, nil
+}
+
+// SaveProductOverride cria o ajuste do produto na loja ou substitui o
+// existente, inclusive limpando os campos que deixaram de ser ajustados
+func (r *GormStoreDataSource) SaveProductOverride(override daos.ProductStoreOverrideDAO) error {
+	model := mappers.FromProductStoreOverrideDAOToModel(override)
+
+	err := r.db.Omit(clause.Associations).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "store_id"}, {Name: "product_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"price", "active", "availability", "updated_at"}),
+	}).Create(&model).Error
+
+	return database_errors.HandleDatabaseErrors(err)
+}
+
+func (r *GormStoreDataSource) SaveCategoryOverride(override daos.CategoryStoreOverrideDAO) error {
+	model := mappers.FromCategoryStoreOverrideDAOToModel(override)
+
+	err := r.db.Omit(clause.Associations).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "store_id"}, {Name: "category_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"visible", "updated_at"}),
+	}).Create(&model).Error
+
+	return database_errors.HandleDatabaseErrors(err)
+}
+
+// DeleteProductOverride e DeleteCategoryOverride não falham quando não há
+// ajuste: remover o que não existe já deixa o item sem ajuste
+func (r *GormStoreDataSource) DeleteProductOverride(storeID, productID string) error {
+	err := r.db.Delete(&models.StoreProductOverrideModel{}, "store_id = ? AND product_id = ?", storeID, productID).Error
+	return database_errors.HandleDatabaseErrors(err)
+}
+
+func (r *GormStoreDataSource) DeleteCategoryOverride(storeID, categoryID string) error {
+	err := r.db.Delete(&models.StoreCategoryOverrideModel{}, "store_id = ? AND category_id = ?", storeID, categoryID).Error
+	return database_errors.HandleDatabaseErrors(err)
+}
+
+func (r *GormStoreDataSource) DeleteOverrides(storeID string) error {
+	if err := r.db.Delete(&models.StoreProductOverrideModel{}, "store_id = ?", storeID).Error; err != nil {
+		return database_errors.HandleDatabaseErrors(err)
+	}
+
+	err := r.db.Delete(&models.StoreCategoryOverrideModel{}, "store_id = ?", storeID).Error
+	return database_errors.HandleDatabaseErrors(err)
+}
+
+func rowsAffectedOrNotFound(result *gorm.DB) error {
+	if result.Error != nil {
+		return database_errors.HandleDatabaseErrors(result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return &exceptions.RecordNotFoundException{}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package data_sources_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/infra/database/data_sources"
+)
+
+func TestGormStoreDataSource_FindProductOverrides(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStoreDataSource(db)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "store_product_overrides" WHERE store_id = $1 ORDER BY product_id`)).
+		WithArgs("sid").
+		WillReturnRows(sqlmock.NewRows([]string{"store_id", "product_id", "price", "active", "availability", "updated_at"}).
+			AddRow("sid", "p1", 19.9, nil, nil, time.Now()).
+			AddRow("sid", "p2", nil, false, `{"weekly":[{"weekdays":["sat"]}]}`, time.Now()))
+
+	overrides, err := ds.FindProductOverrides("sid")
+	require.NoError(t, err)
+	require.Len(t, overrides, 2)
+	require.Equal(t, 19.9, *overrides[0].Price)
+	require.Nil(t, overrides[0].Active)
+	require.Nil(t, overrides[0].Availability)
+	require.Nil(t, overrides[1].Price)
+	require.False(t, *overrides[1].Active)
+	require.Equal(t, []string{"sat"}, overrides[1].Availability.Weekly[0].Weekdays)
+}
+
+func TestGormStoreDataSource_SaveProductOverride_Upserts(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStoreDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "store_product_overrides" ("store_id","product_id","price","active","availability","updated_at") VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT ("store_id","product_id") DO UPDATE SET "price"="excluded"."price","active"="excluded"."active","availability"="excluded"."availability","updated_at"="excluded"."updated_at"`)).
+		WithArgs("sid", "pid", 21.5, nil, nil, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	price := 21.5
+	err := ds.SaveProductOverride(daos.ProductStoreOverrideDAO{StoreID: "sid", ProductID: "pid", Price: &price})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGormStoreDataSource_SaveCategoryOverride_Upserts(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStoreDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "store_category_overrides" ("store_id","category_id","visible","updated_at") VALUES ($1,$2,$3,$4) ON CONFLICT ("store_id","category_id") DO UPDATE SET "visible"="excluded"."visible","updated_at"="excluded"."updated_at"`)).
+		WithArgs("sid", "cid", false, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	hidden := false
+	err := ds.SaveCategoryOverride(daos.CategoryStoreOverrideDAO{StoreID: "sid", CategoryID: "cid", Visible: &hidden})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGormStoreDataSource_Update_NotFound(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStoreDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "stores" SET "name"=$1,"updated_at"=$2 WHERE id = $3`)).
+		WithArgs("Centro", sqlmock.AnyArg(), "sid").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := ds.Update(daos.StoreDAO{ID: "sid", Name: "Centro", UpdatedAt: time.Now()})
+	require.IsType(t, &exceptions.RecordNotFoundException{}, err)
+}
+
+func TestGormStoreDataSource_DeleteOverrides(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewStoreDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "store_product_overrides" WHERE store_id = $1`)).
+		WithArgs("sid").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "store_category_overrides" WHERE store_id = $1`)).
+		WithArgs("sid").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	require.NoError(t, ds.DeleteOverrides("sid"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,32 @@
+package data_sources
+
+import (
+	"gorm.io/gorm"
+
+	database_errors "tech_challenge/internal/product/infra/database/database_errors"
+	"tech_challenge/internal/product/interfaces"
+)
+
+type GormTransactionManager struct {
+	db *gorm.DB
+}
+
+func NewGormTransactionManager(db *gorm.DB) *GormTransactionManager {
+	return &GormTransactionManager{db: db}
+}
+
+// Transaction executa fn com data sources ligados à mesma transação;
+// qualquer erro retornado por fn desfaz todas as alterações. Falhas ao abrir
+// ou confirmar a transação são traduzidas como as dos data sources.
+func (m *GormTransactionManager) Transaction(fn func(dataSources interfaces.TransactionDataSources) error) error {
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		return fn(interfaces.TransactionDataSources{
+			Product:     NewProductDataSource(tx),
+			Category:    NewGormCategoryDataSource(tx),
+			Translation: NewTranslationDataSource(tx),
+			Store:       NewStoreDataSource(tx),
+			Stock:       NewStockDataSource(tx),
+		})
+	})
+	return database_errors.HandleDatabaseErrors(err)
+}
@@ -0,0 +1,60 @@
+package data_sources_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/infra/database/data_sources"
+	"tech_challenge/internal/product/interfaces"
+)
+
+func TestGormTransactionManager_Commit(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	tm := data_sources.NewGormTransactionManager(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "category"`)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := tm.Transaction(func(dataSources interfaces.TransactionDataSources) error {
+		require.NotNil(t, dataSources.Product)
+		return dataSources.Category.Insert(daos.CategoryDAO{ID: "catid", Name: "Bebidas", Active: true})
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGormTransactionManager_Rollback(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	tm := data_sources.NewGormTransactionManager(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "category"`)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectRollback()
+	err := tm.Transaction(func(dataSources interfaces.TransactionDataSources) error {
+		if err := dataSources.Category.Insert(daos.CategoryDAO{ID: "catid", Name: "Bebidas", Active: true}); err != nil {
+			return err
+		}
+		return errors.New("boom")
+	})
+	require.EqualError(t, err, "boom")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGormTransactionManager_StockRollsBackOnError(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	tm := data_sources.NewGormTransactionManager(db)
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	err := tm.Transaction(func(dataSources interfaces.TransactionDataSources) error {
+		require.NotNil(t, dataSources.Stock)
+		return errors.New("boom")
+	})
+	require.EqualError(t, err, "boom")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
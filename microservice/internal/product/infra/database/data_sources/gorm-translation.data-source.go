@@ -0,0 +1,124 @@
+package data_sources
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	database_errors "tech_challenge/internal/product/infra/database/database_errors"
+	"tech_challenge/internal/product/infra/database/mappers"
+	"tech_challenge/internal/product/infra/database/models"
+)
+
+// translationTable liga cada target à sua tabela; as duas têm as mesmas
+// colunas, exceto a chave estrangeira
+type translationTable struct {
+	model     any
+	keyColumn string
+}
+
+var translationTables = map[string]translationTable{
+	"product":  {model: &models.ProductTranslationModel{}, keyColumn: "product_id"},
+	"category": {model: &models.CategoryTranslationModel{}, keyColumn: "category_id"},
+}
+
+type GormTranslationDataSource struct {
+	db *gorm.DB
+}
+
+func NewTranslationDataSource(db *gorm.DB) *GormTranslationDataSource {
+	return &GormTranslationDataSource{db: db}
+}
+
+func (r *GormTranslationDataSource) FindByEntityID(target, entityID string) ([]daos.TranslationDAO, error) {
+	table, err := translationTableFor(target)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.find(target, table, table.keyColumn+" = ?", entityID)
+}
+
+func (r *GormTranslationDataSource) FindAllByLocale(target, locale string) ([]daos.TranslationDAO, error) {
+	table, err := translationTableFor(target)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.find(target, table, "locale = ?", locale)
+}
+
+func (r *GormTranslationDataSource) find(target string, table translationTable, condition string, args ...any) ([]daos.TranslationDAO, error) {
+	var rows []models.TranslationRow
+
+	err := r.db.Model(table.model).
+		Select(table.keyColumn+" AS entity_id", "locale", "name", "description", "updated_at").
+		Where(condition, args...).
+		Order(table.keyColumn + ", locale").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, database_errors.HandleDatabaseErrors(err)
+	}
+
+	result := make([]daos.TranslationDAO, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mappers.FromTranslationRowToDAO(target, row))
+	}
+	return result, nil
+}
+
+// Save cria a tradução do idioma ou substitui a existente
+func (r *GormTranslationDataSource) Save(translation daos.TranslationDAO) error {
+	table, err := translationTableFor(translation.Target)
+	if err != nil {
+		return err
+	}
+
+	updatedAt := translation.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = time.Now()
+	}
+
+	err = r.db.Model(table.model).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: table.keyColumn}, {Name: "locale"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "description", "updated_at"}),
+	}).Create(map[string]any{
+		table.keyColumn: translation.EntityID,
+		"locale":        translation.Locale,
+		"name":          translation.Name,
+		"description":   translation.Description,
+		"updated_at":    updatedAt,
+	}).Error
+
+	return database_errors.HandleDatabaseErrors(err)
+}
+
+func (r *GormTranslationDataSource) Delete(target, entityID, locale string) error {
+	table, err := translationTableFor(target)
+	if err != nil {
+		return err
+	}
+
+	result := r.db.Where(table.keyColumn+" = ? AND locale = ?", entityID, locale).Delete(table.model)
+	if result.Error != nil {
+		return database_errors.HandleDatabaseErrors(result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return &exceptions.RecordNotFoundException{}
+	}
+
+	return nil
+}
+
+func translationTableFor(target string) (translationTable, error) {
+	table, ok := translationTables[target]
+	if !ok {
+		return translationTable{}, fmt.Errorf("unknown translation target %q", target)
+	}
+	return table, nil
+}
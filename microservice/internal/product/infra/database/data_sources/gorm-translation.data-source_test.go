@@ -0,0 +1,91 @@
+package data_sources_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/infra/database/data_sources"
+)
+
+var translationColumns = []string{"entity_id", "locale", "name", "description", "updated_at"}
+
+func TestGormTranslationDataSource_FindByEntityID(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewTranslationDataSource(db)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT product_id AS entity_id,"locale","name","description","updated_at" FROM "product_translations" WHERE product_id = $1 ORDER BY product_id, locale`)).
+		WithArgs("pid").
+		WillReturnRows(sqlmock.NewRows(translationColumns).
+			AddRow("pid", "en", "Cheeseburger", "Beef and cheese", time.Now()).
+			AddRow("pid", "es", "Hamburguesa", "", time.Now()))
+
+	translations, err := ds.FindByEntityID("product", "pid")
+	require.NoError(t, err)
+	require.Len(t, translations, 2)
+	require.Equal(t, "product", translations[0].Target)
+	require.Equal(t, "pid", translations[0].EntityID)
+	require.Equal(t, "Hamburguesa", translations[1].Name)
+}
+
+func TestGormTranslationDataSource_FindAllByLocale(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewTranslationDataSource(db)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT category_id AS entity_id,"locale","name","description","updated_at" FROM "category_translations" WHERE locale = $1 ORDER BY category_id, locale`)).
+		WithArgs("en").
+		WillReturnRows(sqlmock.NewRows(translationColumns).AddRow("cid", "en", "Drinks", "", time.Now()))
+
+	translations, err := ds.FindAllByLocale("category", "en")
+	require.NoError(t, err)
+	require.Equal(t, []daos.TranslationDAO{{
+		Target:    "category",
+		EntityID:  "cid",
+		Locale:    "en",
+		Name:      "Drinks",
+		UpdatedAt: translations[0].UpdatedAt,
+	}}, translations)
+}
+
+func TestGormTranslationDataSource_Save_Upserts(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewTranslationDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "product_translations" ("description","locale","name","product_id","updated_at") VALUES ($1,$2,$3,$4,$5) ON CONFLICT ("product_id","locale") DO UPDATE SET "name"="excluded"."name","description"="excluded"."description","updated_at"="excluded"."updated_at"`)).
+		WithArgs("Beef and cheese", "en", "Cheeseburger", "pid", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := ds.Save(daos.TranslationDAO{Target: "product", EntityID: "pid", Locale: "en", Name: "Cheeseburger", Description: "Beef and cheese"})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGormTranslationDataSource_Delete_NotFound(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewTranslationDataSource(db)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "category_translations" WHERE category_id = $1 AND locale = $2`)).
+		WithArgs("cid", "es").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := ds.Delete("category", "cid", "es")
+	require.True(t, exceptions.IsRecordNotFound(err))
+}
+
+func TestGormTranslationDataSource_UnknownTarget(t *testing.T) {
+	db, _, cleanup := setupMockDB(t)
+	defer cleanup()
+	ds := data_sources.NewTranslationDataSource(db)
+
+	_, err := ds.FindByEntityID("combo", "id")
+	require.EqualError(t, err, `unknown translation target "combo"`)
+}
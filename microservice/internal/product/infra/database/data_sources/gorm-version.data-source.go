@@ -0,0 +1,59 @@
+package data_sources
+
+import (
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/product/domain/exceptions"
+	database_errors "tech_challenge/internal/product/infra/database/database_errors"
+)
+
+// conditionalUpdate grava as colunas só se a versão no banco ainda for a lida,
+// avançando a versão na mesma instrução. Sem linha afetada, diferencia o
+// registro removido do alterado por outra requisição.
+func conditionalUpdate(db *gorm.DB, model any, id string, version int64, columns map[string]any) error {
+	columns["version"] = gorm.Expr("version + 1")
+
+	result := db.Model(model).Where("id = ? AND version = ?", id, version).Updates(columns)
+	if result.Error != nil {
+		return database_errors.HandleDatabaseErrors(result.Error)
+	}
+
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var count int64
+	if err := db.Model(model).Where("id = ?", id).Count(&count).Error; err != nil {
+		return database_errors.HandleDatabaseErrors(err)
+	}
+
+	if count == 0 {
+		return &exceptions.RecordNotFoundException{}
+	}
+
+	return &exceptions.VersionConflictException{}
+}
+
+// conditionalDelete remove o registro só se a versão no banco ainda for a lida,
+// com a mesma distinção entre registro inexistente e alterado
+func conditionalDelete(db *gorm.DB, model any, id string, version int64) error {
+	result := db.Where("id = ? AND version = ?", id, version).Delete(model)
+	if result.Error != nil {
+		return database_errors.HandleDatabaseErrors(result.Error)
+	}
+
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var count int64
+	if err := db.Model(model).Where("id = ?", id).Count(&count).Error; err != nil {
+		return database_errors.HandleDatabaseErrors(err)
+	}
+
+	if count == 0 {
+		return &exceptions.RecordNotFoundException{}
+	}
+
+	return &exceptions.VersionConflictException{}
+}
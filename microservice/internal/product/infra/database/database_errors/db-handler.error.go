@@ -1,31 +1,152 @@
 package database_errors
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
 	"regexp"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+
 	"tech_challenge/internal/product/domain/exceptions"
 )
 
+// Índices únicos criados na migração (ver database.RunMigrations)
+const (
+	CategoryNameKeyIndex = "idx_category_name_key"
+	ProductNameKeyIndex  = "idx_products_category_name_key"
+)
+
+var (
+	sqlStateRegex   = regexp.MustCompile(`SQLSTATE (\w{5})`)
+	constraintRegex = regexp.MustCompile(`constraint "([^"]+)"`)
+)
+
+// HandleDatabaseErrors traduz os erros do GORM/pgx para os erros tipados de
+// repositório, para que os casos de uso diferenciem registro inexistente,
+// conflito e falha de infraestrutura
 func HandleDatabaseErrors(err error) error {
-	if err == nil {
-		return nil
+	if err == nil || isTranslated(err) {
+		return err
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &exceptions.RecordNotFoundException{Err: err}
 	}
 
-	code := ExtractDatabaseState(err.Error())
+	if isTimeout(err) {
+		return &exceptions.RepositoryTimeoutException{Err: err}
+	}
+
+	if isConnectionFailure(err) {
+		return &exceptions.RepositoryUnavailableException{Err: err}
+	}
+
+	code := databaseState(err)
 
 	switch code {
 	case "":
 		return err
 	case "23001", "23503":
-		return &exceptions.CategoryHasProductsException{}
+		return &exceptions.ForeignKeyViolationException{Constraint: constraintName(err), Err: err}
+	case "23505":
+		return handleUniqueViolation(err)
+	case "57014", "55P03":
+		// statement_timeout e lock_timeout
+		return &exceptions.RepositoryTimeoutException{Err: err}
+	case "57P01", "57P02", "57P03":
+		return &exceptions.RepositoryUnavailableException{Err: err}
+	}
+
+	switch code[:2] {
+	case "08", "53":
+		// Falhas de conexão e falta de recursos (ex.: too_many_connections)
+		return &exceptions.RepositoryUnavailableException{Err: err}
 	}
+
 	return err
 }
 
+func handleUniqueViolation(err error) error {
+	constraint := constraintName(err)
+
+	switch {
+	case constraint == CategoryNameKeyIndex:
+		return &exceptions.CategoryAlreadyExistsException{Message: "A category with this name already exists"}
+	case constraint == ProductNameKeyIndex:
+		return &exceptions.ProductAlreadyExistsException{Message: "A product with this name already exists in this category"}
+	}
+
+	// Os demais índices únicos (ex.: external_key) não dizem respeito ao nome
+	return &exceptions.RecordConflictException{Constraint: constraint, Err: err}
+}
+
+func databaseState(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code
+	}
+	return ExtractDatabaseState(err.Error())
+}
+
 func ExtractDatabaseState(msg string) string {
-	re := regexp.MustCompile(`SQLSTATE (\d{5})`)
-	matches := re.FindStringSubmatch(msg)
+	matches := sqlStateRegex.FindStringSubmatch(msg)
 	if len(matches) == 2 {
 		return matches[1]
 	}
 	return ""
 }
+
+func constraintName(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName != "" {
+		return pgErr.ConstraintName
+	}
+
+	matches := constraintRegex.FindStringSubmatch(err.Error())
+	if len(matches) == 2 {
+		return matches[1]
+	}
+	return ""
+}
+
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || pgconn.Timeout(err) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func isConnectionFailure(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) {
+		return true
+	}
+
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
+
+func isTranslated(err error) bool {
+	switch err.(type) {
+	case *exceptions.RecordNotFoundException,
+		*exceptions.RecordConflictException,
+		*exceptions.ForeignKeyViolationException,
+		*exceptions.RepositoryTimeoutException,
+		*exceptions.RepositoryUnavailableException,
+		*exceptions.CategoryAlreadyExistsException,
+		*exceptions.ProductAlreadyExistsException,
+		*exceptions.CategoryHasProductsException:
+		return true
+	}
+	return false
+}
@@ -1,13 +1,19 @@
 package database_errors_test
 
 import (
+	"context"
+	"database/sql/driver"
 	"errors"
+	"fmt"
+	"net"
 	"testing"
 
 	"tech_challenge/internal/product/domain/exceptions"
 	"tech_challenge/internal/product/infra/database/database_errors"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestHandleDatabaseErrors_NilError(t *testing.T) {
@@ -21,18 +27,63 @@ func TestHandleDatabaseErrors_NoSQLState(t *testing.T) {
 	require.Equal(t, err, result)
 }
 
-func TestHandleDatabaseErrors_CategoryHasProducts23001(t *testing.T) {
+func TestHandleDatabaseErrors_ForeignKeyViolation23001(t *testing.T) {
 	err := errors.New("pq: violação de restrição de integridade - SQLSTATE 23001")
 	result := database_errors.HandleDatabaseErrors(err)
-	_, ok := result.(*exceptions.CategoryHasProductsException)
+	_, ok := result.(*exceptions.ForeignKeyViolationException)
 	require.True(t, ok)
 }
 
-func TestHandleDatabaseErrors_CategoryHasProducts23503(t *testing.T) {
-	err := errors.New("pq: violação de restrição de integridade - SQLSTATE 23503")
+func TestHandleDatabaseErrors_ForeignKeyViolation23503(t *testing.T) {
+	err := errors.New(`ERROR: update or delete on table "category" violates foreign key constraint "fk_products_category" (SQLSTATE 23503)`)
 	result := database_errors.HandleDatabaseErrors(err)
-	_, ok := result.(*exceptions.CategoryHasProductsException)
+	fkErr, ok := result.(*exceptions.ForeignKeyViolationException)
 	require.True(t, ok)
+	require.Equal(t, "fk_products_category", fkErr.Constraint)
+	require.ErrorIs(t, result, err)
+}
+
+func TestHandleDatabaseErrors_PgError(t *testing.T) {
+	err := fmt.Errorf("insert: %w", &pgconn.PgError{Code: "23505", ConstraintName: database_errors.ProductNameKeyIndex})
+	require.IsType(t, &exceptions.ProductAlreadyExistsException{}, database_errors.HandleDatabaseErrors(err))
+
+	err = &pgconn.PgError{Code: "23503", ConstraintName: "fk_products_category"}
+	require.IsType(t, &exceptions.ForeignKeyViolationException{}, database_errors.HandleDatabaseErrors(err))
+}
+
+func TestHandleDatabaseErrors_RecordNotFound(t *testing.T) {
+	result := database_errors.HandleDatabaseErrors(gorm.ErrRecordNotFound)
+	require.IsType(t, &exceptions.RecordNotFoundException{}, result)
+	require.True(t, exceptions.IsRecordNotFound(result))
+	require.ErrorIs(t, result, gorm.ErrRecordNotFound)
+}
+
+func TestHandleDatabaseErrors_Timeout(t *testing.T) {
+	for _, err := range []error{
+		context.DeadlineExceeded,
+		fmt.Errorf("query: %w", context.DeadlineExceeded),
+		errors.New("ERROR: canceling statement due to statement timeout (SQLSTATE 57014)"),
+		&pgconn.PgError{Code: "55P03"},
+	} {
+		require.IsType(t, &exceptions.RepositoryTimeoutException{}, database_errors.HandleDatabaseErrors(err), err.Error())
+	}
+}
+
+func TestHandleDatabaseErrors_Unavailable(t *testing.T) {
+	for _, err := range []error{
+		driver.ErrBadConn,
+		&net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+		errors.New("FATAL: the database system is shutting down (SQLSTATE 57P03)"),
+		errors.New("FATAL: sorry, too many clients already (SQLSTATE 53300)"),
+		&pgconn.PgError{Code: "08006"},
+	} {
+		require.IsType(t, &exceptions.RepositoryUnavailableException{}, database_errors.HandleDatabaseErrors(err), err.Error())
+	}
+}
+
+func TestHandleDatabaseErrors_AlreadyTranslated(t *testing.T) {
+	err := &exceptions.RecordNotFoundException{Message: "Product not found"}
+	require.Same(t, err, database_errors.HandleDatabaseErrors(err))
 }
 
 func TestHandleDatabaseErrors_OtherSQLState(t *testing.T) {
@@ -50,3 +101,27 @@ func TestExtractDatabaseState(t *testing.T) {
 	code2 := database_errors.ExtractDatabaseState(msg2)
 	require.Equal(t, "", code2)
 }
+
+func TestHandleDatabaseErrors_UniqueViolation(t *testing.T) {
+	err := errors.New(`ERROR: duplicate key value violates unique constraint "idx_category_name_key" (SQLSTATE 23505)`)
+	result := database_errors.HandleDatabaseErrors(err)
+	require.IsType(t, &exceptions.CategoryAlreadyExistsException{}, result)
+	require.EqualError(t, result, "A category with this name already exists")
+
+	err = errors.New(`ERROR: duplicate key value violates unique constraint "idx_products_category_name_key" (SQLSTATE 23505)`)
+	result = database_errors.HandleDatabaseErrors(err)
+	require.IsType(t, &exceptions.ProductAlreadyExistsException{}, result)
+	require.EqualError(t, result, "A product with this name already exists in this category")
+
+	for _, constraint := range []string{"idx_products_external_key", "idx_category_external_key"} {
+		err = errors.New(`ERROR: duplicate key value violates unique constraint "` + constraint + `" (SQLSTATE 23505)`)
+		result = database_errors.HandleDatabaseErrors(err)
+		require.IsType(t, &exceptions.RecordConflictException{}, result, constraint)
+		require.Equal(t, constraint, result.(*exceptions.RecordConflictException).Constraint)
+	}
+
+	err = errors.New(`ERROR: duplicate key value violates unique constraint "category_pkey" (SQLSTATE 23505)`)
+	result = database_errors.HandleDatabaseErrors(err)
+	require.IsType(t, &exceptions.RecordConflictException{}, result)
+	require.Equal(t, "category_pkey", result.(*exceptions.RecordConflictException).Constraint)
+}
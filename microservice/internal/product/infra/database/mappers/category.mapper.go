@@ -3,21 +3,41 @@ package mappers
 import (
 	"tech_challenge/internal/product/daos"
 	"tech_challenge/internal/product/infra/database/models"
+	"tech_challenge/internal/shared/pkg/normalizer"
 )
 
 func FromCategoryDAOToCategoryModel(category daos.CategoryDAO) models.CategoryModel {
 	return models.CategoryModel{
-		ID:     category.ID,
-		Name:   category.Name,
-		Active: category.Active,
+		ID:            category.ID,
+		ExternalKey:   toNullableString(category.ExternalKey),
+		ParentID:      toNullableString(category.ParentID),
+		Name:          category.Name,
+		NameKey:       normalizer.NameKey(category.Name),
+		Description:   category.Description,
+		Position:      category.Position,
+		ImageFileName: toNullableString(category.ImageFileName),
+		ImageUrl:      toNullableString(category.ImageUrl),
+		Active:        category.Active,
+		Availability:  (*models.AvailabilityModel)(category.Availability),
+		Version:       category.Version,
+		UpdatedAt:     category.UpdatedAt,
 	}
 }
 
 func FromCategoryModelToCategoryDAO(category *models.CategoryModel) daos.CategoryDAO {
 	categoryEntity := daos.CategoryDAO{
-		ID:     category.ID,
-		Name:   category.Name,
-		Active: category.Active,
+		ID:            category.ID,
+		ExternalKey:   fromNullableString(category.ExternalKey),
+		ParentID:      fromNullableString(category.ParentID),
+		Name:          category.Name,
+		Description:   category.Description,
+		Position:      category.Position,
+		ImageFileName: fromNullableString(category.ImageFileName),
+		ImageUrl:      fromNullableString(category.ImageUrl),
+		Active:        category.Active,
+		Availability:  (*daos.AvailabilityDAO)(category.Availability),
+		Version:       category.Version,
+		UpdatedAt:     category.UpdatedAt,
 	}
 
 	return categoryEntity
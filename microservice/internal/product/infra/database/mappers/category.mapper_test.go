@@ -32,3 +32,38 @@ func TestArrayFromCategoryModelToCategoryDAO(t *testing.T) {
 	require.Equal(t, "catid1", arr[0].ID)
 	require.Equal(t, "catid2", arr[1].ID)
 }
+
+func TestCategoryMapper_ExternalKey(t *testing.T) {
+	model := FromCategoryDAOToCategoryModel(daos.CategoryDAO{ID: "catid", Name: "Bebidas"})
+	require.Nil(t, model.ExternalKey)
+
+	model = FromCategoryDAOToCategoryModel(daos.CategoryDAO{ID: "catid", ExternalKey: "bebidas", Name: "Bebidas"})
+	require.Equal(t, "bebidas", *model.ExternalKey)
+	require.Equal(t, "bebidas", FromCategoryModelToCategoryDAO(&model).ExternalKey)
+}
+
+func TestCategoryMapper_DisplayMetadata(t *testing.T) {
+	dao := daos.CategoryDAO{ID: "catid", Name: "Bebidas", Description: "Bebidas geladas", Position: 2, ImageFileName: "icon.png", ImageUrl: "http://bucket/icon.png"}
+	model := FromCategoryDAOToCategoryModel(dao)
+	require.Equal(t, 2, model.Position)
+	require.Equal(t, "icon.png", *model.ImageFileName)
+	require.Equal(t, dao, FromCategoryModelToCategoryDAO(&model))
+
+	model = FromCategoryDAOToCategoryModel(daos.CategoryDAO{ID: "catid", Name: "Bebidas"})
+	require.Nil(t, model.ImageFileName)
+	require.Nil(t, model.ImageUrl)
+}
+
+func TestCategoryMapper_ParentID(t *testing.T) {
+	model := FromCategoryDAOToCategoryModel(daos.CategoryDAO{ID: "catid", Name: "Bebidas"})
+	require.Nil(t, model.ParentID)
+
+	model = FromCategoryDAOToCategoryModel(daos.CategoryDAO{ID: "catid", ParentID: "parentid", Name: "Refrigerantes"})
+	require.Equal(t, "parentid", *model.ParentID)
+	require.Equal(t, "parentid", FromCategoryModelToCategoryDAO(&model).ParentID)
+}
+
+func TestCategoryMapper_NameKey(t *testing.T) {
+	model := FromCategoryDAOToCategoryModel(daos.CategoryDAO{ID: "catid", Name: "Açaí"})
+	require.Equal(t, "acai", model.NameKey)
+}
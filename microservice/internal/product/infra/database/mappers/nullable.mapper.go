@@ -0,0 +1,16 @@
+package mappers
+
+// A coluna external_key é opcional e única, por isso vazio vira NULL
+func toNullableString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func fromNullableString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -3,16 +3,24 @@ package mappers
 import (
 	"tech_challenge/internal/product/daos"
 	"tech_challenge/internal/product/infra/database/models"
+	"tech_challenge/internal/shared/pkg/normalizer"
 )
 
 func FromProductDAOToProductModel(product daos.ProductDAO) *models.ProductModel {
 	return &models.ProductModel{
-		ID:          product.ID,
-		CategoryID:  product.CategoryID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		Active:      product.Active,
+		ID:           product.ID,
+		ExternalKey:  toNullableString(product.ExternalKey),
+		CategoryID:   product.CategoryID,
+		Name:         product.Name,
+		NameKey:      normalizer.NameKey(product.Name),
+		Description:  product.Description,
+		Price:        product.Price,
+		Active:       product.Active,
+		Availability: (*models.AvailabilityModel)(product.Availability),
+		Nutrition:    (*models.NutritionModel)(product.Nutrition),
+		Allergens:    models.AllergensModel(product.Allergens),
+		Version:      product.Version,
+		UpdatedAt:    product.UpdatedAt,
 	}
 }
 
@@ -26,17 +34,24 @@ func FromProductModelToProductDAO(product *models.ProductModel) (daos.ProductDAO
 			Url:       img.Url,
 			IsDefault: img.IsDefault,
 			CreatedAt: img.CreatedAt,
+			UpdatedAt: img.UpdatedAt,
 		}
 	}
 
 	productDAO := daos.ProductDAO{
-		ID:          product.ID,
-		CategoryID:  product.CategoryID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		Images:      images,
-		Active:      product.Active,
+		ID:           product.ID,
+		ExternalKey:  fromNullableString(product.ExternalKey),
+		CategoryID:   product.CategoryID,
+		Name:         product.Name,
+		Description:  product.Description,
+		Price:        product.Price,
+		Images:       images,
+		Active:       product.Active,
+		Availability: (*daos.AvailabilityDAO)(product.Availability),
+		Nutrition:    (*daos.NutritionFactsDAO)(product.Nutrition),
+		Allergens:    []string(product.Allergens),
+		Version:      product.Version,
+		UpdatedAt:    product.UpdatedAt,
 	}
 	return productDAO, nil
 }
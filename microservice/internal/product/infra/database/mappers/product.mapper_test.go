@@ -25,6 +25,7 @@ func TestFromProductDAOToProductModel(t *testing.T) {
 	require.Equal(t, da.Description, model.Description)
 	require.Equal(t, da.Price, model.Price)
 	require.True(t, model.Active)
+	require.Equal(t, "coca-cola", model.NameKey)
 }
 
 func TestFromProductModelToProductDAO(t *testing.T) {
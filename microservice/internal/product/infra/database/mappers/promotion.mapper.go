@@ -0,0 +1,58 @@
+package mappers
+
+import (
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/infra/database/models"
+)
+
+// FromPromotionDAOToModel monta também as linhas de promotion_products e
+// promotion_categories
+func FromPromotionDAOToModel(promotion daos.PromotionDAO) models.PromotionModel {
+	model := models.PromotionModel{
+		ID:            promotion.ID,
+		Name:          promotion.Name,
+		DiscountType:  promotion.DiscountType,
+		DiscountValue: promotion.DiscountValue,
+		Schedule:      (*models.AvailabilityModel)(promotion.Schedule),
+		Priority:      promotion.Priority,
+		Stackable:     promotion.Stackable,
+		Active:        promotion.Active,
+		Products:      make([]models.PromotionProductModel, 0, len(promotion.ProductIDs)),
+		Categories:    make([]models.PromotionCategoryModel, 0, len(promotion.CategoryIDs)),
+		CreatedAt:     promotion.CreatedAt,
+		UpdatedAt:     promotion.UpdatedAt,
+	}
+
+	for _, productID := range promotion.ProductIDs {
+		model.Products = append(model.Products, models.PromotionProductModel{PromotionID: promotion.ID, ProductID: productID})
+	}
+	for _, categoryID := range promotion.CategoryIDs {
+		model.Categories = append(model.Categories, models.PromotionCategoryModel{PromotionID: promotion.ID, CategoryID: categoryID})
+	}
+
+	return model
+}
+
+func FromPromotionModelToDAO(promotion models.PromotionModel) daos.PromotionDAO {
+	dao := daos.PromotionDAO{
+		ID:            promotion.ID,
+		Name:          promotion.Name,
+		DiscountType:  promotion.DiscountType,
+		DiscountValue: promotion.DiscountValue,
+		Schedule:      (*daos.AvailabilityDAO)(promotion.Schedule),
+		Priority:      promotion.Priority,
+		Stackable:     promotion.Stackable,
+		Active:        promotion.Active,
+		CreatedAt:     promotion.CreatedAt,
+		UpdatedAt:     promotion.UpdatedAt,
+	}
+
+	for _, product := range promotion.Products {
+		dao.ProductIDs = append(dao.ProductIDs, product.ProductID)
+	}
+	for _, category := range promotion.Categories {
+		dao.CategoryIDs = append(dao.CategoryIDs, category.CategoryID)
+	}
+
+	return dao
+}
@@ -0,0 +1,30 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+)
+
+func TestPromotionMapper_RoundTrip(t *testing.T) {
+	dao := daos.PromotionDAO{
+		ID:            "promo",
+		Name:          "Terça das sobremesas",
+		DiscountType:  "percentage",
+		DiscountValue: 20,
+		ProductIDs:    []string{"p1"},
+		CategoryIDs:   []string{"c1", "c2"},
+		Schedule:      &daos.AvailabilityDAO{StartDate: "2025-01-01"},
+		Priority:      10,
+		Stackable:     true,
+		Active:        true,
+	}
+
+	model := FromPromotionDAOToModel(dao)
+	require.Equal(t, "promo", model.Products[0].PromotionID)
+	require.Equal(t, "c2", model.Categories[1].CategoryID)
+
+	require.Equal(t, dao, FromPromotionModelToDAO(model))
+}
@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/infra/database/models"
+)
+
+func FromProductStockDAOToModel(stock daos.ProductStockDAO) models.ProductStockModel {
+	return models.ProductStockModel{
+		ProductID: stock.ProductID,
+		Tracked:   stock.Tracked,
+		Quantity:  stock.Quantity,
+		SoldOut:   stock.SoldOut,
+		UpdatedAt: stock.UpdatedAt,
+	}
+}
+
+func FromProductStockModelToDAO(stock models.ProductStockModel) daos.ProductStockDAO {
+	return daos.ProductStockDAO{
+		ProductID: stock.ProductID,
+		Tracked:   stock.Tracked,
+		Quantity:  stock.Quantity,
+		SoldOut:   stock.SoldOut,
+		UpdatedAt: stock.UpdatedAt,
+	}
+}
+
+func FromStockReservationDAOToModel(reservation daos.StockReservationDAO) models.StockReservationModel {
+	return models.StockReservationModel{
+		ID:        reservation.ID,
+		Items:     models.StockReservationItemsModel(reservation.Items),
+		Status:    reservation.Status,
+		ExpiresAt: reservation.ExpiresAt,
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+}
+
+func FromStockReservationModelToDAO(reservation models.StockReservationModel) daos.StockReservationDAO {
+	return daos.StockReservationDAO{
+		ID:        reservation.ID,
+		Items:     []daos.StockReservationItemDAO(reservation.Items),
+		Status:    reservation.Status,
+		ExpiresAt: reservation.ExpiresAt,
+		CreatedAt: reservation.CreatedAt,
+		UpdatedAt: reservation.UpdatedAt,
+	}
+}
@@ -0,0 +1,64 @@
+package mappers
+
+import (
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/infra/database/models"
+)
+
+func FromStoreDAOToModel(store daos.StoreDAO) models.StoreModel {
+	return models.StoreModel{
+		ID:        store.ID,
+		Name:      store.Name,
+		CreatedAt: store.CreatedAt,
+		UpdatedAt: store.UpdatedAt,
+	}
+}
+
+func FromStoreModelToDAO(store models.StoreModel) daos.StoreDAO {
+	return daos.StoreDAO{
+		ID:        store.ID,
+		Name:      store.Name,
+		CreatedAt: store.CreatedAt,
+		UpdatedAt: store.UpdatedAt,
+	}
+}
+
+func FromProductStoreOverrideDAOToModel(override daos.ProductStoreOverrideDAO) models.StoreProductOverrideModel {
+	return models.StoreProductOverrideModel{
+		StoreID:      override.StoreID,
+		ProductID:    override.ProductID,
+		Price:        override.Price,
+		Active:       override.Active,
+		Availability: (*models.AvailabilityModel)(override.Availability),
+		UpdatedAt:    override.UpdatedAt,
+	}
+}
+
+func FromProductStoreOverrideModelToDAO(override models.StoreProductOverrideModel) daos.ProductStoreOverrideDAO {
+	return daos.ProductStoreOverrideDAO{
+		StoreID:      override.StoreID,
+		ProductID:    override.ProductID,
+		Price:        override.Price,
+		Active:       override.Active,
+		Availability: (*daos.AvailabilityDAO)(override.Availability),
+		UpdatedAt:    override.UpdatedAt,
+	}
+}
+
+func FromCategoryStoreOverrideDAOToModel(override daos.CategoryStoreOverrideDAO) models.StoreCategoryOverrideModel {
+	return models.StoreCategoryOverrideModel{
+		StoreID:    override.StoreID,
+		CategoryID: override.CategoryID,
+		Visible:    override.Visible,
+		UpdatedAt:  override.UpdatedAt,
+	}
+}
+
+func FromCategoryStoreOverrideModelToDAO(override models.StoreCategoryOverrideModel) daos.CategoryStoreOverrideDAO {
+	return daos.CategoryStoreOverrideDAO{
+		StoreID:    override.StoreID,
+		CategoryID: override.CategoryID,
+		Visible:    override.Visible,
+		UpdatedAt:  override.UpdatedAt,
+	}
+}
@@ -0,0 +1,17 @@
+package mappers
+
+import (
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/infra/database/models"
+)
+
+func FromTranslationRowToDAO(target string, row models.TranslationRow) daos.TranslationDAO {
+	return daos.TranslationDAO{
+		Target:      target,
+		EntityID:    row.EntityID,
+		Locale:      row.Locale,
+		Name:        row.Name,
+		Description: row.Description,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"tech_challenge/internal/product/daos"
+)
+
+// AvailabilityModel guarda a grade de disponibilidade como jsonb. Implementa
+// Valuer/Scanner em vez de usar o serializer do GORM porque os updates
+// condicionais gravam por map, caminho em que o serializer não é aplicado
+type AvailabilityModel daos.AvailabilityDAO
+
+func (a AvailabilityModel) Value() (driver.Value, error) {
+	encoded, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return string(encoded), nil
+}
+
+func (a *AvailabilityModel) Scan(value any) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*a = AvailabilityModel{}
+		return nil
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("unsupported availability value %T", value)
+	}
+	return json.Unmarshal(raw, a)
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+)
+
+func TestAvailabilityModel_ValueAndScan(t *testing.T) {
+	model := AvailabilityModel{
+		Weekly: []daos.WeeklyAvailabilityDAO{{
+			Weekdays:     []string{"mon", "tue"},
+			TimeRangeDAO: daos.TimeRangeDAO{From: "06:00", To: "10:30"},
+		}},
+		Exceptions: []daos.AvailabilityExceptionDAO{{Date: "2025-12-25"}},
+	}
+
+	value, err := model.Value()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"weekly":[{"weekdays":["mon","tue"],"from":"06:00","to":"10:30"}],"exceptions":[{"date":"2025-12-25"}]}`, value.(string))
+
+	var scanned AvailabilityModel
+	require.NoError(t, scanned.Scan([]byte(value.(string))))
+	require.Equal(t, model, scanned)
+
+	require.NoError(t, scanned.Scan(nil))
+	require.Equal(t, AvailabilityModel{}, scanned)
+	require.Error(t, scanned.Scan(42))
+}
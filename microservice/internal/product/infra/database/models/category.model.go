@@ -1,9 +1,22 @@
 package models
 
+import "time"
+
 type CategoryModel struct {
-	ID     string `gorm:"primaryKey; size:36"`
-	Name   string `gorm:"not null;size:100;"`
-	Active bool   `gorm:"not null;"`
+	ID            string             `gorm:"primaryKey; size:36"`
+	ExternalKey   *string            `gorm:"size:100;uniqueIndex"`
+	ParentID      *string            `gorm:"size:36;index"`
+	Parent        *CategoryModel     `gorm:"foreignKey:ParentID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Name          string             `gorm:"not null;size:100;"`
+	NameKey       string             `gorm:"not null;default:'';size:100;index"`
+	Description   string             `gorm:"not null;default:'';size:255"`
+	Position      int                `gorm:"not null;default:0;index"`
+	ImageFileName *string            `gorm:"size:255"`
+	ImageUrl      *string            `gorm:"size:2048"`
+	Active        bool               `gorm:"not null;"`
+	Availability  *AvailabilityModel `gorm:"type:jsonb"`
+	Version       int64              `gorm:"not null;default:1"`
+	UpdatedAt     time.Time          `gorm:"autoUpdateTime"`
 }
 
 func (CategoryModel) TableName() string {
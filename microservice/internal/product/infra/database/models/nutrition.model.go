@@ -0,0 +1,74 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"tech_challenge/internal/product/daos"
+)
+
+// NutritionModel e AllergensModel são gravados como jsonb pelo mesmo motivo
+// de AvailabilityModel: os updates condicionais gravam por map
+type NutritionModel daos.NutritionFactsDAO
+
+func (n NutritionModel) Value() (driver.Value, error) {
+	encoded, err := json.Marshal(n)
+	if err != nil {
+		return nil, err
+	}
+	return string(encoded), nil
+}
+
+func (n *NutritionModel) Scan(value any) error {
+	raw, err := jsonbBytes(value)
+	if err != nil || raw == nil {
+		*n = NutritionModel{}
+		return err
+	}
+	return json.Unmarshal(raw, n)
+}
+
+// AllergensModel nil é gravado como NULL (alérgenos não declarados), e a
+// lista vazia como [] (declarou não ter nenhum)
+type AllergensModel []string
+
+func (a AllergensModel) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal([]string(a))
+	if err != nil {
+		return nil, err
+	}
+	return string(encoded), nil
+}
+
+func (a *AllergensModel) Scan(value any) error {
+	raw, err := jsonbBytes(value)
+	if err != nil || raw == nil {
+		*a = nil
+		return err
+	}
+
+	allergens := []string{}
+	if err := json.Unmarshal(raw, &allergens); err != nil {
+		return err
+	}
+	*a = allergens
+	return nil
+}
+
+func jsonbBytes(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return nil, fmt.Errorf("unsupported jsonb value %T", value)
+	}
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNutritionModel_ValueAndScan(t *testing.T) {
+	model := NutritionModel{ServingSize: 180, ServingUnit: "g", EnergyKcal: 420, Proteins: 22, Sodium: 780}
+
+	value, err := model.Value()
+	require.NoError(t, err)
+	require.Contains(t, value.(string), `"sodium_mg":780`)
+
+	var scanned NutritionModel
+	require.NoError(t, scanned.Scan([]byte(value.(string))))
+	require.Equal(t, model, scanned)
+
+	require.NoError(t, scanned.Scan(nil))
+	require.Equal(t, NutritionModel{}, scanned)
+	require.Error(t, scanned.Scan(42))
+}
+
+func TestAllergensModel_KeepsUndeclaredApartFromEmpty(t *testing.T) {
+	value, err := AllergensModel(nil).Value()
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	value, err = AllergensModel{}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "[]", value)
+
+	var scanned AllergensModel
+	require.NoError(t, scanned.Scan("[]"))
+	require.NotNil(t, scanned)
+	require.Empty(t, scanned)
+
+	require.NoError(t, scanned.Scan([]byte(`["gluten","milk"]`)))
+	require.Equal(t, AllergensModel{"gluten", "milk"}, scanned)
+
+	require.NoError(t, scanned.Scan(nil))
+	require.Nil(t, scanned)
+}
@@ -5,15 +5,22 @@ import (
 )
 
 type ProductModel struct {
-	ID          string              `gorm:"primaryKey; size:36"`
-	CategoryID  string              `gorm:"not null;size:100;index"`
-	Category    CategoryModel       `gorm:"foreignKey:CategoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
-	Name        string              `gorm:"not null;size:100;"`
-	Description string              `gorm:"not null;"`
-	Price       float64             `gorm:"not null; decimal(10,4);"`
-	Active      bool                `gorm:"not null;"`
-	CreatedAt   time.Time           `gorm:"autoCreateTime"`
-	Images      []ProductImageModel `gorm:"foreignKey:ProductID;constraint:OnDelete:CASCADE,OnUpdate:CASCADE"`
+	ID           string              `gorm:"primaryKey; size:36"`
+	ExternalKey  *string             `gorm:"size:100;uniqueIndex"`
+	CategoryID   string              `gorm:"not null;size:100;index"`
+	Category     CategoryModel       `gorm:"foreignKey:CategoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Name         string              `gorm:"not null;size:100;"`
+	NameKey      string              `gorm:"not null;default:'';size:100;index"`
+	Description  string              `gorm:"not null;"`
+	Price        float64             `gorm:"not null; decimal(10,4);"`
+	Active       bool                `gorm:"not null;"`
+	Availability *AvailabilityModel  `gorm:"type:jsonb"`
+	Nutrition    *NutritionModel     `gorm:"type:jsonb"`
+	Allergens    AllergensModel      `gorm:"type:jsonb"`
+	Version      int64               `gorm:"not null;default:1"`
+	CreatedAt    time.Time           `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time           `gorm:"autoUpdateTime"`
+	Images       []ProductImageModel `gorm:"foreignKey:ProductID;constraint:OnDelete:CASCADE,OnUpdate:CASCADE"`
 }
 
 func (ProductModel) TableName() string {
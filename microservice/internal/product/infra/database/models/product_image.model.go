@@ -13,6 +13,7 @@ type ProductImageModel struct {
 	Url       string       `gorm:"not null;size:2048"`
 	IsDefault bool         `gorm:"not null"`
 	CreatedAt time.Time    `gorm:"autoCreateTime"`
+	UpdatedAt time.Time    `gorm:"autoUpdateTime"`
 }
 
 func (ProductImageModel) TableName() string {
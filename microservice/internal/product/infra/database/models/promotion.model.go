@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// PromotionModel guarda a regra de desconto; os produtos e as categorias que
+// ela alcança ficam em promotion_products e promotion_categories
+type PromotionModel struct {
+	ID            string                   `gorm:"primaryKey;size:36"`
+	Name          string                   `gorm:"not null;size:100"`
+	DiscountType  string                   `gorm:"not null;size:20"`
+	DiscountValue float64                  `gorm:"not null;type:decimal(10,4)"`
+	Schedule      *AvailabilityModel       `gorm:"type:jsonb"`
+	Priority      int                      `gorm:"not null;default:0"`
+	Stackable     bool                     `gorm:"not null;default:false"`
+	Active        bool                     `gorm:"not null;default:true"`
+	Products      []PromotionProductModel  `gorm:"foreignKey:PromotionID;constraint:OnDelete:CASCADE,OnUpdate:CASCADE"`
+	Categories    []PromotionCategoryModel `gorm:"foreignKey:PromotionID;constraint:OnDelete:CASCADE,OnUpdate:CASCADE"`
+	CreatedAt     time.Time                `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time                `gorm:"autoUpdateTime"`
+}
+
+func (PromotionModel) TableName() string {
+	return "promotions"
+}
+
+// PromotionProductModel e PromotionCategoryModel somem junto com a promoção ou
+// com o produto/categoria alvo
+type PromotionProductModel struct {
+	PromotionID string       `gorm:"primaryKey;size:36"`
+	ProductID   string       `gorm:"primaryKey;size:36;index"`
+	Product     ProductModel `gorm:"foreignKey:ProductID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (PromotionProductModel) TableName() string {
+	return "promotion_products"
+}
+
+type PromotionCategoryModel struct {
+	PromotionID string        `gorm:"primaryKey;size:36"`
+	CategoryID  string        `gorm:"primaryKey;size:36;index"`
+	Category    CategoryModel `gorm:"foreignKey:CategoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (PromotionCategoryModel) TableName() string {
+	return "promotion_categories"
+}
@@ -0,0 +1,13 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromotionModels_TableName(t *testing.T) {
+	require.Equal(t, "promotions", PromotionModel{}.TableName())
+	require.Equal(t, "promotion_products", PromotionProductModel{}.TableName())
+	require.Equal(t, "promotion_categories", PromotionCategoryModel{}.TableName())
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"tech_challenge/internal/product/daos"
+)
+
+// ProductStockModel fica numa tabela própria para que as baixas de estoque
+// não avancem a versão do produto nem invalidem o cache do catálogo
+type ProductStockModel struct {
+	ProductID string       `gorm:"primaryKey;size:36"`
+	Product   ProductModel `gorm:"foreignKey:ProductID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Tracked   bool         `gorm:"not null;default:false"`
+	Quantity  int          `gorm:"not null;default:0;check:chk_product_stocks_quantity,quantity >= 0"`
+	SoldOut   bool         `gorm:"not null;default:false"`
+	UpdatedAt time.Time    `gorm:"autoUpdateTime"`
+}
+
+func (ProductStockModel) TableName() string {
+	return "product_stocks"
+}
+
+type StockReservationModel struct {
+	ID        string                     `gorm:"primaryKey;size:36"`
+	Items     StockReservationItemsModel `gorm:"type:jsonb;not null"`
+	Status    string                     `gorm:"not null;size:20;index:idx_stock_reservations_status_expires_at,priority:1"`
+	ExpiresAt time.Time                  `gorm:"not null;index:idx_stock_reservations_status_expires_at,priority:2"`
+	CreatedAt time.Time                  `gorm:"autoCreateTime"`
+	UpdatedAt time.Time                  `gorm:"autoUpdateTime"`
+}
+
+func (StockReservationModel) TableName() string {
+	return "stock_reservations"
+}
+
+// StockReservationItemsModel guarda os itens da reserva como jsonb; eles só
+// são lidos junto com a reserva
+type StockReservationItemsModel []daos.StockReservationItemDAO
+
+func (i StockReservationItemsModel) Value() (driver.Value, error) {
+	if i == nil {
+		i = StockReservationItemsModel{}
+	}
+	encoded, err := json.Marshal([]daos.StockReservationItemDAO(i))
+	if err != nil {
+		return nil, err
+	}
+	return string(encoded), nil
+}
+
+func (i *StockReservationItemsModel) Scan(value any) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*i = nil
+		return nil
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("unsupported reservation items value %T", value)
+	}
+	return json.Unmarshal(raw, (*[]daos.StockReservationItemDAO)(i))
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStockModels_TableName(t *testing.T) {
+	require.Equal(t, "product_stocks", ProductStockModel{}.TableName())
+	require.Equal(t, "stock_reservations", StockReservationModel{}.TableName())
+}
+
+func TestStockReservationItemsModel_ValueAndScan(t *testing.T) {
+	items := StockReservationItemsModel{{ProductID: "p1", Quantity: 2}}
+
+	value, err := items.Value()
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"product_id":"p1","quantity":2}]`, value.(string))
+
+	var scanned StockReservationItemsModel
+	require.NoError(t, scanned.Scan(value))
+	require.Equal(t, items, scanned)
+
+	empty, err := StockReservationItemsModel(nil).Value()
+	require.NoError(t, err)
+	require.Equal(t, "[]", empty)
+
+	require.NoError(t, scanned.Scan(nil))
+	require.Nil(t, scanned)
+	require.Error(t, scanned.Scan(42))
+}
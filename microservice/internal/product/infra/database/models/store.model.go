@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+type StoreModel struct {
+	ID        string    `gorm:"primaryKey;size:36"`
+	Name      string    `gorm:"not null;size:100"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (StoreModel) TableName() string {
+	return "stores"
+}
+
+// StoreProductOverrideModel guarda os ajustes de um produto numa loja; colunas
+// nulas seguem o cadastro do produto. Os ajustes somem junto com a loja ou
+// com o produto
+type StoreProductOverrideModel struct {
+	StoreID      string       `gorm:"primaryKey;size:36"`
+	Store        StoreModel   `gorm:"foreignKey:StoreID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ProductID    string       `gorm:"primaryKey;size:36;index"`
+	Product      ProductModel `gorm:"foreignKey:ProductID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Price        *float64     `gorm:"type:decimal(10,4)"`
+	Active       *bool
+	Availability *AvailabilityModel `gorm:"type:jsonb"`
+	UpdatedAt    time.Time          `gorm:"autoUpdateTime"`
+}
+
+func (StoreProductOverrideModel) TableName() string {
+	return "store_product_overrides"
+}
+
+type StoreCategoryOverrideModel struct {
+	StoreID    string        `gorm:"primaryKey;size:36"`
+	Store      StoreModel    `gorm:"foreignKey:StoreID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CategoryID string        `gorm:"primaryKey;size:36;index"`
+	Category   CategoryModel `gorm:"foreignKey:CategoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Visible    *bool
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+}
+
+func (StoreCategoryOverrideModel) TableName() string {
+	return "store_category_overrides"
+}
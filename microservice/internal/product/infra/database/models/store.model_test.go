@@ -0,0 +1,13 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreModels_TableName(t *testing.T) {
+	require.Equal(t, "stores", StoreModel{}.TableName())
+	require.Equal(t, "store_product_overrides", StoreProductOverrideModel{}.TableName())
+	require.Equal(t, "store_category_overrides", StoreCategoryOverrideModel{}.TableName())
+}
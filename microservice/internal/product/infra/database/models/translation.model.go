@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// ProductTranslationModel guarda nome e descrição do produto em outro idioma;
+// a tradução some junto com o produto
+type ProductTranslationModel struct {
+	ProductID   string       `gorm:"primaryKey;size:36"`
+	Product     ProductModel `gorm:"foreignKey:ProductID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Locale      string       `gorm:"primaryKey;size:10;index"`
+	Name        string       `gorm:"not null;size:100"`
+	Description string       `gorm:"not null;default:''"`
+	UpdatedAt   time.Time    `gorm:"autoUpdateTime"`
+}
+
+func (ProductTranslationModel) TableName() string {
+	return "product_translations"
+}
+
+type CategoryTranslationModel struct {
+	CategoryID  string        `gorm:"primaryKey;size:36"`
+	Category    CategoryModel `gorm:"foreignKey:CategoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Locale      string        `gorm:"primaryKey;size:10;index"`
+	Name        string        `gorm:"not null;size:100"`
+	Description string        `gorm:"not null;default:'';size:255"`
+	UpdatedAt   time.Time     `gorm:"autoUpdateTime"`
+}
+
+func (CategoryTranslationModel) TableName() string {
+	return "category_translations"
+}
+
+// TranslationRow é a leitura comum às duas tabelas, com a chave estrangeira
+// renomeada para entity_id
+type TranslationRow struct {
+	EntityID    string
+	Locale      string
+	Name        string
+	Description string
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,102 @@
+package fixtures
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"tech_challenge/internal/product/application/dtos"
+)
+
+type CategoryFixture struct {
+	Key    string `yaml:"key"`
+	ID     string `yaml:"id"`
+	Name   string `yaml:"name"`
+	Active *bool  `yaml:"active"`
+}
+
+type ProductFixture struct {
+	Key         string   `yaml:"key"`
+	ID          string   `yaml:"id"`
+	Category    string   `yaml:"category"`
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description"`
+	Price       float64  `yaml:"price"`
+	Active      *bool    `yaml:"active"`
+	Images      []string `yaml:"images"`
+}
+
+type CatalogFixture struct {
+	ImagesDir  string            `yaml:"images_dir"`
+	Categories []CategoryFixture `yaml:"categories"`
+	Products   []ProductFixture  `yaml:"products"`
+}
+
+// LoadCatalogFixture lê um arquivo YAML ou JSON (JSON também é YAML válido)
+// e carrega as imagens a partir de images_dir, relativo ao arquivo.
+func LoadCatalogFixture(path string) (dtos.SeedCatalogDTO, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return dtos.SeedCatalogDTO{}, err
+	}
+
+	var fixture CatalogFixture
+	if err := yaml.Unmarshal(content, &fixture); err != nil {
+		return dtos.SeedCatalogDTO{}, fmt.Errorf("invalid fixture file %s: %w", path, err)
+	}
+
+	imagesDir := filepath.Join(filepath.Dir(path), fixture.ImagesDir)
+
+	return fixture.ToSeedDTO(imagesDir)
+}
+
+func (f *CatalogFixture) ToSeedDTO(imagesDir string) (dtos.SeedCatalogDTO, error) {
+	seedDTO := dtos.SeedCatalogDTO{
+		Categories: make([]dtos.SeedCategoryDTO, len(f.Categories)),
+		Products:   make([]dtos.SeedProductDTO, len(f.Products)),
+	}
+
+	for i, category := range f.Categories {
+		seedDTO.Categories[i] = dtos.SeedCategoryDTO{
+			Key:    category.Key,
+			ID:     category.ID,
+			Name:   category.Name,
+			Active: isActive(category.Active),
+		}
+	}
+
+	for i, product := range f.Products {
+		images := make([]dtos.SeedImageDTO, len(product.Images))
+		for j, fileName := range product.Images {
+			fileContent, err := os.ReadFile(filepath.Join(imagesDir, fileName))
+			if err != nil {
+				return dtos.SeedCatalogDTO{}, fmt.Errorf("failed to read image of product %q: %w", product.Name, err)
+			}
+
+			images[j] = dtos.SeedImageDTO{
+				FileName:    filepath.Base(fileName),
+				FileContent: fileContent,
+			}
+		}
+
+		seedDTO.Products[i] = dtos.SeedProductDTO{
+			Key:         product.Key,
+			ID:          product.ID,
+			CategoryKey: product.Category,
+			Name:        product.Name,
+			Description: product.Description,
+			Price:       product.Price,
+			Active:      isActive(product.Active),
+			Images:      images,
+		}
+	}
+
+	return seedDTO, nil
+}
+
+// Itens sem o campo active são considerados ativos
+func isActive(active *bool) bool {
+	return active == nil || *active
+}
@@ -0,0 +1,79 @@
+package fixtures
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCatalogFixture_DemoCatalog(t *testing.T) {
+	seedDTO, err := LoadCatalogFixture("../../../../fixtures/catalog.yaml")
+	require.NoError(t, err)
+	require.NotEmpty(t, seedDTO.Categories)
+	require.NotEmpty(t, seedDTO.Products)
+
+	categoryKeys := map[string]bool{}
+	for _, category := range seedDTO.Categories {
+		require.NotEmpty(t, category.Key)
+		categoryKeys[category.Key] = true
+	}
+
+	productKeys := map[string]bool{}
+	for _, product := range seedDTO.Products {
+		require.NotEmpty(t, product.Key)
+		require.False(t, productKeys[product.Key], "duplicated key %s", product.Key)
+		productKeys[product.Key] = true
+		require.True(t, categoryKeys[product.CategoryKey], "unknown category %s", product.CategoryKey)
+		require.True(t, product.Active)
+		for _, image := range product.Images {
+			require.NotEmpty(t, image.FileContent)
+		}
+	}
+
+	require.Equal(t, "X-Salada", seedDTO.Products[0].Name)
+	require.Equal(t, "76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae", seedDTO.Products[0].ID)
+	require.Equal(t, 20.5, seedDTO.Products[0].Price)
+}
+
+func TestLoadCatalogFixture_JSON(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cola.png"), []byte("png"), 0o644))
+	content := `{
+		"categories": [{"key": "bebidas", "name": "Bebidas", "active": false}],
+		"products": [{"key": "cola", "category": "bebidas", "name": "Coca-Cola", "price": 5.99, "images": ["cola.png"]}]
+	}`
+	path := filepath.Join(dir, "catalog.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	seedDTO, err := LoadCatalogFixture(path)
+	require.NoError(t, err)
+	require.False(t, seedDTO.Categories[0].Active)
+	require.True(t, seedDTO.Products[0].Active)
+	require.Equal(t, "bebidas", seedDTO.Products[0].CategoryKey)
+	require.Equal(t, "cola.png", seedDTO.Products[0].Images[0].FileName)
+	require.Equal(t, []byte("png"), seedDTO.Products[0].Images[0].FileContent)
+}
+
+func TestLoadCatalogFixture_MissingImage(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "catalog.yaml")
+	content := "products:\n  - key: cola\n    category: bebidas\n    name: Coca-Cola\n    images: [missing.png]\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	_, err := LoadCatalogFixture(path)
+	require.ErrorContains(t, err, "Coca-Cola")
+}
+
+func TestLoadCatalogFixture_InvalidFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "catalog.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("categories: [unclosed"), 0o644))
+
+	_, err := LoadCatalogFixture(path)
+	require.Error(t, err)
+
+	_, err = LoadCatalogFixture(filepath.Join(dir, "missing.yaml"))
+	require.Error(t, err)
+}
@@ -0,0 +1,147 @@
+package grpc_errors
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/infra/api/http_errors"
+	"tech_challenge/internal/shared/infra/api/problems"
+)
+
+// ErrorDomain identifica este serviço no ErrorInfo dos status de erro
+const ErrorDomain = "catalog.tech_challenge"
+
+// HandleDomainErrors converte as exceções do domínio no status gRPC
+// equivalente. O reason do ErrorInfo é o mesmo código da API HTTP, para que os
+// clientes tratem os erros pelas mesmas chaves nos dois protocolos
+func HandleDomainErrors(err error, method string) (*status.Status, bool) {
+	switch e := err.(type) {
+	case *exceptions.ProductNotFoundException:
+		return domainStatus(codes.NotFound, http_errors.CodeProductNotFound, e), true
+	case *exceptions.ProductAlreadyExistsException:
+		return domainStatus(codes.AlreadyExists, http_errors.CodeProductAlreadyExists, e), true
+	case *exceptions.InvalidProductDataException:
+		return domainStatus(codes.InvalidArgument, http_errors.CodeInvalidProductData, e), true
+	case *exceptions.InvalidProductImageException:
+		return domainStatus(codes.InvalidArgument, http_errors.CodeInvalidProductImage, e), true
+	case *exceptions.ImageNotFoundException:
+		return domainStatus(codes.NotFound, http_errors.CodeImageNotFound, e), true
+	case *exceptions.ProductImagesNotFoundException:
+		return domainStatus(codes.NotFound, http_errors.CodeProductImagesNotFound, e), true
+	case *exceptions.ProductImageCannotBeEmptyException:
+		return domainStatus(codes.FailedPrecondition, http_errors.CodeProductImageRequired, e), true
+	case *exceptions.CategoryNotFoundException:
+		return domainStatus(codes.NotFound, http_errors.CodeCategoryNotFound, e), true
+	case *exceptions.CategoryAlreadyExistsException:
+		return domainStatus(codes.AlreadyExists, http_errors.CodeCategoryAlreadyExists, e), true
+	case *exceptions.InvalidCategoryDataException:
+		return domainStatus(codes.InvalidArgument, http_errors.CodeInvalidCategoryData, e), true
+	case *exceptions.CategoryHasProductsException:
+		return domainStatus(codes.FailedPrecondition, http_errors.CodeCategoryHasProducts, e), true
+	case *exceptions.CategoryHasChildrenException:
+		return domainStatus(codes.FailedPrecondition, http_errors.CodeCategoryHasChildren, e), true
+	case *exceptions.InvalidAvailabilityException:
+		return domainStatus(codes.InvalidArgument, http_errors.CodeInvalidAvailability, e), true
+	case *exceptions.InvalidStockDataException:
+		return domainStatus(codes.InvalidArgument, http_errors.CodeInvalidStockData, e), true
+	case *exceptions.InsufficientStockException:
+		return domainStatus(codes.FailedPrecondition, http_errors.CodeInsufficientStock, e), true
+	case *exceptions.ProductSoldOutException:
+		return domainStatus(codes.FailedPrecondition, http_errors.CodeProductSoldOut, e), true
+	case *exceptions.StockReservationNotFoundException:
+		return domainStatus(codes.NotFound, http_errors.CodeStockReservationNotFound, e), true
+	case *exceptions.StockReservationExpiredException:
+		return domainStatus(codes.FailedPrecondition, http_errors.CodeStockReservationExpired, e), true
+	case *exceptions.InvalidStockReservationStateException:
+		return domainStatus(codes.FailedPrecondition, http_errors.CodeInvalidStockReservationState, e), true
+	case *exceptions.InvalidNutritionFactsException:
+		return domainStatus(codes.InvalidArgument, http_errors.CodeInvalidNutritionFacts, e), true
+	case *exceptions.InvalidAllergenException:
+		return domainStatus(codes.InvalidArgument, http_errors.CodeInvalidAllergen, e), true
+	case *exceptions.InvalidLocaleException:
+		return domainStatus(codes.InvalidArgument, http_errors.CodeInvalidLocale, e), true
+	case *exceptions.InvalidTranslationException:
+		return domainStatus(codes.InvalidArgument, http_errors.CodeInvalidTranslation, e), true
+	case *exceptions.TranslationNotFoundException:
+		return domainStatus(codes.NotFound, http_errors.CodeTranslationNotFound, e), true
+	case *exceptions.StoreNotFoundException:
+		return domainStatus(codes.NotFound, http_errors.CodeStoreNotFound, e), true
+	case *exceptions.InvalidStoreDataException:
+		return domainStatus(codes.InvalidArgument, http_errors.CodeInvalidStoreData, e), true
+	case *exceptions.InvalidStoreOverrideException:
+		return domainStatus(codes.InvalidArgument, http_errors.CodeInvalidStoreOverride, e), true
+	case *exceptions.PromotionNotFoundException:
+		return domainStatus(codes.NotFound, http_errors.CodePromotionNotFound, e), true
+	case *exceptions.InvalidPromotionException:
+		return domainStatus(codes.InvalidArgument, http_errors.CodeInvalidPromotion, e), true
+	case *exceptions.RecordNotFoundException:
+		return domainStatus(codes.NotFound, http_errors.CodeRecordNotFound, e), true
+	case *exceptions.RecordConflictException:
+		return domainStatus(codes.AlreadyExists, http_errors.CodeRecordConflict, e), true
+	case *exceptions.ForeignKeyViolationException:
+		return domainStatus(codes.FailedPrecondition, http_errors.CodeForeignKeyViolation, e), true
+	case *exceptions.VersionConflictException:
+		return domainStatus(codes.Aborted, http_errors.CodePreconditionFailed, e), true
+	case *exceptions.RepositoryTimeoutException:
+		return unavailableStatus(http_errors.CodeDatabaseTimeout, e, e.RetryAfterSeconds(), method), true
+	case *exceptions.RepositoryUnavailableException:
+		return unavailableStatus(http_errors.CodeDatabaseUnavailable, e, e.RetryAfterSeconds(), method), true
+	case *exceptions.DeleteImagesStorageException:
+		return domainStatus(codes.Internal, http_errors.CodeStorageDeleteFailed, e), true
+	case *exceptions.BucketNotFoundException:
+		// A mensagem padrão desta exceção vem em português
+		return withDetails(status.New(codes.Internal, "S3 bucket does not exist or is invalid"), errorInfo(http_errors.CodeBucketNotFound)), true
+	}
+
+	return nil, false
+}
+
+// InvalidArgument é o erro de um campo inválido da requisição, com o mesmo
+// código das validações de parâmetros da API HTTP
+func InvalidArgument(field, description string) error {
+	return withDetails(
+		status.New(codes.InvalidArgument, description),
+		errorInfo(problems.CodeInvalidParameter),
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}}},
+	).Err()
+}
+
+func domainStatus(code codes.Code, reason string, err error) *status.Status {
+	return withDetails(status.New(code, err.Error()), errorInfo(reason))
+}
+
+// Falhas de infraestrutura são temporárias: o cliente recebe Unavailable e a
+// sugestão de quando tentar de novo no RetryInfo. O erro original do banco só
+// vai para o log
+func unavailableStatus(reason string, err error, retryAfter int, method string) *status.Status {
+	if cause := errors.Unwrap(err); cause != nil {
+		log.Printf("infrastructure failure on %s: %v", method, cause)
+	}
+
+	return withDetails(
+		status.New(codes.Unavailable, err.Error()),
+		errorInfo(reason),
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Duration(retryAfter) * time.Second)},
+	)
+}
+
+func errorInfo(reason string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{Reason: reason, Domain: ErrorDomain}
+}
+
+// withDetails só falha com detalhes que não são mensagens protobuf, o que não
+// acontece aqui; nesse caso o status segue sem os detalhes
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) *status.Status {
+	if detailed, err := st.WithDetails(details...); err == nil {
+		return detailed
+	}
+	return st
+}
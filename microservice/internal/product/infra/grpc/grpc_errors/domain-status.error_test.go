@@ -0,0 +1,119 @@
+package grpc_errors
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/infra/api/http_errors"
+	"tech_challenge/internal/shared/infra/api/problems"
+)
+
+func errorInfoOf(t *testing.T, st *status.Status) *errdetails.ErrorInfo {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	t.Fatalf("status %v has no ErrorInfo", st)
+	return nil
+}
+
+func TestHandleDomainErrors(t *testing.T) {
+	cases := []struct {
+		err          error
+		expectedCode codes.Code
+		reason       string
+	}{
+		{&exceptions.ProductNotFoundException{}, codes.NotFound, http_errors.CodeProductNotFound},
+		{&exceptions.InvalidProductDataException{}, codes.InvalidArgument, http_errors.CodeInvalidProductData},
+		{&exceptions.InvalidCategoryDataException{}, codes.InvalidArgument, http_errors.CodeInvalidCategoryData},
+		{&exceptions.CategoryAlreadyExistsException{}, codes.AlreadyExists, http_errors.CodeCategoryAlreadyExists},
+		{&exceptions.CategoryNotFoundException{}, codes.NotFound, http_errors.CodeCategoryNotFound},
+		{&exceptions.InvalidProductImageException{}, codes.InvalidArgument, http_errors.CodeInvalidProductImage},
+		{&exceptions.ImageNotFoundException{}, codes.NotFound, http_errors.CodeImageNotFound},
+		{&exceptions.ProductImagesNotFoundException{}, codes.NotFound, http_errors.CodeProductImagesNotFound},
+		{&exceptions.CategoryHasProductsException{}, codes.FailedPrecondition, http_errors.CodeCategoryHasProducts},
+		{&exceptions.CategoryHasChildrenException{}, codes.FailedPrecondition, http_errors.CodeCategoryHasChildren},
+		{&exceptions.InvalidAvailabilityException{}, codes.InvalidArgument, http_errors.CodeInvalidAvailability},
+		{&exceptions.InvalidStockDataException{}, codes.InvalidArgument, http_errors.CodeInvalidStockData},
+		{&exceptions.InsufficientStockException{}, codes.FailedPrecondition, http_errors.CodeInsufficientStock},
+		{&exceptions.ProductSoldOutException{}, codes.FailedPrecondition, http_errors.CodeProductSoldOut},
+		{&exceptions.StockReservationNotFoundException{}, codes.NotFound, http_errors.CodeStockReservationNotFound},
+		{&exceptions.StockReservationExpiredException{}, codes.FailedPrecondition, http_errors.CodeStockReservationExpired},
+		{&exceptions.InvalidStockReservationStateException{}, codes.FailedPrecondition, http_errors.CodeInvalidStockReservationState},
+		{&exceptions.InvalidNutritionFactsException{}, codes.InvalidArgument, http_errors.CodeInvalidNutritionFacts},
+		{&exceptions.InvalidAllergenException{}, codes.InvalidArgument, http_errors.CodeInvalidAllergen},
+		{&exceptions.InvalidLocaleException{}, codes.InvalidArgument, http_errors.CodeInvalidLocale},
+		{&exceptions.InvalidTranslationException{}, codes.InvalidArgument, http_errors.CodeInvalidTranslation},
+		{&exceptions.TranslationNotFoundException{}, codes.NotFound, http_errors.CodeTranslationNotFound},
+		{&exceptions.StoreNotFoundException{}, codes.NotFound, http_errors.CodeStoreNotFound},
+		{&exceptions.InvalidStoreDataException{}, codes.InvalidArgument, http_errors.CodeInvalidStoreData},
+		{&exceptions.InvalidStoreOverrideException{}, codes.InvalidArgument, http_errors.CodeInvalidStoreOverride},
+		{&exceptions.PromotionNotFoundException{}, codes.NotFound, http_errors.CodePromotionNotFound},
+		{&exceptions.InvalidPromotionException{}, codes.InvalidArgument, http_errors.CodeInvalidPromotion},
+		{&exceptions.ProductAlreadyExistsException{}, codes.AlreadyExists, http_errors.CodeProductAlreadyExists},
+		{&exceptions.ProductImageCannotBeEmptyException{}, codes.FailedPrecondition, http_errors.CodeProductImageRequired},
+		{&exceptions.RecordNotFoundException{}, codes.NotFound, http_errors.CodeRecordNotFound},
+		{&exceptions.RecordConflictException{}, codes.AlreadyExists, http_errors.CodeRecordConflict},
+		{&exceptions.VersionConflictException{}, codes.Aborted, http_errors.CodePreconditionFailed},
+		{&exceptions.ForeignKeyViolationException{}, codes.FailedPrecondition, http_errors.CodeForeignKeyViolation},
+		{&exceptions.RepositoryTimeoutException{}, codes.Unavailable, http_errors.CodeDatabaseTimeout},
+		{&exceptions.RepositoryUnavailableException{}, codes.Unavailable, http_errors.CodeDatabaseUnavailable},
+		{&exceptions.DeleteImagesStorageException{}, codes.Internal, http_errors.CodeStorageDeleteFailed},
+		{&exceptions.BucketNotFoundException{}, codes.Internal, http_errors.CodeBucketNotFound},
+	}
+
+	for _, c := range cases {
+		st, ok := HandleDomainErrors(c.err, "/catalog.v1.ProductService/GetProduct")
+		require.True(t, ok)
+		require.Equal(t, c.expectedCode, st.Code(), c.reason)
+
+		info := errorInfoOf(t, st)
+		require.Equal(t, c.reason, info.Reason)
+		require.Equal(t, ErrorDomain, info.Domain)
+	}
+
+	_, ok := HandleDomainErrors(errors.New("other error"), "/catalog.v1.ProductService/GetProduct")
+	require.False(t, ok)
+}
+
+func TestHandleDomainErrors_KeepsMessage(t *testing.T) {
+	st, _ := HandleDomainErrors(&exceptions.CategoryNotFoundException{Message: "Parent category 42 not found"}, "")
+	require.Equal(t, "Parent category 42 not found", st.Message())
+}
+
+func TestHandleDomainErrors_Unavailable(t *testing.T) {
+	st, _ := HandleDomainErrors(&exceptions.RepositoryTimeoutException{RetryAfter: 3}, "/catalog.v1.ProductService/ListProducts")
+
+	var retry *errdetails.RetryInfo
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			retry = info
+		}
+	}
+	require.NotNil(t, retry)
+	require.Equal(t, 3*time.Second, retry.RetryDelay.AsDuration())
+}
+
+func TestInvalidArgument(t *testing.T) {
+	st := status.Convert(InvalidArgument("store_id", "store_id must be a valid UUID"))
+
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Equal(t, problems.CodeInvalidParameter, errorInfoOf(t, st).Reason)
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			violations = badRequest.FieldViolations
+		}
+	}
+	require.Len(t, violations, 1)
+	require.Equal(t, "store_id", violations[0].Field)
+}
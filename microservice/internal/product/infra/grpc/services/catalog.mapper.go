@@ -0,0 +1,141 @@
+package services
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/infra/grpc/catalogpb"
+)
+
+func toProductMessage(product dtos.ProductResultDTO) *catalogpb.Product {
+	images := make([]*catalogpb.ProductImage, 0, len(product.Images))
+	for _, image := range product.Images {
+		images = append(images, &catalogpb.ProductImage{
+			Id:        image.ID,
+			FileName:  image.FileName,
+			Url:       image.Url,
+			IsDefault: image.IsDefault,
+		})
+	}
+
+	promotions := make([]*catalogpb.AppliedPromotion, 0, len(product.AppliedPromotions))
+	for _, promotion := range product.AppliedPromotions {
+		promotions = append(promotions, &catalogpb.AppliedPromotion{
+			Id:            promotion.ID,
+			Name:          promotion.Name,
+			DiscountType:  promotion.DiscountType,
+			DiscountValue: promotion.DiscountValue,
+			Amount:        promotion.Amount,
+		})
+	}
+
+	return &catalogpb.Product{
+		Id:                product.ID,
+		ExternalKey:       product.ExternalKey,
+		Name:              product.Name,
+		Description:       product.Description,
+		Price:             product.Price,
+		Active:            product.Active,
+		CategoryId:        product.CategoryID,
+		Images:            images,
+		Availability:      toAvailabilityMessage(product.Availability),
+		Nutrition:         toNutritionFactsMessage(product.Nutrition),
+		Allergens:         product.Allergens,
+		AvailableNow:      product.AvailableNow,
+		OriginalPrice:     product.OriginalPrice,
+		EffectivePrice:    product.EffectivePrice,
+		AppliedPromotions: promotions,
+		Version:           product.Version,
+		UpdatedAt:         timestamppb.New(product.UpdatedAt),
+	}
+}
+
+func toProductMessages(products []dtos.ProductResultDTO) []*catalogpb.Product {
+	messages := make([]*catalogpb.Product, 0, len(products))
+	for _, product := range products {
+		messages = append(messages, toProductMessage(product))
+	}
+	return messages
+}
+
+func toCategoryMessage(category dtos.CategoryResultDTO) *catalogpb.Category {
+	return &catalogpb.Category{
+		Id:            category.ID,
+		ExternalKey:   category.ExternalKey,
+		ParentId:      category.ParentID,
+		Name:          category.Name,
+		Description:   category.Description,
+		Position:      int32(category.Position),
+		ImageFileName: category.ImageFileName,
+		ImageUrl:      category.ImageUrl,
+		Active:        category.Active,
+		Availability:  toAvailabilityMessage(category.Availability),
+		AvailableNow:  category.AvailableNow,
+		Version:       category.Version,
+		UpdatedAt:     timestamppb.New(category.UpdatedAt),
+	}
+}
+
+func toCategoryMessages(categories []dtos.CategoryResultDTO) []*catalogpb.Category {
+	messages := make([]*catalogpb.Category, 0, len(categories))
+	for _, category := range categories {
+		messages = append(messages, toCategoryMessage(category))
+	}
+	return messages
+}
+
+func toCategoryNodeMessages(tree []dtos.CategoryTreeDTO) []*catalogpb.CategoryNode {
+	nodes := make([]*catalogpb.CategoryNode, 0, len(tree))
+	for _, node := range tree {
+		nodes = append(nodes, &catalogpb.CategoryNode{
+			Category: toCategoryMessage(node.CategoryResultDTO),
+			Children: toCategoryNodeMessages(node.Children),
+		})
+	}
+	return nodes
+}
+
+// Sem grade de horários a mensagem fica ausente, como o campo null da API HTTP
+func toAvailabilityMessage(availability *dtos.AvailabilityDTO) *catalogpb.Availability {
+	if availability.IsEmpty() {
+		return nil
+	}
+
+	weekly := make([]*catalogpb.WeeklyAvailability, 0, len(availability.Weekly))
+	for _, window := range availability.Weekly {
+		weekly = append(weekly, &catalogpb.WeeklyAvailability{Weekdays: window.Weekdays, From: window.From, To: window.To})
+	}
+
+	exceptions := make([]*catalogpb.AvailabilityException, 0, len(availability.Exceptions))
+	for _, exception := range availability.Exceptions {
+		exceptions = append(exceptions, &catalogpb.AvailabilityException{Date: exception.Date, From: exception.From, To: exception.To})
+	}
+
+	return &catalogpb.Availability{
+		Weekly:     weekly,
+		StartDate:  availability.StartDate,
+		EndDate:    availability.EndDate,
+		Exceptions: exceptions,
+	}
+}
+
+func toNutritionFactsMessage(nutrition *dtos.NutritionFactsDTO) *catalogpb.NutritionFacts {
+	if nutrition.IsEmpty() {
+		return nil
+	}
+
+	return &catalogpb.NutritionFacts{
+		ServingSize:   nutrition.ServingSize,
+		ServingUnit:   nutrition.ServingUnit,
+		EnergyKcal:    nutrition.EnergyKcal,
+		Carbohydrates: nutrition.Carbohydrates,
+		TotalSugars:   nutrition.TotalSugars,
+		AddedSugars:   nutrition.AddedSugars,
+		Proteins:      nutrition.Proteins,
+		TotalFat:      nutrition.TotalFat,
+		SaturatedFat:  nutrition.SaturatedFat,
+		TransFat:      nutrition.TransFat,
+		DietaryFiber:  nutrition.DietaryFiber,
+		Sodium:        nutrition.Sodium,
+	}
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/factories"
+	"tech_challenge/internal/product/infra/grpc/catalogpb"
+	shared_factories "tech_challenge/internal/shared/factories"
+)
+
+type CategoryService struct {
+	catalogpb.UnimplementedCategoryServiceServer
+	categoryController controllers.CategoryController
+}
+
+func NewCategoryService() *CategoryService {
+	categoryController := controllers.NewCategoryController(
+		factories.NewCategoryDataSource(),
+		factories.NewTranslationDataSource(),
+		factories.NewStoreDataSource(),
+		factories.NewTransactionManager(),
+		shared_factories.NewFileProvider(),
+		factories.NewCatalogCache(),
+	)
+
+	return &CategoryService{categoryController: *categoryController}
+}
+
+func RegisterCategoryService(server grpc.ServiceRegistrar) {
+	catalogpb.RegisterCategoryServiceServer(server, NewCategoryService())
+}
+
+func (s *CategoryService) GetCategory(ctx context.Context, req *catalogpb.GetCategoryRequest) (*catalogpb.Category, error) {
+	if err := bindUUID("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := bindStoreID(req.GetStoreId()); err != nil {
+		return nil, err
+	}
+
+	category, err := s.categoryController.FindByID(req.GetId(), negotiateLocale(ctx, req.GetLocale()), req.GetStoreId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toCategoryMessage(category), nil
+}
+
+func (s *CategoryService) ListCategories(ctx context.Context, req *catalogpb.ListCategoriesRequest) (*catalogpb.ListCategoriesResponse, error) {
+	if err := bindStoreID(req.GetStoreId()); err != nil {
+		return nil, err
+	}
+
+	at, err := bindAt(req.GetAt())
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryController.FindAll(at, negotiateLocale(ctx, req.GetLocale()), req.GetStoreId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &catalogpb.ListCategoriesResponse{Categories: toCategoryMessages(categories)}, nil
+}
+
+func (s *CategoryService) GetCategoryTree(ctx context.Context, req *catalogpb.GetCategoryTreeRequest) (*catalogpb.GetCategoryTreeResponse, error) {
+	if err := bindStoreID(req.GetStoreId()); err != nil {
+		return nil, err
+	}
+
+	at, err := bindAt(req.GetAt())
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := s.categoryController.FindTree(at, negotiateLocale(ctx, req.GetLocale()), req.GetStoreId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &catalogpb.GetCategoryTreeResponse{Categories: toCategoryNodeMessages(tree)}, nil
+}
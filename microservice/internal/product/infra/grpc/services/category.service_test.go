@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/infra/api/http_errors"
+	"tech_challenge/internal/product/infra/grpc/catalogpb"
+	testmocks "tech_challenge/internal/shared/test"
+)
+
+func TestGetCategory(t *testing.T) {
+	_, categoryDs := catalogDataSources()
+	client := setupCategoryClient(t, categoryDs)
+
+	category, err := client.GetCategory(context.Background(), &catalogpb.GetCategoryRequest{Id: testCategoryID})
+
+	require.NoError(t, err)
+	require.Equal(t, "Lanches", category.Name)
+	require.Equal(t, "lanches", category.ExternalKey)
+	require.True(t, category.AvailableNow)
+}
+
+func TestGetCategory_NotFound(t *testing.T) {
+	client := setupCategoryClient(t, &testmocks.MockCategoryDataSource{
+		FindByIDFunc: func(string) (daos.CategoryDAO, error) {
+			return daos.CategoryDAO{}, &exceptions.CategoryNotFoundException{}
+		},
+	})
+
+	_, err := client.GetCategory(context.Background(), &catalogpb.GetCategoryRequest{Id: testCategoryID})
+
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, http_errors.CodeCategoryNotFound, errorReason(t, err))
+
+	_, err = client.GetCategory(context.Background(), &catalogpb.GetCategoryRequest{Id: "abc"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListCategories(t *testing.T) {
+	_, categoryDs := catalogDataSources()
+	client := setupCategoryClient(t, categoryDs)
+
+	resp, err := client.ListCategories(context.Background(), &catalogpb.ListCategoriesRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Categories, 1)
+	require.Equal(t, testCategoryID, resp.Categories[0].Id)
+}
+
+func TestGetCategoryTree(t *testing.T) {
+	client := setupCategoryClient(t, &testmocks.MockCategoryDataSource{
+		FindAllFunc: func() ([]daos.CategoryDAO, error) {
+			return []daos.CategoryDAO{
+				{ID: "1", Name: "Bebidas", Position: 2, Active: true},
+				{ID: "2", ParentID: "1", Name: "Refrigerantes", Position: 3, Active: true},
+				{ID: "3", Name: "Lanches", Position: 1, Active: true},
+			}, nil
+		},
+	})
+
+	resp, err := client.GetCategoryTree(context.Background(), &catalogpb.GetCategoryTreeRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Categories, 2)
+	require.Equal(t, "Lanches", resp.Categories[0].Category.Name)
+	require.Empty(t, resp.Categories[0].Children)
+	require.Equal(t, "Bebidas", resp.Categories[1].Category.Name)
+	require.Equal(t, "Refrigerantes", resp.Categories[1].Children[0].Category.Name)
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+
+	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/factories"
+	"tech_challenge/internal/product/infra/grpc/catalogpb"
+	"tech_challenge/internal/product/infra/grpc/grpc_errors"
+	"tech_challenge/internal/shared/config/env"
+	shared_factories "tech_challenge/internal/shared/factories"
+)
+
+// ProductService expõe as leituras de produtos da API HTTP aos serviços
+// internos. Os erros do controller seguem sem tratamento e o interceptor de
+// erros do servidor os converte em status gRPC
+type ProductService struct {
+	catalogpb.UnimplementedProductServiceServer
+	productController controllers.ProductController
+	batchMaxIDs       int
+}
+
+func NewProductService() *ProductService {
+	productController := controllers.NewProductController(
+		factories.NewProductDataSource(),
+		factories.NewCategoryDataSource(),
+		factories.NewStockDataSource(),
+		factories.NewTranslationDataSource(),
+		factories.NewStoreDataSource(),
+		factories.NewPromotionDataSource(),
+		factories.NewTransactionManager(),
+		shared_factories.NewFileProvider(),
+		factories.NewCatalogCache(),
+	)
+
+	return &ProductService{
+		productController: *productController,
+		batchMaxIDs:       env.GetConfig().APIBatchMaxIDs,
+	}
+}
+
+func RegisterProductService(server grpc.ServiceRegistrar) {
+	catalogpb.RegisterProductServiceServer(server, NewProductService())
+}
+
+func (s *ProductService) GetProduct(ctx context.Context, req *catalogpb.GetProductRequest) (*catalogpb.Product, error) {
+	if err := bindUUID("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := bindStoreID(req.GetStoreId()); err != nil {
+		return nil, err
+	}
+
+	product, err := s.productController.FindByID(req.GetId(), negotiateLocale(ctx, req.GetLocale()), req.GetStoreId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProductMessage(product), nil
+}
+
+func (s *ProductService) ListProducts(ctx context.Context, req *catalogpb.ListProductsRequest) (*catalogpb.ListProductsResponse, error) {
+	var filter dtos.ProductFilterDTO
+
+	if categoryID := req.GetCategoryId(); categoryID != "" {
+		if err := bindUUID("category_id", categoryID); err != nil {
+			return nil, err
+		}
+		filter.CategoryID = &categoryID
+	}
+
+	for _, allergen := range req.GetExcludeAllergens() {
+		if allergen != "" {
+			filter.ExcludeAllergens = append(filter.ExcludeAllergens, allergen)
+		}
+	}
+
+	if err := bindStoreID(req.GetStoreId()); err != nil {
+		return nil, err
+	}
+
+	at, err := bindAt(req.GetAt())
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := s.productController.FindAll(filter, at, negotiateLocale(ctx, req.GetLocale()), req.GetStoreId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &catalogpb.ListProductsResponse{Products: toProductMessages(products)}, nil
+}
+
+// BatchGetProducts segue a leitura em lote da API HTTP: no máximo
+// API_BATCH_MAX_IDS ids, na ordem pedida e sem repetições
+func (s *ProductService) BatchGetProducts(ctx context.Context, req *catalogpb.BatchGetProductsRequest) (*catalogpb.BatchGetProductsResponse, error) {
+	ids := req.GetIds()
+
+	if len(ids) == 0 {
+		return nil, grpc_errors.InvalidArgument("ids", "ids must have at least 1 item")
+	}
+
+	if len(ids) > s.batchMaxIDs {
+		return nil, grpc_errors.InvalidArgument("ids", "ids must have at most "+strconv.Itoa(s.batchMaxIDs)+" items")
+	}
+
+	for _, id := range ids {
+		if err := bindUUID("ids", id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := bindStoreID(req.GetStoreId()); err != nil {
+		return nil, err
+	}
+
+	at, err := bindAt(req.GetAt())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.productController.FindByIDs(ids, at, negotiateLocale(ctx, req.GetLocale()), req.GetStoreId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &catalogpb.BatchGetProductsResponse{
+		Products:   toProductMessages(result.Products),
+		MissingIds: result.MissingIDs,
+	}, nil
+}
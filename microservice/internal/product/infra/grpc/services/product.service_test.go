@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/infra/api/http_errors"
+	"tech_challenge/internal/product/infra/grpc/catalogpb"
+	"tech_challenge/internal/shared/infra/api/problems"
+	testmocks "tech_challenge/internal/shared/test"
+)
+
+func catalogDataSources() (*testmocks.MockProductDataSource, *testmocks.MockCategoryDataSource) {
+	product := daos.ProductDAO{ID: testProductID, CategoryID: testCategoryID, Name: "X-Salada", Description: "Pão, carne e queijo", Price: 20.5, Active: true, Allergens: []string{"gluten"}}
+	category := daos.CategoryDAO{ID: testCategoryID, ExternalKey: "lanches", Name: "Lanches", Active: true}
+
+	categoryDs := &testmocks.MockCategoryDataSource{
+		FindAllFunc:  func() ([]daos.CategoryDAO, error) { return []daos.CategoryDAO{category}, nil },
+		FindByIDFunc: func(string) (daos.CategoryDAO, error) { return category, nil },
+	}
+	productDs := &testmocks.MockProductDataSource{
+		FindAllFunc:              func() ([]daos.ProductDAO, error) { return []daos.ProductDAO{product}, nil },
+		FindAllByCategoryIDsFunc: func([]string) ([]daos.ProductDAO, error) { return []daos.ProductDAO{product}, nil },
+		FindByIDFunc:             func(string) (daos.ProductDAO, error) { return product, nil },
+	}
+	return productDs, categoryDs
+}
+
+func TestGetProduct(t *testing.T) {
+	productDs, categoryDs := catalogDataSources()
+	translationDs := &testmocks.MockTranslationDataSource{Translations: []daos.TranslationDAO{
+		{Target: "product", EntityID: testProductID, Locale: "en", Name: "Cheeseburger"},
+	}}
+	client := setupProductClient(t, productDs, categoryDs, translationDs, 10)
+
+	cases := []struct {
+		locale          string
+		contentLanguage string
+		name            string
+	}{
+		{"en-US,en;q=0.9", "en", "Cheeseburger"},
+		{"fr-FR", "pt-BR", "X-Salada"},
+		{"", "pt-BR", "X-Salada"},
+	}
+	for _, c := range cases {
+		var header metadata.MD
+		product, err := client.GetProduct(context.Background(), &catalogpb.GetProductRequest{Id: testProductID, Locale: c.locale}, grpc.Header(&header))
+
+		require.NoError(t, err)
+		require.Equal(t, c.name, product.Name, c.locale)
+		require.Equal(t, []string{c.contentLanguage}, header.Get(contentLanguageHeader))
+		require.Equal(t, 20.5, product.EffectivePrice)
+		require.Equal(t, []string{"gluten"}, product.Allergens)
+		require.True(t, product.AvailableNow)
+		// Sem grade de horários e sem tabela nutricional as mensagens ficam ausentes
+		require.Nil(t, product.Availability)
+		require.Nil(t, product.Nutrition)
+	}
+}
+
+func TestGetProduct_NotFound(t *testing.T) {
+	productDs, categoryDs := catalogDataSources()
+	productDs.FindByIDFunc = func(string) (daos.ProductDAO, error) {
+		return daos.ProductDAO{}, &exceptions.RecordNotFoundException{}
+	}
+	client := setupProductClient(t, productDs, categoryDs, &testmocks.MockTranslationDataSource{}, 10)
+
+	_, err := client.GetProduct(context.Background(), &catalogpb.GetProductRequest{Id: testProductID})
+
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, http_errors.CodeProductNotFound, errorReason(t, err))
+}
+
+func TestGetProduct_InvalidArgument(t *testing.T) {
+	productDs, categoryDs := catalogDataSources()
+	productDs.FindByIDFunc = func(string) (daos.ProductDAO, error) {
+		t.Fatal("product read should not run for an invalid request")
+		return daos.ProductDAO{}, nil
+	}
+	client := setupProductClient(t, productDs, categoryDs, &testmocks.MockTranslationDataSource{}, 10)
+
+	for _, req := range []*catalogpb.GetProductRequest{
+		{Id: "abc"},
+		{Id: testProductID, StoreId: "abc"},
+	} {
+		_, err := client.GetProduct(context.Background(), req)
+
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, problems.CodeInvalidParameter, errorReason(t, err))
+	}
+}
+
+func TestListProducts(t *testing.T) {
+	productDs, categoryDs := catalogDataSources()
+	client := setupProductClient(t, productDs, categoryDs, &testmocks.MockTranslationDataSource{}, 10)
+
+	resp, err := client.ListProducts(context.Background(), &catalogpb.ListProductsRequest{CategoryId: testCategoryID, At: timestamppb.New(time.Now())})
+	require.NoError(t, err)
+	require.Len(t, resp.Products, 1)
+	require.Equal(t, "X-Salada", resp.Products[0].Name)
+
+	resp, err = client.ListProducts(context.Background(), &catalogpb.ListProductsRequest{ExcludeAllergens: []string{"gluten"}})
+	require.NoError(t, err)
+	require.Empty(t, resp.Products)
+
+	_, err = client.ListProducts(context.Background(), &catalogpb.ListProductsRequest{CategoryId: "abc"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListProducts_UnexpectedError(t *testing.T) {
+	productDs, categoryDs := catalogDataSources()
+	productDs.FindAllFunc = func() ([]daos.ProductDAO, error) {
+		return nil, errors.New("pq: password authentication failed")
+	}
+	client := setupProductClient(t, productDs, categoryDs, &testmocks.MockTranslationDataSource{}, 10)
+
+	_, err := client.ListProducts(context.Background(), &catalogpb.ListProductsRequest{})
+
+	require.Equal(t, codes.Internal, status.Code(err))
+	// A mensagem do erro inesperado nunca vai para o cliente
+	require.NotContains(t, status.Convert(err).Message(), "password")
+}
+
+func TestBatchGetProducts(t *testing.T) {
+	productDs, categoryDs := catalogDataSources()
+	client := setupProductClient(t, productDs, categoryDs, &testmocks.MockTranslationDataSource{}, 3)
+
+	resp, err := client.BatchGetProducts(context.Background(), &catalogpb.BatchGetProductsRequest{Ids: []string{testMissingID, testProductID, testMissingID}})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Products, 1)
+	require.Equal(t, testProductID, resp.Products[0].Id)
+	require.Equal(t, []string{testMissingID}, resp.MissingIds)
+}
+
+func TestBatchGetProducts_InvalidArgument(t *testing.T) {
+	productDs, categoryDs := catalogDataSources()
+	productDs.FindAllByIDsFunc = func([]string) ([]daos.ProductDAO, error) {
+		t.Fatal("batch read should not run for an invalid request")
+		return nil, nil
+	}
+	client := setupProductClient(t, productDs, categoryDs, &testmocks.MockTranslationDataSource{}, 2)
+
+	for _, req := range []*catalogpb.BatchGetProductsRequest{
+		{},
+		{Ids: []string{testProductID, testMissingID, testCategoryID}},
+		{Ids: []string{"abc"}},
+		{Ids: []string{testProductID}, At: &timestamppb.Timestamp{Nanos: -1}},
+	} {
+		_, err := client.BatchGetProducts(context.Background(), req)
+
+		require.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+}
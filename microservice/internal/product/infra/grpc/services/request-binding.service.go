@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/text/language"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/infra/grpc/grpc_errors"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+// contentLanguageHeader informa na resposta o idioma escolhido para os textos
+const contentLanguageHeader = "content-language"
+
+// contentLocales começa pelo idioma padrão, usado quando o locale pedido não
+// casa com nenhum idioma traduzido
+var (
+	contentLocales = controllers.ContentLocales()
+	contentMatcher = language.NewMatcher(contentLanguageTags(contentLocales))
+)
+
+func contentLanguageTags(locales []string) []language.Tag {
+	tags := make([]language.Tag, 0, len(locales))
+	for _, locale := range locales {
+		tags = append(tags, language.MustParse(locale))
+	}
+	return tags
+}
+
+// negotiateLocale escolhe o idioma dos textos como o Accept-Language da API
+// HTTP: o locale pedido pode ser uma lista de preferências e, sem
+// correspondência, vale o idioma padrão
+func negotiateLocale(ctx context.Context, requested string) string {
+	locale := contentLocales[0]
+	if tags, _, err := language.ParseAcceptLanguage(requested); err == nil && len(tags) > 0 {
+		if _, index, confidence := contentMatcher.Match(tags...); confidence != language.No {
+			locale = contentLocales[index]
+		}
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(contentLanguageHeader, locale))
+	return locale
+}
+
+func bindUUID(field, value string) error {
+	if identity_manager.IsNotValidUUID(value) {
+		return grpc_errors.InvalidArgument(field, field+" must be a valid UUID")
+	}
+	return nil
+}
+
+// bindStoreID aceita store_id vazio, que mantém o catálogo sem ajustes de loja
+func bindStoreID(storeID string) error {
+	if storeID == "" {
+		return nil
+	}
+	return bindUUID("store_id", storeID)
+}
+
+// bindAt devolve nil sem at, e então available_now é avaliado no instante atual
+func bindAt(at *timestamppb.Timestamp) (*time.Time, error) {
+	if at == nil {
+		return nil, nil
+	}
+
+	if err := at.CheckValid(); err != nil {
+		return nil, grpc_errors.InvalidArgument("at", "at must be a valid timestamp")
+	}
+
+	value := at.AsTime()
+	return &value, nil
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/infra/grpc/catalogpb"
+	"tech_challenge/internal/shared/infra/grpc_api/interceptors"
+	testmocks "tech_challenge/internal/shared/test"
+)
+
+func TestMain(m *testing.M) {
+	testmocks.SetupTestEnv()
+	code := m.Run()
+	os.Exit(code)
+}
+
+const (
+	testCategoryID = "2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"
+	testProductID  = "76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"
+	testMissingID  = "0b4c6a9e-2f0e-4a3c-9a52-3f4f2b1d7c11"
+)
+
+// Os testes passam pelo mesmo interceptor de erros do servidor para validar
+// os status devolvidos aos clientes
+func dialTestServer(t *testing.T, register func(grpc.ServiceRegistrar)) *grpc.ClientConn {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors.ErrorHandlerInterceptor()))
+	register(server)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func setupProductClient(t *testing.T, productDs *testmocks.MockProductDataSource, categoryDs *testmocks.MockCategoryDataSource, translationDs *testmocks.MockTranslationDataSource, batchMaxIDs int) catalogpb.ProductServiceClient {
+	transactionManager := &testmocks.MockTransactionManager{ProductDataSource: productDs, CategoryDataSource: categoryDs}
+	ctrl := controllers.NewProductController(productDs, categoryDs, &testmocks.MockStockDataSource{}, translationDs, &testmocks.MockStoreDataSource{}, &testmocks.MockPromotionDataSource{}, transactionManager, nil, nil)
+	service := &ProductService{productController: *ctrl, batchMaxIDs: batchMaxIDs}
+
+	return catalogpb.NewProductServiceClient(dialTestServer(t, func(server grpc.ServiceRegistrar) {
+		catalogpb.RegisterProductServiceServer(server, service)
+	}))
+}
+
+func setupCategoryClient(t *testing.T, categoryDs *testmocks.MockCategoryDataSource) catalogpb.CategoryServiceClient {
+	transactionManager := &testmocks.MockTransactionManager{CategoryDataSource: categoryDs}
+	ctrl := controllers.NewCategoryController(categoryDs, &testmocks.MockTranslationDataSource{}, &testmocks.MockStoreDataSource{}, transactionManager, nil, nil)
+	service := &CategoryService{categoryController: *ctrl}
+
+	return catalogpb.NewCategoryServiceClient(dialTestServer(t, func(server grpc.ServiceRegistrar) {
+		catalogpb.RegisterCategoryServiceServer(server, service)
+	}))
+}
+
+func errorReason(t *testing.T, err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+	t.Fatalf("error %v has no ErrorInfo", err)
+	return ""
+}
@@ -0,0 +1,44 @@
+package jobs
+
+import (
+	"log"
+	"time"
+
+	"tech_challenge/internal/product/application/controllers"
+	"tech_challenge/internal/product/factories"
+	shared_factories "tech_challenge/internal/shared/factories"
+)
+
+// StartStockReservationSweeper expira, a cada interval, as reservas pendentes
+// vencidas e devolve as unidades ao estoque. A confirmação já recusa reservas
+// vencidas por conta própria; a varredura só evita que o saldo fique preso
+// quando o serviço de pedidos não volta a chamar
+func StartStockReservationSweeper(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	stockController := controllers.NewStockController(
+		factories.NewProductDataSource(),
+		factories.NewStockDataSource(),
+		factories.NewTransactionManager(),
+		shared_factories.NewEventPublisher(),
+	)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			expired, err := stockController.ExpireReservations()
+			if err != nil {
+				log.Printf("failed to expire stock reservations: %v", err)
+				continue
+			}
+
+			if expired > 0 {
+				log.Printf("expired %d stock reservations", expired)
+			}
+		}
+	}()
+}
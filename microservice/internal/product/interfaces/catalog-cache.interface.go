@@ -0,0 +1,6 @@
+package interfaces
+
+// ICatalogCache limpa as leituras do catálogo guardadas pelos data sources
+type ICatalogCache interface {
+	Invalidate() error
+}
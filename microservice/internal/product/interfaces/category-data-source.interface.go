@@ -7,7 +7,10 @@ import (
 type ICategoryDataSource interface {
 	Insert(category daos.CategoryDAO) error
 	FindByID(id string) (daos.CategoryDAO, error)
+	FindByExternalKey(externalKey string) (daos.CategoryDAO, error)
 	FindAll() ([]daos.CategoryDAO, error)
+	FindAllWithAncestors(ids []string) ([]daos.CategoryDAO, error)
 	Update(category daos.CategoryDAO) error
-	Delete(id string) error
+	UpdatePositions(orderedIDs []string) error
+	Delete(id string, version int64) error
 }
@@ -50,17 +50,17 @@ func (mr *MockICategoryDataSourceMockRecorder) Insert(category interface{}) *gom
 }
 
 // Delete mocks base method.
-func (m *MockICategoryDataSource) Delete(id string) error {
+func (m *MockICategoryDataSource) Delete(id string, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockICategoryDataSourceMockRecorder) Delete(id interface{}) *gomock.Call {
+func (mr *MockICategoryDataSourceMockRecorder) Delete(id, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockICategoryDataSource)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockICategoryDataSource)(nil).Delete), id, version)
 }
 
 // FindAll mocks base method.
@@ -78,6 +78,21 @@ func (mr *MockICategoryDataSourceMockRecorder) FindAll() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockICategoryDataSource)(nil).FindAll))
 }
 
+// FindAllWithAncestors mocks base method.
+func (m *MockICategoryDataSource) FindAllWithAncestors(ids []string) ([]daos.CategoryDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllWithAncestors", ids)
+	ret0, _ := ret[0].([]daos.CategoryDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllWithAncestors indicates an expected call of FindAllWithAncestors.
+func (mr *MockICategoryDataSourceMockRecorder) FindAllWithAncestors(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllWithAncestors", reflect.TypeOf((*MockICategoryDataSource)(nil).FindAllWithAncestors), ids)
+}
+
 // FindByID mocks base method.
 func (m *MockICategoryDataSource) FindByID(id string) (daos.CategoryDAO, error) {
 	m.ctrl.T.Helper()
@@ -106,3 +121,32 @@ func (mr *MockICategoryDataSourceMockRecorder) Update(category interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockICategoryDataSource)(nil).Update), category)
 }
+
+// FindByExternalKey mocks base method.
+func (m *MockICategoryDataSource) FindByExternalKey(externalKey string) (daos.CategoryDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByExternalKey", externalKey)
+	ret0, _ := ret[0].(daos.CategoryDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByExternalKey indicates an expected call of FindByExternalKey.
+func (mr *MockICategoryDataSourceMockRecorder) FindByExternalKey(externalKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByExternalKey", reflect.TypeOf((*MockICategoryDataSource)(nil).FindByExternalKey), externalKey)
+}
+
+// UpdatePositions mocks base method.
+func (m *MockICategoryDataSource) UpdatePositions(orderedIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePositions", orderedIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePositions indicates an expected call of UpdatePositions.
+func (mr *MockICategoryDataSourceMockRecorder) UpdatePositions(orderedIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePositions", reflect.TypeOf((*MockICategoryDataSource)(nil).UpdatePositions), orderedIDs)
+}
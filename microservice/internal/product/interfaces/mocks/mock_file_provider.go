@@ -89,3 +89,18 @@ func (mr *MockIFileProviderMockRecorder) UploadFile(fileName, fileContent interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockIFileProvider)(nil).UploadFile), fileName, fileContent)
 }
+
+// ListFiles mocks base method.
+func (m *MockIFileProvider) ListFiles() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFiles")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFiles indicates an expected call of ListFiles.
+func (mr *MockIFileProviderMockRecorder) ListFiles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockIFileProvider)(nil).ListFiles))
+}
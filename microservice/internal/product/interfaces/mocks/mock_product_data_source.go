@@ -49,17 +49,17 @@ func (mr *MockIProductDataSourceMockRecorder) AddProductImage(productImage inter
 }
 
 // Delete mocks base method.
-func (m *MockIProductDataSource) Delete(id string) error {
+func (m *MockIProductDataSource) Delete(id string, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockIProductDataSourceMockRecorder) Delete(id interface{}) *gomock.Call {
+func (mr *MockIProductDataSourceMockRecorder) Delete(id, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIProductDataSource)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIProductDataSource)(nil).Delete), id, version)
 }
 
 // DeleteImage mocks base method.
@@ -233,3 +233,78 @@ func (mr *MockIProductDataSourceMockRecorder) DeleteProductImage(imageFileName i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductImage", reflect.TypeOf((*MockIProductDataSource)(nil).DeleteProductImage), imageFileName)
 }
+
+// FindAllImageFileNames mocks base method.
+func (m *MockIProductDataSource) FindAllImageFileNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllImageFileNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllImageFileNames indicates an expected call of FindAllImageFileNames.
+func (mr *MockIProductDataSourceMockRecorder) FindAllImageFileNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllImageFileNames", reflect.TypeOf((*MockIProductDataSource)(nil).FindAllImageFileNames))
+}
+
+// FindByExternalKey mocks base method.
+func (m *MockIProductDataSource) FindByExternalKey(externalKey string) (daos.ProductDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByExternalKey", externalKey)
+	ret0, _ := ret[0].(daos.ProductDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByExternalKey indicates an expected call of FindByExternalKey.
+func (mr *MockIProductDataSourceMockRecorder) FindByExternalKey(externalKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByExternalKey", reflect.TypeOf((*MockIProductDataSource)(nil).FindByExternalKey), externalKey)
+}
+
+// FindAllByCategoryIDs mocks base method.
+func (m *MockIProductDataSource) FindAllByCategoryIDs(categoryIDs []string) ([]daos.ProductDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByCategoryIDs", categoryIDs)
+	ret0, _ := ret[0].([]daos.ProductDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByCategoryIDs indicates an expected call of FindAllByCategoryIDs.
+func (mr *MockIProductDataSourceMockRecorder) FindAllByCategoryIDs(categoryIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByCategoryIDs", reflect.TypeOf((*MockIProductDataSource)(nil).FindAllByCategoryIDs), categoryIDs)
+}
+
+// FindAllByIDs mocks base method.
+func (m *MockIProductDataSource) FindAllByIDs(ids []string) ([]daos.ProductDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByIDs", ids)
+	ret0, _ := ret[0].([]daos.ProductDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByIDs indicates an expected call of FindAllByIDs.
+func (mr *MockIProductDataSourceMockRecorder) FindAllByIDs(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByIDs", reflect.TypeOf((*MockIProductDataSource)(nil).FindAllByIDs), ids)
+}
+
+// FindAllActive mocks base method.
+func (m *MockIProductDataSource) FindAllActive() ([]daos.ProductDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllActive")
+	ret0, _ := ret[0].([]daos.ProductDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllActive indicates an expected call of FindAllActive.
+func (mr *MockIProductDataSourceMockRecorder) FindAllActive() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllActive", reflect.TypeOf((*MockIProductDataSource)(nil).FindAllActive))
+}
@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/product/interfaces/promotion-data-source.interface.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	reflect "reflect"
+	daos "tech_challenge/internal/product/daos"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIPromotionDataSource is a mock of IPromotionDataSource interface.
+type MockIPromotionDataSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPromotionDataSourceMockRecorder
+}
+
+// MockIPromotionDataSourceMockRecorder is the mock recorder for MockIPromotionDataSource.
+type MockIPromotionDataSourceMockRecorder struct {
+	mock *MockIPromotionDataSource
+}
+
+// NewMockIPromotionDataSource creates a new mock instance.
+func NewMockIPromotionDataSource(ctrl *gomock.Controller) *MockIPromotionDataSource {
+	mock := &MockIPromotionDataSource{ctrl: ctrl}
+	mock.recorder = &MockIPromotionDataSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPromotionDataSource) EXPECT() *MockIPromotionDataSourceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockIPromotionDataSource) Delete(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIPromotionDataSourceMockRecorder) Delete(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIPromotionDataSource)(nil).Delete), id)
+}
+
+// FindAll mocks base method.
+func (m *MockIPromotionDataSource) FindAll() ([]daos.PromotionDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll")
+	ret0, _ := ret[0].([]daos.PromotionDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockIPromotionDataSourceMockRecorder) FindAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockIPromotionDataSource)(nil).FindAll))
+}
+
+// FindAllByTargets mocks base method.
+func (m *MockIPromotionDataSource) FindAllByTargets(productIDs, categoryIDs []string) ([]daos.PromotionDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByTargets", productIDs, categoryIDs)
+	ret0, _ := ret[0].([]daos.PromotionDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByTargets indicates an expected call of FindAllByTargets.
+func (mr *MockIPromotionDataSourceMockRecorder) FindAllByTargets(productIDs, categoryIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByTargets", reflect.TypeOf((*MockIPromotionDataSource)(nil).FindAllByTargets), productIDs, categoryIDs)
+}
+
+// FindByID mocks base method.
+func (m *MockIPromotionDataSource) FindByID(id string) (daos.PromotionDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", id)
+	ret0, _ := ret[0].(daos.PromotionDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockIPromotionDataSourceMockRecorder) FindByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockIPromotionDataSource)(nil).FindByID), id)
+}
+
+// Insert mocks base method.
+func (m *MockIPromotionDataSource) Insert(promotion daos.PromotionDAO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", promotion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockIPromotionDataSourceMockRecorder) Insert(promotion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockIPromotionDataSource)(nil).Insert), promotion)
+}
+
+// Update mocks base method.
+func (m *MockIPromotionDataSource) Update(promotion daos.PromotionDAO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", promotion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIPromotionDataSourceMockRecorder) Update(promotion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIPromotionDataSource)(nil).Update), promotion)
+}
@@ -0,0 +1,198 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/product/interfaces/stock-data-source.interface.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	reflect "reflect"
+	daos "tech_challenge/internal/product/daos"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIStockDataSource is a mock of IStockDataSource interface.
+type MockIStockDataSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStockDataSourceMockRecorder
+}
+
+// MockIStockDataSourceMockRecorder is the mock recorder for MockIStockDataSource.
+type MockIStockDataSourceMockRecorder struct {
+	mock *MockIStockDataSource
+}
+
+// NewMockIStockDataSource creates a new mock instance.
+func NewMockIStockDataSource(ctrl *gomock.Controller) *MockIStockDataSource {
+	mock := &MockIStockDataSource{ctrl: ctrl}
+	mock.recorder = &MockIStockDataSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStockDataSource) EXPECT() *MockIStockDataSourceMockRecorder {
+	return m.recorder
+}
+
+// Decrement mocks base method.
+func (m *MockIStockDataSource) Decrement(productID string, quantity int) (daos.ProductStockDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrement", productID, quantity)
+	ret0, _ := ret[0].(daos.ProductStockDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrement indicates an expected call of Decrement.
+func (mr *MockIStockDataSourceMockRecorder) Decrement(productID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrement", reflect.TypeOf((*MockIStockDataSource)(nil).Decrement), productID, quantity)
+}
+
+// FindAll mocks base method.
+func (m *MockIStockDataSource) FindAll() ([]daos.ProductStockDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll")
+	ret0, _ := ret[0].([]daos.ProductStockDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockIStockDataSourceMockRecorder) FindAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockIStockDataSource)(nil).FindAll))
+}
+
+// FindAllByProductIDs mocks base method.
+func (m *MockIStockDataSource) FindAllByProductIDs(productIDs []string) ([]daos.ProductStockDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByProductIDs", productIDs)
+	ret0, _ := ret[0].([]daos.ProductStockDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByProductIDs indicates an expected call of FindAllByProductIDs.
+func (mr *MockIStockDataSourceMockRecorder) FindAllByProductIDs(productIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByProductIDs", reflect.TypeOf((*MockIStockDataSource)(nil).FindAllByProductIDs), productIDs)
+}
+
+// FindByProductID mocks base method.
+func (m *MockIStockDataSource) FindByProductID(productID string) (daos.ProductStockDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProductID", productID)
+	ret0, _ := ret[0].(daos.ProductStockDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProductID indicates an expected call of FindByProductID.
+func (mr *MockIStockDataSourceMockRecorder) FindByProductID(productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProductID", reflect.TypeOf((*MockIStockDataSource)(nil).FindByProductID), productID)
+}
+
+// FindByProductIDForUpdate mocks base method.
+func (m *MockIStockDataSource) FindByProductIDForUpdate(productID string) (daos.ProductStockDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProductIDForUpdate", productID)
+	ret0, _ := ret[0].(daos.ProductStockDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProductIDForUpdate indicates an expected call of FindByProductIDForUpdate.
+func (mr *MockIStockDataSourceMockRecorder) FindByProductIDForUpdate(productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProductIDForUpdate", reflect.TypeOf((*MockIStockDataSource)(nil).FindByProductIDForUpdate), productID)
+}
+
+// FindExpiredReservations mocks base method.
+func (m *MockIStockDataSource) FindExpiredReservations(now time.Time, limit int) ([]daos.StockReservationDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpiredReservations", now, limit)
+	ret0, _ := ret[0].([]daos.StockReservationDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpiredReservations indicates an expected call of FindExpiredReservations.
+func (mr *MockIStockDataSourceMockRecorder) FindExpiredReservations(now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpiredReservations", reflect.TypeOf((*MockIStockDataSource)(nil).FindExpiredReservations), now, limit)
+}
+
+// FindReservationByID mocks base method.
+func (m *MockIStockDataSource) FindReservationByID(id string) (daos.StockReservationDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReservationByID", id)
+	ret0, _ := ret[0].(daos.StockReservationDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReservationByID indicates an expected call of FindReservationByID.
+func (mr *MockIStockDataSourceMockRecorder) FindReservationByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReservationByID", reflect.TypeOf((*MockIStockDataSource)(nil).FindReservationByID), id)
+}
+
+// Increment mocks base method.
+func (m *MockIStockDataSource) Increment(productID string, quantity int) (daos.ProductStockDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increment", productID, quantity)
+	ret0, _ := ret[0].(daos.ProductStockDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockIStockDataSourceMockRecorder) Increment(productID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockIStockDataSource)(nil).Increment), productID, quantity)
+}
+
+// InsertReservation mocks base method.
+func (m *MockIStockDataSource) InsertReservation(reservation daos.StockReservationDAO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertReservation", reservation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertReservation indicates an expected call of InsertReservation.
+func (mr *MockIStockDataSourceMockRecorder) InsertReservation(reservation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertReservation", reflect.TypeOf((*MockIStockDataSource)(nil).InsertReservation), reservation)
+}
+
+// Save mocks base method.
+func (m *MockIStockDataSource) Save(stock daos.ProductStockDAO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", stock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIStockDataSourceMockRecorder) Save(stock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIStockDataSource)(nil).Save), stock)
+}
+
+// UpdateReservationStatus mocks base method.
+func (m *MockIStockDataSource) UpdateReservationStatus(id, fromStatus, toStatus string, updatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReservationStatus", id, fromStatus, toStatus, updatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReservationStatus indicates an expected call of UpdateReservationStatus.
+func (mr *MockIStockDataSourceMockRecorder) UpdateReservationStatus(id, fromStatus, toStatus, updatedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReservationStatus", reflect.TypeOf((*MockIStockDataSource)(nil).UpdateReservationStatus), id, fromStatus, toStatus, updatedAt)
+}
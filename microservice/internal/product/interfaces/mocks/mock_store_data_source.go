@@ -0,0 +1,207 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/product/interfaces/store-data-source.interface.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	reflect "reflect"
+	daos "tech_challenge/internal/product/daos"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIStoreDataSource is a mock of IStoreDataSource interface.
+type MockIStoreDataSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStoreDataSourceMockRecorder
+}
+
+// MockIStoreDataSourceMockRecorder is the mock recorder for MockIStoreDataSource.
+type MockIStoreDataSourceMockRecorder struct {
+	mock *MockIStoreDataSource
+}
+
+// NewMockIStoreDataSource creates a new mock instance.
+func NewMockIStoreDataSource(ctrl *gomock.Controller) *MockIStoreDataSource {
+	mock := &MockIStoreDataSource{ctrl: ctrl}
+	mock.recorder = &MockIStoreDataSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStoreDataSource) EXPECT() *MockIStoreDataSourceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockIStoreDataSource) Delete(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIStoreDataSourceMockRecorder) Delete(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIStoreDataSource)(nil).Delete), id)
+}
+
+// DeleteCategoryOverride mocks base method.
+func (m *MockIStoreDataSource) DeleteCategoryOverride(storeID, categoryID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryOverride", storeID, categoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategoryOverride indicates an expected call of DeleteCategoryOverride.
+func (mr *MockIStoreDataSourceMockRecorder) DeleteCategoryOverride(storeID, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryOverride", reflect.TypeOf((*MockIStoreDataSource)(nil).DeleteCategoryOverride), storeID, categoryID)
+}
+
+// DeleteOverrides mocks base method.
+func (m *MockIStoreDataSource) DeleteOverrides(storeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOverrides", storeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOverrides indicates an expected call of DeleteOverrides.
+func (mr *MockIStoreDataSourceMockRecorder) DeleteOverrides(storeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOverrides", reflect.TypeOf((*MockIStoreDataSource)(nil).DeleteOverrides), storeID)
+}
+
+// DeleteProductOverride mocks base method.
+func (m *MockIStoreDataSource) DeleteProductOverride(storeID, productID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductOverride", storeID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductOverride indicates an expected call of DeleteProductOverride.
+func (mr *MockIStoreDataSourceMockRecorder) DeleteProductOverride(storeID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductOverride", reflect.TypeOf((*MockIStoreDataSource)(nil).DeleteProductOverride), storeID, productID)
+}
+
+// FindAll mocks base method.
+func (m *MockIStoreDataSource) FindAll() ([]daos.StoreDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll")
+	ret0, _ := ret[0].([]daos.StoreDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockIStoreDataSourceMockRecorder) FindAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockIStoreDataSource)(nil).FindAll))
+}
+
+// FindByID mocks base method.
+func (m *MockIStoreDataSource) FindByID(id string) (daos.StoreDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", id)
+	ret0, _ := ret[0].(daos.StoreDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockIStoreDataSourceMockRecorder) FindByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockIStoreDataSource)(nil).FindByID), id)
+}
+
+// FindCategoryOverrides mocks base method.
+func (m *MockIStoreDataSource) FindCategoryOverrides(storeID string) ([]daos.CategoryStoreOverrideDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCategoryOverrides", storeID)
+	ret0, _ := ret[0].([]daos.CategoryStoreOverrideDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCategoryOverrides indicates an expected call of FindCategoryOverrides.
+func (mr *MockIStoreDataSourceMockRecorder) FindCategoryOverrides(storeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCategoryOverrides", reflect.TypeOf((*MockIStoreDataSource)(nil).FindCategoryOverrides), storeID)
+}
+
+// FindProductOverrides mocks base method.
+func (m *MockIStoreDataSource) FindProductOverrides(storeID string) ([]daos.ProductStoreOverrideDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductOverrides", storeID)
+	ret0, _ := ret[0].([]daos.ProductStoreOverrideDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductOverrides indicates an expected call of FindProductOverrides.
+func (mr *MockIStoreDataSourceMockRecorder) FindProductOverrides(storeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductOverrides", reflect.TypeOf((*MockIStoreDataSource)(nil).FindProductOverrides), storeID)
+}
+
+// Insert mocks base method.
+func (m *MockIStoreDataSource) Insert(store daos.StoreDAO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", store)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockIStoreDataSourceMockRecorder) Insert(store interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockIStoreDataSource)(nil).Insert), store)
+}
+
+// SaveCategoryOverride mocks base method.
+func (m *MockIStoreDataSource) SaveCategoryOverride(override daos.CategoryStoreOverrideDAO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCategoryOverride", override)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCategoryOverride indicates an expected call of SaveCategoryOverride.
+func (mr *MockIStoreDataSourceMockRecorder) SaveCategoryOverride(override interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCategoryOverride", reflect.TypeOf((*MockIStoreDataSource)(nil).SaveCategoryOverride), override)
+}
+
+// SaveProductOverride mocks base method.
+func (m *MockIStoreDataSource) SaveProductOverride(override daos.ProductStoreOverrideDAO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProductOverride", override)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveProductOverride indicates an expected call of SaveProductOverride.
+func (mr *MockIStoreDataSourceMockRecorder) SaveProductOverride(override interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProductOverride", reflect.TypeOf((*MockIStoreDataSource)(nil).SaveProductOverride), override)
+}
+
+// Update mocks base method.
+func (m *MockIStoreDataSource) Update(store daos.StoreDAO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", store)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIStoreDataSourceMockRecorder) Update(store interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIStoreDataSource)(nil).Update), store)
+}
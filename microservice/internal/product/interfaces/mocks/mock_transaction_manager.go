@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/product/interfaces/transaction-manager.interface.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	reflect "reflect"
+	interfaces "tech_challenge/internal/product/interfaces"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockITransactionManager is a mock of ITransactionManager interface.
+type MockITransactionManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockITransactionManagerMockRecorder
+}
+
+// MockITransactionManagerMockRecorder is the mock recorder for MockITransactionManager.
+type MockITransactionManagerMockRecorder struct {
+	mock *MockITransactionManager
+}
+
+// NewMockITransactionManager creates a new mock instance.
+func NewMockITransactionManager(ctrl *gomock.Controller) *MockITransactionManager {
+	mock := &MockITransactionManager{ctrl: ctrl}
+	mock.recorder = &MockITransactionManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITransactionManager) EXPECT() *MockITransactionManagerMockRecorder {
+	return m.recorder
+}
+
+// Transaction mocks base method.
+func (m *MockITransactionManager) Transaction(fn func(interfaces.TransactionDataSources) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockITransactionManagerMockRecorder) Transaction(fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockITransactionManager)(nil).Transaction), fn)
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/product/interfaces/translation-data-source.interface.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	reflect "reflect"
+	daos "tech_challenge/internal/product/daos"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockITranslationDataSource is a mock of ITranslationDataSource interface.
+type MockITranslationDataSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockITranslationDataSourceMockRecorder
+}
+
+// MockITranslationDataSourceMockRecorder is the mock recorder for MockITranslationDataSource.
+type MockITranslationDataSourceMockRecorder struct {
+	mock *MockITranslationDataSource
+}
+
+// NewMockITranslationDataSource creates a new mock instance.
+func NewMockITranslationDataSource(ctrl *gomock.Controller) *MockITranslationDataSource {
+	mock := &MockITranslationDataSource{ctrl: ctrl}
+	mock.recorder = &MockITranslationDataSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITranslationDataSource) EXPECT() *MockITranslationDataSourceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockITranslationDataSource) Delete(target, entityID, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", target, entityID, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITranslationDataSourceMockRecorder) Delete(target, entityID, locale interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITranslationDataSource)(nil).Delete), target, entityID, locale)
+}
+
+// FindAllByLocale mocks base method.
+func (m *MockITranslationDataSource) FindAllByLocale(target, locale string) ([]daos.TranslationDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByLocale", target, locale)
+	ret0, _ := ret[0].([]daos.TranslationDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByLocale indicates an expected call of FindAllByLocale.
+func (mr *MockITranslationDataSourceMockRecorder) FindAllByLocale(target, locale interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByLocale", reflect.TypeOf((*MockITranslationDataSource)(nil).FindAllByLocale), target, locale)
+}
+
+// FindByEntityID mocks base method.
+func (m *MockITranslationDataSource) FindByEntityID(target, entityID string) ([]daos.TranslationDAO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEntityID", target, entityID)
+	ret0, _ := ret[0].([]daos.TranslationDAO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEntityID indicates an expected call of FindByEntityID.
+func (mr *MockITranslationDataSourceMockRecorder) FindByEntityID(target, entityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEntityID", reflect.TypeOf((*MockITranslationDataSource)(nil).FindByEntityID), target, entityID)
+}
+
+// Save mocks base method.
+func (m *MockITranslationDataSource) Save(translation daos.TranslationDAO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", translation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockITranslationDataSourceMockRecorder) Save(translation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockITranslationDataSource)(nil).Save), translation)
+}
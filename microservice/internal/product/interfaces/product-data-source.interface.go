@@ -7,13 +7,18 @@ import (
 type IProductDataSource interface {
 	Insert(product daos.ProductDAO) error
 	Update(product daos.ProductDAO) error
-	Delete(id string) error
+	Delete(id string, version int64) error
 	FindAll() ([]daos.ProductDAO, error)
+	FindAllActive() ([]daos.ProductDAO, error)
 	FindByID(id string) (daos.ProductDAO, error)
+	FindAllByIDs(ids []string) ([]daos.ProductDAO, error)
+	FindByExternalKey(externalKey string) (daos.ProductDAO, error)
 	FindAllByCategoryID(categoryID string) ([]daos.ProductDAO, error)
+	FindAllByCategoryIDs(categoryIDs []string) ([]daos.ProductDAO, error)
 	FindAllImagesProductById(productID string) ([]daos.ProductImageDAO, error)
 	AddProductImage(productImage daos.ProductImageDAO) error
 	SetAllPreviousImagesAsNotDefault(productID, exceptImageID string) error
 	SetImageAsDefault(productID, imageID string) error
 	DeleteImage(imageFileName string) error
+	FindAllImageFileNames() ([]string, error)
 }
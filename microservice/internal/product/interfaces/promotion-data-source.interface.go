@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"tech_challenge/internal/product/daos"
+)
+
+// IPromotionDataSource guarda as promoções junto com os produtos e as
+// categorias que cada uma alcança
+type IPromotionDataSource interface {
+	Insert(promotion daos.PromotionDAO) error
+	FindAll() ([]daos.PromotionDAO, error)
+	FindAllByTargets(productIDs []string, categoryIDs []string) ([]daos.PromotionDAO, error)
+	FindByID(id string) (daos.PromotionDAO, error)
+	Update(promotion daos.PromotionDAO) error
+	Delete(id string) error
+}
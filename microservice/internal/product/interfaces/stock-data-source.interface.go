@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/daos"
+)
+
+// IStockDataSource guarda o estoque dos produtos e as reservas do serviço de
+// pedidos. Decrement e Increment alteram a quantidade numa única instrução no
+// banco, sem ler e regravar o valor
+type IStockDataSource interface {
+	FindByProductID(productID string) (daos.ProductStockDAO, error)
+	FindByProductIDForUpdate(productID string) (daos.ProductStockDAO, error)
+	FindAll() ([]daos.ProductStockDAO, error)
+	FindAllByProductIDs(productIDs []string) ([]daos.ProductStockDAO, error)
+	Save(stock daos.ProductStockDAO) error
+	Decrement(productID string, quantity int) (daos.ProductStockDAO, error)
+	Increment(productID string, quantity int) (daos.ProductStockDAO, error)
+	InsertReservation(reservation daos.StockReservationDAO) error
+	FindReservationByID(id string) (daos.StockReservationDAO, error)
+	UpdateReservationStatus(id, fromStatus, toStatus string, updatedAt time.Time) error
+	FindExpiredReservations(now time.Time, limit int) ([]daos.StockReservationDAO, error)
+}
@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"tech_challenge/internal/product/daos"
+)
+
+// IStoreDataSource guarda as lojas e os ajustes de produtos e categorias de
+// cada uma
+type IStoreDataSource interface {
+	Insert(store daos.StoreDAO) error
+	FindAll() ([]daos.StoreDAO, error)
+	FindByID(id string) (daos.StoreDAO, error)
+	Update(store daos.StoreDAO) error
+	Delete(id string) error
+	FindProductOverrides(storeID string) ([]daos.ProductStoreOverrideDAO, error)
+	FindCategoryOverrides(storeID string) ([]daos.CategoryStoreOverrideDAO, error)
+	SaveProductOverride(override daos.ProductStoreOverrideDAO) error
+	SaveCategoryOverride(override daos.CategoryStoreOverrideDAO) error
+	DeleteProductOverride(storeID, productID string) error
+	DeleteCategoryOverride(storeID, categoryID string) error
+	DeleteOverrides(storeID string) error
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+type TransactionDataSources struct {
+	Product     IProductDataSource
+	Category    ICategoryDataSource
+	Translation ITranslationDataSource
+	Store       IStoreDataSource
+	Stock       IStockDataSource
+}
+
+type ITransactionManager interface {
+	Transaction(fn func(dataSources TransactionDataSources) error) error
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"tech_challenge/internal/product/daos"
+)
+
+// ITranslationDataSource guarda as traduções de produtos e categorias; target
+// é "product" ou "category"
+type ITranslationDataSource interface {
+	FindByEntityID(target, entityID string) ([]daos.TranslationDAO, error)
+	FindAllByLocale(target, locale string) ([]daos.TranslationDAO, error)
+	Save(translation daos.TranslationDAO) error
+	Delete(target, entityID, locale string) error
+}
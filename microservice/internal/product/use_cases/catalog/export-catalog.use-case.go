@@ -0,0 +1,32 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type ExportCatalogUseCase struct {
+	productGateway  gateways.ProductGateway
+	categoryGateway gateways.CategoryGateway
+}
+
+func NewExportCatalogUseCase(productGateway gateways.ProductGateway, categoryGateway gateways.CategoryGateway) *ExportCatalogUseCase {
+	return &ExportCatalogUseCase{
+		productGateway:  productGateway,
+		categoryGateway: categoryGateway,
+	}
+}
+
+func (uc *ExportCatalogUseCase) Execute() ([]*entities.Category, []entities.Product, error) {
+	categories, err := uc.categoryGateway.FindAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	products, err := uc.productGateway.FindAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return categories, products, nil
+}
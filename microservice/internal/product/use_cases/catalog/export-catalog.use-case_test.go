@@ -0,0 +1,51 @@
+package use_cases_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	use_cases "tech_challenge/internal/product/use_cases/catalog"
+	testenv "tech_challenge/internal/shared/test"
+)
+
+func TestMain(m *testing.M) {
+	testenv.SetupTestEnv()
+	code := m.Run()
+	os.Exit(code)
+}
+
+func TestExportCatalogUseCase_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	mockCategoryDataSource.EXPECT().FindAll().Return([]daos.CategoryDAO{{ID: "catid", Name: "Bebidas", Active: true}}, nil)
+	mockProductDataSource.EXPECT().FindAll().Return([]daos.ProductDAO{{ID: "pid", CategoryID: "catid", Name: "Coca-Cola", Price: 5.99, Active: true}}, nil)
+
+	uc := use_cases.NewExportCatalogUseCase(*gateways.NewProductGateway(mockProductDataSource, mockFileProvider), gateways.NewCategoryGateway(mockCategoryDataSource))
+	categories, products, err := uc.Execute()
+	require.NoError(t, err)
+	require.Len(t, categories, 1)
+	require.Len(t, products, 1)
+}
+
+func TestExportCatalogUseCase_CategoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	mockCategoryDataSource.EXPECT().FindAll().Return(nil, errors.New("fail"))
+
+	uc := use_cases.NewExportCatalogUseCase(*gateways.NewProductGateway(mockProductDataSource, mockFileProvider), gateways.NewCategoryGateway(mockCategoryDataSource))
+	_, _, err := uc.Execute()
+	require.Error(t, err)
+}
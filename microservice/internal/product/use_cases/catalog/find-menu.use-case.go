@@ -0,0 +1,78 @@
+package use_cases
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type FindMenuUseCase struct {
+	productGateway   gateways.ProductGateway
+	categoryGateway  gateways.CategoryGateway
+	stockGateway     gateways.StockGateway
+	promotionGateway gateways.PromotionGateway
+}
+
+func NewFindMenuUseCase(
+	productGateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+	stockGateway gateways.StockGateway,
+	promotionGateway gateways.PromotionGateway,
+) *FindMenuUseCase {
+	return &FindMenuUseCase{
+		productGateway:   productGateway,
+		categoryGateway:  categoryGateway,
+		stockGateway:     stockGateway,
+		promotionGateway: promotionGateway,
+	}
+}
+
+// Execute carrega categorias e produtos ativos de uma vez e monta o cardápio
+// em memória, sem uma consulta por categoria. local é o horário, já no fuso
+// do estabelecimento, usado para as grades de disponibilidade. O estoque não
+// passa pelo cache de leituras e marca os esgotados a cada montagem.
+// overrides aplica os ajustes de uma loja antes da montagem, e as promoções
+// são aplicadas sobre o preço já ajustado
+func (uc *FindMenuUseCase) Execute(local time.Time, overrides entities.StoreOverrides) ([]entities.MenuSection, error) {
+	categories, err := uc.categoryGateway.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	overrides.ApplyToCategories(categories)
+
+	products, err := uc.findProducts(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	stocks, err := uc.stockGateway.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	promotions, err := uc.promotionGateway.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.NewMenu(categories, products, stocks, promotions, local), nil
+}
+
+// findProducts só lista também os inativos quando a loja ativa algum produto
+// que está inativo no catálogo
+func (uc *FindMenuUseCase) findProducts(overrides entities.StoreOverrides) ([]entities.Product, error) {
+	findProducts := uc.productGateway.FindAllActive
+	if overrides.ActivatesProducts() {
+		findProducts = uc.productGateway.FindAll
+	}
+
+	products, err := findProducts()
+	if err != nil {
+		return nil, err
+	}
+
+	overrides.ApplyToProducts(products)
+	return products, nil
+}
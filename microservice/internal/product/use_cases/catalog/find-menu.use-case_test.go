@@ -0,0 +1,51 @@
+package use_cases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/entities"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	use_cases "tech_challenge/internal/product/use_cases/catalog"
+)
+
+func TestFindMenuUseCase_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	mockCategoryDataSource.EXPECT().FindAll().Return([]daos.CategoryDAO{{ID: "catid", Name: "Bebidas", Active: true}}, nil)
+	mockProductDataSource.EXPECT().FindAllActive().Return([]daos.ProductDAO{{ID: "pid", CategoryID: "catid", Name: "Coca-Cola", Price: 5.99, Active: true}}, nil)
+	mockStockDataSource := mock_interfaces.NewMockIStockDataSource(ctrl)
+	mockStockDataSource.EXPECT().FindAll().Return([]daos.ProductStockDAO{{ProductID: "pid", Tracked: true, Quantity: 0}}, nil)
+	mockPromotionDataSource := mock_interfaces.NewMockIPromotionDataSource(ctrl)
+	mockPromotionDataSource.EXPECT().FindAll().Return([]daos.PromotionDAO{{ID: "promo", Name: "Bebidas 10%", DiscountType: "percentage", DiscountValue: 10, CategoryIDs: []string{"catid"}, Active: true}}, nil)
+
+	uc := use_cases.NewFindMenuUseCase(*gateways.NewProductGateway(mockProductDataSource, mockFileProvider), gateways.NewCategoryGateway(mockCategoryDataSource), gateways.NewStockGateway(mockStockDataSource, nil), gateways.NewPromotionGateway(mockPromotionDataSource))
+	menu, err := uc.Execute(time.Now(), entities.StoreOverrides{})
+	require.NoError(t, err)
+	require.Len(t, menu, 1)
+	require.Len(t, menu[0].Products, 1)
+	require.True(t, menu[0].Products[0].SoldOut)
+	require.Equal(t, 5.39, menu[0].Products[0].Pricing.EffectivePrice)
+}
+
+func TestFindMenuUseCase_CategoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	mockCategoryDataSource.EXPECT().FindAll().Return(nil, errors.New("fail"))
+
+	uc := use_cases.NewFindMenuUseCase(*gateways.NewProductGateway(mockProductDataSource, mockFileProvider), gateways.NewCategoryGateway(mockCategoryDataSource), gateways.NewStockGateway(mock_interfaces.NewMockIStockDataSource(ctrl), nil), gateways.NewPromotionGateway(mock_interfaces.NewMockIPromotionDataSource(ctrl)))
+	_, err := uc.Execute(time.Now(), entities.StoreOverrides{})
+	require.Error(t, err)
+}
@@ -0,0 +1,386 @@
+package use_cases
+
+import (
+	"errors"
+	"fmt"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+const (
+	importEntityCategory = "category"
+	importEntityProduct  = "product"
+)
+
+var errImportRejected = errors.New("catalog import has invalid rows")
+
+type ImportCatalogUseCase struct {
+	productGateway     gateways.ProductGateway
+	categoryGateway    gateways.CategoryGateway
+	transactionGateway gateways.TransactionGateway
+	cacheGateway       gateways.CatalogCacheGateway
+}
+
+func NewImportCatalogUseCase(
+	productGateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+	transactionGateway gateways.TransactionGateway,
+	cacheGateway gateways.CatalogCacheGateway,
+) *ImportCatalogUseCase {
+	return &ImportCatalogUseCase{
+		productGateway:     productGateway,
+		categoryGateway:    categoryGateway,
+		transactionGateway: transactionGateway,
+		cacheGateway:       cacheGateway,
+	}
+}
+
+func (uc *ImportCatalogUseCase) Execute(catalogDTO dtos.ImportCatalogDTO) (dtos.ImportCatalogResultDTO, error) {
+	if catalogDTO.DryRun {
+		plan, err := buildImportPlan(uc.productGateway, uc.categoryGateway, catalogDTO)
+		if err != nil {
+			return dtos.ImportCatalogResultDTO{}, err
+		}
+
+		return plan.result(true), nil
+	}
+
+	var result dtos.ImportCatalogResultDTO
+
+	defer uc.cacheGateway.Invalidate()
+
+	// O plano é montado dentro da transação para que a validação e a
+	// aplicação enxerguem o mesmo estado do catálogo
+	err := uc.transactionGateway.Run(func(gateways gateways.TransactionGateways) error {
+		plan, err := buildImportPlan(gateways.Product, gateways.Category, catalogDTO)
+		if err != nil {
+			return err
+		}
+
+		result = plan.result(false)
+		if len(plan.errors) > 0 {
+			return errImportRejected
+		}
+
+		if err := plan.apply(gateways.Product, gateways.Category); err != nil {
+			return err
+		}
+
+		result.Applied = true
+		return nil
+	})
+
+	if errors.Is(err, errImportRejected) {
+		return result, nil
+	}
+
+	if err != nil {
+		return dtos.ImportCatalogResultDTO{}, err
+	}
+
+	return result, nil
+}
+
+type importPlan struct {
+	categoriesToInsert     []entities.Category
+	categoriesToUpdate     []entities.Category
+	categoriesToDeactivate []entities.Category
+	productsToInsert       []entities.Product
+	productsToUpdate       []entities.Product
+	productsToDeactivate   []entities.Product
+	errors                 []dtos.ImportRowErrorDTO
+	lastCategoryPosition   int
+}
+
+// importIndex guarda os IDs e chaves externas já existentes de um tipo de
+// registro, os que já apareceram no arquivo (para detectar duplicidades) e
+// os que serão criados pela importação
+type importIndex struct {
+	entity       string
+	existingIDs  map[string]bool
+	existingKeys map[string]string
+	seenIDs      map[string]bool
+	seenKeys     map[string]bool
+	createdIDs   map[string]bool
+	createdKeys  map[string]string
+}
+
+func newImportIndex(entity string) *importIndex {
+	return &importIndex{
+		entity:       entity,
+		existingIDs:  map[string]bool{},
+		existingKeys: map[string]string{},
+		seenIDs:      map[string]bool{},
+		seenKeys:     map[string]bool{},
+		createdIDs:   map[string]bool{},
+		createdKeys:  map[string]string{},
+	}
+}
+
+func (idx *importIndex) add(id, externalKey string) {
+	idx.existingIDs[id] = true
+	if externalKey != "" {
+		idx.existingKeys[externalKey] = id
+	}
+}
+
+// resolve decide qual registro a linha atualiza (por ID ou chave externa) ou
+// qual ID o novo registro recebe
+func (idx *importIndex) resolve(row int, id, externalKey string) (string, bool, []dtos.ImportRowErrorDTO) {
+	var rowErrors []dtos.ImportRowErrorDTO
+	rowError := func(field, message string) {
+		rowErrors = append(rowErrors, dtos.ImportRowErrorDTO{Entity: idx.entity, Row: row, Field: field, Message: message})
+	}
+
+	keyOwner, keyExists := idx.existingKeys[externalKey]
+	if externalKey == "" {
+		keyExists = false
+	}
+
+	resolvedID := id
+	exists := false
+
+	switch {
+	case id != "":
+		if identity_manager.IsNotValidUUID(id) {
+			rowError("id", "id must be a valid UUID")
+			return "", false, rowErrors
+		}
+		exists = idx.existingIDs[id]
+		if keyExists && keyOwner != id {
+			rowError("external_key", fmt.Sprintf("external key %q already belongs to another %s", externalKey, idx.entity))
+		}
+	case keyExists:
+		resolvedID = keyOwner
+		exists = true
+	default:
+		resolvedID = identity_manager.NewUUIDV4()
+	}
+
+	if idx.seenIDs[resolvedID] {
+		rowError("id", fmt.Sprintf("%s appears more than once in the file", idx.entity))
+	}
+	if externalKey != "" && idx.seenKeys[externalKey] {
+		rowError("external_key", fmt.Sprintf("external key %q appears more than once in the file", externalKey))
+	}
+
+	idx.seenIDs[resolvedID] = true
+	if externalKey != "" {
+		idx.seenKeys[externalKey] = true
+	}
+
+	return resolvedID, exists, rowErrors
+}
+
+func buildImportPlan(
+	productGateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+	catalogDTO dtos.ImportCatalogDTO,
+) (*importPlan, error) {
+	categories, err := categoryGateway.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := productGateway.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &importPlan{errors: append([]dtos.ImportRowErrorDTO(nil), catalogDTO.RowErrors...)}
+
+	categoriesByID := make(map[string]*entities.Category, len(categories))
+	categoryIndex := newImportIndex(importEntityCategory)
+	for _, category := range categories {
+		categoriesByID[category.ID] = category
+		categoryIndex.add(category.ID, category.ExternalKey)
+		plan.lastCategoryPosition = max(plan.lastCategoryPosition, category.Position)
+	}
+
+	productsByID := make(map[string]entities.Product, len(products))
+	productIndex := newImportIndex(importEntityProduct)
+	for _, product := range products {
+		productsByID[product.ID] = product
+		productIndex.add(product.ID, product.ExternalKey)
+	}
+
+	for _, row := range catalogDTO.Categories {
+		plan.addCategory(row, categoryIndex, categoriesByID)
+	}
+
+	for _, row := range catalogDTO.Products {
+		plan.addProduct(row, productIndex, categoryIndex, productsByID)
+	}
+
+	if catalogDTO.DeactivateMissing {
+		for _, category := range categories {
+			if category.Active && !categoryIndex.seenIDs[category.ID] {
+				category.Active = false
+				plan.categoriesToDeactivate = append(plan.categoriesToDeactivate, *category)
+			}
+		}
+
+		for _, product := range products {
+			if product.Active && !productIndex.seenIDs[product.ID] {
+				_ = product.Deactivate()
+				plan.productsToDeactivate = append(plan.productsToDeactivate, product)
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+func (p *importPlan) addCategory(row dtos.ImportCategoryDTO, index *importIndex, categoriesByID map[string]*entities.Category) {
+	id, exists, rowErrors := index.resolve(row.Row, row.ID, row.ExternalKey)
+	p.errors = append(p.errors, rowErrors...)
+
+	if _, err := value_objects.NewCategoryName(row.Name); err != nil {
+		p.errors = append(p.errors, dtos.ImportRowErrorDTO{Entity: importEntityCategory, Row: row.Row, Field: "name", Message: err.Error()})
+		return
+	}
+
+	if len(rowErrors) > 0 {
+		return
+	}
+
+	if exists {
+		category := *categoriesByID[id]
+		_ = category.SetName(row.Name)
+		category.Active = row.Active
+		if row.ExternalKey != "" {
+			category.ExternalKey = row.ExternalKey
+		}
+		p.categoriesToUpdate = append(p.categoriesToUpdate, category)
+		return
+	}
+
+	category, _ := entities.NewCategory(id, row.Name, row.Active)
+	category.ExternalKey = row.ExternalKey
+
+	// Categorias novas entram no fim do cardápio, na ordem do arquivo
+	p.lastCategoryPosition++
+	_ = category.SetPosition(p.lastCategoryPosition)
+	p.categoriesToInsert = append(p.categoriesToInsert, *category)
+
+	// Produtos do mesmo arquivo podem referenciar a categoria recém-criada
+	index.createdIDs[id] = true
+	if row.ExternalKey != "" {
+		index.createdKeys[row.ExternalKey] = id
+	}
+}
+
+func (p *importPlan) addProduct(row dtos.ImportProductDTO, index, categoryIndex *importIndex, productsByID map[string]entities.Product) {
+	id, exists, rowErrors := index.resolve(row.Row, row.ID, row.ExternalKey)
+
+	rowError := func(field string, err error) {
+		rowErrors = append(rowErrors, dtos.ImportRowErrorDTO{Entity: importEntityProduct, Row: row.Row, Field: field, Message: err.Error()})
+	}
+
+	if _, err := value_objects.NewName(row.Name); err != nil {
+		rowError("name", err)
+	}
+
+	if _, err := value_objects.NewPrice(row.Price); err != nil {
+		rowError("price", err)
+	}
+
+	categoryID, err := resolveImportCategory(row, categoryIndex)
+	if err != nil {
+		rowError("category_id", err)
+	}
+
+	p.errors = append(p.errors, rowErrors...)
+	if len(rowErrors) > 0 {
+		return
+	}
+
+	if exists {
+		product := productsByID[id]
+		_ = product.SetName(row.Name)
+		_ = product.SetDescription(row.Description)
+		_ = product.SetPrice(row.Price)
+		_ = product.SetCategory(categoryID)
+		if row.Active {
+			_ = product.Activate()
+		} else {
+			_ = product.Deactivate()
+		}
+		if row.ExternalKey != "" {
+			product.ExternalKey = row.ExternalKey
+		}
+		p.productsToUpdate = append(p.productsToUpdate, product)
+		return
+	}
+
+	product, _ := entities.NewProduct(id, categoryID, row.Name, row.Description, row.Price, row.Active)
+	product.ExternalKey = row.ExternalKey
+	p.productsToInsert = append(p.productsToInsert, *product)
+}
+
+func resolveImportCategory(row dtos.ImportProductDTO, categoryIndex *importIndex) (string, error) {
+	if row.CategoryID != "" {
+		if !categoryIndex.existingIDs[row.CategoryID] && !categoryIndex.createdIDs[row.CategoryID] {
+			return "", fmt.Errorf("category %q not found", row.CategoryID)
+		}
+		return row.CategoryID, nil
+	}
+
+	if row.CategoryKey != "" {
+		categoryID, ok := categoryIndex.existingKeys[row.CategoryKey]
+		if !ok {
+			categoryID, ok = categoryIndex.createdKeys[row.CategoryKey]
+		}
+		if !ok {
+			return "", fmt.Errorf("category with external key %q not found", row.CategoryKey)
+		}
+		return categoryID, nil
+	}
+
+	return "", errors.New("category_id or category_key is required")
+}
+
+func (p *importPlan) apply(productGateway gateways.ProductGateway, categoryGateway gateways.CategoryGateway) error {
+	for _, category := range p.categoriesToInsert {
+		if err := categoryGateway.Insert(category); err != nil {
+			return err
+		}
+	}
+
+	for _, category := range append(p.categoriesToUpdate, p.categoriesToDeactivate...) {
+		if err := categoryGateway.Update(&category); err != nil {
+			return err
+		}
+	}
+
+	for _, product := range p.productsToInsert {
+		if err := productGateway.Insert(product); err != nil {
+			return err
+		}
+	}
+
+	for _, product := range append(p.productsToUpdate, p.productsToDeactivate...) {
+		if err := productGateway.Update(&product); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *importPlan) result(dryRun bool) dtos.ImportCatalogResultDTO {
+	return dtos.ImportCatalogResultDTO{
+		DryRun:                dryRun,
+		CategoriesCreated:     len(p.categoriesToInsert),
+		CategoriesUpdated:     len(p.categoriesToUpdate),
+		CategoriesDeactivated: len(p.categoriesToDeactivate),
+		ProductsCreated:       len(p.productsToInsert),
+		ProductsUpdated:       len(p.productsToUpdate),
+		ProductsDeactivated:   len(p.productsToDeactivate),
+		Errors:                p.errors,
+	}
+}
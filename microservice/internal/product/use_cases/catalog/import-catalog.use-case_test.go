@@ -0,0 +1,253 @@
+package use_cases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/interfaces"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	use_cases "tech_challenge/internal/product/use_cases/catalog"
+)
+
+const (
+	lanchesID = "2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"
+	bebidasID = "123e4567-e89b-12d3-a456-426614174000"
+	xSaladaID = "76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"
+)
+
+type importMocks struct {
+	product     *mock_interfaces.MockIProductDataSource
+	category    *mock_interfaces.MockICategoryDataSource
+	transaction *mock_interfaces.MockITransactionManager
+}
+
+func newImportUseCase(t *testing.T) (*use_cases.ImportCatalogUseCase, importMocks) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mocks := importMocks{
+		product:     mock_interfaces.NewMockIProductDataSource(ctrl),
+		category:    mock_interfaces.NewMockICategoryDataSource(ctrl),
+		transaction: mock_interfaces.NewMockITransactionManager(ctrl),
+	}
+	fileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+
+	uc := use_cases.NewImportCatalogUseCase(
+		*gateways.NewProductGateway(mocks.product, fileProvider),
+		gateways.NewCategoryGateway(mocks.category),
+		gateways.NewTransactionGateway(mocks.transaction, fileProvider),
+		gateways.CatalogCacheGateway{},
+	)
+	return uc, mocks
+}
+
+func (m importMocks) expectTransaction() {
+	m.transaction.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(interfaces.TransactionDataSources) error) error {
+		return fn(interfaces.TransactionDataSources{Product: m.product, Category: m.category})
+	})
+}
+
+func (m importMocks) expectCatalog(categories []daos.CategoryDAO, products []daos.ProductDAO) {
+	m.category.EXPECT().FindAll().Return(categories, nil)
+	m.product.EXPECT().FindAll().Return(products, nil)
+}
+
+func existingCatalog() ([]daos.CategoryDAO, []daos.ProductDAO) {
+	return []daos.CategoryDAO{
+		{ID: lanchesID, ExternalKey: "lanches", Name: "Lanches", Position: 1, Active: true},
+	}, []daos.ProductDAO{
+		{ID: xSaladaID, ExternalKey: "x-salada", CategoryID: lanchesID, Name: "X-Salada", Description: "Lanche", Price: 20.5, Active: true},
+	}
+}
+
+func TestImportCatalogUseCase_DryRunDoesNotWrite(t *testing.T) {
+	uc, mocks := newImportUseCase(t)
+	mocks.expectCatalog(existingCatalog())
+
+	result, err := uc.Execute(dtos.ImportCatalogDTO{
+		DryRun: true,
+		Categories: []dtos.ImportCategoryDTO{
+			{Row: 1, ExternalKey: "bebidas", Name: "Bebidas", Active: true},
+			{Row: 2, ID: lanchesID, Name: "Lanches", Active: false},
+		},
+		Products: []dtos.ImportProductDTO{
+			{Row: 1, CategoryKey: "bebidas", Name: "Coca-Cola", Price: 5.99, Active: true},
+			{Row: 2, ExternalKey: "x-salada", CategoryID: lanchesID, Name: "X-Salada", Price: 22.0, Active: true},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, result.DryRun)
+	require.False(t, result.Applied)
+	require.Empty(t, result.Errors)
+	require.Equal(t, 1, result.CategoriesCreated)
+	require.Equal(t, 1, result.CategoriesUpdated)
+	require.Equal(t, 1, result.ProductsCreated)
+	require.Equal(t, 1, result.ProductsUpdated)
+}
+
+func TestImportCatalogUseCase_AppliesInTransaction(t *testing.T) {
+	uc, mocks := newImportUseCase(t)
+	mocks.expectTransaction()
+	mocks.expectCatalog(existingCatalog())
+
+	mocks.category.EXPECT().Insert(gomock.Any()).DoAndReturn(func(category daos.CategoryDAO) error {
+		require.Equal(t, "bebidas", category.ExternalKey)
+		require.Equal(t, "Bebidas", category.Name)
+		require.Equal(t, 2, category.Position)
+		return nil
+	})
+	mocks.product.EXPECT().Insert(gomock.Any()).DoAndReturn(func(product daos.ProductDAO) error {
+		require.Equal(t, "Coca-Cola", product.Name)
+		require.NotEqual(t, lanchesID, product.CategoryID)
+		return nil
+	})
+	mocks.product.EXPECT().Update(gomock.Any()).DoAndReturn(func(product daos.ProductDAO) error {
+		require.Equal(t, xSaladaID, product.ID)
+		require.Equal(t, "x-salada", product.ExternalKey)
+		require.Equal(t, 22.0, product.Price)
+		require.False(t, product.Active)
+		return nil
+	})
+
+	result, err := uc.Execute(dtos.ImportCatalogDTO{
+		Categories: []dtos.ImportCategoryDTO{
+			{Row: 1, ExternalKey: "bebidas", Name: "Bebidas", Active: true},
+		},
+		Products: []dtos.ImportProductDTO{
+			{Row: 1, CategoryKey: "bebidas", Name: "Coca-Cola", Price: 5.99, Active: true},
+			{Row: 2, ExternalKey: "x-salada", CategoryKey: "lanches", Name: "X-Salada", Price: 22.0, Active: false},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, result.Applied)
+	require.Equal(t, 1, result.CategoriesCreated)
+	require.Equal(t, 1, result.ProductsCreated)
+	require.Equal(t, 1, result.ProductsUpdated)
+}
+
+func TestImportCatalogUseCase_ReportsRowErrorsAndDoesNotApply(t *testing.T) {
+	uc, mocks := newImportUseCase(t)
+	mocks.expectTransaction()
+	mocks.expectCatalog(existingCatalog())
+
+	result, err := uc.Execute(dtos.ImportCatalogDTO{
+		Categories: []dtos.ImportCategoryDTO{
+			{Row: 1, Name: "AB", Active: true},
+			{Row: 2, ID: "not-a-uuid", Name: "Bebidas", Active: true},
+		},
+		Products: []dtos.ImportProductDTO{
+			{Row: 1, CategoryKey: "lanches", Name: "X", Price: 0},
+			{Row: 2, CategoryKey: "sobremesas", Name: "Sundae", Price: 9.9},
+			{Row: 3, Name: "Batata Frita", Price: 12},
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, result.Applied)
+	require.Equal(t, []dtos.ImportRowErrorDTO{
+		{Entity: "category", Row: 1, Field: "name", Message: "category name must have at least 3 characters"},
+		{Entity: "category", Row: 2, Field: "id", Message: "id must be a valid UUID"},
+		{Entity: "product", Row: 1, Field: "name", Message: "name must be at least 3 characters long"},
+		{Entity: "product", Row: 1, Field: "price", Message: "price must be greater than 0"},
+		{Entity: "product", Row: 2, Field: "category_id", Message: `category with external key "sobremesas" not found`},
+		{Entity: "product", Row: 3, Field: "category_id", Message: "category_id or category_key is required"},
+	}, result.Errors)
+}
+
+func TestImportCatalogUseCase_FileRowErrorsDoNotApply(t *testing.T) {
+	uc, mocks := newImportUseCase(t)
+	mocks.expectTransaction()
+	mocks.expectCatalog(existingCatalog())
+
+	result, err := uc.Execute(dtos.ImportCatalogDTO{
+		Products: []dtos.ImportProductDTO{
+			{Row: 3, CategoryKey: "lanches", Name: "X-Bacon", Price: 22.5, Active: true},
+		},
+		RowErrors: []dtos.ImportRowErrorDTO{
+			{Entity: "product", Row: 2, Field: "price", Message: `invalid price value "abc"`},
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, result.Applied)
+	require.Equal(t, 1, result.ProductsCreated)
+	require.Equal(t, []dtos.ImportRowErrorDTO{
+		{Entity: "product", Row: 2, Field: "price", Message: `invalid price value "abc"`},
+	}, result.Errors)
+}
+
+func TestImportCatalogUseCase_DuplicatedAndConflictingKeys(t *testing.T) {
+	uc, mocks := newImportUseCase(t)
+	mocks.expectCatalog(existingCatalog())
+
+	result, err := uc.Execute(dtos.ImportCatalogDTO{
+		DryRun: true,
+		Categories: []dtos.ImportCategoryDTO{
+			{Row: 1, ExternalKey: "bebidas", Name: "Bebidas", Active: true},
+			{Row: 2, ExternalKey: "bebidas", Name: "Refrigerantes", Active: true},
+			{Row: 3, ID: bebidasID, ExternalKey: "lanches", Name: "Sucos", Active: true},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 2)
+	require.Equal(t, 2, result.Errors[0].Row)
+	require.Equal(t, "external_key", result.Errors[0].Field)
+	require.Equal(t, 3, result.Errors[1].Row)
+	require.Contains(t, result.Errors[1].Message, "already belongs to another category")
+}
+
+func TestImportCatalogUseCase_DeactivateMissing(t *testing.T) {
+	uc, mocks := newImportUseCase(t)
+	mocks.expectTransaction()
+	categories, products := existingCatalog()
+	products = append(products, daos.ProductDAO{ID: "0d6c1f0e-4b7a-4e58-9f2a-1c3b5d7e9f01", CategoryID: lanchesID, Name: "X-Bacon", Price: 24.9, Active: true})
+	mocks.expectCatalog(categories, products)
+
+	mocks.category.EXPECT().Update(gomock.Any()).Return(nil)
+	gomock.InOrder(
+		mocks.product.EXPECT().Update(gomock.Any()).DoAndReturn(func(product daos.ProductDAO) error {
+			require.Equal(t, xSaladaID, product.ID)
+			require.True(t, product.Active)
+			return nil
+		}),
+		mocks.product.EXPECT().Update(gomock.Any()).DoAndReturn(func(product daos.ProductDAO) error {
+			require.Equal(t, "X-Bacon", product.Name)
+			require.False(t, product.Active)
+			return nil
+		}),
+	)
+
+	result, err := uc.Execute(dtos.ImportCatalogDTO{
+		DeactivateMissing: true,
+		Categories:        []dtos.ImportCategoryDTO{{Row: 1, ExternalKey: "lanches", Name: "Lanches", Active: true}},
+		Products:          []dtos.ImportProductDTO{{Row: 1, ID: xSaladaID, CategoryID: lanchesID, Name: "X-Salada", Price: 20.5, Active: true}},
+	})
+	require.NoError(t, err)
+	require.True(t, result.Applied)
+	require.Equal(t, 0, result.CategoriesDeactivated)
+	require.Equal(t, 1, result.ProductsDeactivated)
+}
+
+func TestImportCatalogUseCase_WriteErrorRollsBack(t *testing.T) {
+	uc, mocks := newImportUseCase(t)
+	mocks.expectTransaction()
+	mocks.expectCatalog(existingCatalog())
+	mocks.category.EXPECT().Insert(gomock.Any()).Return(errors.New("db error"))
+
+	result, err := uc.Execute(dtos.ImportCatalogDTO{
+		Categories: []dtos.ImportCategoryDTO{{Row: 1, Name: "Bebidas", Active: true}},
+	})
+	require.EqualError(t, err, "db error")
+	require.False(t, result.Applied)
+}
+
+func TestImportCatalogUseCase_FindAllError(t *testing.T) {
+	uc, mocks := newImportUseCase(t)
+	mocks.category.EXPECT().FindAll().Return(nil, errors.New("db error"))
+
+	_, err := uc.Execute(dtos.ImportCatalogDTO{DryRun: true})
+	require.EqualError(t, err, "db error")
+}
@@ -0,0 +1,84 @@
+package use_cases
+
+import (
+	"slices"
+	"time"
+
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type QuoteCatalogUseCase struct {
+	productGateway   gateways.ProductGateway
+	categoryGateway  gateways.CategoryGateway
+	stockGateway     gateways.StockGateway
+	promotionGateway gateways.PromotionGateway
+}
+
+func NewQuoteCatalogUseCase(
+	productGateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+	stockGateway gateways.StockGateway,
+	promotionGateway gateways.PromotionGateway,
+) *QuoteCatalogUseCase {
+	return &QuoteCatalogUseCase{
+		productGateway:   productGateway,
+		categoryGateway:  categoryGateway,
+		stockGateway:     stockGateway,
+		promotionGateway: promotionGateway,
+	}
+}
+
+// Execute cota os itens com as mesmas regras do cardápio, lendo só o que os
+// itens alcançam: os produtos pedidos, as categorias deles e as acima delas, o
+// estoque desses produtos e as promoções que miram algum deles. Os produtos
+// inativos também são carregados, para que a cotação diferencie um produto
+// inativo de um inexistente
+func (uc *QuoteCatalogUseCase) Execute(items []entities.QuoteItem, local time.Time, overrides entities.StoreOverrides) (entities.Quote, error) {
+	requestedIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		if !slices.Contains(requestedIDs, item.ProductID) {
+			requestedIDs = append(requestedIDs, item.ProductID)
+		}
+	}
+
+	products, err := uc.productGateway.FindAllByIDs(requestedIDs)
+	if err != nil {
+		return entities.Quote{}, err
+	}
+
+	overrides.ApplyToProducts(products)
+
+	productIDs := make([]string, 0, len(products))
+	productCategoryIDs := make([]string, 0, len(products))
+	for _, product := range products {
+		productIDs = append(productIDs, product.ID)
+		if !slices.Contains(productCategoryIDs, product.CategoryID) {
+			productCategoryIDs = append(productCategoryIDs, product.CategoryID)
+		}
+	}
+
+	categories, err := uc.categoryGateway.FindAllWithAncestors(productCategoryIDs)
+	if err != nil {
+		return entities.Quote{}, err
+	}
+
+	overrides.ApplyToCategories(categories)
+
+	categoryIDs := make([]string, 0, len(categories))
+	for _, category := range categories {
+		categoryIDs = append(categoryIDs, category.ID)
+	}
+
+	stocks, err := uc.stockGateway.FindAllByProductIDs(productIDs)
+	if err != nil {
+		return entities.Quote{}, err
+	}
+
+	promotions, err := uc.promotionGateway.FindAllByTargets(productIDs, categoryIDs)
+	if err != nil {
+		return entities.Quote{}, err
+	}
+
+	return entities.NewQuote(items, products, categories, stocks, promotions, local), nil
+}
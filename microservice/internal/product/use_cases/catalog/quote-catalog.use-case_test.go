@@ -0,0 +1,58 @@
+package use_cases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/entities"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	use_cases "tech_challenge/internal/product/use_cases/catalog"
+)
+
+func TestQuoteCatalogUseCase_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockStockDataSource := mock_interfaces.NewMockIStockDataSource(ctrl)
+	mockPromotionDataSource := mock_interfaces.NewMockIPromotionDataSource(ctrl)
+	// Só o que os itens alcançam é lido: nada de FindAll
+	mockProductDataSource.EXPECT().FindAllByIDs([]string{"pid", "old", "ghost"}).Return([]daos.ProductDAO{
+		{ID: "pid", CategoryID: "catid", Name: "Coca-Cola", Price: 5.99, Active: true},
+		{ID: "old", CategoryID: "catid", Name: "Guaraná", Price: 4.5, Active: false},
+	}, nil)
+	mockCategoryDataSource.EXPECT().FindAllWithAncestors([]string{"catid"}).Return([]daos.CategoryDAO{
+		{ID: "root", Name: "Cardápio", Active: true},
+		{ID: "catid", ParentID: "root", Name: "Bebidas", Active: true},
+	}, nil)
+	mockStockDataSource.EXPECT().FindAllByProductIDs([]string{"pid", "old"}).Return(nil, nil)
+	mockPromotionDataSource.EXPECT().FindAllByTargets([]string{"pid", "old"}, []string{"root", "catid"}).Return([]daos.PromotionDAO{{ID: "promo", Name: "Cardápio 10%", DiscountType: "percentage", DiscountValue: 10, CategoryIDs: []string{"root"}, Active: true}}, nil)
+
+	uc := use_cases.NewQuoteCatalogUseCase(*gateways.NewProductGateway(mockProductDataSource, mock_interfaces.NewMockIFileProvider(ctrl)), gateways.NewCategoryGateway(mockCategoryDataSource), gateways.NewStockGateway(mockStockDataSource, nil), gateways.NewPromotionGateway(mockPromotionDataSource))
+	quote, err := uc.Execute([]entities.QuoteItem{{ProductID: "pid", Quantity: 2}, {ProductID: "old", Quantity: 1}, {ProductID: "ghost", Quantity: 1}, {ProductID: "pid", Quantity: 1}}, time.Now(), entities.StoreOverrides{})
+	require.NoError(t, err)
+	require.Len(t, quote.Lines, 4)
+	require.Equal(t, 10.78, quote.Lines[0].Total)
+	require.Equal(t, entities.QuoteIssueInactive, quote.Lines[1].Issues[0].Code)
+	require.Equal(t, entities.QuoteIssueUnknown, quote.Lines[2].Issues[0].Code)
+	require.Equal(t, 16.17, quote.Total)
+	require.False(t, quote.IsOrderable())
+}
+
+func TestQuoteCatalogUseCase_ProductError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockProductDataSource.EXPECT().FindAllByIDs([]string{"pid"}).Return(nil, errors.New("fail"))
+
+	uc := use_cases.NewQuoteCatalogUseCase(*gateways.NewProductGateway(mockProductDataSource, mock_interfaces.NewMockIFileProvider(ctrl)), gateways.NewCategoryGateway(mockCategoryDataSource), gateways.NewStockGateway(mock_interfaces.NewMockIStockDataSource(ctrl), nil), gateways.NewPromotionGateway(mock_interfaces.NewMockIPromotionDataSource(ctrl)))
+	_, err := uc.Execute([]entities.QuoteItem{{ProductID: "pid", Quantity: 1}}, time.Now(), entities.StoreOverrides{})
+	require.Error(t, err)
+}
@@ -0,0 +1,147 @@
+package use_cases
+
+import (
+	"fmt"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+	product_use_cases "tech_challenge/internal/product/use_cases/product"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+type SeedCatalogUseCase struct {
+	productGateway     gateways.ProductGateway
+	categoryGateway    gateways.CategoryGateway
+	transactionGateway gateways.TransactionGateway
+	cacheGateway       gateways.CatalogCacheGateway
+}
+
+func NewSeedCatalogUseCase(
+	productGateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+	transactionGateway gateways.TransactionGateway,
+	cacheGateway gateways.CatalogCacheGateway,
+) *SeedCatalogUseCase {
+	return &SeedCatalogUseCase{
+		productGateway:     productGateway,
+		categoryGateway:    categoryGateway,
+		transactionGateway: transactionGateway,
+		cacheGateway:       cacheGateway,
+	}
+}
+
+func (uc *SeedCatalogUseCase) Execute(seedDTO dtos.SeedCatalogDTO) (dtos.SeedCatalogResultDTO, error) {
+	result := dtos.SeedCatalogResultDTO{}
+	importDTO := dtos.ImportCatalogDTO{}
+	categoryIDs := make(map[string]string, len(seedDTO.Categories))
+
+	for i, categoryDTO := range seedDTO.Categories {
+		id := stableID("category", categoryDTO.Key, categoryDTO.ID)
+		if id == "" {
+			return result, fmt.Errorf("category %q must have a key or an id", categoryDTO.Name)
+		}
+		categoryIDs[categoryDTO.Key] = id
+
+		importDTO.Categories = append(importDTO.Categories, dtos.ImportCategoryDTO{
+			Row:         i + 1,
+			ID:          id,
+			ExternalKey: categoryDTO.Key,
+			Name:        categoryDTO.Name,
+			Active:      categoryDTO.Active,
+		})
+	}
+
+	productIDs := make([]string, len(seedDTO.Products))
+	for i, productDTO := range seedDTO.Products {
+		id := stableID("product", productDTO.Key, productDTO.ID)
+		if id == "" {
+			return result, fmt.Errorf("product %q must have a key or an id", productDTO.Name)
+		}
+		productIDs[i] = id
+
+		categoryID, ok := categoryIDs[productDTO.CategoryKey]
+		if !ok {
+			return result, fmt.Errorf("product %q references unknown category %q", productDTO.Name, productDTO.CategoryKey)
+		}
+
+		importDTO.Products = append(importDTO.Products, dtos.ImportProductDTO{
+			Row:         i + 1,
+			ID:          id,
+			ExternalKey: productDTO.Key,
+			CategoryID:  categoryID,
+			Name:        productDTO.Name,
+			Description: productDTO.Description,
+			Price:       productDTO.Price,
+			Active:      productDTO.Active,
+		})
+	}
+
+	importResult, err := NewImportCatalogUseCase(uc.productGateway, uc.categoryGateway, uc.transactionGateway, uc.cacheGateway).Execute(importDTO)
+	result.ImportCatalogResultDTO = importResult
+	if err != nil {
+		return result, err
+	}
+
+	if len(importResult.Errors) > 0 {
+		rowError := importResult.Errors[0]
+		return result, fmt.Errorf("invalid %s #%d: %s: %s", rowError.Entity, rowError.Row, rowError.Field, rowError.Message)
+	}
+
+	uploadImageUseCase := product_use_cases.NewUploadProductImageUseCase(uc.productGateway, uc.cacheGateway)
+
+	for i, productDTO := range seedDTO.Products {
+		if len(productDTO.Images) == 0 {
+			continue
+		}
+
+		// Produto que já tem imagem enviada não recebe as imagens de novo
+		hasImages, err := uc.hasUploadedImages(productIDs[i])
+		if err != nil {
+			return result, err
+		}
+		if hasImages {
+			result.ImagesSkipped += len(productDTO.Images)
+			continue
+		}
+
+		for _, image := range productDTO.Images {
+			err := uploadImageUseCase.Execute(dtos.UploadProductImageDTO{
+				ProductID:   productIDs[i],
+				FileName:    image.FileName,
+				FileContent: image.FileContent,
+			})
+			if err != nil {
+				return result, err
+			}
+			result.ImagesUploaded++
+		}
+	}
+
+	return result, nil
+}
+
+func (uc *SeedCatalogUseCase) hasUploadedImages(productID string) (bool, error) {
+	product, err := uc.productGateway.FindAllImagesProductById(productID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, image := range product.Images {
+		if image.FileName != value_objects.DEFAULT_IMAGE_FILE_NAME {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func stableID(kind, key, id string) string {
+	if id != "" {
+		return id
+	}
+	if key == "" {
+		return ""
+	}
+	return identity_manager.NewUUIDFromKey(kind + ":" + key)
+}
@@ -0,0 +1,148 @@
+package use_cases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	use_cases "tech_challenge/internal/product/use_cases/catalog"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+func seedDTO() dtos.SeedCatalogDTO {
+	return dtos.SeedCatalogDTO{
+		Categories: []dtos.SeedCategoryDTO{{Key: "bebidas", Name: "Bebidas", Active: true}},
+		Products: []dtos.SeedProductDTO{{
+			Key:         "coca-cola",
+			CategoryKey: "bebidas",
+			Name:        "Coca-Cola",
+			Description: "Refrigerante lata 350ml",
+			Price:       5.99,
+			Active:      true,
+			Images:      []dtos.SeedImageDTO{{FileName: "coca-cola.png", FileContent: []byte("png")}},
+		}},
+	}
+}
+
+func newSeedUseCase(t *testing.T) (*use_cases.SeedCatalogUseCase, importMocks, *mock_interfaces.MockIFileProvider) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mocks := importMocks{
+		product:     mock_interfaces.NewMockIProductDataSource(ctrl),
+		category:    mock_interfaces.NewMockICategoryDataSource(ctrl),
+		transaction: mock_interfaces.NewMockITransactionManager(ctrl),
+	}
+	fileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+
+	uc := use_cases.NewSeedCatalogUseCase(
+		*gateways.NewProductGateway(mocks.product, fileProvider),
+		gateways.NewCategoryGateway(mocks.category),
+		gateways.NewTransactionGateway(mocks.transaction, fileProvider),
+		gateways.CatalogCacheGateway{},
+	)
+	return uc, mocks, fileProvider
+}
+
+func TestSeedCatalogUseCase_CreatesAndUploadsImages(t *testing.T) {
+	uc, mocks, mockFileProvider := newSeedUseCase(t)
+
+	categoryID := identity_manager.NewUUIDFromKey("category:bebidas")
+	productID := identity_manager.NewUUIDFromKey("product:coca-cola")
+
+	mocks.expectTransaction()
+	mocks.expectCatalog(nil, nil)
+	mocks.category.EXPECT().Insert(gomock.Any()).DoAndReturn(func(category daos.CategoryDAO) error {
+		require.False(t, category.UpdatedAt.IsZero())
+		category.UpdatedAt = time.Time{}
+		require.Equal(t, daos.CategoryDAO{ID: categoryID, ExternalKey: "bebidas", Name: "Bebidas", Position: 1, Active: true, Version: 1}, category)
+		return nil
+	})
+	mocks.product.EXPECT().Insert(gomock.Any()).DoAndReturn(func(product daos.ProductDAO) error {
+		require.Equal(t, productID, product.ID)
+		require.Equal(t, "coca-cola", product.ExternalKey)
+		require.Equal(t, categoryID, product.CategoryID)
+		return nil
+	})
+	mocks.product.EXPECT().FindAllImagesProductById(productID).Return([]daos.ProductImageDAO{
+		{ID: "img", ProductID: productID, FileName: value_objects.DEFAULT_IMAGE_FILE_NAME, IsDefault: true},
+	}, nil)
+	mocks.product.EXPECT().FindByID(productID).Return(daos.ProductDAO{ID: productID, CategoryID: categoryID, Name: "Coca-Cola", Price: 5.99, Active: true}, nil)
+	mockFileProvider.EXPECT().UploadFile(gomock.Any(), []byte("png")).Return(nil)
+	mockFileProvider.EXPECT().GetPresignedURL(gomock.Any()).Return("http://localhost/coca-cola.png", nil)
+	mocks.product.EXPECT().AddProductImage(gomock.Any()).Return(nil)
+	mocks.product.EXPECT().SetAllPreviousImagesAsNotDefault(productID, gomock.Any()).Return(nil)
+	mocks.product.EXPECT().Update(gomock.Any()).Return(nil)
+
+	result, err := uc.Execute(seedDTO())
+	require.NoError(t, err)
+	require.Equal(t, 1, result.CategoriesCreated)
+	require.Equal(t, 1, result.ProductsCreated)
+	require.Equal(t, 1, result.ImagesUploaded)
+	require.Equal(t, 0, result.ImagesSkipped)
+}
+
+func TestSeedCatalogUseCase_RerunSkipsExistingImages(t *testing.T) {
+	uc, mocks, _ := newSeedUseCase(t)
+
+	categoryID := identity_manager.NewUUIDFromKey("category:bebidas")
+	productID := identity_manager.NewUUIDFromKey("product:coca-cola")
+
+	mocks.expectTransaction()
+	mocks.expectCatalog(
+		[]daos.CategoryDAO{{ID: categoryID, ExternalKey: "bebidas", Name: "Bebidas", Active: true}},
+		[]daos.ProductDAO{{ID: productID, ExternalKey: "coca-cola", CategoryID: categoryID, Name: "Coca-Cola", Price: 5.99, Active: true}},
+	)
+	mocks.category.EXPECT().Update(gomock.Any()).DoAndReturn(func(category daos.CategoryDAO) error {
+		require.False(t, category.UpdatedAt.IsZero())
+		category.UpdatedAt = time.Time{}
+		require.Equal(t, daos.CategoryDAO{ID: categoryID, ExternalKey: "bebidas", Name: "Bebidas", Active: true}, category)
+		return nil
+	})
+	mocks.product.EXPECT().Update(gomock.Any()).Return(nil)
+	mocks.product.EXPECT().FindAllImagesProductById(productID).Return([]daos.ProductImageDAO{
+		{ID: "img", ProductID: productID, FileName: "coca-cola_123.png", IsDefault: true},
+	}, nil)
+
+	result, err := uc.Execute(seedDTO())
+	require.NoError(t, err)
+	require.Equal(t, 1, result.CategoriesUpdated)
+	require.Equal(t, 1, result.ProductsUpdated)
+	require.Equal(t, 0, result.ImagesUploaded)
+	require.Equal(t, 1, result.ImagesSkipped)
+}
+
+func TestSeedCatalogUseCase_InvalidFixtureRow(t *testing.T) {
+	uc, mocks, _ := newSeedUseCase(t)
+	mocks.expectTransaction()
+	mocks.expectCatalog(nil, nil)
+
+	seed := seedDTO()
+	seed.Products[0].Price = 0
+
+	_, err := uc.Execute(seed)
+	require.ErrorContains(t, err, "invalid product #1: price")
+}
+
+func TestSeedCatalogUseCase_UnknownCategoryKey(t *testing.T) {
+	uc, _, _ := newSeedUseCase(t)
+
+	seed := seedDTO()
+	seed.Products[0].CategoryKey = "lanches"
+
+	_, err := uc.Execute(seed)
+	require.ErrorContains(t, err, `unknown category "lanches"`)
+}
+
+func TestSeedCatalogUseCase_MissingKey(t *testing.T) {
+	uc, _, _ := newSeedUseCase(t)
+
+	_, err := uc.Execute(dtos.SeedCatalogDTO{Categories: []dtos.SeedCategoryDTO{{Name: "Bebidas"}}})
+	require.Error(t, err)
+}
@@ -1,32 +1,79 @@
 package use_cases
 
 import (
+	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/application/presenters"
 	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
 	identity_manager "tech_challenge/internal/shared/pkg/identity"
 )
 
 type CreateCategoryUseCase struct {
-	gateway gateways.CategoryGateway
+	gateway      gateways.CategoryGateway
+	cacheGateway gateways.CatalogCacheGateway
 }
 
-func NewCreateCategoryUseCase(gateway gateways.CategoryGateway) *CreateCategoryUseCase {
+func NewCreateCategoryUseCase(gateway gateways.CategoryGateway, cacheGateway gateways.CatalogCacheGateway) *CreateCategoryUseCase {
 	return &CreateCategoryUseCase{
-		gateway: gateway,
+		gateway:      gateway,
+		cacheGateway: cacheGateway,
 	}
 }
 
-func (uc *CreateCategoryUseCase) Execute(name string, active bool) (entities.Category, error) {
+func (uc *CreateCategoryUseCase) Execute(categoryDTO dtos.CreateCategoryDTO) (entities.Category, error) {
 	category, err := entities.NewCategory(
 		identity_manager.NewUUIDV4(),
-		name,
-		active,
+		categoryDTO.Name,
+		categoryDTO.Active,
 	)
 
 	if err != nil {
 		return entities.Category{}, err
 	}
 
+	if err = category.SetDescription(categoryDTO.Description); err != nil {
+		return entities.Category{}, err
+	}
+
+	if category.Availability, err = presenters.AvailabilityFromDTOToDomain(categoryDTO.Availability); err != nil {
+		return entities.Category{}, err
+	}
+
+	categories, err := uc.gateway.FindAll()
+
+	if err != nil {
+		return entities.Category{}, err
+	}
+
+	if err = ensureUniqueCategoryName(categories, *category); err != nil {
+		return entities.Category{}, err
+	}
+
+	tree := entities.NewCategoryTree(categories)
+
+	if err = tree.ValidateParent("", categoryDTO.ParentID); err != nil {
+		return entities.Category{}, err
+	}
+
+	if err = tree.ValidateActive(categoryDTO.ParentID, categoryDTO.Active); err != nil {
+		return entities.Category{}, err
+	}
+
+	category.ParentID = categoryDTO.ParentID
+
+	// Novas categorias entram no fim do cardápio
+	lastPosition := 0
+	for _, existing := range categories {
+		lastPosition = max(lastPosition, existing.Position)
+	}
+
+	if err = category.SetPosition(lastPosition + 1); err != nil {
+		return entities.Category{}, err
+	}
+
+	defer uc.cacheGateway.Invalidate()
+
 	err = uc.gateway.Insert(*category)
 
 	if err != nil {
@@ -35,3 +82,16 @@ func (uc *CreateCategoryUseCase) Execute(name string, active bool) (entities.Cat
 
 	return *category, nil
 }
+
+// Nomes de categoria são únicos ignorando maiúsculas e acentos
+func ensureUniqueCategoryName(categories []*entities.Category, category entities.Category) error {
+	for _, existing := range categories {
+		if existing.ID != category.ID && existing.Name.Key() == category.Name.Key() {
+			return &exceptions.CategoryAlreadyExistsException{
+				Message: "Category %q already exists", Args: []any{existing.Name.Value()},
+			}
+		}
+	}
+
+	return nil
+}
@@ -1,26 +1,145 @@
 package use_cases_test
 
 import (
+	"errors"
+	"os"
+	"strings"
 	"testing"
 
+	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
 	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
 	category "tech_challenge/internal/product/use_cases/category"
+	testenv "tech_challenge/internal/shared/test"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
+func TestMain(m *testing.M) {
+	testenv.SetupTestEnv()
+	code := m.Run()
+	os.Exit(code)
+}
+
 func TestCreateCategoryUseCase_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockCategoryDataSource.EXPECT().FindAll().Return(nil, nil)
 	mockCategoryDataSource.EXPECT().Insert(gomock.Any()).Return(nil)
 
 	categoryGateway := gateways.NewCategoryGateway(mockCategoryDataSource)
-	uc := category.NewCreateCategoryUseCase(categoryGateway)
-	cat, err := uc.Execute("Bebidas", true)
+	uc := category.NewCreateCategoryUseCase(categoryGateway, gateways.CatalogCacheGateway{})
+	cat, err := uc.Execute(dtos.CreateCategoryDTO{Name: "Bebidas", Description: "Bebidas geladas", Active: true})
 	require.NoError(t, err)
 	require.Equal(t, "Bebidas", cat.Name.Value())
+	require.Equal(t, "Bebidas geladas", cat.Description)
+	require.Equal(t, 1, cat.Position)
 	require.True(t, cat.Active)
 }
+
+func TestCreateCategoryUseCase_AppendsToTheEnd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockCategoryDataSource.EXPECT().FindAll().Return([]daos.CategoryDAO{
+		{ID: "cat-1", Name: "Lanches", Position: 3},
+		{ID: "cat-2", Name: "Bebidas", Position: 1},
+	}, nil)
+	mockCategoryDataSource.EXPECT().Insert(gomock.Any()).DoAndReturn(func(dao daos.CategoryDAO) error {
+		require.Equal(t, 4, dao.Position)
+		return nil
+	})
+
+	uc := category.NewCreateCategoryUseCase(gateways.NewCategoryGateway(mockCategoryDataSource), gateways.CatalogCacheGateway{})
+	cat, err := uc.Execute(dtos.CreateCategoryDTO{Name: "Sobremesas", Active: true})
+	require.NoError(t, err)
+	require.Equal(t, 4, cat.Position)
+}
+
+func TestCreateCategoryUseCase_InvalidDescription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+
+	uc := category.NewCreateCategoryUseCase(gateways.NewCategoryGateway(mockCategoryDataSource), gateways.CatalogCacheGateway{})
+	_, err := uc.Execute(dtos.CreateCategoryDTO{Name: "Bebidas", Description: strings.Repeat("a", 256)})
+	require.EqualError(t, err, "category description must have at most 255 characters")
+}
+
+func TestCreateCategoryUseCase_FindAllError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockCategoryDataSource.EXPECT().FindAll().Return(nil, errors.New("db error"))
+
+	uc := category.NewCreateCategoryUseCase(gateways.NewCategoryGateway(mockCategoryDataSource), gateways.CatalogCacheGateway{})
+	_, err := uc.Execute(dtos.CreateCategoryDTO{Name: "Bebidas", Active: true})
+	require.EqualError(t, err, "db error")
+}
+
+func TestCreateCategoryUseCase_WithParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockCategoryDataSource.EXPECT().FindAll().Return([]daos.CategoryDAO{
+		{ID: "cat-1", Name: "Bebidas", Position: 1, Active: true},
+	}, nil)
+	mockCategoryDataSource.EXPECT().Insert(gomock.Any()).DoAndReturn(func(dao daos.CategoryDAO) error {
+		require.Equal(t, "cat-1", dao.ParentID)
+		return nil
+	})
+
+	uc := category.NewCreateCategoryUseCase(gateways.NewCategoryGateway(mockCategoryDataSource), gateways.CatalogCacheGateway{})
+	cat, err := uc.Execute(dtos.CreateCategoryDTO{ParentID: "cat-1", Name: "Refrigerantes", Active: true})
+	require.NoError(t, err)
+	require.Equal(t, "cat-1", cat.ParentID)
+}
+
+func TestCreateCategoryUseCase_InvalidParent(t *testing.T) {
+	categories := []daos.CategoryDAO{
+		{ID: "cat-1", Name: "Bebidas", Active: false},
+		{ID: "cat-2", ParentID: "cat-1", Name: "Refrigerantes"},
+		{ID: "cat-3", ParentID: "cat-2", Name: "Latas"},
+	}
+
+	cases := []struct {
+		name     string
+		dto      dtos.CreateCategoryDTO
+		expected string
+	}{
+		{"parent not found", dtos.CreateCategoryDTO{ParentID: "cat-9", Name: "Sucos"}, "Parent category cat-9 not found"},
+		{"too deep", dtos.CreateCategoryDTO{ParentID: "cat-3", Name: "Zero"}, "categories can be nested at most 3 levels deep"},
+		{"inactive parent", dtos.CreateCategoryDTO{ParentID: "cat-1", Name: "Sucos", Active: true}, "subcategory cannot be active while its parent category is inactive"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+			mockCategoryDataSource.EXPECT().FindAll().Return(categories, nil)
+
+			uc := category.NewCreateCategoryUseCase(gateways.NewCategoryGateway(mockCategoryDataSource), gateways.CatalogCacheGateway{})
+			_, err := uc.Execute(c.dto)
+			require.EqualError(t, err, c.expected)
+		})
+	}
+}
+
+func TestCreateCategoryUseCase_DuplicatedName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockCategoryDataSource.EXPECT().FindAll().Return([]daos.CategoryDAO{
+		{ID: "cat-1", Name: "Bebidas", Position: 1, Active: true},
+	}, nil)
+
+	uc := category.NewCreateCategoryUseCase(gateways.NewCategoryGateway(mockCategoryDataSource), gateways.CatalogCacheGateway{})
+	_, err := uc.Execute(dtos.CreateCategoryDTO{Name: " BEBÍDAS ", Active: true})
+	require.IsType(t, &exceptions.CategoryAlreadyExistsException{}, err)
+	require.EqualError(t, err, `Category "Bebidas" already exists`)
+}
@@ -0,0 +1,43 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+type DeleteCategoryImageUseCase struct {
+	gateway      gateways.CategoryGateway
+	fileGateway  gateways.FileGateway
+	cacheGateway gateways.CatalogCacheGateway
+}
+
+func NewDeleteCategoryImageUseCase(gateway gateways.CategoryGateway, fileGateway gateways.FileGateway, cacheGateway gateways.CatalogCacheGateway) *DeleteCategoryImageUseCase {
+	return &DeleteCategoryImageUseCase{
+		gateway:      gateway,
+		fileGateway:  fileGateway,
+		cacheGateway: cacheGateway,
+	}
+}
+
+func (uc *DeleteCategoryImageUseCase) Execute(categoryID string) error {
+	category, err := uc.gateway.FindByID(categoryID)
+	if err != nil {
+		if exceptions.IsRecordNotFound(err) {
+			return &exceptions.CategoryNotFoundException{}
+		}
+		return err
+	}
+
+	removed, err := category.RemoveImage()
+	if err != nil {
+		return err
+	}
+
+	defer uc.cacheGateway.Invalidate()
+
+	if err := uc.gateway.Update(category); err != nil {
+		return err
+	}
+
+	return uc.fileGateway.DeleteImage(removed.FileName)
+}
@@ -1,32 +1,218 @@
 package use_cases
 
 import (
+	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
 	"tech_challenge/internal/product/domain/exceptions"
 )
 
+const (
+	DeleteCategoryStrategyRestrict   = "restrict"
+	DeleteCategoryStrategyReassign   = "reassign"
+	DeleteCategoryStrategyDeactivate = "deactivate"
+)
+
 type DeleteCategoryUseCase struct {
-	gateway gateways.CategoryGateway
+	gateway            gateways.CategoryGateway
+	transactionGateway gateways.TransactionGateway
+	cacheGateway       gateways.CatalogCacheGateway
 }
 
-func NewDeleteCategoryUseCase(gateway gateways.CategoryGateway) *DeleteCategoryUseCase {
+func NewDeleteCategoryUseCase(gateway gateways.CategoryGateway, transactionGateway gateways.TransactionGateway, cacheGateway gateways.CatalogCacheGateway) *DeleteCategoryUseCase {
 	return &DeleteCategoryUseCase{
-		gateway: gateway,
+		gateway:            gateway,
+		transactionGateway: transactionGateway,
+		cacheGateway:       cacheGateway,
 	}
 }
 
-func (uc *DeleteCategoryUseCase) Execute(id string) error {
-	category, err := uc.gateway.FindByID(id)
+func (uc *DeleteCategoryUseCase) Execute(deleteDTO dtos.DeleteCategoryDTO) (dtos.DeleteCategoryResultDTO, error) {
+	strategy := deleteDTO.Strategy
+	if strategy == "" {
+		strategy = DeleteCategoryStrategyRestrict
+	}
+
+	if strategy != DeleteCategoryStrategyRestrict && strategy != DeleteCategoryStrategyReassign && strategy != DeleteCategoryStrategyDeactivate {
+		return dtos.DeleteCategoryResultDTO{}, &exceptions.InvalidCategoryDataException{
+			Message: "strategy must be %s, %s or %s", Args: []any{DeleteCategoryStrategyRestrict, DeleteCategoryStrategyReassign, DeleteCategoryStrategyDeactivate},
+		}
+	}
+
+	if strategy != DeleteCategoryStrategyReassign && deleteDTO.TargetCategoryID != "" {
+		return dtos.DeleteCategoryResultDTO{}, &exceptions.InvalidCategoryDataException{
+			Message: "target_category_id is only allowed with the reassign strategy",
+		}
+	}
+
+	category, err := uc.gateway.FindByID(deleteDTO.ID)
 
 	if err != nil {
-		return &exceptions.CategoryNotFoundException{}
+		if exceptions.IsRecordNotFound(err) {
+			return dtos.DeleteCategoryResultDTO{}, &exceptions.CategoryNotFoundException{}
+		}
+		return dtos.DeleteCategoryResultDTO{}, err
+	}
+
+	if !deleteDTO.Precondition.Matches(category.Version) {
+		return dtos.DeleteCategoryResultDTO{}, &exceptions.VersionConflictException{}
+	}
+
+	categories, err := uc.gateway.FindAll()
+
+	if err != nil {
+		return dtos.DeleteCategoryResultDTO{}, err
 	}
 
-	err = uc.gateway.Delete(category.ID)
+	tree := entities.NewCategoryTree(categories)
+
+	result := dtos.DeleteCategoryResultDTO{
+		CategoryID:            category.ID,
+		Strategy:              strategy,
+		DeactivatedCategories: []string{},
+		AffectedProducts:      []string{},
+	}
+
+	defer uc.cacheGateway.Invalidate()
+
+	switch strategy {
+	case DeleteCategoryStrategyDeactivate:
+		err = uc.deactivate(tree, category, &result)
+	case DeleteCategoryStrategyReassign:
+		err = uc.reassign(tree, category, deleteDTO.TargetCategoryID, &result)
+	default:
+		err = uc.restrict(tree, category, &result)
+	}
 
 	if err != nil {
-		return err
+		return dtos.DeleteCategoryResultDTO{}, err
 	}
 
+	return result, nil
+}
+
+func (uc *DeleteCategoryUseCase) restrict(tree *entities.CategoryTree, category *entities.Category, result *dtos.DeleteCategoryResultDTO) error {
+	if len(tree.Children(category.ID)) > 0 {
+		return &exceptions.CategoryHasChildrenException{}
+	}
+
+	// Com produtos vinculados a FK impede a remoção e o erro do banco vira
+	// CategoryHasProductsException. A remoção só acontece se a versão lida
+	// ainda for a do banco
+	if err := uc.gateway.Delete(category.ID, category.Version); err != nil {
+		return deleteError(err)
+	}
+
+	result.Deleted = true
 	return nil
 }
+
+func (uc *DeleteCategoryUseCase) reassign(tree *entities.CategoryTree, category *entities.Category, targetCategoryID string, result *dtos.DeleteCategoryResultDTO) error {
+	if len(tree.Children(category.ID)) > 0 {
+		return &exceptions.CategoryHasChildrenException{}
+	}
+
+	if targetCategoryID == "" {
+		return &exceptions.InvalidCategoryDataException{
+			Message: "target_category_id is required for the reassign strategy",
+		}
+	}
+
+	if targetCategoryID == category.ID {
+		return &exceptions.InvalidCategoryDataException{
+			Message: "target_category_id must be different from the deleted category",
+		}
+	}
+
+	if _, ok := tree.Find(targetCategoryID); !ok {
+		return &exceptions.CategoryNotFoundException{
+			Message: "Target category %s not found", Args: []any{targetCategoryID},
+		}
+	}
+
+	result.TargetCategoryID = targetCategoryID
+
+	return uc.transactionGateway.Run(func(gateways gateways.TransactionGateways) error {
+		products, err := gateways.Product.FindAllByCategoryID(category.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, product := range products {
+			if err := product.SetCategory(targetCategoryID); err != nil {
+				return err
+			}
+
+			if err := gateways.Product.Update(&product); err != nil {
+				return err
+			}
+
+			result.AffectedProducts = append(result.AffectedProducts, product.ID)
+		}
+
+		if err := gateways.Category.Delete(category.ID, category.Version); err != nil {
+			return deleteError(err)
+		}
+
+		result.Deleted = true
+		return nil
+	})
+}
+
+// deleteError traduz a categoria removida por outra requisição entre a leitura
+// e a remoção
+func deleteError(err error) error {
+	if exceptions.IsRecordNotFound(err) {
+		return &exceptions.CategoryNotFoundException{}
+	}
+	return err
+}
+
+// deactivate mantém a categoria no banco, desativando-a junto com as
+// subcategorias e os produtos de toda a subárvore
+func (uc *DeleteCategoryUseCase) deactivate(tree *entities.CategoryTree, category *entities.Category, result *dtos.DeleteCategoryResultDTO) error {
+	categoriesToDeactivate := append([]*entities.Category{category}, tree.Descendants(category.ID)...)
+
+	categoryIDs := make([]string, 0, len(categoriesToDeactivate))
+	for _, categoryToDeactivate := range categoriesToDeactivate {
+		categoryIDs = append(categoryIDs, categoryToDeactivate.ID)
+	}
+
+	return uc.transactionGateway.Run(func(gateways gateways.TransactionGateways) error {
+		products, err := gateways.Product.FindAllByCategoryIDs(categoryIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, product := range products {
+			if !product.Active {
+				continue
+			}
+
+			if err := product.Deactivate(); err != nil {
+				return err
+			}
+
+			if err := gateways.Product.Update(&product); err != nil {
+				return err
+			}
+
+			result.AffectedProducts = append(result.AffectedProducts, product.ID)
+		}
+
+		for _, categoryToDeactivate := range categoriesToDeactivate {
+			if !categoryToDeactivate.Active {
+				continue
+			}
+
+			categoryToDeactivate.Active = false
+			if err := gateways.Category.Update(categoryToDeactivate); err != nil {
+				return err
+			}
+
+			result.DeactivatedCategories = append(result.DeactivatedCategories, categoryToDeactivate.ID)
+		}
+
+		return nil
+	})
+}
@@ -1,10 +1,14 @@
 package use_cases_test
 
 import (
+	"errors"
 	"testing"
 
+	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/application/gateways"
 	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/interfaces"
 	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
 	category "tech_challenge/internal/product/use_cases/category"
 
@@ -12,17 +16,185 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestDeleteCategoryUseCase_Success(t *testing.T) {
+type deleteMocks struct {
+	product     *mock_interfaces.MockIProductDataSource
+	category    *mock_interfaces.MockICategoryDataSource
+	transaction *mock_interfaces.MockITransactionManager
+}
+
+func newDeleteCategoryUseCase(t *testing.T) (*category.DeleteCategoryUseCase, deleteMocks) {
 	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+	t.Cleanup(ctrl.Finish)
+	mocks := deleteMocks{
+		product:     mock_interfaces.NewMockIProductDataSource(ctrl),
+		category:    mock_interfaces.NewMockICategoryDataSource(ctrl),
+		transaction: mock_interfaces.NewMockITransactionManager(ctrl),
+	}
 
-	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
-	categoryID := "cat-1"
-	mockCategoryDataSource.EXPECT().FindByID(categoryID).Return(daos.CategoryDAO{ID: categoryID, Name: "Categoria Teste", Active: true}, nil)
-	mockCategoryDataSource.EXPECT().Delete(gomock.Any()).Return(nil)
+	uc := category.NewDeleteCategoryUseCase(
+		gateways.NewCategoryGateway(mocks.category),
+		gateways.NewTransactionGateway(mocks.transaction, mock_interfaces.NewMockIFileProvider(ctrl)),
+		gateways.CatalogCacheGateway{},
+	)
+	return uc, mocks
+}
+
+func (m deleteMocks) expectTransaction() {
+	m.transaction.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(interfaces.TransactionDataSources) error) error {
+		return fn(interfaces.TransactionDataSources{Product: m.product, Category: m.category})
+	})
+}
 
-	categoryGateway := gateways.NewCategoryGateway(mockCategoryDataSource)
-	uc := category.NewDeleteCategoryUseCase(categoryGateway)
-	err := uc.Execute(categoryID)
+func (m deleteMocks) expectHierarchy(id string) {
+	categories := categoryHierarchy()
+	for _, dao := range categories {
+		if dao.ID == id {
+			m.category.EXPECT().FindByID(id).Return(dao, nil)
+		}
+	}
+	m.category.EXPECT().FindAll().Return(categories, nil)
+}
+
+func TestDeleteCategoryUseCase_Success(t *testing.T) {
+	uc, mocks := newDeleteCategoryUseCase(t)
+	mocks.expectHierarchy("cat-4")
+	mocks.category.EXPECT().Delete("cat-4", int64(0)).Return(nil)
+
+	result, err := uc.Execute(dtos.DeleteCategoryDTO{ID: "cat-4"})
 	require.NoError(t, err)
+	require.True(t, result.Deleted)
+	require.Equal(t, category.DeleteCategoryStrategyRestrict, result.Strategy)
+	require.Empty(t, result.AffectedProducts)
+}
+
+func TestDeleteCategoryUseCase_ChangedBeforeDelete(t *testing.T) {
+	uc, mocks := newDeleteCategoryUseCase(t)
+	mocks.expectHierarchy("cat-4")
+	// Outra requisição alterou a categoria depois da leitura
+	mocks.category.EXPECT().Delete("cat-4", int64(0)).Return(&exceptions.VersionConflictException{})
+
+	result, err := uc.Execute(dtos.DeleteCategoryDTO{ID: "cat-4"})
+	require.IsType(t, &exceptions.VersionConflictException{}, err)
+	require.False(t, result.Deleted)
+}
+
+func TestDeleteCategoryUseCase_NotFound(t *testing.T) {
+	uc, mocks := newDeleteCategoryUseCase(t)
+	mocks.category.EXPECT().FindByID("cat-9").Return(daos.CategoryDAO{}, &exceptions.RecordNotFoundException{})
+
+	_, err := uc.Execute(dtos.DeleteCategoryDTO{ID: "cat-9"})
+	require.IsType(t, &exceptions.CategoryNotFoundException{}, err)
+}
+
+func TestDeleteCategoryUseCase_HasChildren(t *testing.T) {
+	for _, strategy := range []string{category.DeleteCategoryStrategyRestrict, category.DeleteCategoryStrategyReassign} {
+		t.Run(strategy, func(t *testing.T) {
+			uc, mocks := newDeleteCategoryUseCase(t)
+			mocks.expectHierarchy("cat-1")
+
+			_, err := uc.Execute(dtos.DeleteCategoryDTO{ID: "cat-1", Strategy: strategy, TargetCategoryID: ""})
+			require.IsType(t, &exceptions.CategoryHasChildrenException{}, err)
+		})
+	}
+}
+
+func TestDeleteCategoryUseCase_InvalidStrategy(t *testing.T) {
+	uc, _ := newDeleteCategoryUseCase(t)
+
+	_, err := uc.Execute(dtos.DeleteCategoryDTO{ID: "cat-1", Strategy: "cascade"})
+	require.EqualError(t, err, "strategy must be restrict, reassign or deactivate")
+
+	_, err = uc.Execute(dtos.DeleteCategoryDTO{ID: "cat-1", Strategy: "restrict", TargetCategoryID: "cat-4"})
+	require.EqualError(t, err, "target_category_id is only allowed with the reassign strategy")
+}
+
+func TestDeleteCategoryUseCase_Reassign(t *testing.T) {
+	uc, mocks := newDeleteCategoryUseCase(t)
+	mocks.expectHierarchy("cat-3")
+	mocks.expectTransaction()
+	mocks.product.EXPECT().FindAllByCategoryID("cat-3").Return([]daos.ProductDAO{
+		{ID: "pid-1", CategoryID: "cat-3", Name: "Coca-Cola", Price: 5.99, Active: true},
+		{ID: "pid-2", CategoryID: "cat-3", Name: "Guaraná", Price: 5.49, Active: false},
+	}, nil)
+	mocks.product.EXPECT().Update(gomock.Any()).Times(2).DoAndReturn(func(product daos.ProductDAO) error {
+		require.Equal(t, "cat-2", product.CategoryID)
+		return nil
+	})
+	mocks.category.EXPECT().Delete("cat-3", int64(0)).Return(nil)
+
+	result, err := uc.Execute(dtos.DeleteCategoryDTO{ID: "cat-3", Strategy: "reassign", TargetCategoryID: "cat-2"})
+	require.NoError(t, err)
+	require.True(t, result.Deleted)
+	require.Equal(t, "cat-2", result.TargetCategoryID)
+	require.Equal(t, []string{"pid-1", "pid-2"}, result.AffectedProducts)
+}
+
+func TestDeleteCategoryUseCase_ReassignInvalidTarget(t *testing.T) {
+	cases := []struct {
+		name     string
+		target   string
+		expected string
+	}{
+		{"missing", "", "target_category_id is required for the reassign strategy"},
+		{"same category", "cat-3", "target_category_id must be different from the deleted category"},
+		{"not found", "cat-9", "Target category cat-9 not found"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			uc, mocks := newDeleteCategoryUseCase(t)
+			mocks.expectHierarchy("cat-3")
+
+			_, err := uc.Execute(dtos.DeleteCategoryDTO{ID: "cat-3", Strategy: "reassign", TargetCategoryID: c.target})
+			require.EqualError(t, err, c.expected)
+		})
+	}
+}
+
+func TestDeleteCategoryUseCase_ReassignRollsBack(t *testing.T) {
+	uc, mocks := newDeleteCategoryUseCase(t)
+	mocks.expectHierarchy("cat-3")
+	mocks.expectTransaction()
+	mocks.product.EXPECT().FindAllByCategoryID("cat-3").Return([]daos.ProductDAO{
+		{ID: "pid-1", CategoryID: "cat-3", Name: "Coca-Cola", Price: 5.99, Active: true},
+	}, nil)
+	mocks.product.EXPECT().Update(gomock.Any()).Return(nil)
+	mocks.category.EXPECT().Delete("cat-3", int64(0)).Return(errors.New("db error"))
+
+	result, err := uc.Execute(dtos.DeleteCategoryDTO{ID: "cat-3", Strategy: "reassign", TargetCategoryID: "cat-4"})
+	require.EqualError(t, err, "db error")
+	require.False(t, result.Deleted)
+}
+
+func TestDeleteCategoryUseCase_Deactivate(t *testing.T) {
+	uc, mocks := newDeleteCategoryUseCase(t)
+	mocks.expectHierarchy("cat-1")
+	mocks.expectTransaction()
+	mocks.product.EXPECT().FindAllByCategoryIDs([]string{"cat-1", "cat-2", "cat-3"}).Return([]daos.ProductDAO{
+		{ID: "pid-1", CategoryID: "cat-3", Name: "Coca-Cola", Price: 5.99, Active: true},
+		{ID: "pid-2", CategoryID: "cat-2", Name: "Guaraná", Price: 5.49, Active: false},
+	}, nil)
+	mocks.product.EXPECT().Update(gomock.Any()).DoAndReturn(func(product daos.ProductDAO) error {
+		require.Equal(t, "pid-1", product.ID)
+		require.False(t, product.Active)
+		return nil
+	})
+	mocks.category.EXPECT().Update(gomock.Any()).Times(3).DoAndReturn(func(dao daos.CategoryDAO) error {
+		require.False(t, dao.Active)
+		return nil
+	})
+
+	result, err := uc.Execute(dtos.DeleteCategoryDTO{ID: "cat-1", Strategy: "deactivate"})
+	require.NoError(t, err)
+	require.False(t, result.Deleted)
+	require.Equal(t, []string{"cat-1", "cat-2", "cat-3"}, result.DeactivatedCategories)
+	require.Equal(t, []string{"pid-1"}, result.AffectedProducts)
+}
+
+func TestDeleteCategoryUseCase_FindByIDTimeout(t *testing.T) {
+	uc, mocks := newDeleteCategoryUseCase(t)
+	mocks.category.EXPECT().FindByID("cat-9").Return(daos.CategoryDAO{}, &exceptions.RepositoryTimeoutException{})
+
+	_, err := uc.Execute(dtos.DeleteCategoryDTO{ID: "cat-9"})
+	require.IsType(t, &exceptions.RepositoryTimeoutException{}, err)
 }
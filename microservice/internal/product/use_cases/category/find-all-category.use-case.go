@@ -1,6 +1,8 @@
 package use_cases
 
 import (
+	"slices"
+
 	"tech_challenge/internal/product/application/gateways"
 	"tech_challenge/internal/product/domain/entities"
 )
@@ -22,5 +24,9 @@ func (uc *FindAllCategoryUseCase) Execute() ([]*entities.Category, error) {
 		return []*entities.Category{}, err
 	}
 
+	slices.SortStableFunc(categories, func(a, b *entities.Category) int {
+		return a.Position - b.Position
+	})
+
 	return categories, nil
 }
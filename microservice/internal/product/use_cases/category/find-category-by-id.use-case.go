@@ -20,7 +20,10 @@ func (uc *FindCategoryByIDUseCase) Execute(id string) (entities.Category, error)
 	category, err := uc.gateway.FindByID(id)
 
 	if err != nil {
-		return entities.Category{}, &exceptions.CategoryNotFoundException{}
+		if exceptions.IsRecordNotFound(err) {
+			return entities.Category{}, &exceptions.CategoryNotFoundException{}
+		}
+		return entities.Category{}, err
 	}
 
 	return *category, nil
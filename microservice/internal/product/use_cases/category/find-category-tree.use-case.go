@@ -0,0 +1,26 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type FindCategoryTreeUseCase struct {
+	gateway gateways.CategoryGateway
+}
+
+func NewFindCategoryTreeUseCase(gateway gateways.CategoryGateway) *FindCategoryTreeUseCase {
+	return &FindCategoryTreeUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *FindCategoryTreeUseCase) Execute() (*entities.CategoryTree, error) {
+	categories, err := uc.gateway.FindAll()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.NewCategoryTree(categories), nil
+}
@@ -0,0 +1,67 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/application/presenters"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+// PatchCategoryUseCase aplica um merge patch: só os campos informados passam
+// pelos setters; hierarquia, ativação em cascata e nome único seguem as mesmas
+// regras do PUT
+type PatchCategoryUseCase struct {
+	update *UpdateCategoryUseCase
+}
+
+func NewPatchCategoryUseCase(gateway gateways.CategoryGateway, transactionGateway gateways.TransactionGateway, cacheGateway gateways.CatalogCacheGateway) *PatchCategoryUseCase {
+	return &PatchCategoryUseCase{
+		update: NewUpdateCategoryUseCase(gateway, transactionGateway, cacheGateway),
+	}
+}
+
+func (uc *PatchCategoryUseCase) Execute(patchDTO dtos.PatchCategoryDTO) (entities.Category, error) {
+	category, err := uc.update.gateway.FindByID(patchDTO.ID)
+
+	if err != nil {
+		if exceptions.IsRecordNotFound(err) {
+			return entities.Category{}, &exceptions.CategoryNotFoundException{}
+		}
+		return entities.Category{}, err
+	}
+
+	if !patchDTO.Precondition.Matches(category.Version) {
+		return entities.Category{}, &exceptions.VersionConflictException{}
+	}
+
+	if patchDTO.Name != nil {
+		if err = category.SetName(*patchDTO.Name); err != nil {
+			return entities.Category{}, err
+		}
+	}
+
+	if patchDTO.Description != nil {
+		if err = category.SetDescription(*patchDTO.Description); err != nil {
+			return entities.Category{}, err
+		}
+	}
+
+	if patchDTO.Availability != nil {
+		if category.Availability, err = presenters.AvailabilityFromDTOToDomain(patchDTO.Availability); err != nil {
+			return entities.Category{}, err
+		}
+	}
+
+	parentID := category.ParentID
+	if patchDTO.ParentID != nil {
+		parentID = *patchDTO.ParentID
+	}
+
+	active := category.Active
+	if patchDTO.Active != nil {
+		active = *patchDTO.Active
+	}
+
+	return uc.update.save(category, parentID, active)
+}
@@ -0,0 +1,82 @@
+package use_cases_test
+
+import (
+	"testing"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	category "tech_challenge/internal/product/use_cases/category"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func newPatchCategoryUseCase(t *testing.T) (*category.PatchCategoryUseCase, *mock_interfaces.MockICategoryDataSource) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+
+	uc := category.NewPatchCategoryUseCase(
+		gateways.NewCategoryGateway(mockCategoryDataSource),
+		gateways.NewTransactionGateway(mock_interfaces.NewMockITransactionManager(ctrl), mock_interfaces.NewMockIFileProvider(ctrl)),
+		gateways.CatalogCacheGateway{},
+	)
+	return uc, mockCategoryDataSource
+}
+
+func TestPatchCategoryUseCase_KeepsOmittedFields(t *testing.T) {
+	uc, mockCategoryDataSource := newPatchCategoryUseCase(t)
+	mockCategoryDataSource.EXPECT().FindByID("cat-3").Return(categoryHierarchy()[2], nil)
+	mockCategoryDataSource.EXPECT().FindAll().Return(categoryHierarchy(), nil)
+	mockCategoryDataSource.EXPECT().Update(gomock.Any()).DoAndReturn(func(dao daos.CategoryDAO) error {
+		require.Equal(t, "Latas 350ml", dao.Name)
+		require.Equal(t, "cat-2", dao.ParentID)
+		require.True(t, dao.Active)
+		return nil
+	})
+
+	name := "Latas 350ml"
+	cat, err := uc.Execute(dtos.PatchCategoryDTO{ID: "cat-3", Name: &name})
+	require.NoError(t, err)
+	require.Equal(t, "cat-2", cat.ParentID)
+}
+
+func TestPatchCategoryUseCase_NullParentMovesToRoot(t *testing.T) {
+	uc, mockCategoryDataSource := newPatchCategoryUseCase(t)
+	mockCategoryDataSource.EXPECT().FindByID("cat-3").Return(categoryHierarchy()[2], nil)
+	mockCategoryDataSource.EXPECT().FindAll().Return(categoryHierarchy(), nil)
+	mockCategoryDataSource.EXPECT().Update(gomock.Any()).DoAndReturn(func(dao daos.CategoryDAO) error {
+		require.Empty(t, dao.ParentID)
+		require.Equal(t, "Latas", dao.Name)
+		return nil
+	})
+
+	root := ""
+	cat, err := uc.Execute(dtos.PatchCategoryDTO{ID: "cat-3", ParentID: &root})
+	require.NoError(t, err)
+	require.Empty(t, cat.ParentID)
+}
+
+func TestPatchCategoryUseCase_InvalidParent(t *testing.T) {
+	uc, mockCategoryDataSource := newPatchCategoryUseCase(t)
+	mockCategoryDataSource.EXPECT().FindByID("cat-1").Return(categoryHierarchy()[0], nil)
+	mockCategoryDataSource.EXPECT().FindAll().Return(categoryHierarchy(), nil)
+
+	parentID := "cat-3"
+	_, err := uc.Execute(dtos.PatchCategoryDTO{ID: "cat-1", ParentID: &parentID})
+	require.EqualError(t, err, "category cannot be moved under one of its subcategories")
+}
+
+func TestPatchCategoryUseCase_StalePrecondition(t *testing.T) {
+	uc, mockCategoryDataSource := newPatchCategoryUseCase(t)
+	stored := categoryHierarchy()[2]
+	stored.Version = 5
+	mockCategoryDataSource.EXPECT().FindByID("cat-3").Return(stored, nil)
+
+	name := "Latas 350ml"
+	_, err := uc.Execute(dtos.PatchCategoryDTO{ID: "cat-3", Name: &name, Precondition: dtos.Precondition{IfMatch: true, Versions: []int64{4}}})
+	require.IsType(t, &exceptions.VersionConflictException{}, err)
+}
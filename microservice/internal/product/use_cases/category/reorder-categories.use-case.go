@@ -0,0 +1,88 @@
+package use_cases
+
+import (
+	"slices"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+type ReorderCategoriesUseCase struct {
+	gateway      gateways.CategoryGateway
+	cacheGateway gateways.CatalogCacheGateway
+}
+
+func NewReorderCategoriesUseCase(gateway gateways.CategoryGateway, cacheGateway gateways.CatalogCacheGateway) *ReorderCategoriesUseCase {
+	return &ReorderCategoriesUseCase{
+		gateway:      gateway,
+		cacheGateway: cacheGateway,
+	}
+}
+
+// Execute coloca as categorias informadas no início, na ordem recebida; as
+// demais mantêm a ordem relativa atual logo depois delas
+func (uc *ReorderCategoriesUseCase) Execute(reorderDTO dtos.ReorderCategoriesDTO) ([]*entities.Category, error) {
+	if len(reorderDTO.CategoryIDs) == 0 {
+		return nil, &exceptions.InvalidCategoryDataException{
+			Message: "category_ids must not be empty",
+		}
+	}
+
+	categories, err := uc.gateway.FindAll()
+
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(categories, func(a, b *entities.Category) int {
+		return a.Position - b.Position
+	})
+
+	categoriesByID := make(map[string]*entities.Category, len(categories))
+	for _, category := range categories {
+		categoriesByID[category.ID] = category
+	}
+
+	ordered := make([]*entities.Category, 0, len(categories))
+	listed := make(map[string]bool, len(reorderDTO.CategoryIDs))
+
+	for _, id := range reorderDTO.CategoryIDs {
+		if listed[id] {
+			return nil, &exceptions.InvalidCategoryDataException{
+				Message: "category %s appears more than once", Args: []any{id},
+			}
+		}
+
+		category, ok := categoriesByID[id]
+		if !ok {
+			return nil, &exceptions.CategoryNotFoundException{
+				Message: "Category %s not found", Args: []any{id},
+			}
+		}
+
+		listed[id] = true
+		ordered = append(ordered, category)
+	}
+
+	for _, category := range categories {
+		if !listed[category.ID] {
+			ordered = append(ordered, category)
+		}
+	}
+
+	orderedIDs := make([]string, len(ordered))
+	for i, category := range ordered {
+		orderedIDs[i] = category.ID
+		_ = category.SetPosition(i + 1)
+	}
+
+	defer uc.cacheGateway.Invalidate()
+
+	if err := uc.gateway.UpdatePositions(orderedIDs); err != nil {
+		return nil, err
+	}
+
+	return ordered, nil
+}
@@ -0,0 +1,56 @@
+package use_cases_test
+
+import (
+	"testing"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	category "tech_challenge/internal/product/use_cases/category"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupReorderUseCase(t *testing.T) (*category.ReorderCategoriesUseCase, *mock_interfaces.MockICategoryDataSource) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockCategoryDataSource.EXPECT().FindAll().Return([]daos.CategoryDAO{
+		{ID: "lanches", Name: "Lanches", Position: 1},
+		{ID: "bebidas", Name: "Bebidas", Position: 2},
+		{ID: "sobremesas", Name: "Sobremesas", Position: 3},
+		{ID: "acompanhamentos", Name: "Acompanhamentos", Position: 4},
+	}, nil).AnyTimes()
+
+	return category.NewReorderCategoriesUseCase(gateways.NewCategoryGateway(mockCategoryDataSource), gateways.CatalogCacheGateway{}), mockCategoryDataSource
+}
+
+func TestReorderCategoriesUseCase_ListedFirstOthersKeepOrder(t *testing.T) {
+	uc, mockCategoryDataSource := setupReorderUseCase(t)
+	mockCategoryDataSource.EXPECT().UpdatePositions([]string{"sobremesas", "bebidas", "lanches", "acompanhamentos"}).Return(nil)
+
+	categories, err := uc.Execute(dtos.ReorderCategoriesDTO{CategoryIDs: []string{"sobremesas", "bebidas"}})
+	require.NoError(t, err)
+	require.Len(t, categories, 4)
+	require.Equal(t, "sobremesas", categories[0].ID)
+	require.Equal(t, 1, categories[0].Position)
+	require.Equal(t, "acompanhamentos", categories[3].ID)
+	require.Equal(t, 4, categories[3].Position)
+}
+
+func TestReorderCategoriesUseCase_Invalid(t *testing.T) {
+	uc, _ := setupReorderUseCase(t)
+
+	_, err := uc.Execute(dtos.ReorderCategoriesDTO{})
+	require.IsType(t, &exceptions.InvalidCategoryDataException{}, err)
+
+	_, err = uc.Execute(dtos.ReorderCategoriesDTO{CategoryIDs: []string{"bebidas", "bebidas"}})
+	require.IsType(t, &exceptions.InvalidCategoryDataException{}, err)
+
+	_, err = uc.Execute(dtos.ReorderCategoriesDTO{CategoryIDs: []string{"pizzas"}})
+	require.IsType(t, &exceptions.CategoryNotFoundException{}, err)
+	require.EqualError(t, err, "Category pizzas not found")
+}
@@ -3,17 +3,22 @@ package use_cases
 import (
 	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/application/presenters"
 	"tech_challenge/internal/product/domain/entities"
 	"tech_challenge/internal/product/domain/exceptions"
 )
 
 type UpdateCategoryUseCase struct {
-	gateway gateways.CategoryGateway
+	gateway            gateways.CategoryGateway
+	transactionGateway gateways.TransactionGateway
+	cacheGateway       gateways.CatalogCacheGateway
 }
 
-func NewUpdateCategoryUseCase(gateway gateways.CategoryGateway) *UpdateCategoryUseCase {
+func NewUpdateCategoryUseCase(gateway gateways.CategoryGateway, transactionGateway gateways.TransactionGateway, cacheGateway gateways.CatalogCacheGateway) *UpdateCategoryUseCase {
 	return &UpdateCategoryUseCase{
-		gateway: gateway,
+		gateway:            gateway,
+		transactionGateway: transactionGateway,
+		cacheGateway:       cacheGateway,
 	}
 }
 
@@ -21,19 +26,96 @@ func (uc *UpdateCategoryUseCase) Execute(categoryDTO dtos.UpdateCategoryDTO) (en
 	category, err := uc.gateway.FindByID(categoryDTO.ID)
 
 	if err != nil {
-		return entities.Category{}, &exceptions.CategoryNotFoundException{}
+		if exceptions.IsRecordNotFound(err) {
+			return entities.Category{}, &exceptions.CategoryNotFoundException{}
+		}
+		return entities.Category{}, err
+	}
+
+	if !categoryDTO.Precondition.Matches(category.Version) {
+		return entities.Category{}, &exceptions.VersionConflictException{}
 	}
 
 	if err = category.SetName(categoryDTO.Name); err != nil {
 		return entities.Category{}, err
 	}
 
-	category.Active = categoryDTO.Active
+	if err = category.SetDescription(categoryDTO.Description); err != nil {
+		return entities.Category{}, err
+	}
+
+	// PUT substitui a grade: sem availability a categoria fica sem restrição
+	if category.Availability, err = presenters.AvailabilityFromDTOToDomain(categoryDTO.Availability); err != nil {
+		return entities.Category{}, err
+	}
+
+	return uc.save(category, categoryDTO.ParentID, categoryDTO.Active)
+}
 
-	err = uc.gateway.Update(*category)
+// save valida nome e hierarquia da categoria já alterada e grava, junto com as
+// subcategorias desativadas em cascata; também é usado pelo PATCH
+func (uc *UpdateCategoryUseCase) save(category *entities.Category, parentID string, active bool) (entities.Category, error) {
+	categories, err := uc.gateway.FindAll()
 
 	if err != nil {
-		return entities.Category{}, &exceptions.InvalidCategoryDataException{}
+		return entities.Category{}, err
+	}
+
+	if err = ensureUniqueCategoryName(categories, *category); err != nil {
+		return entities.Category{}, err
+	}
+
+	tree := entities.NewCategoryTree(categories)
+
+	if err = tree.ValidateParent(category.ID, parentID); err != nil {
+		return entities.Category{}, err
+	}
+
+	if err = tree.ValidateActive(parentID, active); err != nil {
+		return entities.Category{}, err
+	}
+
+	category.ParentID = parentID
+	category.Active = active
+
+	// Desativar uma categoria desativa também todas as subcategorias; ao
+	// reativar, as subcategorias continuam inativas até serem reativadas
+	var deactivatedDescendants []entities.Category
+	if !category.Active {
+		for _, descendant := range tree.Descendants(category.ID) {
+			if descendant.Active {
+				descendant.Active = false
+				deactivatedDescendants = append(deactivatedDescendants, *descendant)
+			}
+		}
+	}
+
+	defer uc.cacheGateway.Invalidate()
+
+	if len(deactivatedDescendants) == 0 {
+		if err = uc.gateway.Update(category); err != nil {
+			return entities.Category{}, err
+		}
+
+		return *category, nil
+	}
+
+	err = uc.transactionGateway.Run(func(gateways gateways.TransactionGateways) error {
+		if err := gateways.Category.Update(category); err != nil {
+			return err
+		}
+
+		for i := range deactivatedDescendants {
+			if err := gateways.Category.Update(&deactivatedDescendants[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entities.Category{}, err
 	}
 
 	return *category, nil
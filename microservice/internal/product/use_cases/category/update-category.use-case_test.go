@@ -0,0 +1,145 @@
+package use_cases_test
+
+import (
+	"errors"
+	"testing"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/interfaces"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	category "tech_challenge/internal/product/use_cases/category"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func categoryHierarchy() []daos.CategoryDAO {
+	return []daos.CategoryDAO{
+		{ID: "cat-1", Name: "Bebidas", Active: true},
+		{ID: "cat-2", ParentID: "cat-1", Name: "Refrigerantes", Active: true},
+		{ID: "cat-3", ParentID: "cat-2", Name: "Latas", Active: true},
+		{ID: "cat-4", Name: "Lanches", Active: true},
+	}
+}
+
+func newUpdateCategoryUseCase(t *testing.T) (*category.UpdateCategoryUseCase, *mock_interfaces.MockICategoryDataSource, *mock_interfaces.MockITransactionManager) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockTransactionManager := mock_interfaces.NewMockITransactionManager(ctrl)
+
+	uc := category.NewUpdateCategoryUseCase(
+		gateways.NewCategoryGateway(mockCategoryDataSource),
+		gateways.NewTransactionGateway(mockTransactionManager, mock_interfaces.NewMockIFileProvider(ctrl)),
+		gateways.CatalogCacheGateway{},
+	)
+	return uc, mockCategoryDataSource, mockTransactionManager
+}
+
+func TestUpdateCategoryUseCase_MovesCategory(t *testing.T) {
+	uc, mockCategoryDataSource, _ := newUpdateCategoryUseCase(t)
+	mockCategoryDataSource.EXPECT().FindByID("cat-3").Return(categoryHierarchy()[2], nil)
+	mockCategoryDataSource.EXPECT().FindAll().Return(categoryHierarchy(), nil)
+	mockCategoryDataSource.EXPECT().Update(gomock.Any()).DoAndReturn(func(dao daos.CategoryDAO) error {
+		require.Equal(t, "cat-1", dao.ParentID)
+		return nil
+	})
+
+	cat, err := uc.Execute(dtos.UpdateCategoryDTO{ID: "cat-3", ParentID: "cat-1", Name: "Latas", Active: true})
+	require.NoError(t, err)
+	require.Equal(t, "cat-1", cat.ParentID)
+}
+
+func TestUpdateCategoryUseCase_InvalidParent(t *testing.T) {
+	cases := []struct {
+		name     string
+		dto      dtos.UpdateCategoryDTO
+		expected string
+	}{
+		{"self", dtos.UpdateCategoryDTO{ID: "cat-1", ParentID: "cat-1", Name: "Bebidas", Active: true}, "category cannot be its own parent"},
+		{"cycle", dtos.UpdateCategoryDTO{ID: "cat-1", ParentID: "cat-3", Name: "Bebidas", Active: true}, "category cannot be moved under one of its subcategories"},
+		{"too deep", dtos.UpdateCategoryDTO{ID: "cat-1", ParentID: "cat-4", Name: "Bebidas", Active: true}, "categories can be nested at most 3 levels deep"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			uc, mockCategoryDataSource, _ := newUpdateCategoryUseCase(t)
+			mockCategoryDataSource.EXPECT().FindByID(c.dto.ID).Return(categoryHierarchy()[0], nil)
+			mockCategoryDataSource.EXPECT().FindAll().Return(categoryHierarchy(), nil)
+
+			_, err := uc.Execute(c.dto)
+			require.IsType(t, &exceptions.InvalidCategoryDataException{}, err)
+			require.EqualError(t, err, c.expected)
+		})
+	}
+}
+
+func TestUpdateCategoryUseCase_DeactivationCascades(t *testing.T) {
+	uc, mockCategoryDataSource, mockTransactionManager := newUpdateCategoryUseCase(t)
+	mockCategoryDataSource.EXPECT().FindByID("cat-1").Return(categoryHierarchy()[0], nil)
+	mockCategoryDataSource.EXPECT().FindAll().Return(categoryHierarchy(), nil)
+	mockTransactionManager.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(interfaces.TransactionDataSources) error) error {
+		return fn(interfaces.TransactionDataSources{Category: mockCategoryDataSource})
+	})
+
+	var updated []string
+	mockCategoryDataSource.EXPECT().Update(gomock.Any()).Times(3).DoAndReturn(func(dao daos.CategoryDAO) error {
+		require.False(t, dao.Active)
+		updated = append(updated, dao.ID)
+		return nil
+	})
+
+	cat, err := uc.Execute(dtos.UpdateCategoryDTO{ID: "cat-1", Name: "Bebidas", Active: false})
+	require.NoError(t, err)
+	require.False(t, cat.Active)
+	require.Equal(t, []string{"cat-1", "cat-2", "cat-3"}, updated)
+}
+
+func TestUpdateCategoryUseCase_DeactivationRollsBack(t *testing.T) {
+	uc, mockCategoryDataSource, mockTransactionManager := newUpdateCategoryUseCase(t)
+	mockCategoryDataSource.EXPECT().FindByID("cat-1").Return(categoryHierarchy()[0], nil)
+	mockCategoryDataSource.EXPECT().FindAll().Return(categoryHierarchy(), nil)
+	mockTransactionManager.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(interfaces.TransactionDataSources) error) error {
+		return fn(interfaces.TransactionDataSources{Category: mockCategoryDataSource})
+	})
+	mockCategoryDataSource.EXPECT().Update(gomock.Any()).Return(nil)
+	mockCategoryDataSource.EXPECT().Update(gomock.Any()).Return(errors.New("db error"))
+
+	_, err := uc.Execute(dtos.UpdateCategoryDTO{ID: "cat-1", Name: "Bebidas", Active: false})
+	require.EqualError(t, err, "db error")
+}
+
+func TestUpdateCategoryUseCase_ActivateUnderInactiveParent(t *testing.T) {
+	uc, mockCategoryDataSource, _ := newUpdateCategoryUseCase(t)
+	categories := categoryHierarchy()
+	categories[0].Active = false
+	categories[1].Active = false
+	mockCategoryDataSource.EXPECT().FindByID("cat-2").Return(categories[1], nil)
+	mockCategoryDataSource.EXPECT().FindAll().Return(categories, nil)
+
+	_, err := uc.Execute(dtos.UpdateCategoryDTO{ID: "cat-2", ParentID: "cat-1", Name: "Refrigerantes", Active: true})
+	require.EqualError(t, err, "subcategory cannot be active while its parent category is inactive")
+}
+
+func TestUpdateCategoryUseCase_DuplicatedName(t *testing.T) {
+	uc, mockCategoryDataSource, _ := newUpdateCategoryUseCase(t)
+	mockCategoryDataSource.EXPECT().FindByID("cat-4").Return(categoryHierarchy()[3], nil)
+	mockCategoryDataSource.EXPECT().FindAll().Return(categoryHierarchy(), nil)
+
+	_, err := uc.Execute(dtos.UpdateCategoryDTO{ID: "cat-4", Name: "bebidas", Active: true})
+	require.IsType(t, &exceptions.CategoryAlreadyExistsException{}, err)
+}
+
+func TestUpdateCategoryUseCase_KeepsOwnName(t *testing.T) {
+	uc, mockCategoryDataSource, _ := newUpdateCategoryUseCase(t)
+	mockCategoryDataSource.EXPECT().FindByID("cat-4").Return(categoryHierarchy()[3], nil)
+	mockCategoryDataSource.EXPECT().FindAll().Return(categoryHierarchy(), nil)
+	mockCategoryDataSource.EXPECT().Update(gomock.Any()).Return(nil)
+
+	cat, err := uc.Execute(dtos.UpdateCategoryDTO{ID: "cat-4", Name: "LANCHES", Active: true})
+	require.NoError(t, err)
+	require.Equal(t, "LANCHES", cat.Name.Value())
+}
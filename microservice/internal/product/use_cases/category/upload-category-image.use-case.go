@@ -0,0 +1,57 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+type UploadCategoryImageUseCase struct {
+	gateway      gateways.CategoryGateway
+	fileGateway  gateways.FileGateway
+	cacheGateway gateways.CatalogCacheGateway
+}
+
+func NewUploadCategoryImageUseCase(gateway gateways.CategoryGateway, fileGateway gateways.FileGateway, cacheGateway gateways.CatalogCacheGateway) *UploadCategoryImageUseCase {
+	return &UploadCategoryImageUseCase{
+		gateway:      gateway,
+		fileGateway:  fileGateway,
+		cacheGateway: cacheGateway,
+	}
+}
+
+func (uc *UploadCategoryImageUseCase) Execute(uploadDTO dtos.UploadCategoryImageDTO) (entities.Category, error) {
+	category, err := uc.gateway.FindByID(uploadDTO.CategoryID)
+	if err != nil {
+		if exceptions.IsRecordNotFound(err) {
+			return entities.Category{}, &exceptions.CategoryNotFoundException{}
+		}
+		return entities.Category{}, err
+	}
+
+	previous, err := category.SetImage(uploadDTO.FileName)
+	if err != nil {
+		return entities.Category{}, &exceptions.InvalidProductImageException{}
+	}
+
+	url, err := uc.fileGateway.UploadImage(category.Image.FileName, uploadDTO.FileContent)
+	if err != nil {
+		return entities.Category{}, err
+	}
+	category.Image.Url = url
+
+	defer uc.cacheGateway.Invalidate()
+
+	if err := uc.gateway.Update(category); err != nil {
+		// Evita deixar no bucket um arquivo que nenhuma categoria referencia
+		_ = uc.fileGateway.DeleteImage(category.Image.FileName)
+		return entities.Category{}, err
+	}
+
+	if previous != nil {
+		_ = uc.fileGateway.DeleteImage(previous.FileName)
+	}
+
+	return *category, nil
+}
@@ -0,0 +1,90 @@
+package use_cases_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	category "tech_challenge/internal/product/use_cases/category"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadCategoryImageUseCase_ReplacesPreviousImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+
+	mockCategoryDataSource.EXPECT().FindByID("cat-1").Return(daos.CategoryDAO{ID: "cat-1", Name: "Bebidas", Active: true, ImageFileName: "old.png", ImageUrl: "http://bucket/old.png"}, nil)
+	mockFileProvider.EXPECT().UploadFile(gomock.Any(), []byte("png")).Return(nil)
+	mockFileProvider.EXPECT().GetPresignedURL(gomock.Any()).Return("http://bucket/icon.png", nil)
+	mockCategoryDataSource.EXPECT().Update(gomock.Any()).DoAndReturn(func(dao daos.CategoryDAO) error {
+		require.True(t, strings.HasPrefix(dao.ImageFileName, "icon_"))
+		require.Equal(t, "http://bucket/icon.png", dao.ImageUrl)
+		return nil
+	})
+	mockFileProvider.EXPECT().DeleteFile("old.png").Return(nil)
+
+	uc := category.NewUploadCategoryImageUseCase(gateways.NewCategoryGateway(mockCategoryDataSource), gateways.NewFileGateway(mockFileProvider), gateways.CatalogCacheGateway{})
+	cat, err := uc.Execute(dtos.UploadCategoryImageDTO{CategoryID: "cat-1", FileName: "icon.png", FileContent: []byte("png")})
+	require.NoError(t, err)
+	require.Equal(t, "http://bucket/icon.png", cat.Image.Url)
+}
+
+func TestUploadCategoryImageUseCase_UpdateErrorRemovesUploadedFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+
+	mockCategoryDataSource.EXPECT().FindByID("cat-1").Return(daos.CategoryDAO{ID: "cat-1", Name: "Bebidas", Active: true}, nil)
+	mockFileProvider.EXPECT().UploadFile(gomock.Any(), gomock.Any()).Return(nil)
+	mockFileProvider.EXPECT().GetPresignedURL(gomock.Any()).Return("http://bucket/icon.png", nil)
+	mockCategoryDataSource.EXPECT().Update(gomock.Any()).Return(errors.New("db error"))
+	mockFileProvider.EXPECT().DeleteFile(gomock.Any()).DoAndReturn(func(fileName string) error {
+		require.True(t, strings.HasPrefix(fileName, "icon_"))
+		return nil
+	})
+
+	uc := category.NewUploadCategoryImageUseCase(gateways.NewCategoryGateway(mockCategoryDataSource), gateways.NewFileGateway(mockFileProvider), gateways.CatalogCacheGateway{})
+	_, err := uc.Execute(dtos.UploadCategoryImageDTO{CategoryID: "cat-1", FileName: "icon.png"})
+	require.EqualError(t, err, "db error")
+}
+
+func TestUploadCategoryImageUseCase_CategoryNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockCategoryDataSource.EXPECT().FindByID("cat-1").Return(daos.CategoryDAO{}, &exceptions.RecordNotFoundException{})
+
+	uc := category.NewUploadCategoryImageUseCase(gateways.NewCategoryGateway(mockCategoryDataSource), gateways.NewFileGateway(mock_interfaces.NewMockIFileProvider(ctrl)), gateways.CatalogCacheGateway{})
+	_, err := uc.Execute(dtos.UploadCategoryImageDTO{CategoryID: "cat-1", FileName: "icon.png"})
+	require.IsType(t, &exceptions.CategoryNotFoundException{}, err)
+}
+
+func TestDeleteCategoryImageUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+
+	mockCategoryDataSource.EXPECT().FindByID("cat-1").Return(daos.CategoryDAO{ID: "cat-1", Name: "Bebidas", ImageFileName: "icon.png"}, nil)
+	mockCategoryDataSource.EXPECT().Update(gomock.Any()).DoAndReturn(func(dao daos.CategoryDAO) error {
+		require.Empty(t, dao.ImageFileName)
+		return nil
+	})
+	mockFileProvider.EXPECT().DeleteFile("icon.png").Return(nil)
+
+	uc := category.NewDeleteCategoryImageUseCase(gateways.NewCategoryGateway(mockCategoryDataSource), gateways.NewFileGateway(mockFileProvider), gateways.CatalogCacheGateway{})
+	require.NoError(t, uc.Execute("cat-1"))
+
+	mockCategoryDataSource.EXPECT().FindByID("cat-2").Return(daos.CategoryDAO{ID: "cat-2", Name: "Lanches"}, nil)
+	require.IsType(t, &exceptions.ImageNotFoundException{}, uc.Execute("cat-2"))
+}
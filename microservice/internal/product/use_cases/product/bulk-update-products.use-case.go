@@ -0,0 +1,264 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+const (
+	BulkOperationActivate     = "activate"
+	BulkOperationDeactivate   = "deactivate"
+	BulkOperationMoveCategory = "move_category"
+	BulkOperationAdjustPrice  = "adjust_price"
+)
+
+type BulkUpdateProductsUseCase struct {
+	gateway            gateways.ProductGateway
+	categoryGateway    gateways.CategoryGateway
+	transactionGateway gateways.TransactionGateway
+	cacheGateway       gateways.CatalogCacheGateway
+}
+
+func NewBulkUpdateProductsUseCase(
+	gateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+	transactionGateway gateways.TransactionGateway,
+	cacheGateway gateways.CatalogCacheGateway,
+) *BulkUpdateProductsUseCase {
+	return &BulkUpdateProductsUseCase{
+		gateway:            gateway,
+		categoryGateway:    categoryGateway,
+		transactionGateway: transactionGateway,
+		cacheGateway:       cacheGateway,
+	}
+}
+
+func (uc *BulkUpdateProductsUseCase) Execute(bulkDTO dtos.BulkUpdateProductsDTO) (dtos.BulkUpdateProductsResultDTO, error) {
+	if err := validateBulkFilter(bulkDTO.Filter); err != nil {
+		return dtos.BulkUpdateProductsResultDTO{}, err
+	}
+
+	apply, err := newBulkOperation(bulkDTO.Operation)
+	if err != nil {
+		return dtos.BulkUpdateProductsResultDTO{}, err
+	}
+
+	if bulkDTO.DryRun {
+		result, _, err := planBulkUpdate(uc.gateway, uc.categoryGateway, bulkDTO, apply)
+		if err != nil {
+			return dtos.BulkUpdateProductsResultDTO{}, err
+		}
+
+		result.DryRun = true
+		return result, nil
+	}
+
+	var result dtos.BulkUpdateProductsResultDTO
+
+	defer uc.cacheGateway.Invalidate()
+
+	err = uc.transactionGateway.Run(func(gateways gateways.TransactionGateways) error {
+		planned, changedProducts, err := planBulkUpdate(gateways.Product, gateways.Category, bulkDTO, apply)
+		if err != nil {
+			return err
+		}
+
+		for _, product := range changedProducts {
+			if err := gateways.Product.Update(&product); err != nil {
+				return err
+			}
+		}
+
+		result = planned
+		result.Applied = true
+		return nil
+	})
+
+	if err != nil {
+		return dtos.BulkUpdateProductsResultDTO{}, err
+	}
+
+	return result, nil
+}
+
+func validateBulkFilter(filter dtos.BulkProductFilterDTO) error {
+	// Sem nenhum critério a operação atingiria o catálogo inteiro por engano
+	if filter.CategoryID == nil && len(filter.IDs) == 0 && filter.Active == nil {
+		return &exceptions.InvalidProductDataException{
+			Message: "filter must have at least one of category_id, ids or active",
+		}
+	}
+
+	for _, id := range filter.IDs {
+		if identity_manager.IsNotValidUUID(id) {
+			return &exceptions.InvalidProductDataException{
+				Message: "product id %q must be a valid UUID", Args: []any{id},
+			}
+		}
+	}
+
+	return nil
+}
+
+// bulkOperation altera o produto e informa se houve mudança
+type bulkOperation func(product *entities.Product) (bool, error)
+
+func newBulkOperation(operation dtos.BulkProductOperationDTO) (bulkOperation, error) {
+	switch operation.Type {
+	case BulkOperationActivate:
+		return func(product *entities.Product) (bool, error) {
+			changed := !product.Active
+			return changed, product.Activate()
+		}, nil
+
+	case BulkOperationDeactivate:
+		return func(product *entities.Product) (bool, error) {
+			changed := product.Active
+			return changed, product.Deactivate()
+		}, nil
+
+	case BulkOperationMoveCategory:
+		if operation.TargetCategoryID == "" {
+			return nil, &exceptions.InvalidProductDataException{
+				Message: "category_id is required to move products",
+			}
+		}
+
+		return func(product *entities.Product) (bool, error) {
+			changed := product.CategoryID != operation.TargetCategoryID
+			return changed, product.SetCategory(operation.TargetCategoryID)
+		}, nil
+
+	case BulkOperationAdjustPrice:
+		adjustment, err := value_objects.NewPriceAdjustment(
+			operation.PriceAdjustment.Mode,
+			operation.PriceAdjustment.Value,
+			operation.PriceAdjustment.Rounding,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(product *entities.Product) (bool, error) {
+			current := product.Price.Value()
+			price := adjustment.Apply(current)
+
+			if err := product.SetPrice(price); err != nil {
+				return false, &exceptions.InvalidProductDataException{
+					Message: "product %s would have price %.2f: %s", Args: []any{product.ID, price, err},
+				}
+			}
+
+			// O arredondamento pode devolver o preço atual, e aí não há mudança
+			return product.Price.Value() != current, nil
+		}, nil
+	}
+
+	return nil, &exceptions.InvalidProductDataException{
+		Message: "operation must be activate, deactivate, move_category or adjust_price",
+	}
+}
+
+func planBulkUpdate(
+	productGateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+	bulkDTO dtos.BulkUpdateProductsDTO,
+	apply bulkOperation,
+) (dtos.BulkUpdateProductsResultDTO, []entities.Product, error) {
+	if bulkDTO.Operation.Type == BulkOperationMoveCategory {
+		if _, err := categoryGateway.FindByID(bulkDTO.Operation.TargetCategoryID); err != nil {
+			if exceptions.IsRecordNotFound(err) {
+				return dtos.BulkUpdateProductsResultDTO{}, nil, &exceptions.CategoryNotFoundException{}
+			}
+			return dtos.BulkUpdateProductsResultDTO{}, nil, err
+		}
+	}
+
+	products, err := findBulkProducts(productGateway, categoryGateway, bulkDTO.Filter)
+	if err != nil {
+		return dtos.BulkUpdateProductsResultDTO{}, nil, err
+	}
+
+	result := dtos.BulkUpdateProductsResultDTO{
+		Operation: bulkDTO.Operation.Type,
+		Matched:   len(products),
+		Changes:   []dtos.BulkProductChangeDTO{},
+	}
+
+	var changedProducts []entities.Product
+
+	for _, product := range products {
+		previous := product
+
+		changed, err := apply(&product)
+		if err != nil {
+			return dtos.BulkUpdateProductsResultDTO{}, nil, err
+		}
+
+		if !changed {
+			continue
+		}
+
+		changedProducts = append(changedProducts, product)
+		result.Changes = append(result.Changes, dtos.BulkProductChangeDTO{
+			ProductID:          product.ID,
+			Name:               product.Name.Value(),
+			PreviousActive:     previous.Active,
+			Active:             product.Active,
+			PreviousCategoryID: previous.CategoryID,
+			CategoryID:         product.CategoryID,
+			PreviousPrice:      previous.Price.Value(),
+			Price:              product.Price.Value(),
+		})
+	}
+
+	result.Changed = len(changedProducts)
+	return result, changedProducts, nil
+}
+
+func findBulkProducts(
+	productGateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+	filter dtos.BulkProductFilterDTO,
+) ([]entities.Product, error) {
+	var products []entities.Product
+	var err error
+
+	if filter.CategoryID != nil {
+		if _, err := categoryGateway.FindByID(*filter.CategoryID); err != nil {
+			if exceptions.IsRecordNotFound(err) {
+				return nil, &exceptions.CategoryNotFoundException{}
+			}
+			return nil, err
+		}
+		products, err = productGateway.FindAllByCategoryID(*filter.CategoryID)
+	} else {
+		products, err = productGateway.FindAll()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]bool, len(filter.IDs))
+	for _, id := range filter.IDs {
+		ids[id] = true
+	}
+
+	matched := make([]entities.Product, 0, len(products))
+	for _, product := range products {
+		if len(ids) > 0 && !ids[product.ID] {
+			continue
+		}
+		if filter.Active != nil && product.Active != *filter.Active {
+			continue
+		}
+		matched = append(matched, product)
+	}
+
+	return matched, nil
+}
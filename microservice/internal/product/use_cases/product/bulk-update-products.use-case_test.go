@@ -0,0 +1,242 @@
+package use_cases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/interfaces"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	use_cases "tech_challenge/internal/product/use_cases/product"
+)
+
+const (
+	bulkCategoryID = "2cb7f56d-89a1-4e60-b488-65dc4ffacbc6"
+	bulkTargetID   = "123e4567-e89b-12d3-a456-426614174000"
+	bulkXSaladaID  = "76fbddb3-3e2f-4f5f-a4e1-30a0a2384eae"
+	bulkXBaconID   = "0d6c1f0e-4b7a-4e58-9f2a-1c3b5d7e9f01"
+)
+
+type bulkMocks struct {
+	product     *mock_interfaces.MockIProductDataSource
+	category    *mock_interfaces.MockICategoryDataSource
+	transaction *mock_interfaces.MockITransactionManager
+}
+
+func newBulkUseCase(t *testing.T) (*use_cases.BulkUpdateProductsUseCase, bulkMocks) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mocks := bulkMocks{
+		product:     mock_interfaces.NewMockIProductDataSource(ctrl),
+		category:    mock_interfaces.NewMockICategoryDataSource(ctrl),
+		transaction: mock_interfaces.NewMockITransactionManager(ctrl),
+	}
+	fileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+
+	uc := use_cases.NewBulkUpdateProductsUseCase(
+		*gateways.NewProductGateway(mocks.product, fileProvider),
+		gateways.NewCategoryGateway(mocks.category),
+		gateways.NewTransactionGateway(mocks.transaction, fileProvider),
+		gateways.CatalogCacheGateway{},
+	)
+	return uc, mocks
+}
+
+func (m bulkMocks) expectTransaction() {
+	m.transaction.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(interfaces.TransactionDataSources) error) error {
+		return fn(interfaces.TransactionDataSources{Product: m.product, Category: m.category})
+	})
+}
+
+func (m bulkMocks) expectCategoryProducts() {
+	m.category.EXPECT().FindByID(bulkCategoryID).Return(daos.CategoryDAO{ID: bulkCategoryID, Name: "Lanches", Active: true}, nil)
+	m.product.EXPECT().FindAllByCategoryID(bulkCategoryID).Return([]daos.ProductDAO{
+		{ID: bulkXSaladaID, CategoryID: bulkCategoryID, Name: "X-Salada", Price: 20.5, Active: true},
+		{ID: bulkXBaconID, CategoryID: bulkCategoryID, Name: "X-Bacon", Price: 24.9, Active: false},
+	}, nil)
+}
+
+func TestBulkUpdateProductsUseCase_PreviewPriceAdjustment(t *testing.T) {
+	uc, mocks := newBulkUseCase(t)
+	mocks.expectCategoryProducts()
+	categoryID := bulkCategoryID
+
+	result, err := uc.Execute(dtos.BulkUpdateProductsDTO{
+		DryRun: true,
+		Filter: dtos.BulkProductFilterDTO{CategoryID: &categoryID},
+		Operation: dtos.BulkProductOperationDTO{
+			Type:            use_cases.BulkOperationAdjustPrice,
+			PriceAdjustment: dtos.BulkPriceAdjustmentDTO{Mode: "percentage", Value: -10, Rounding: "ten_cents"},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, result.DryRun)
+	require.False(t, result.Applied)
+	require.Equal(t, 2, result.Matched)
+	require.Equal(t, 2, result.Changed)
+	require.Equal(t, 20.5, result.Changes[0].PreviousPrice)
+	require.Equal(t, 18.5, result.Changes[0].Price)
+	require.Equal(t, 24.9, result.Changes[1].PreviousPrice)
+	require.Equal(t, 22.4, result.Changes[1].Price)
+}
+
+func TestBulkUpdateProductsUseCase_PriceAdjustmentRoundedBackIsNotAChange(t *testing.T) {
+	uc, mocks := newBulkUseCase(t)
+	mocks.expectTransaction()
+	mocks.expectCategoryProducts()
+	categoryID := bulkCategoryID
+
+	// +0,1% arredondado para dez centavos devolve o preço atual dos dois produtos
+	result, err := uc.Execute(dtos.BulkUpdateProductsDTO{
+		Filter: dtos.BulkProductFilterDTO{CategoryID: &categoryID},
+		Operation: dtos.BulkProductOperationDTO{
+			Type:            use_cases.BulkOperationAdjustPrice,
+			PriceAdjustment: dtos.BulkPriceAdjustmentDTO{Mode: "percentage", Value: 0.1, Rounding: "ten_cents"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Matched)
+	require.Equal(t, 0, result.Changed)
+	require.Empty(t, result.Changes)
+}
+
+func TestBulkUpdateProductsUseCase_ActivateAppliesOnlyChangedProducts(t *testing.T) {
+	uc, mocks := newBulkUseCase(t)
+	mocks.expectTransaction()
+	mocks.expectCategoryProducts()
+	mocks.product.EXPECT().Update(gomock.Any()).DoAndReturn(func(product daos.ProductDAO) error {
+		require.Equal(t, bulkXBaconID, product.ID)
+		require.True(t, product.Active)
+		return nil
+	})
+	categoryID := bulkCategoryID
+
+	result, err := uc.Execute(dtos.BulkUpdateProductsDTO{
+		Filter:    dtos.BulkProductFilterDTO{CategoryID: &categoryID},
+		Operation: dtos.BulkProductOperationDTO{Type: use_cases.BulkOperationActivate},
+	})
+	require.NoError(t, err)
+	require.True(t, result.Applied)
+	require.Equal(t, 2, result.Matched)
+	require.Equal(t, 1, result.Changed)
+	require.False(t, result.Changes[0].PreviousActive)
+	require.True(t, result.Changes[0].Active)
+}
+
+func TestBulkUpdateProductsUseCase_MoveCategoryFilteredByIDsAndActive(t *testing.T) {
+	uc, mocks := newBulkUseCase(t)
+	mocks.expectTransaction()
+	mocks.category.EXPECT().FindByID(bulkTargetID).Return(daos.CategoryDAO{ID: bulkTargetID, Name: "Promoções", Active: true}, nil)
+	mocks.product.EXPECT().FindAll().Return([]daos.ProductDAO{
+		{ID: bulkXSaladaID, CategoryID: bulkCategoryID, Name: "X-Salada", Price: 20.5, Active: true},
+		{ID: bulkXBaconID, CategoryID: bulkCategoryID, Name: "X-Bacon", Price: 24.9, Active: false},
+	}, nil)
+	mocks.product.EXPECT().Update(gomock.Any()).DoAndReturn(func(product daos.ProductDAO) error {
+		require.Equal(t, bulkXSaladaID, product.ID)
+		require.Equal(t, bulkTargetID, product.CategoryID)
+		return nil
+	})
+	active := true
+
+	result, err := uc.Execute(dtos.BulkUpdateProductsDTO{
+		Filter:    dtos.BulkProductFilterDTO{IDs: []string{bulkXSaladaID, bulkXBaconID}, Active: &active},
+		Operation: dtos.BulkProductOperationDTO{Type: use_cases.BulkOperationMoveCategory, TargetCategoryID: bulkTargetID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Matched)
+	require.Equal(t, bulkCategoryID, result.Changes[0].PreviousCategoryID)
+	require.Equal(t, bulkTargetID, result.Changes[0].CategoryID)
+}
+
+func TestBulkUpdateProductsUseCase_UpdateErrorRollsBack(t *testing.T) {
+	uc, mocks := newBulkUseCase(t)
+	mocks.expectTransaction()
+	mocks.expectCategoryProducts()
+	mocks.product.EXPECT().Update(gomock.Any()).Return(nil)
+	mocks.product.EXPECT().Update(gomock.Any()).Return(errors.New("db error"))
+	categoryID := bulkCategoryID
+
+	result, err := uc.Execute(dtos.BulkUpdateProductsDTO{
+		Filter: dtos.BulkProductFilterDTO{CategoryID: &categoryID},
+		Operation: dtos.BulkProductOperationDTO{
+			Type:            use_cases.BulkOperationAdjustPrice,
+			PriceAdjustment: dtos.BulkPriceAdjustmentDTO{Mode: "fixed", Value: 1},
+		},
+	})
+	require.EqualError(t, err, "db error")
+	require.False(t, result.Applied)
+}
+
+func TestBulkUpdateProductsUseCase_RejectsNonPositiveResultingPrice(t *testing.T) {
+	uc, mocks := newBulkUseCase(t)
+	mocks.expectCategoryProducts()
+	categoryID := bulkCategoryID
+
+	_, err := uc.Execute(dtos.BulkUpdateProductsDTO{
+		DryRun: true,
+		Filter: dtos.BulkProductFilterDTO{CategoryID: &categoryID},
+		Operation: dtos.BulkProductOperationDTO{
+			Type:            use_cases.BulkOperationAdjustPrice,
+			PriceAdjustment: dtos.BulkPriceAdjustmentDTO{Mode: "fixed", Value: -21},
+		},
+	})
+	var invalid *exceptions.InvalidProductDataException
+	require.ErrorAs(t, err, &invalid)
+	require.Contains(t, err.Error(), bulkXSaladaID)
+}
+
+func TestBulkUpdateProductsUseCase_CategoryNotFound(t *testing.T) {
+	uc, mocks := newBulkUseCase(t)
+	mocks.category.EXPECT().FindByID(bulkTargetID).Return(daos.CategoryDAO{}, &exceptions.RecordNotFoundException{})
+	active := true
+
+	_, err := uc.Execute(dtos.BulkUpdateProductsDTO{
+		DryRun:    true,
+		Filter:    dtos.BulkProductFilterDTO{Active: &active},
+		Operation: dtos.BulkProductOperationDTO{Type: use_cases.BulkOperationMoveCategory, TargetCategoryID: bulkTargetID},
+	})
+	require.IsType(t, &exceptions.CategoryNotFoundException{}, err)
+}
+
+func TestBulkUpdateProductsUseCase_InvalidRequest(t *testing.T) {
+	uc, _ := newBulkUseCase(t)
+	active := true
+
+	cases := []struct {
+		bulkDTO dtos.BulkUpdateProductsDTO
+		message string
+	}{
+		{
+			dtos.BulkUpdateProductsDTO{Operation: dtos.BulkProductOperationDTO{Type: use_cases.BulkOperationActivate}},
+			"filter must have at least one of category_id, ids or active",
+		},
+		{
+			dtos.BulkUpdateProductsDTO{Filter: dtos.BulkProductFilterDTO{IDs: []string{"abc"}}, Operation: dtos.BulkProductOperationDTO{Type: use_cases.BulkOperationActivate}},
+			`product id "abc" must be a valid UUID`,
+		},
+		{
+			dtos.BulkUpdateProductsDTO{Filter: dtos.BulkProductFilterDTO{Active: &active}, Operation: dtos.BulkProductOperationDTO{Type: "delete"}},
+			"operation must be activate, deactivate, move_category or adjust_price",
+		},
+		{
+			dtos.BulkUpdateProductsDTO{Filter: dtos.BulkProductFilterDTO{Active: &active}, Operation: dtos.BulkProductOperationDTO{Type: use_cases.BulkOperationMoveCategory}},
+			"category_id is required to move products",
+		},
+		{
+			dtos.BulkUpdateProductsDTO{Filter: dtos.BulkProductFilterDTO{Active: &active}, Operation: dtos.BulkProductOperationDTO{Type: use_cases.BulkOperationAdjustPrice}},
+			"price adjustment mode must be percentage or fixed",
+		},
+	}
+
+	for _, c := range cases {
+		_, err := uc.Execute(c.bulkDTO)
+		require.IsType(t, &exceptions.InvalidProductDataException{}, err)
+		require.EqualError(t, err, c.message)
+	}
+}
@@ -3,20 +3,24 @@ package use_cases
 import (
 	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/application/presenters"
 	"tech_challenge/internal/product/domain/entities"
 	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
 	identity_manager "tech_challenge/internal/shared/pkg/identity"
 )
 
 type CreateProductUseCase struct {
 	productGateway  gateways.ProductGateway
 	categoryGateway gateways.CategoryGateway
+	cacheGateway    gateways.CatalogCacheGateway
 }
 
-func NewCreateProductUseCase(productGateway gateways.ProductGateway, categoryGateway gateways.CategoryGateway) *CreateProductUseCase {
+func NewCreateProductUseCase(productGateway gateways.ProductGateway, categoryGateway gateways.CategoryGateway, cacheGateway gateways.CatalogCacheGateway) *CreateProductUseCase {
 	return &CreateProductUseCase{
 		productGateway:  productGateway,
 		categoryGateway: categoryGateway,
+		cacheGateway:    cacheGateway,
 	}
 }
 
@@ -33,11 +37,28 @@ func (uc *CreateProductUseCase) Execute(productDTO dtos.CreateProductDTO) (entit
 		return entities.Product{}, err
 	}
 
-	_, err = uc.categoryGateway.FindByID(product.CategoryID)
-	if err != nil {
-		return entities.Product{}, &exceptions.CategoryNotFoundException{}
+	if product.Availability, err = presenters.AvailabilityFromDTOToDomain(productDTO.Availability); err != nil {
+		return entities.Product{}, err
+	}
+
+	if product.Nutrition, err = presenters.NutritionFromDTOToDomain(productDTO.Nutrition); err != nil {
+		return entities.Product{}, err
+	}
+
+	if product.Allergens, err = value_objects.NewAllergens(productDTO.Allergens); err != nil {
+		return entities.Product{}, err
+	}
+
+	if err = ensureCategoryExists(uc.categoryGateway, product.CategoryID); err != nil {
+		return entities.Product{}, err
+	}
+
+	if err = ensureUniqueProductName(uc.productGateway, *product); err != nil {
+		return entities.Product{}, err
 	}
 
+	defer uc.cacheGateway.Invalidate()
+
 	err = uc.productGateway.Insert(*product)
 	if err != nil {
 		return entities.Product{}, err
@@ -45,3 +66,33 @@ func (uc *CreateProductUseCase) Execute(productDTO dtos.CreateProductDTO) (entit
 
 	return *product, nil
 }
+
+func ensureCategoryExists(gateway gateways.CategoryGateway, categoryID string) error {
+	_, err := gateway.FindByID(categoryID)
+	if err != nil {
+		if exceptions.IsRecordNotFound(err) {
+			return &exceptions.CategoryNotFoundException{}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Nomes de produto são únicos dentro da categoria, ignorando maiúsculas e acentos
+func ensureUniqueProductName(gateway gateways.ProductGateway, product entities.Product) error {
+	products, err := gateway.FindAllByCategoryID(product.CategoryID)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range products {
+		if existing.ID != product.ID && existing.Name.Key() == product.Name.Key() {
+			return &exceptions.ProductAlreadyExistsException{
+				Message: "Product %q already exists in this category", Args: []any{existing.Name.Value()},
+			}
+		}
+	}
+
+	return nil
+}
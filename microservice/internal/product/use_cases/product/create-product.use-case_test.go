@@ -42,10 +42,11 @@ func TestCreateProductUseCase_Success(t *testing.T) {
 	productDTO, mockProductDataSource, mockCategoryDataSource, mockFileProvider, categoryID, ctrl := setupCreateProductTest(t, "Produto Teste")
 	defer ctrl.Finish()
 	mockCategoryDataSource.EXPECT().FindByID(categoryID).Return(daos.CategoryDAO{ID: categoryID, Name: "Categoria Teste", Active: true}, nil)
+	mockProductDataSource.EXPECT().FindAllByCategoryID(categoryID).Return(nil, nil)
 	mockProductDataSource.EXPECT().Insert(gomock.Any()).Return(nil)
 	categoryGateway := gateways.NewCategoryGateway(mockCategoryDataSource)
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := NewCreateProductUseCase(*productGateway, categoryGateway)
+	uc := NewCreateProductUseCase(*productGateway, categoryGateway, gateways.CatalogCacheGateway{})
 	product, err := uc.Execute(productDTO)
 	require.NoError(t, err)
 	require.Equal(t, productDTO.Name, product.Name.Value())
@@ -58,10 +59,10 @@ func TestCreateProductUseCase_Success(t *testing.T) {
 func TestCreateProductUseCase_CategoryNotFound(t *testing.T) {
 	productDTO, mockProductDataSource, mockCategoryDataSource, mockFileProvider, categoryID, ctrl := setupCreateProductTest(t, "Produto Teste")
 	defer ctrl.Finish()
-	mockCategoryDataSource.EXPECT().FindByID(categoryID).Return(daos.CategoryDAO{}, errors.New("not found"))
+	mockCategoryDataSource.EXPECT().FindByID(categoryID).Return(daos.CategoryDAO{}, &exceptions.RecordNotFoundException{})
 	categoryGateway := gateways.NewCategoryGateway(mockCategoryDataSource)
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := NewCreateProductUseCase(*productGateway, categoryGateway)
+	uc := NewCreateProductUseCase(*productGateway, categoryGateway, gateways.CatalogCacheGateway{})
 	_, err := uc.Execute(productDTO)
 	_, ok := err.(*exceptions.CategoryNotFoundException)
 	require.True(t, ok)
@@ -72,7 +73,7 @@ func TestCreateProductUseCase_InvalidProduct(t *testing.T) {
 	defer ctrl.Finish()
 	categoryGateway := gateways.NewCategoryGateway(mockCategoryDataSource)
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := NewCreateProductUseCase(*productGateway, categoryGateway)
+	uc := NewCreateProductUseCase(*productGateway, categoryGateway, gateways.CatalogCacheGateway{})
 	_, err := uc.Execute(productDTO)
 	require.Error(t, err)
 }
@@ -81,10 +82,26 @@ func TestCreateProductUseCase_InsertError(t *testing.T) {
 	productDTO, mockProductDataSource, mockCategoryDataSource, mockFileProvider, categoryID, ctrl := setupCreateProductTest(t, "Produto Teste")
 	defer ctrl.Finish()
 	mockCategoryDataSource.EXPECT().FindByID(categoryID).Return(daos.CategoryDAO{ID: categoryID, Name: "Categoria Teste", Active: true}, nil)
+	mockProductDataSource.EXPECT().FindAllByCategoryID(categoryID).Return(nil, nil)
 	mockProductDataSource.EXPECT().Insert(gomock.Any()).Return(errors.New("insert error"))
 	categoryGateway := gateways.NewCategoryGateway(mockCategoryDataSource)
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := NewCreateProductUseCase(*productGateway, categoryGateway)
+	uc := NewCreateProductUseCase(*productGateway, categoryGateway, gateways.CatalogCacheGateway{})
 	_, err := uc.Execute(productDTO)
 	require.EqualError(t, err, "insert error")
 }
+
+func TestCreateProductUseCase_DuplicatedName(t *testing.T) {
+	productDTO, mockProductDataSource, mockCategoryDataSource, mockFileProvider, categoryID, ctrl := setupCreateProductTest(t, "Pão de Queijo")
+	defer ctrl.Finish()
+	mockCategoryDataSource.EXPECT().FindByID(categoryID).Return(daos.CategoryDAO{ID: categoryID, Name: "Categoria Teste", Active: true}, nil)
+	mockProductDataSource.EXPECT().FindAllByCategoryID(categoryID).Return([]daos.ProductDAO{
+		{ID: "pid", CategoryID: categoryID, Name: "PAO DE QUEIJO", Price: 8.5, Active: true},
+	}, nil)
+	categoryGateway := gateways.NewCategoryGateway(mockCategoryDataSource)
+	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
+	uc := NewCreateProductUseCase(*productGateway, categoryGateway, gateways.CatalogCacheGateway{})
+	_, err := uc.Execute(productDTO)
+	require.IsType(t, &exceptions.ProductAlreadyExistsException{}, err)
+	require.EqualError(t, err, `Product "PAO DE QUEIJO" already exists in this category`)
+}
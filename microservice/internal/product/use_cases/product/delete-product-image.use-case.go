@@ -8,19 +8,24 @@ import (
 )
 
 type DeleteProductImageUseCase struct {
-	gateway gateways.ProductGateway
+	gateway      gateways.ProductGateway
+	cacheGateway gateways.CatalogCacheGateway
 }
 
-func NewDeleteProductImageUseCase(gateway gateways.ProductGateway) *DeleteProductImageUseCase {
+func NewDeleteProductImageUseCase(gateway gateways.ProductGateway, cacheGateway gateways.CatalogCacheGateway) *DeleteProductImageUseCase {
 	return &DeleteProductImageUseCase{
-		gateway: gateway,
+		gateway:      gateway,
+		cacheGateway: cacheGateway,
 	}
 }
 
 func (uc *DeleteProductImageUseCase) Execute(productID string, imageFileName string) error {
-	_, err := uc.gateway.FindByID(productID)
+	product, err := uc.gateway.FindByID(productID)
 	if err != nil {
-		return &exceptions.ProductNotFoundException{}
+		if exceptions.IsRecordNotFound(err) {
+			return &exceptions.ProductNotFoundException{}
+		}
+		return err
 	}
 	productImages, err := uc.gateway.FindAllImagesProductById(productID)
 	if err != nil || len(productImages.Images) == 0 {
@@ -33,6 +38,8 @@ func (uc *DeleteProductImageUseCase) Execute(productID string, imageFileName str
 
 	isDefault := productImages.ImageIsDefault(imageFileName)
 
+	defer uc.cacheGateway.Invalidate()
+
 	if isDefault {
 		err = uc.gateway.SetLastImageAsDefault(productID, imageFileName)
 		if err != nil {
@@ -45,6 +52,10 @@ func (uc *DeleteProductImageUseCase) Execute(productID string, imageFileName str
 		return &exceptions.InvalidProductImageException{Message: "Failed to delete image from database"}
 	}
 
+	if err := uc.gateway.Update(&product); err != nil {
+		return err
+	}
+
 	if imageFileName != value_objects.DEFAULT_IMAGE_FILE_NAME {
 		err = uc.gateway.DeleteImage(imageFileName)
 		if err != nil {
@@ -31,10 +31,14 @@ func TestDeleteProductImageUseCase_Success(t *testing.T) {
 	mockProductDataSource.EXPECT().ImageIsDefault(imageFileName).Return(false).AnyTimes()
 	mockProductDataSource.EXPECT().DeleteProductImage(imageFileName).Return(nil).AnyTimes()
 	mockProductDataSource.EXPECT().DeleteImage(imageFileName).Return(nil)
+	mockProductDataSource.EXPECT().Update(gomock.Any()).DoAndReturn(func(product daos.ProductDAO) error {
+		require.Equal(t, productID, product.ID)
+		return nil
+	})
 	mockFileProvider.EXPECT().DeleteFile(imageFileName).Return(nil)
 
 	gw := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := NewDeleteProductImageUseCase(*gw)
+	uc := NewDeleteProductImageUseCase(*gw, gateways.CatalogCacheGateway{})
 	err := uc.Execute(productID, imageFileName)
 	require.NoError(t, err)
 }
@@ -47,10 +51,10 @@ func TestDeleteProductImageUseCase_ProductNotFound(t *testing.T) {
 	productID := "notfound"
 	imageFileName := "img1.jpg"
 
-	mockProductDataSource.EXPECT().FindByID(productID).Return(daos.ProductDAO{}, fmt.Errorf("not found"))
+	mockProductDataSource.EXPECT().FindByID(productID).Return(daos.ProductDAO{}, &exceptions.RecordNotFoundException{})
 
 	gw := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := NewDeleteProductImageUseCase(*gw)
+	uc := NewDeleteProductImageUseCase(*gw, gateways.CatalogCacheGateway{})
 	err := uc.Execute(productID, imageFileName)
 	_, ok := err.(*exceptions.ProductNotFoundException)
 	require.True(t, ok)
@@ -69,7 +73,7 @@ func TestDeleteProductImageUseCase_ImagesNotFound(t *testing.T) {
 	mockProductDataSource.EXPECT().FindAllImagesProductById(productID).Return(nil, fmt.Errorf("not found"))
 
 	gw := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := NewDeleteProductImageUseCase(*gw)
+	uc := NewDeleteProductImageUseCase(*gw, gateways.CatalogCacheGateway{})
 	err := uc.Execute(productID, imageFileName)
 	_, ok := err.(*exceptions.ProductImagesNotFoundException)
 	require.True(t, ok)
@@ -91,7 +95,7 @@ func TestDeleteProductImageUseCase_CannotBeEmpty(t *testing.T) {
 		}, nil)
 
 	gw := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := NewDeleteProductImageUseCase(*gw)
+	uc := NewDeleteProductImageUseCase(*gw, gateways.CatalogCacheGateway{})
 	err := uc.Execute(productID, imageFileName)
 	_, ok := err.(*exceptions.ProductImageCannotBeEmptyException)
 	require.True(t, ok)
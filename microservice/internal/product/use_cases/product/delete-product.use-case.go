@@ -1,38 +1,71 @@
 package use_cases
 
 import (
+	"log"
+
+	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
 	"tech_challenge/internal/product/domain/exceptions"
 )
 
 type DeleteProductUseCase struct {
-	gateway gateways.ProductGateway
+	gateway            gateways.ProductGateway
+	transactionGateway gateways.TransactionGateway
+	cacheGateway       gateways.CatalogCacheGateway
 }
 
-func NewDeleteProductUseCase(gateway gateways.ProductGateway) *DeleteProductUseCase {
+func NewDeleteProductUseCase(gateway gateways.ProductGateway, transactionGateway gateways.TransactionGateway, cacheGateway gateways.CatalogCacheGateway) *DeleteProductUseCase {
 	return &DeleteProductUseCase{
-		gateway: gateway,
+		gateway:            gateway,
+		transactionGateway: transactionGateway,
+		cacheGateway:       cacheGateway,
 	}
 }
 
-func (uc *DeleteProductUseCase) Execute(productID string) error {
-	_, err := uc.gateway.FindByID(productID)
-	if err != nil {
-		return &exceptions.ProductNotFoundException{}
-	}
+func (uc *DeleteProductUseCase) Execute(deleteDTO dtos.DeleteProductDTO) error {
+	productID := deleteDTO.ID
 
-	product_images, err := uc.gateway.FindAllImagesProductById(productID)
+	product, err := uc.gateway.FindByID(productID)
 	if err != nil {
-		return &exceptions.ProductImagesNotFoundException{}
+		if exceptions.IsRecordNotFound(err) {
+			return &exceptions.ProductNotFoundException{}
+		}
+		return err
 	}
-	err = uc.gateway.DeleteFiles(product_images.Images)
-	if err != nil {
-		return &exceptions.DeleteImagesStorageException{}
+
+	if !deleteDTO.Precondition.Matches(product.Version) {
+		return &exceptions.VersionConflictException{}
 	}
-	err = uc.gateway.Delete(productID)
+
+	// A remoção só acontece se a versão lida ainda for a do banco, e os arquivos
+	// só saem do storage depois que a linha foi removida
+	var productImages entities.Product
+	defer uc.cacheGateway.Invalidate()
+
+	err = uc.transactionGateway.Run(func(gateways gateways.TransactionGateways) error {
+		productImages, err = gateways.Product.FindAllImagesProductById(productID)
+		if err != nil {
+			if exceptions.IsRecordNotFound(err) {
+				return &exceptions.ProductImagesNotFoundException{}
+			}
+			return err
+		}
+
+		return gateways.Product.Delete(productID, product.Version)
+	})
 	if err != nil {
+		if exceptions.IsRecordNotFound(err) {
+			return &exceptions.ProductNotFoundException{}
+		}
 		return err
 	}
 
+	// O produto já foi removido; arquivos que sobrarem são recolhidos pela
+	// coleta de lixo do storage
+	if err := uc.gateway.DeleteFiles(productImages.Images); err != nil {
+		log.Printf("failed to delete images of product %s: %v", productID, err)
+	}
+
 	return nil
 }
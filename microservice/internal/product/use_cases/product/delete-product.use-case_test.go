@@ -7,95 +7,134 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/application/gateways"
 	"tech_challenge/internal/product/daos"
 	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/interfaces"
 	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	testenv "tech_challenge/internal/shared/test"
 )
 
-func TestDeleteProductUseCase_Success(t *testing.T) {
+type deleteProductMocks struct {
+	t           *testing.T
+	product     *mock_interfaces.MockIProductDataSource
+	file        *mock_interfaces.MockIFileProvider
+	transaction *mock_interfaces.MockITransactionManager
+	cache       *testenv.MockCatalogCache
+}
+
+func newDeleteProductUseCase(t *testing.T) (*DeleteProductUseCase, deleteProductMocks) {
 	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
-	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	t.Cleanup(ctrl.Finish)
+	mocks := deleteProductMocks{
+		t:           t,
+		product:     mock_interfaces.NewMockIProductDataSource(ctrl),
+		file:        mock_interfaces.NewMockIFileProvider(ctrl),
+		transaction: mock_interfaces.NewMockITransactionManager(ctrl),
+		cache:       &testenv.MockCatalogCache{},
+	}
+
+	uc := NewDeleteProductUseCase(
+		*gateways.NewProductGateway(mocks.product, mocks.file),
+		gateways.NewTransactionGateway(mocks.transaction, mocks.file),
+		gateways.NewCatalogCacheGateway(mocks.cache),
+	)
+	return uc, mocks
+}
+
+func (m deleteProductMocks) transactionCall() *gomock.Call {
+	return m.transaction.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(interfaces.TransactionDataSources) error) error {
+		err := fn(interfaces.TransactionDataSources{Product: m.product})
+		// O cache só é limpo depois do commit
+		require.Zero(m.t, m.cache.Invalidations)
+		return err
+	})
+}
+
+func TestDeleteProductUseCase_Success(t *testing.T) {
+	uc, mocks := newDeleteProductUseCase(t)
 	id := "a3bb189e-8bf9-3888-9912-ace4e6543002"
 	gomock.InOrder(
-		mockProductDataSource.EXPECT().FindByID(id).Return(daos.ProductDAO{ID: id, Name: "Product 1", Description: "Description 1", Price: 100}, nil),
-		mockProductDataSource.EXPECT().FindAllImagesProductById(id).Return([]daos.ProductImageDAO{{FileName: "img1.jpg"}}, nil),
-		mockFileProvider.EXPECT().DeleteFiles([]string{"img1.jpg"}).Return(nil),
-		mockProductDataSource.EXPECT().Delete(id).Return(nil),
+		mocks.product.EXPECT().FindByID(id).Return(daos.ProductDAO{ID: id, Name: "Product 1", Description: "Description 1", Price: 100, Version: 3}, nil),
+		mocks.transactionCall(),
+		mocks.product.EXPECT().FindAllImagesProductById(id).Return([]daos.ProductImageDAO{{FileName: "img1.jpg"}}, nil),
+		mocks.product.EXPECT().Delete(id, int64(3)).Return(nil),
+		mocks.file.EXPECT().DeleteFiles([]string{"img1.jpg"}).Return(nil),
 	)
-	gw := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := NewDeleteProductUseCase(*gw)
-	require.NoError(t, uc.Execute(id))
+	require.NoError(t, uc.Execute(dtos.DeleteProductDTO{ID: id}))
+	require.Equal(t, 1, mocks.cache.Invalidations)
 }
 
 func TestDeleteProductUseCase_NotFound(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
-	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	uc, mocks := newDeleteProductUseCase(t)
 	id := "not-found-id"
-	mockProductDataSource.EXPECT().FindByID(id).Return(daos.ProductDAO{}, errors.New("not found"))
+	mocks.product.EXPECT().FindByID(id).Return(daos.ProductDAO{}, &exceptions.RecordNotFoundException{})
 
-	gw := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := NewDeleteProductUseCase(*gw)
-	err := uc.Execute(id)
+	err := uc.Execute(dtos.DeleteProductDTO{ID: id})
 	_, ok := err.(*exceptions.ProductNotFoundException)
 	require.True(t, ok)
 }
 
 func TestDeleteProductUseCase_ImagesNotFound(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
-	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	uc, mocks := newDeleteProductUseCase(t)
 	id := "img-not-found-id"
-	mockProductDataSource.EXPECT().FindByID(id).Return(daos.ProductDAO{ID: id, Name: "Product 1", Description: "Description 1", Price: 100}, nil)
-	mockProductDataSource.EXPECT().FindAllImagesProductById(gomock.Any()).DoAndReturn(
-		func(productID string) ([]daos.ProductImageDAO, error) {
-			t.Logf("FindAllImagesProductById called with: %s", productID)
-			return nil, &exceptions.ProductImagesNotFoundException{}
-		},
-	).Times(1)
-	gw := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := NewDeleteProductUseCase(*gw)
-	err := uc.Execute(id)
-	t.Logf("Error returned: %v", err)
+	mocks.product.EXPECT().FindByID(id).Return(daos.ProductDAO{ID: id, Name: "Product 1", Description: "Description 1", Price: 100}, nil)
+	mocks.transactionCall()
+	mocks.product.EXPECT().FindAllImagesProductById(id).Return(nil, &exceptions.RecordNotFoundException{})
+
+	err := uc.Execute(dtos.DeleteProductDTO{ID: id})
 	_, ok := err.(*exceptions.ProductImagesNotFoundException)
 	require.True(t, ok)
 }
 
-func TestDeleteProductUseCase_DeleteFilesError(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
-	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+func TestDeleteProductUseCase_DeleteFilesErrorKeepsDeletion(t *testing.T) {
+	uc, mocks := newDeleteProductUseCase(t)
 	id := "delete-files-error-id"
-	mockProductDataSource.EXPECT().FindByID(id).Return(daos.ProductDAO{ID: id, Name: "Product 1", Description: "Description 1", Price: 100}, nil)
-	mockProductDataSource.EXPECT().FindAllImagesProductById(id).Return([]daos.ProductImageDAO{{FileName: "img1.jpg"}}, nil)
-	mockFileProvider.EXPECT().DeleteFiles(gomock.Any()).Return(errors.New("delete files error"))
-	gw := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := NewDeleteProductUseCase(*gw)
-	err := uc.Execute(id)
-	_, ok := err.(*exceptions.DeleteImagesStorageException)
-	require.True(t, ok)
+	mocks.product.EXPECT().FindByID(id).Return(daos.ProductDAO{ID: id, Name: "Product 1", Description: "Description 1", Price: 100}, nil)
+	mocks.transactionCall()
+	mocks.product.EXPECT().FindAllImagesProductById(id).Return([]daos.ProductImageDAO{{FileName: "img1.jpg"}}, nil)
+	mocks.product.EXPECT().Delete(id, int64(0)).Return(nil)
+	mocks.file.EXPECT().DeleteFiles(gomock.Any()).Return(errors.New("delete files error"))
+
+	// O produto já foi removido; os arquivos ficam para a coleta de lixo do storage
+	require.NoError(t, uc.Execute(dtos.DeleteProductDTO{ID: id}))
 }
 
-func TestDeleteProductUseCase_DeleteError(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
-	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+func TestDeleteProductUseCase_DeleteErrorKeepsFiles(t *testing.T) {
+	uc, mocks := newDeleteProductUseCase(t)
 	id := "delete-error-id"
-	gomock.InOrder(
-		mockProductDataSource.EXPECT().FindByID(id).Return(daos.ProductDAO{ID: id, Name: "Product 1", Description: "Description 1", Price: 100}, nil),
-		mockProductDataSource.EXPECT().FindAllImagesProductById(id).Return([]daos.ProductImageDAO{{FileName: "img1.jpg"}}, nil),
-		mockFileProvider.EXPECT().DeleteFiles([]string{"img1.jpg"}).Return(nil),
-		mockProductDataSource.EXPECT().Delete(id).Return(errors.New("delete error")),
-	)
-	gw := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := NewDeleteProductUseCase(*gw)
-	err := uc.Execute(id)
+	mocks.product.EXPECT().FindByID(id).Return(daos.ProductDAO{ID: id, Name: "Product 1", Description: "Description 1", Price: 100}, nil)
+	mocks.transactionCall()
+	mocks.product.EXPECT().FindAllImagesProductById(id).Return([]daos.ProductImageDAO{{FileName: "img1.jpg"}}, nil)
+	mocks.product.EXPECT().Delete(id, int64(0)).Return(errors.New("delete error"))
+	mocks.file.EXPECT().DeleteFiles(gomock.Any()).Times(0)
+
+	err := uc.Execute(dtos.DeleteProductDTO{ID: id})
 	require.EqualError(t, err, "delete error")
+	require.Equal(t, 1, mocks.cache.Invalidations)
+}
+
+func TestDeleteProductUseCase_ChangedBeforeDelete(t *testing.T) {
+	uc, mocks := newDeleteProductUseCase(t)
+	id := "a3bb189e-8bf9-3888-9912-ace4e6543002"
+	mocks.product.EXPECT().FindByID(id).Return(daos.ProductDAO{ID: id, Name: "Product 1", Description: "Description 1", Price: 100, Version: 3}, nil)
+	mocks.transactionCall()
+	mocks.product.EXPECT().FindAllImagesProductById(id).Return([]daos.ProductImageDAO{{FileName: "img1.jpg"}}, nil)
+	// Outra requisição alterou o produto entre a leitura e a remoção
+	mocks.product.EXPECT().Delete(id, int64(3)).Return(&exceptions.VersionConflictException{})
+	mocks.file.EXPECT().DeleteFiles(gomock.Any()).Times(0)
+
+	err := uc.Execute(dtos.DeleteProductDTO{ID: id, Precondition: dtos.Precondition{IfMatch: true, Versions: []int64{3}}})
+	require.IsType(t, &exceptions.VersionConflictException{}, err)
+}
+
+func TestDeleteProductUseCase_StaleIfMatch(t *testing.T) {
+	uc, mocks := newDeleteProductUseCase(t)
+	id := "a3bb189e-8bf9-3888-9912-ace4e6543002"
+	mocks.product.EXPECT().FindByID(id).Return(daos.ProductDAO{ID: id, Name: "Product 1", Description: "Description 1", Price: 100, Version: 3}, nil)
+
+	err := uc.Execute(dtos.DeleteProductDTO{ID: id, Precondition: dtos.Precondition{IfMatch: true, Versions: []int64{2}}})
+	require.IsType(t, &exceptions.VersionConflictException{}, err)
+	require.Zero(t, mocks.cache.Invalidations)
 }
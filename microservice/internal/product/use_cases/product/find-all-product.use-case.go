@@ -1,9 +1,11 @@
 package use_cases
 
 import (
+	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/application/gateways"
 	"tech_challenge/internal/product/domain/entities"
 	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
 )
 
 type FindAllProductsUseCase struct {
@@ -18,13 +20,63 @@ func NewFindAllProductsUseCase(gateway gateways.ProductGateway, categoryGateway
 	}
 }
 
-func (uc *FindAllProductsUseCase) Execute(categoryID *string) ([]entities.Product, error) {
+func (uc *FindAllProductsUseCase) Execute(filter dtos.ProductFilterDTO) ([]entities.Product, error) {
+	excluded, err := parseExcludedAllergens(filter.ExcludeAllergens)
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := uc.findProducts(filter.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(excluded) == 0 {
+		return products, nil
+	}
+
+	// Produtos sem alérgenos declarados também saem: não há como garantir
+	// que não contêm os excluídos
+	safe := make([]entities.Product, 0, len(products))
+	for _, product := range products {
+		if !product.Allergens.ContainsAny(excluded) {
+			safe = append(safe, product)
+		}
+	}
+	return safe, nil
+}
+
+func (uc *FindAllProductsUseCase) findProducts(categoryID *string) ([]entities.Product, error) {
 	if categoryID != nil {
-		_, err := uc.categoryGateway.FindByID(*categoryID)
+		categories, err := uc.categoryGateway.FindAll()
 		if err != nil {
+			return nil, err
+		}
+
+		tree := entities.NewCategoryTree(categories)
+		if _, ok := tree.Find(*categoryID); !ok {
 			return nil, &exceptions.CategoryNotFoundException{}
 		}
-		return uc.gateway.FindAllByCategoryID(*categoryID)
+
+		// Filtrar por uma categoria também traz os produtos das subcategorias
+		categoryIDs := []string{*categoryID}
+		for _, descendant := range tree.Descendants(*categoryID) {
+			categoryIDs = append(categoryIDs, descendant.ID)
+		}
+
+		return uc.gateway.FindAllByCategoryIDs(categoryIDs)
 	}
 	return uc.gateway.FindAll()
 }
+
+func parseExcludedAllergens(values []string) (value_objects.Allergens, error) {
+	excluded := make(value_objects.Allergens, 0, len(values))
+	for _, value := range values {
+		allergen, ok := value_objects.ParseAllergen(value)
+		if !ok {
+			return nil, &exceptions.InvalidAllergenException{Message: "exclude_allergens: unknown allergen %q", Args: []any{value}}
+		}
+		excluded = append(excluded, allergen)
+	}
+	return excluded, nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/application/gateways"
 	"tech_challenge/internal/product/daos"
 	"tech_challenge/internal/product/domain/exceptions"
@@ -21,10 +22,15 @@ func TestFindAllProductsUseCase_Success_WithCategory(t *testing.T) {
 	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
 
 	categoryID := "cat-1"
-	mockCategoryDataSource.EXPECT().FindByID(categoryID).Return(daos.CategoryDAO{ID: categoryID, Name: "Categoria Teste", Active: true}, nil)
-	mockProductDataSource.EXPECT().FindAllByCategoryID(categoryID).Return(
+	mockCategoryDataSource.EXPECT().FindAll().Return([]daos.CategoryDAO{
+		{ID: categoryID, Name: "Bebidas", Active: true},
+		{ID: "cat-2", ParentID: categoryID, Name: "Refrigerantes", Active: true},
+		{ID: "cat-3", ParentID: "cat-2", Name: "Latas", Active: true},
+		{ID: "cat-4", Name: "Lanches", Active: true},
+	}, nil)
+	mockProductDataSource.EXPECT().FindAllByCategoryIDs([]string{categoryID, "cat-2", "cat-3"}).Return(
 		[]daos.ProductDAO{
-			{ID: "pid", Name: "Coca-Cola", CategoryID: categoryID, Price: 5.99, Active: true, Images: []daos.ProductImageDAO{}},
+			{ID: "pid", Name: "Coca-Cola", CategoryID: "cat-3", Price: 5.99, Active: true, Images: []daos.ProductImageDAO{}},
 		},
 		nil,
 	)
@@ -32,7 +38,7 @@ func TestFindAllProductsUseCase_Success_WithCategory(t *testing.T) {
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
 	uc := NewFindAllProductsUseCase(*productGateway, categoryGateway)
 
-	products, err := uc.Execute(&categoryID)
+	products, err := uc.Execute(dtos.ProductFilterDTO{CategoryID: &categoryID})
 	require.NoError(t, err)
 	require.Len(t, products, 1)
 	require.Equal(t, "pid", products[0].ID)
@@ -55,7 +61,7 @@ func TestFindAllProductsUseCase_Success_WithoutCategory(t *testing.T) {
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
 	uc := NewFindAllProductsUseCase(*productGateway, categoryGateway)
 
-	products, err := uc.Execute(nil)
+	products, err := uc.Execute(dtos.ProductFilterDTO{})
 	require.NoError(t, err)
 	require.Len(t, products, 1)
 	require.Equal(t, "pid", products[0].ID)
@@ -70,15 +76,69 @@ func TestFindAllProductsUseCase_Error_CategoryNotFound(t *testing.T) {
 	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
 
 	categoryID := "cat-1"
-	// Simula erro ao buscar categoria
-	mockCategoryDataSource.EXPECT().FindByID(categoryID).Return(daos.CategoryDAO{}, errors.New("not found"))
+	mockCategoryDataSource.EXPECT().FindAll().Return([]daos.CategoryDAO{{ID: "cat-2", Name: "Lanches", Active: true}}, nil)
 
 	categoryGateway := gateways.NewCategoryGateway(mockCategoryDataSource)
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
 	uc := NewFindAllProductsUseCase(*productGateway, categoryGateway)
 
-	products, err := uc.Execute(&categoryID)
+	products, err := uc.Execute(dtos.ProductFilterDTO{CategoryID: &categoryID})
 	_, ok := err.(*exceptions.CategoryNotFoundException)
 	require.True(t, ok)
 	require.Nil(t, products)
 }
+
+func TestFindAllProductsUseCase_Error_FindAllCategories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+
+	categoryID := "cat-1"
+	mockCategoryDataSource.EXPECT().FindAll().Return(nil, errors.New("db error"))
+
+	categoryGateway := gateways.NewCategoryGateway(mockCategoryDataSource)
+	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
+	uc := NewFindAllProductsUseCase(*productGateway, categoryGateway)
+
+	products, err := uc.Execute(dtos.ProductFilterDTO{CategoryID: &categoryID})
+	require.EqualError(t, err, "db error")
+	require.Nil(t, products)
+}
+
+func TestFindAllProductsUseCase_ExcludeAllergens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+
+	mockProductDataSource.EXPECT().FindAll().Return(
+		[]daos.ProductDAO{
+			{ID: "x-salada", Name: "X-Salada", CategoryID: "cat-1", Price: 20.5, Active: true, Allergens: []string{"gluten", "milk"}},
+			{ID: "salada", Name: "Salada", CategoryID: "cat-1", Price: 15, Active: true, Allergens: []string{}},
+			{ID: "suco", Name: "Suco", CategoryID: "cat-1", Price: 8, Active: true, Allergens: []string{"soy"}},
+			{ID: "pudim", Name: "Pudim", CategoryID: "cat-1", Price: 8, Active: true},
+		},
+		nil,
+	)
+	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
+	uc := NewFindAllProductsUseCase(*productGateway, gateways.NewCategoryGateway(mock_interfaces.NewMockICategoryDataSource(ctrl)))
+
+	products, err := uc.Execute(dtos.ProductFilterDTO{ExcludeAllergens: []string{"Gluten", "lactose"}})
+	require.NoError(t, err)
+	require.Len(t, products, 2)
+	require.Equal(t, "salada", products[0].ID)
+	require.Equal(t, "suco", products[1].ID)
+}
+
+func TestFindAllProductsUseCase_ExcludeUnknownAllergen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	productGateway := gateways.NewProductGateway(mock_interfaces.NewMockIProductDataSource(ctrl), mock_interfaces.NewMockIFileProvider(ctrl))
+	uc := NewFindAllProductsUseCase(*productGateway, gateways.NewCategoryGateway(mock_interfaces.NewMockICategoryDataSource(ctrl)))
+
+	_, err := uc.Execute(dtos.ProductFilterDTO{ExcludeAllergens: []string{"mustard"}})
+	require.EqualError(t, err, `exclude_allergens: unknown allergen "mustard"`)
+	require.IsType(t, &exceptions.InvalidAllergenException{}, err)
+}
@@ -19,6 +19,10 @@ func NewFindProductByIDUseCase(gateway gateways.ProductGateway) *FindProductByID
 func (uc *FindProductByIDUseCase) Execute(id string) (entities.Product, error) {
 	product, err := uc.gateway.FindByID(id)
 
+	if err != nil && !exceptions.IsRecordNotFound(err) {
+		return entities.Product{}, err
+	}
+
 	if err != nil || product.IsEmpty() {
 		return entities.Product{}, &exceptions.ProductNotFoundException{}
 	}
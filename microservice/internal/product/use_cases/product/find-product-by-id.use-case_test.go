@@ -1,7 +1,6 @@
 package use_cases
 
 import (
-	"errors"
 	"testing"
 
 	"tech_challenge/internal/product/application/gateways"
@@ -44,7 +43,7 @@ func TestFindProductByIDUseCase_Error_NotFound(t *testing.T) {
 	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
 	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
 	id := "notfound"
-	mockProductDataSource.EXPECT().FindByID(id).Return(daos.ProductDAO{}, errors.New("not found"))
+	mockProductDataSource.EXPECT().FindByID(id).Return(daos.ProductDAO{}, &exceptions.RecordNotFoundException{})
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
 	uc := NewFindProductByIDUseCase(*productGateway)
 	product, err := uc.Execute(id)
@@ -67,3 +66,15 @@ func TestFindProductByIDUseCase_Error_EmptyProduct(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, entities.Product{}, product)
 }
+
+func TestFindProductByIDUseCase_Error_Unavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{}, &exceptions.RepositoryUnavailableException{})
+	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
+	uc := NewFindProductByIDUseCase(*productGateway)
+	_, err := uc.Execute("pid")
+	require.IsType(t, &exceptions.RepositoryUnavailableException{}, err)
+}
@@ -0,0 +1,51 @@
+package use_cases
+
+import (
+	"slices"
+
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type FindProductsByIDsUseCase struct {
+	gateway gateways.ProductGateway
+}
+
+func NewFindProductsByIDsUseCase(gateway gateways.ProductGateway) *FindProductsByIDsUseCase {
+	return &FindProductsByIDsUseCase{
+		gateway: gateway,
+	}
+}
+
+// Execute busca os produtos de uma vez e os devolve na ordem dos IDs pedidos,
+// sem repetição, junto com os IDs que não existem
+func (uc *FindProductsByIDsUseCase) Execute(ids []string) ([]entities.Product, []string, error) {
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+
+	found, err := uc.gateway.FindAllByIDs(unique)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byID := make(map[string]entities.Product, len(found))
+	for _, product := range found {
+		byID[product.ID] = product
+	}
+
+	products := make([]entities.Product, 0, len(found))
+	missing := []string{}
+	for _, id := range unique {
+		if product, ok := byID[id]; ok {
+			products = append(products, product)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	return products, missing, nil
+}
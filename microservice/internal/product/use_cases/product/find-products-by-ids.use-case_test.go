@@ -0,0 +1,44 @@
+package use_cases
+
+import (
+	"errors"
+	"testing"
+
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindProductsByIDsUseCase_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	mockProductDataSource.EXPECT().FindAllByIDs([]string{"p2", "p1", "p3"}).Return([]daos.ProductDAO{
+		{ID: "p1", Name: "Coca-Cola", CategoryID: "cat-1", Price: 5.99, Active: true},
+		{ID: "p2", Name: "X-Salada", CategoryID: "cat-2", Price: 20.5, Active: true},
+	}, nil)
+
+	uc := NewFindProductsByIDsUseCase(*gateways.NewProductGateway(mockProductDataSource, mockFileProvider))
+	products, missing, err := uc.Execute([]string{"p2", "p1", "p2", "p3"})
+	require.NoError(t, err)
+	require.Len(t, products, 2)
+	require.Equal(t, "p2", products[0].ID)
+	require.Equal(t, "p1", products[1].ID)
+	require.Equal(t, []string{"p3"}, missing)
+}
+
+func TestFindProductsByIDsUseCase_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	mockProductDataSource.EXPECT().FindAllByIDs([]string{"p1"}).Return(nil, errors.New("fail"))
+
+	uc := NewFindProductsByIDsUseCase(*gateways.NewProductGateway(mockProductDataSource, mockFileProvider))
+	_, _, err := uc.Execute([]string{"p1"})
+	require.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package use_cases
+
+import (
+	"slices"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+type GarbageCollectStorageUseCase struct {
+	gateway gateways.ProductGateway
+}
+
+func NewGarbageCollectStorageUseCase(gateway gateways.ProductGateway) *GarbageCollectStorageUseCase {
+	return &GarbageCollectStorageUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *GarbageCollectStorageUseCase) Execute(dryRun bool) (dtos.StorageGarbageCollectionResultDTO, error) {
+	storedFiles, err := uc.gateway.ListStoredFiles()
+	if err != nil {
+		return dtos.StorageGarbageCollectionResultDTO{}, err
+	}
+
+	referencedFiles, err := uc.gateway.FindAllImageFileNames()
+	if err != nil {
+		return dtos.StorageGarbageCollectionResultDTO{}, err
+	}
+
+	referenced := make(map[string]struct{}, len(referencedFiles)+1)
+	for _, fileName := range referencedFiles {
+		referenced[fileName] = struct{}{}
+	}
+	// A imagem default é compartilhada por todos os produtos e nunca deve ser removida
+	referenced[value_objects.DEFAULT_IMAGE_FILE_NAME] = struct{}{}
+
+	orphanFiles := make([]string, 0)
+	for _, fileName := range storedFiles {
+		if _, ok := referenced[fileName]; !ok {
+			orphanFiles = append(orphanFiles, fileName)
+		}
+	}
+	slices.Sort(orphanFiles)
+
+	result := dtos.StorageGarbageCollectionResultDTO{
+		DryRun:          dryRun,
+		StoredFiles:     len(storedFiles),
+		ReferencedFiles: len(referencedFiles),
+		OrphanFiles:     orphanFiles,
+	}
+
+	if dryRun || len(orphanFiles) == 0 {
+		return result, nil
+	}
+
+	if err := uc.gateway.DeleteStoredFiles(orphanFiles); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,61 @@
+package use_cases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/gateways"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	use_cases "tech_challenge/internal/product/use_cases/product"
+)
+
+func TestGarbageCollectStorageUseCase_DryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	mockFileProvider.EXPECT().ListFiles().Return([]string{"orphan.png", "default_product_image.webp", "used.png"}, nil)
+	mockProductDataSource.EXPECT().FindAllImageFileNames().Return([]string{"used.png"}, nil)
+	mockFileProvider.EXPECT().DeleteFiles(gomock.Any()).Times(0)
+
+	gw := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
+	uc := use_cases.NewGarbageCollectStorageUseCase(*gw)
+	result, err := uc.Execute(true)
+	require.NoError(t, err)
+	require.True(t, result.DryRun)
+	require.Equal(t, 3, result.StoredFiles)
+	require.Equal(t, 1, result.ReferencedFiles)
+	require.Equal(t, []string{"orphan.png"}, result.OrphanFiles)
+}
+
+func TestGarbageCollectStorageUseCase_DeletesOrphans(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	mockFileProvider.EXPECT().ListFiles().Return([]string{"b.png", "a.png", "used.png"}, nil)
+	mockProductDataSource.EXPECT().FindAllImageFileNames().Return([]string{"used.png"}, nil)
+	mockFileProvider.EXPECT().DeleteFiles([]string{"a.png", "b.png"}).Return(nil)
+
+	gw := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
+	uc := use_cases.NewGarbageCollectStorageUseCase(*gw)
+	result, err := uc.Execute(false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a.png", "b.png"}, result.OrphanFiles)
+}
+
+func TestGarbageCollectStorageUseCase_ListFilesError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	mockFileProvider.EXPECT().ListFiles().Return(nil, errors.New("fail"))
+
+	gw := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
+	uc := use_cases.NewGarbageCollectStorageUseCase(*gw)
+	_, err := uc.Execute(true)
+	require.Error(t, err)
+}
@@ -0,0 +1,118 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/application/presenters"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+// PatchProductUseCase aplica um merge patch: só os campos informados passam
+// pelos setters da entidade, os demais mantêm o valor atual
+type PatchProductUseCase struct {
+	gateway         gateways.ProductGateway
+	categoryGateway gateways.CategoryGateway
+	cacheGateway    gateways.CatalogCacheGateway
+}
+
+func NewPatchProductUseCase(gateway gateways.ProductGateway, categoryGateway gateways.CategoryGateway, cacheGateway gateways.CatalogCacheGateway) *PatchProductUseCase {
+	return &PatchProductUseCase{
+		gateway:         gateway,
+		categoryGateway: categoryGateway,
+		cacheGateway:    cacheGateway,
+	}
+}
+
+func (uc *PatchProductUseCase) Execute(patchDTO dtos.PatchProductDTO) (entities.Product, error) {
+	product, err := uc.gateway.FindByID(patchDTO.ID)
+
+	if err != nil {
+		if exceptions.IsRecordNotFound(err) {
+			return entities.Product{}, &exceptions.ProductNotFoundException{}
+		}
+		return entities.Product{}, err
+	}
+
+	if !patchDTO.Precondition.Matches(product.Version) {
+		return entities.Product{}, &exceptions.VersionConflictException{}
+	}
+
+	nameChanged := false
+
+	if patchDTO.Name != nil {
+		if err = product.SetName(*patchDTO.Name); err != nil {
+			return entities.Product{}, err
+		}
+		nameChanged = true
+	}
+
+	if patchDTO.Description != nil {
+		if err = product.SetDescription(*patchDTO.Description); err != nil {
+			return entities.Product{}, err
+		}
+	}
+
+	if patchDTO.Price != nil {
+		if err = product.SetPrice(*patchDTO.Price); err != nil {
+			return entities.Product{}, err
+		}
+	}
+
+	if patchDTO.Availability != nil {
+		if product.Availability, err = presenters.AvailabilityFromDTOToDomain(patchDTO.Availability); err != nil {
+			return entities.Product{}, err
+		}
+	}
+
+	if patchDTO.Nutrition != nil {
+		if product.Nutrition, err = presenters.NutritionFromDTOToDomain(patchDTO.Nutrition); err != nil {
+			return entities.Product{}, err
+		}
+	}
+
+	if patchDTO.Allergens != nil {
+		if product.Allergens, err = value_objects.NewAllergens(*patchDTO.Allergens); err != nil {
+			return entities.Product{}, err
+		}
+	}
+
+	if patchDTO.CategoryID != nil && *patchDTO.CategoryID != product.CategoryID {
+		if err = ensureCategoryExists(uc.categoryGateway, *patchDTO.CategoryID); err != nil {
+			return entities.Product{}, err
+		}
+
+		if err = product.SetCategory(*patchDTO.CategoryID); err != nil {
+			return entities.Product{}, err
+		}
+		nameChanged = true
+	}
+
+	// O nome só precisa ser conferido quando muda ele ou a categoria
+	if nameChanged {
+		if err = ensureUniqueProductName(uc.gateway, product); err != nil {
+			return entities.Product{}, err
+		}
+	}
+
+	if patchDTO.Active != nil {
+		if *patchDTO.Active {
+			err = product.Activate()
+		} else {
+			err = product.Deactivate()
+		}
+
+		if err != nil {
+			return entities.Product{}, err
+		}
+	}
+
+	defer uc.cacheGateway.Invalidate()
+
+	if err = uc.gateway.Update(&product); err != nil {
+		return entities.Product{}, err
+	}
+
+	return product, nil
+}
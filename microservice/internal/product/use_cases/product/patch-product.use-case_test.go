@@ -0,0 +1,122 @@
+package use_cases_test
+
+import (
+	"testing"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	use_cases "tech_challenge/internal/product/use_cases/product"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func newPatchProductUseCase(t *testing.T) (*use_cases.PatchProductUseCase, *mock_interfaces.MockIProductDataSource, *mock_interfaces.MockICategoryDataSource) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+
+	uc := use_cases.NewPatchProductUseCase(
+		*gateways.NewProductGateway(mockProductDataSource, mock_interfaces.NewMockIFileProvider(ctrl)),
+		gateways.NewCategoryGateway(mockCategoryDataSource),
+		gateways.CatalogCacheGateway{},
+	)
+	return uc, mockProductDataSource, mockCategoryDataSource
+}
+
+func storedProduct() daos.ProductDAO {
+	return daos.ProductDAO{ID: "pid", CategoryID: "cat-1", Name: "X-Burger", Description: "Pão e carne", Price: 20.0, Active: true}
+}
+
+func TestPatchProductUseCase_OnlyProvidedFieldsChange(t *testing.T) {
+	uc, mockProductDataSource, _ := newPatchProductUseCase(t)
+	mockProductDataSource.EXPECT().FindByID("pid").Return(storedProduct(), nil)
+	mockProductDataSource.EXPECT().Update(gomock.Any()).DoAndReturn(func(dao daos.ProductDAO) error {
+		require.Equal(t, "X-Burger", dao.Name)
+		require.Equal(t, "Pão, carne e queijo", dao.Description)
+		require.Equal(t, 20.0, dao.Price)
+		require.Equal(t, "cat-1", dao.CategoryID)
+		require.True(t, dao.Active)
+		return nil
+	})
+
+	description := "Pão, carne e queijo"
+	product, err := uc.Execute(dtos.PatchProductDTO{ID: "pid", Description: &description})
+	require.NoError(t, err)
+	require.Equal(t, description, product.Description)
+}
+
+func TestPatchProductUseCase_Deactivates(t *testing.T) {
+	uc, mockProductDataSource, _ := newPatchProductUseCase(t)
+	mockProductDataSource.EXPECT().FindByID("pid").Return(storedProduct(), nil)
+	mockProductDataSource.EXPECT().Update(gomock.Any()).DoAndReturn(func(dao daos.ProductDAO) error {
+		require.False(t, dao.Active)
+		return nil
+	})
+
+	active := false
+	product, err := uc.Execute(dtos.PatchProductDTO{ID: "pid", Active: &active})
+	require.NoError(t, err)
+	require.False(t, product.Active)
+}
+
+func TestPatchProductUseCase_NameMustBeUnique(t *testing.T) {
+	uc, mockProductDataSource, _ := newPatchProductUseCase(t)
+	mockProductDataSource.EXPECT().FindByID("pid").Return(storedProduct(), nil)
+	mockProductDataSource.EXPECT().FindAllByCategoryID("cat-1").Return([]daos.ProductDAO{
+		storedProduct(),
+		{ID: "other", CategoryID: "cat-1", Name: "X-Salada", Description: "Salada", Price: 22.0, Active: true},
+	}, nil)
+
+	name := "x-salada"
+	_, err := uc.Execute(dtos.PatchProductDTO{ID: "pid", Name: &name})
+	require.IsType(t, &exceptions.ProductAlreadyExistsException{}, err)
+}
+
+func TestPatchProductUseCase_CategoryNotFound(t *testing.T) {
+	uc, mockProductDataSource, mockCategoryDataSource := newPatchProductUseCase(t)
+	mockProductDataSource.EXPECT().FindByID("pid").Return(storedProduct(), nil)
+	mockCategoryDataSource.EXPECT().FindByID("cat-9").Return(daos.CategoryDAO{}, &exceptions.RecordNotFoundException{})
+
+	categoryID := "cat-9"
+	_, err := uc.Execute(dtos.PatchProductDTO{ID: "pid", CategoryID: &categoryID})
+	require.IsType(t, &exceptions.CategoryNotFoundException{}, err)
+}
+
+func TestPatchProductUseCase_ProductNotFound(t *testing.T) {
+	uc, mockProductDataSource, _ := newPatchProductUseCase(t)
+	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{}, &exceptions.RecordNotFoundException{})
+
+	_, err := uc.Execute(dtos.PatchProductDTO{ID: "pid"})
+	require.IsType(t, &exceptions.ProductNotFoundException{}, err)
+}
+
+func TestPatchProductUseCase_StalePrecondition(t *testing.T) {
+	uc, mockProductDataSource, _ := newPatchProductUseCase(t)
+	stored := storedProduct()
+	stored.Version = 3
+	mockProductDataSource.EXPECT().FindByID("pid").Return(stored, nil)
+
+	price := 25.0
+	_, err := uc.Execute(dtos.PatchProductDTO{ID: "pid", Price: &price, Precondition: dtos.Precondition{IfMatch: true, Versions: []int64{2}}})
+	require.IsType(t, &exceptions.VersionConflictException{}, err)
+}
+
+func TestPatchProductUseCase_ConcurrentChange(t *testing.T) {
+	uc, mockProductDataSource, _ := newPatchProductUseCase(t)
+	stored := storedProduct()
+	stored.Version = 3
+	mockProductDataSource.EXPECT().FindByID("pid").Return(stored, nil)
+	mockProductDataSource.EXPECT().Update(gomock.Any()).DoAndReturn(func(dao daos.ProductDAO) error {
+		require.Equal(t, int64(3), dao.Version)
+		return &exceptions.VersionConflictException{}
+	})
+
+	price := 25.0
+	_, err := uc.Execute(dtos.PatchProductDTO{ID: "pid", Price: &price, Precondition: dtos.Precondition{IfMatch: true, Versions: []int64{3}}})
+	require.IsType(t, &exceptions.VersionConflictException{}, err)
+}
@@ -33,8 +33,12 @@ func TestUploadProductImageUseCase_Success(t *testing.T) {
 	mockProductDataSource.EXPECT().SetAllPreviousImagesAsNotDefault("pid", gomock.Any()).Return(nil).AnyTimes()
 	mockProductDataSource.EXPECT().AddProductImage(gomock.Any()).Return(nil)
 	mockProductDataSource.EXPECT().SetImageAsDefault("pid", gomock.Any()).Return(nil).AnyTimes()
+	mockProductDataSource.EXPECT().Update(gomock.Any()).DoAndReturn(func(product daos.ProductDAO) error {
+		require.Equal(t, "pid", product.ID)
+		return nil
+	})
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUploadProductImageUseCase(*productGateway)
+	uc := use_cases.NewUploadProductImageUseCase(*productGateway, gateways.CatalogCacheGateway{})
 	productDTO := makeUploadProductImageDTO()
 	err := uc.Execute(productDTO)
 	require.NoError(t, err)
@@ -47,7 +51,7 @@ func TestUploadProductImageUseCase_ProductNotFound(t *testing.T) {
 	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
 	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{}, errors.New("not found"))
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUploadProductImageUseCase(*productGateway)
+	uc := use_cases.NewUploadProductImageUseCase(*productGateway, gateways.CatalogCacheGateway{})
 	productDTO := makeUploadProductImageDTO()
 	err := uc.Execute(productDTO)
 	require.Error(t, err)
@@ -60,7 +64,7 @@ func TestUploadProductImageUseCase_InvalidImage(t *testing.T) {
 	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
 	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{ID: "pid", Name: "Produto Teste", Description: "desc", Price: 10.0, CategoryID: "cat1", Active: true}, nil)
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUploadProductImageUseCase(*productGateway)
+	uc := use_cases.NewUploadProductImageUseCase(*productGateway, gateways.CatalogCacheGateway{})
 	productDTO := dtos.UploadProductImageDTO{ProductID: "pid", FileName: "", FileContent: []byte("filedata")}
 	err := uc.Execute(productDTO)
 	require.Error(t, err)
@@ -74,7 +78,7 @@ func TestUploadProductImageUseCase_UploadFileError(t *testing.T) {
 	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{ID: "pid", Name: "Produto Teste", Description: "desc", Price: 10.0, CategoryID: "cat1", Active: true}, nil)
 	mockFileProvider.EXPECT().UploadFile(gomock.Any(), gomock.Any()).Return(errors.New("upload error"))
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUploadProductImageUseCase(*productGateway)
+	uc := use_cases.NewUploadProductImageUseCase(*productGateway, gateways.CatalogCacheGateway{})
 	productDTO := makeUploadProductImageDTO()
 	err := uc.Execute(productDTO)
 	require.EqualError(t, err, "upload error")
@@ -95,8 +99,9 @@ func TestUploadProductImageUseCase_GetPresignedURLReturnNil(t *testing.T) {
 	mockProductDataSource.EXPECT().AddProductImage(gomock.Any()).AnyTimes()
 	mockProductDataSource.EXPECT().SetAllPreviousImagesAsNotDefault(gomock.Any(), gomock.Any()).AnyTimes()
 	mockProductDataSource.EXPECT().SetImageAsDefault(gomock.Any(), gomock.Any()).AnyTimes()
+	mockProductDataSource.EXPECT().Update(gomock.Any()).AnyTimes()
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUploadProductImageUseCase(*productGateway)
+	uc := use_cases.NewUploadProductImageUseCase(*productGateway, gateways.CatalogCacheGateway{})
 	productDTO := makeUploadProductImageDTO()
 	err := uc.Execute(productDTO)
 	require.Nil(t, err)
@@ -114,9 +119,8 @@ func TestUploadProductImageUseCase_AddProductImageError(t *testing.T) {
 	mockProductDataSource.EXPECT().AddProductImage(gomock.Any()).Return(errors.New("add error"))
 	mockProductDataSource.EXPECT().SetImageAsDefault("pid", gomock.Any()).Return(nil).AnyTimes()
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUploadProductImageUseCase(*productGateway)
+	uc := use_cases.NewUploadProductImageUseCase(*productGateway, gateways.CatalogCacheGateway{})
 	productDTO := makeUploadProductImageDTO()
 	err := uc.Execute(productDTO)
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "Invalid product data")
+	require.EqualError(t, err, "add error")
 }
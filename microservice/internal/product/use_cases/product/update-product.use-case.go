@@ -3,17 +3,23 @@ package use_cases
 import (
 	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/application/presenters"
 	"tech_challenge/internal/product/domain/entities"
 	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
 )
 
 type UpdateProductUseCase struct {
-	gateway gateways.ProductGateway
+	gateway         gateways.ProductGateway
+	categoryGateway gateways.CategoryGateway
+	cacheGateway    gateways.CatalogCacheGateway
 }
 
-func NewUpdateProductUseCase(gateway gateways.ProductGateway) *UpdateProductUseCase {
+func NewUpdateProductUseCase(gateway gateways.ProductGateway, categoryGateway gateways.CategoryGateway, cacheGateway gateways.CatalogCacheGateway) *UpdateProductUseCase {
 	return &UpdateProductUseCase{
-		gateway: gateway,
+		gateway:         gateway,
+		categoryGateway: categoryGateway,
+		cacheGateway:    cacheGateway,
 	}
 }
 
@@ -21,7 +27,16 @@ func (uc *UpdateProductUseCase) Execute(productDTO dtos.UpdateProductDTO) (entit
 	product, err := uc.gateway.FindByID(productDTO.ID)
 
 	if err != nil {
-		return entities.Product{}, &exceptions.ProductNotFoundException{}
+		if exceptions.IsRecordNotFound(err) {
+			return entities.Product{}, &exceptions.ProductNotFoundException{}
+		}
+		return entities.Product{}, err
+	}
+
+	// A versão lida também condiciona a gravação, então uma alteração feita
+	// entre esta leitura e o Update ainda é detectada pelo data source
+	if !productDTO.Precondition.Matches(product.Version) {
+		return entities.Product{}, &exceptions.VersionConflictException{}
 	}
 
 	if err = product.SetName(productDTO.Name); err != nil {
@@ -36,10 +51,34 @@ func (uc *UpdateProductUseCase) Execute(productDTO dtos.UpdateProductDTO) (entit
 		return entities.Product{}, err
 	}
 
+	// PUT substitui a grade: sem availability o produto fica sem restrição
+	if product.Availability, err = presenters.AvailabilityFromDTOToDomain(productDTO.Availability); err != nil {
+		return entities.Product{}, err
+	}
+
+	// Assim como a grade, tabela nutricional e alérgenos ausentes são removidos
+	if product.Nutrition, err = presenters.NutritionFromDTOToDomain(productDTO.Nutrition); err != nil {
+		return entities.Product{}, err
+	}
+
+	if product.Allergens, err = value_objects.NewAllergens(productDTO.Allergens); err != nil {
+		return entities.Product{}, err
+	}
+
+	if productDTO.CategoryID != product.CategoryID {
+		if err = ensureCategoryExists(uc.categoryGateway, productDTO.CategoryID); err != nil {
+			return entities.Product{}, err
+		}
+	}
+
 	if err = product.SetCategory(productDTO.CategoryID); err != nil {
 		return entities.Product{}, err
 	}
 
+	if err = ensureUniqueProductName(uc.gateway, product); err != nil {
+		return entities.Product{}, err
+	}
+
 	if productDTO.Active {
 		if err := product.Activate(); err != nil {
 			return entities.Product{}, err
@@ -50,10 +89,12 @@ func (uc *UpdateProductUseCase) Execute(productDTO dtos.UpdateProductDTO) (entit
 		}
 	}
 
-	err = uc.gateway.Update(product)
+	defer uc.cacheGateway.Invalidate()
+
+	err = uc.gateway.Update(&product)
 
 	if err != nil {
-		return entities.Product{}, &exceptions.InvalidProductDataException{}
+		return entities.Product{}, err
 	}
 
 	return product, nil
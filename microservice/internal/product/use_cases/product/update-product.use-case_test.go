@@ -33,8 +33,9 @@ func TestUpdateProductUseCase_Success(t *testing.T) {
 	productDTO := makeProductDTO("pid", categoryID, "Produto Teste", "Descrição", 10.0, true)
 	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{ID: "pid", CategoryID: categoryID, Name: "Produto Teste", Description: "Descrição", Price: 10.0, Active: true}, nil)
 	mockProductDataSource.EXPECT().Update(gomock.Any()).Return(nil)
+	mockProductDataSource.EXPECT().FindAllByCategoryID(gomock.Any()).Return(nil, nil).AnyTimes()
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUpdateProductUseCase(*productGateway)
+	uc := use_cases.NewUpdateProductUseCase(*productGateway, gateways.NewCategoryGateway(mock_interfaces.NewMockICategoryDataSource(ctrl)), gateways.CatalogCacheGateway{})
 	_, err := uc.Execute(productDTO)
 	require.NoError(t, err)
 }
@@ -48,8 +49,9 @@ func TestUpdateProductUseCase_ProductNotFoundException(t *testing.T) {
 	productDTO := makeProductDTO("pid", categoryID, "Produto Teste", "Descrição", 10.0, true)
 	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{}, &exceptions.ProductNotFoundException{})
 	mockProductDataSource.EXPECT().Update(gomock.Any()).Return(nil).AnyTimes()
+	mockProductDataSource.EXPECT().FindAllByCategoryID(gomock.Any()).Return(nil, nil).AnyTimes()
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUpdateProductUseCase(*productGateway)
+	uc := use_cases.NewUpdateProductUseCase(*productGateway, gateways.NewCategoryGateway(mock_interfaces.NewMockICategoryDataSource(ctrl)), gateways.CatalogCacheGateway{})
 	_, err := uc.Execute(productDTO)
 	require.Error(t, err)
 	_, ok := err.(*exceptions.ProductNotFoundException)
@@ -65,8 +67,9 @@ func TestUpdateProductUseCase_SetNameError(t *testing.T) {
 	productDTO := makeProductDTO("pid", categoryID, "", "Descrição", 10.0, true)
 	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{ID: "pid", CategoryID: categoryID, Name: "Produto Teste", Description: "Descrição", Price: 10.0, Active: true}, nil)
 	mockProductDataSource.EXPECT().Update(gomock.Any()).Return(nil).AnyTimes()
+	mockProductDataSource.EXPECT().FindAllByCategoryID(gomock.Any()).Return(nil, nil).AnyTimes()
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUpdateProductUseCase(*productGateway)
+	uc := use_cases.NewUpdateProductUseCase(*productGateway, gateways.NewCategoryGateway(mock_interfaces.NewMockICategoryDataSource(ctrl)), gateways.CatalogCacheGateway{})
 	_, err := uc.Execute(productDTO)
 	require.Error(t, err)
 }
@@ -80,8 +83,9 @@ func TestUpdateProductUseCase_SetDescriptioReturnNil(t *testing.T) {
 	productDTO := makeProductDTO("pid", categoryID, "Produto Teste", "", 10.0, true)
 	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{ID: "pid", CategoryID: categoryID, Name: "Produto Teste", Description: "Descrição", Price: 10.0, Active: true}, nil)
 	mockProductDataSource.EXPECT().Update(gomock.Any()).Return(nil).AnyTimes()
+	mockProductDataSource.EXPECT().FindAllByCategoryID(gomock.Any()).Return(nil, nil).AnyTimes()
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUpdateProductUseCase(*productGateway)
+	uc := use_cases.NewUpdateProductUseCase(*productGateway, gateways.NewCategoryGateway(mock_interfaces.NewMockICategoryDataSource(ctrl)), gateways.CatalogCacheGateway{})
 	_, err := uc.Execute(productDTO)
 	require.NoError(t, err)
 }
@@ -96,8 +100,9 @@ func TestUpdateProductUseCase_ActivateReturnNil(t *testing.T) {
 
 	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{ID: "pid", CategoryID: categoryID, Name: "Produto Teste", Description: "Descrição", Price: 10.0, Active: false}, nil)
 	mockProductDataSource.EXPECT().Update(gomock.Any()).Return(nil).AnyTimes()
+	mockProductDataSource.EXPECT().FindAllByCategoryID(gomock.Any()).Return(nil, nil).AnyTimes()
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUpdateProductUseCase(*productGateway)
+	uc := use_cases.NewUpdateProductUseCase(*productGateway, gateways.NewCategoryGateway(mock_interfaces.NewMockICategoryDataSource(ctrl)), gateways.CatalogCacheGateway{})
 	_, err := uc.Execute(productDTO)
 	require.NoError(t, err)
 }
@@ -112,8 +117,9 @@ func TestUpdateProductUseCase_DeactivateReturnNil(t *testing.T) {
 
 	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{ID: "pid", CategoryID: categoryID, Name: "Produto Teste", Description: "Descrição", Price: 10.0, Active: true}, nil)
 	mockProductDataSource.EXPECT().Update(gomock.Any()).Return(nil).AnyTimes()
+	mockProductDataSource.EXPECT().FindAllByCategoryID(gomock.Any()).Return(nil, nil).AnyTimes()
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUpdateProductUseCase(*productGateway)
+	uc := use_cases.NewUpdateProductUseCase(*productGateway, gateways.NewCategoryGateway(mock_interfaces.NewMockICategoryDataSource(ctrl)), gateways.CatalogCacheGateway{})
 	_, err := uc.Execute(productDTO)
 	require.NoError(t, err)
 }
@@ -129,8 +135,9 @@ func TestUpdateProductUseCase_SetPriceError(t *testing.T) {
 		ID: "pid", CategoryID: categoryID, Name: "Produto Teste", Description: "Descrição", Price: 10.0, Active: true,
 	}, nil)
 	mockProductDataSource.EXPECT().Update(gomock.Any()).Return(nil).AnyTimes()
+	mockProductDataSource.EXPECT().FindAllByCategoryID(gomock.Any()).Return(nil, nil).AnyTimes()
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUpdateProductUseCase(*productGateway)
+	uc := use_cases.NewUpdateProductUseCase(*productGateway, gateways.NewCategoryGateway(mock_interfaces.NewMockICategoryDataSource(ctrl)), gateways.CatalogCacheGateway{})
 	_, err := uc.Execute(productDTO)
 	require.Error(t, err)
 }
@@ -146,8 +153,74 @@ func TestUpdateProductUseCase_SetCategoryReturnNil(t *testing.T) {
 		ID: "pid", CategoryID: "cat-1", Name: "Produto Teste", Description: "Descrição", Price: 10.0, Active: true,
 	}, nil)
 	mockProductDataSource.EXPECT().Update(gomock.Any()).Return(nil).AnyTimes()
+	mockProductDataSource.EXPECT().FindAllByCategoryID(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockCategoryDataSource.EXPECT().FindByID(categoryID).Return(daos.CategoryDAO{ID: categoryID, Name: "Lanches", Active: true}, nil)
 	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
-	uc := use_cases.NewUpdateProductUseCase(*productGateway)
+	uc := use_cases.NewUpdateProductUseCase(*productGateway, gateways.NewCategoryGateway(mockCategoryDataSource), gateways.CatalogCacheGateway{})
 	_, err := uc.Execute(productDTO)
 	require.Nil(t, err)
 }
+
+func TestUpdateProductUseCase_CategoryNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	productDTO := makeProductDTO("pid", "cat-9", "Produto Teste", "Descrição", 10.0, true)
+	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{
+		ID: "pid", CategoryID: "cat-1", Name: "Produto Teste", Description: "Descrição", Price: 10.0, Active: true,
+	}, nil)
+	mockCategoryDataSource := mock_interfaces.NewMockICategoryDataSource(ctrl)
+	mockCategoryDataSource.EXPECT().FindByID("cat-9").Return(daos.CategoryDAO{}, &exceptions.RecordNotFoundException{})
+	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
+	uc := use_cases.NewUpdateProductUseCase(*productGateway, gateways.NewCategoryGateway(mockCategoryDataSource), gateways.CatalogCacheGateway{})
+	_, err := uc.Execute(productDTO)
+	require.IsType(t, &exceptions.CategoryNotFoundException{}, err)
+}
+
+func TestUpdateProductUseCase_DuplicatedName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	categoryID := "cat-1"
+	productDTO := makeProductDTO("pid", categoryID, "Café Expresso", "Descrição", 10.0, true)
+	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{ID: "pid", CategoryID: categoryID, Name: "Produto Teste", Description: "Descrição", Price: 10.0, Active: true}, nil)
+	mockProductDataSource.EXPECT().FindAllByCategoryID(categoryID).Return([]daos.ProductDAO{
+		{ID: "pid", CategoryID: categoryID, Name: "Produto Teste", Price: 10.0, Active: true},
+		{ID: "pid-2", CategoryID: categoryID, Name: "cafe expresso", Price: 6.0, Active: true},
+	}, nil)
+	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
+	uc := use_cases.NewUpdateProductUseCase(*productGateway, gateways.NewCategoryGateway(mock_interfaces.NewMockICategoryDataSource(ctrl)), gateways.CatalogCacheGateway{})
+	_, err := uc.Execute(productDTO)
+	require.IsType(t, &exceptions.ProductAlreadyExistsException{}, err)
+}
+
+func TestUpdateProductUseCase_FindByIDUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	productDTO := makeProductDTO("pid", "cat-1", "Produto Teste", "Descrição", 10.0, true)
+	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{}, &exceptions.RepositoryUnavailableException{})
+	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
+	uc := use_cases.NewUpdateProductUseCase(*productGateway, gateways.NewCategoryGateway(mock_interfaces.NewMockICategoryDataSource(ctrl)), gateways.CatalogCacheGateway{})
+	_, err := uc.Execute(productDTO)
+	require.IsType(t, &exceptions.RepositoryUnavailableException{}, err)
+}
+
+func TestUpdateProductUseCase_UpdateErrorIsPreserved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProductDataSource := mock_interfaces.NewMockIProductDataSource(ctrl)
+	mockFileProvider := mock_interfaces.NewMockIFileProvider(ctrl)
+	productDTO := makeProductDTO("pid", "cat-1", "Produto Teste", "Descrição", 10.0, true)
+	mockProductDataSource.EXPECT().FindByID("pid").Return(daos.ProductDAO{ID: "pid", CategoryID: "cat-1", Name: "Produto Teste", Description: "Descrição", Price: 10.0, Active: true}, nil)
+	mockProductDataSource.EXPECT().FindAllByCategoryID(gomock.Any()).Return(nil, nil)
+	mockProductDataSource.EXPECT().Update(gomock.Any()).Return(&exceptions.RepositoryTimeoutException{})
+	productGateway := gateways.NewProductGateway(mockProductDataSource, mockFileProvider)
+	uc := use_cases.NewUpdateProductUseCase(*productGateway, gateways.NewCategoryGateway(mock_interfaces.NewMockICategoryDataSource(ctrl)), gateways.CatalogCacheGateway{})
+	_, err := uc.Execute(productDTO)
+	require.IsType(t, &exceptions.RepositoryTimeoutException{}, err)
+}
@@ -7,19 +7,24 @@ import (
 )
 
 type UploadProductImageUseCase struct {
-	gateway gateways.ProductGateway
+	gateway      gateways.ProductGateway
+	cacheGateway gateways.CatalogCacheGateway
 }
 
-func NewUploadProductImageUseCase(gateway gateways.ProductGateway) *UploadProductImageUseCase {
+func NewUploadProductImageUseCase(gateway gateways.ProductGateway, cacheGateway gateways.CatalogCacheGateway) *UploadProductImageUseCase {
 	return &UploadProductImageUseCase{
-		gateway: gateway,
+		gateway:      gateway,
+		cacheGateway: cacheGateway,
 	}
 }
 
 func (uc *UploadProductImageUseCase) Execute(productDTO dtos.UploadProductImageDTO) error {
 	product, err := uc.gateway.FindByID(productDTO.ProductID)
 	if err != nil {
-		return &exceptions.ProductNotFoundException{}
+		if exceptions.IsRecordNotFound(err) {
+			return &exceptions.ProductNotFoundException{}
+		}
+		return err
 	}
 
 	newFileName, err := product.AddImage(productDTO.FileName)
@@ -32,9 +37,13 @@ func (uc *UploadProductImageUseCase) Execute(productDTO dtos.UploadProductImageD
 		return err
 	}
 
+	defer uc.cacheGateway.Invalidate()
+
 	if err := uc.gateway.AddAndSetDefaultImage(product, url); err != nil {
-		return &exceptions.InvalidProductDataException{}
+		return err
 	}
 
-	return nil
+	// A imagem padrão faz parte da representação do produto, então a troca
+	// avança a versão (e o ETag) do produto
+	return uc.gateway.Update(&product)
 }
@@ -0,0 +1,136 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/application/presenters"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+type CreatePromotionUseCase struct {
+	gateway         gateways.PromotionGateway
+	productGateway  gateways.ProductGateway
+	categoryGateway gateways.CategoryGateway
+	cacheGateway    gateways.CatalogCacheGateway
+}
+
+func NewCreatePromotionUseCase(
+	gateway gateways.PromotionGateway,
+	productGateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+	cacheGateway gateways.CatalogCacheGateway,
+) *CreatePromotionUseCase {
+	return &CreatePromotionUseCase{
+		gateway:         gateway,
+		productGateway:  productGateway,
+		categoryGateway: categoryGateway,
+		cacheGateway:    cacheGateway,
+	}
+}
+
+func (uc *CreatePromotionUseCase) Execute(promotionDTO dtos.CreatePromotionDTO) (entities.Promotion, error) {
+	promotion, err := newPromotionFromDTO(identity_manager.NewUUIDV4(), promotionDTO, uc.productGateway, uc.categoryGateway)
+	if err != nil {
+		return entities.Promotion{}, err
+	}
+
+	defer uc.cacheGateway.Invalidate()
+
+	if err := uc.gateway.Insert(*promotion); err != nil {
+		return entities.Promotion{}, err
+	}
+
+	return *promotion, nil
+}
+
+// newPromotionFromDTO valida a promoção e confere que os produtos e as
+// categorias alvo existem antes de qualquer gravação
+func newPromotionFromDTO(
+	id string,
+	promotionDTO dtos.CreatePromotionDTO,
+	productGateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+) (*entities.Promotion, error) {
+	discount, err := value_objects.NewDiscount(promotionDTO.DiscountType, promotionDTO.DiscountValue)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := presenters.AvailabilityFromDTOToDomain(promotionDTO.Schedule)
+	if err != nil {
+		return nil, &exceptions.InvalidAvailabilityException{Message: "schedule: %s", Args: []any{err}}
+	}
+
+	promotion, err := entities.NewPromotion(entities.Promotion{
+		ID:          id,
+		Name:        promotionDTO.Name,
+		Discount:    discount,
+		ProductIDs:  promotionDTO.ProductIDs,
+		CategoryIDs: promotionDTO.CategoryIDs,
+		Schedule:    schedule,
+		Priority:    promotionDTO.Priority,
+		Stackable:   promotionDTO.Stackable,
+		Active:      promotionDTO.Active,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkPromotionProducts(promotionDTO.ProductIDs, productGateway); err != nil {
+		return nil, err
+	}
+
+	if err := checkPromotionCategories(promotionDTO.CategoryIDs, categoryGateway); err != nil {
+		return nil, err
+	}
+
+	return promotion, nil
+}
+
+func checkPromotionProducts(productIDs []string, productGateway gateways.ProductGateway) error {
+	if len(productIDs) == 0 {
+		return nil
+	}
+
+	existing, err := productGateway.FindAll()
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, len(existing))
+	for _, product := range existing {
+		known[product.ID] = true
+	}
+
+	for i, productID := range productIDs {
+		if !known[productID] {
+			return &exceptions.ProductNotFoundException{Message: "product_ids[%d]: product %s not found", Args: []any{i, productID}}
+		}
+	}
+
+	return nil
+}
+
+func checkPromotionCategories(categoryIDs []string, categoryGateway gateways.CategoryGateway) error {
+	if len(categoryIDs) == 0 {
+		return nil
+	}
+
+	existing, err := categoryGateway.FindAll()
+	if err != nil {
+		return err
+	}
+
+	tree := entities.NewCategoryTree(existing)
+
+	for i, categoryID := range categoryIDs {
+		if _, ok := tree.Find(categoryID); !ok {
+			return &exceptions.CategoryNotFoundException{Message: "category_ids[%d]: category %s not found", Args: []any{i, categoryID}}
+		}
+	}
+
+	return nil
+}
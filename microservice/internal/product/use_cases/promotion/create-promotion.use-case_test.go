@@ -0,0 +1,123 @@
+package use_cases
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+)
+
+type promotionTestMocks struct {
+	productDataSource   *mock_interfaces.MockIProductDataSource
+	categoryDataSource  *mock_interfaces.MockICategoryDataSource
+	promotionDataSource *mock_interfaces.MockIPromotionDataSource
+	useCase             *CreatePromotionUseCase
+}
+
+func setupPromotionTest(t *testing.T) promotionTestMocks {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mocks := promotionTestMocks{
+		productDataSource:   mock_interfaces.NewMockIProductDataSource(ctrl),
+		categoryDataSource:  mock_interfaces.NewMockICategoryDataSource(ctrl),
+		promotionDataSource: mock_interfaces.NewMockIPromotionDataSource(ctrl),
+	}
+	mocks.useCase = NewCreatePromotionUseCase(
+		gateways.NewPromotionGateway(mocks.promotionDataSource),
+		*gateways.NewProductGateway(mocks.productDataSource, nil),
+		gateways.NewCategoryGateway(mocks.categoryDataSource),
+		gateways.CatalogCacheGateway{},
+	)
+	return mocks
+}
+
+func TestCreatePromotionUseCase(t *testing.T) {
+	mocks := setupPromotionTest(t)
+	mocks.productDataSource.EXPECT().FindAll().Return([]daos.ProductDAO{
+		{ID: "p1", Name: "X-Bacon", CategoryID: "c1", Price: 30, Active: true, Images: []daos.ProductImageDAO{}},
+	}, nil)
+	mocks.categoryDataSource.EXPECT().FindAll().Return([]daos.CategoryDAO{{ID: "c2", Name: "Sobremesas", Active: true}}, nil)
+	mocks.promotionDataSource.EXPECT().Insert(gomock.Any()).DoAndReturn(func(promotion daos.PromotionDAO) error {
+		require.NotEmpty(t, promotion.ID)
+		require.Equal(t, "fixed", promotion.DiscountType)
+		require.Equal(t, 5.0, promotion.DiscountValue)
+		require.Equal(t, []string{"p1"}, promotion.ProductIDs)
+		require.Equal(t, []string{"c2"}, promotion.CategoryIDs)
+		require.Equal(t, []string{"tue"}, promotion.Schedule.Weekly[0].Weekdays)
+		return nil
+	})
+
+	promotion, err := mocks.useCase.Execute(dtos.CreatePromotionDTO{
+		Name:          "Terça do X-Bacon",
+		DiscountType:  "fixed",
+		DiscountValue: 5,
+		ProductIDs:    []string{"p1"},
+		CategoryIDs:   []string{"c2"},
+		Schedule:      &dtos.AvailabilityDTO{Weekly: []dtos.WeeklyAvailabilityDTO{{Weekdays: []string{"tue"}, From: "00:00", To: "24:00"}}},
+		Active:        true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Terça do X-Bacon", promotion.Name)
+}
+
+func TestCreatePromotionUseCase_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		dto     dtos.CreatePromotionDTO
+		setup   func(mocks promotionTestMocks)
+		errType error
+		message string
+	}{
+		{
+			name:    "unknown discount type",
+			dto:     dtos.CreatePromotionDTO{Name: "Combo", DiscountType: "bogo", DiscountValue: 1, ProductIDs: []string{"p1"}},
+			errType: &exceptions.InvalidPromotionException{},
+			message: "discount type must be percentage or fixed",
+		},
+		{
+			name:    "invalid schedule",
+			dto:     dtos.CreatePromotionDTO{Name: "Combo", DiscountType: "percentage", DiscountValue: 10, ProductIDs: []string{"p1"}, Schedule: &dtos.AvailabilityDTO{Weekly: []dtos.WeeklyAvailabilityDTO{{Weekdays: []string{"xyz"}, From: "00:00", To: "10:00"}}}},
+			errType: &exceptions.InvalidAvailabilityException{},
+		},
+		{
+			name: "unknown product",
+			dto:  dtos.CreatePromotionDTO{Name: "Combo", DiscountType: "percentage", DiscountValue: 10, ProductIDs: []string{"p9"}},
+			setup: func(mocks promotionTestMocks) {
+				mocks.productDataSource.EXPECT().FindAll().Return([]daos.ProductDAO{}, nil)
+			},
+			errType: &exceptions.ProductNotFoundException{},
+			message: "product_ids[0]: product p9 not found",
+		},
+		{
+			name: "unknown category",
+			dto:  dtos.CreatePromotionDTO{Name: "Combo", DiscountType: "percentage", DiscountValue: 10, CategoryIDs: []string{"c9"}},
+			setup: func(mocks promotionTestMocks) {
+				mocks.categoryDataSource.EXPECT().FindAll().Return([]daos.CategoryDAO{}, nil)
+			},
+			errType: &exceptions.CategoryNotFoundException{},
+			message: "category_ids[0]: category c9 not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocks := setupPromotionTest(t)
+			if tt.setup != nil {
+				tt.setup(mocks)
+			}
+
+			_, err := mocks.useCase.Execute(tt.dto)
+			require.IsType(t, tt.errType, err)
+			if tt.message != "" {
+				require.EqualError(t, err, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+)
+
+type DeletePromotionUseCase struct {
+	gateway      gateways.PromotionGateway
+	cacheGateway gateways.CatalogCacheGateway
+}
+
+func NewDeletePromotionUseCase(gateway gateways.PromotionGateway, cacheGateway gateways.CatalogCacheGateway) *DeletePromotionUseCase {
+	return &DeletePromotionUseCase{gateway: gateway, cacheGateway: cacheGateway}
+}
+
+// Execute remove a promoção; os preços voltam ao cadastrado na próxima leitura
+func (uc *DeletePromotionUseCase) Execute(id string) error {
+	defer uc.cacheGateway.Invalidate()
+
+	return uc.gateway.Delete(id)
+}
@@ -0,0 +1,18 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type FindAllPromotionsUseCase struct {
+	gateway gateways.PromotionGateway
+}
+
+func NewFindAllPromotionsUseCase(gateway gateways.PromotionGateway) *FindAllPromotionsUseCase {
+	return &FindAllPromotionsUseCase{gateway: gateway}
+}
+
+func (uc *FindAllPromotionsUseCase) Execute() (entities.Promotions, error) {
+	return uc.gateway.FindAll()
+}
@@ -0,0 +1,23 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type FindPromotionByIDUseCase struct {
+	gateway gateways.PromotionGateway
+}
+
+func NewFindPromotionByIDUseCase(gateway gateways.PromotionGateway) *FindPromotionByIDUseCase {
+	return &FindPromotionByIDUseCase{gateway: gateway}
+}
+
+func (uc *FindPromotionByIDUseCase) Execute(id string) (entities.Promotion, error) {
+	promotion, err := uc.gateway.FindByID(id)
+	if err != nil {
+		return entities.Promotion{}, err
+	}
+
+	return *promotion, nil
+}
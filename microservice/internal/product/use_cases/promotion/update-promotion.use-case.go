@@ -0,0 +1,50 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type UpdatePromotionUseCase struct {
+	gateway         gateways.PromotionGateway
+	productGateway  gateways.ProductGateway
+	categoryGateway gateways.CategoryGateway
+	cacheGateway    gateways.CatalogCacheGateway
+}
+
+func NewUpdatePromotionUseCase(
+	gateway gateways.PromotionGateway,
+	productGateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+	cacheGateway gateways.CatalogCacheGateway,
+) *UpdatePromotionUseCase {
+	return &UpdatePromotionUseCase{
+		gateway:         gateway,
+		productGateway:  productGateway,
+		categoryGateway: categoryGateway,
+		cacheGateway:    cacheGateway,
+	}
+}
+
+// Execute substitui a promoção inteira, inclusive os alvos
+func (uc *UpdatePromotionUseCase) Execute(promotionDTO dtos.UpdatePromotionDTO) (entities.Promotion, error) {
+	current, err := uc.gateway.FindByID(promotionDTO.ID)
+	if err != nil {
+		return entities.Promotion{}, err
+	}
+
+	promotion, err := newPromotionFromDTO(current.ID, promotionDTO.CreatePromotionDTO, uc.productGateway, uc.categoryGateway)
+	if err != nil {
+		return entities.Promotion{}, err
+	}
+	promotion.CreatedAt = current.CreatedAt
+
+	defer uc.cacheGateway.Invalidate()
+
+	if err := uc.gateway.Update(promotion); err != nil {
+		return entities.Promotion{}, err
+	}
+
+	return *promotion, nil
+}
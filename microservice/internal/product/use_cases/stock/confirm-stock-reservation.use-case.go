@@ -0,0 +1,63 @@
+package use_cases
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+type ConfirmStockReservationUseCase struct {
+	stockGateway       gateways.StockGateway
+	transactionGateway gateways.TransactionGateway
+}
+
+func NewConfirmStockReservationUseCase(stockGateway gateways.StockGateway, transactionGateway gateways.TransactionGateway) *ConfirmStockReservationUseCase {
+	return &ConfirmStockReservationUseCase{
+		stockGateway:       stockGateway,
+		transactionGateway: transactionGateway,
+	}
+}
+
+// Execute consome as unidades reservadas. Uma reserva que venceu antes da
+// confirmação é liberada na hora, sem esperar a varredura, e a confirmação
+// falha
+func (uc *ConfirmStockReservationUseCase) Execute(id string, now time.Time) (*entities.StockReservation, error) {
+	var reservation *entities.StockReservation
+	var restocked []entities.ProductStock
+	expired := false
+
+	err := uc.transactionGateway.Run(func(transaction gateways.TransactionGateways) error {
+		var err error
+
+		reservation, err = transaction.Stock.FindReservationByID(id)
+		if err != nil {
+			return err
+		}
+
+		if reservation.IsExpired(now) {
+			expired = true
+			restocked, err = releaseReservation(transaction.Stock, reservation, entities.StockReservationExpired, now)
+			return err
+		}
+
+		changed, err := reservation.Confirm(now)
+		if err != nil || !changed {
+			return err
+		}
+
+		return transaction.Stock.UpdateReservationStatus(*reservation, entities.StockReservationPending)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if expired {
+		publishAvailabilityChanges(uc.stockGateway, restocked, now)
+		return nil, &exceptions.StockReservationExpiredException{}
+	}
+
+	return reservation, nil
+}
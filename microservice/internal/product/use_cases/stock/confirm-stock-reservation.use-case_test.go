@@ -0,0 +1,53 @@
+package use_cases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+func pendingReservationDAO(expiresAt time.Time) daos.StockReservationDAO {
+	return daos.StockReservationDAO{
+		ID:        "r1",
+		Items:     []daos.StockReservationItemDAO{{ProductID: "p1", Quantity: 2}},
+		Status:    string(entities.StockReservationPending),
+		ExpiresAt: expiresAt,
+		CreatedAt: stockNow.Add(-time.Minute),
+		UpdatedAt: stockNow.Add(-time.Minute),
+	}
+}
+
+func TestConfirmStockReservationUseCase_Success(t *testing.T) {
+	mocks := setupStockTest(t)
+	mocks.stockDataSource.EXPECT().FindReservationByID("r1").Return(pendingReservationDAO(stockNow.Add(time.Minute)), nil)
+	mocks.stockDataSource.EXPECT().UpdateReservationStatus("r1", "pending", "confirmed", stockNow).Return(nil)
+
+	reservation, err := NewConfirmStockReservationUseCase(mocks.stockGateway, mocks.transactionGateway).Execute("r1", stockNow)
+	require.NoError(t, err)
+	require.Equal(t, entities.StockReservationConfirmed, reservation.Status)
+}
+
+func TestConfirmStockReservationUseCase_ExpiredReleasesUnits(t *testing.T) {
+	mocks := setupStockTest(t)
+	mocks.stockDataSource.EXPECT().FindReservationByID("r1").Return(pendingReservationDAO(stockNow), nil)
+	mocks.stockDataSource.EXPECT().UpdateReservationStatus("r1", "pending", "expired", stockNow).Return(nil)
+	mocks.stockDataSource.EXPECT().Increment("p1", 2).Return(daos.ProductStockDAO{ProductID: "p1", Tracked: true, Quantity: 2}, nil)
+	mocks.publisher.EXPECT().Publish(gomock.Any()).Return(nil)
+
+	_, err := NewConfirmStockReservationUseCase(mocks.stockGateway, mocks.transactionGateway).Execute("r1", stockNow)
+	require.IsType(t, &exceptions.StockReservationExpiredException{}, err)
+}
+
+func TestConfirmStockReservationUseCase_NotFound(t *testing.T) {
+	mocks := setupStockTest(t)
+	mocks.stockDataSource.EXPECT().FindReservationByID("r1").Return(daos.StockReservationDAO{}, &exceptions.RecordNotFoundException{})
+
+	_, err := NewConfirmStockReservationUseCase(mocks.stockGateway, mocks.transactionGateway).Execute("r1", stockNow)
+	require.IsType(t, &exceptions.StockReservationNotFoundException{}, err)
+}
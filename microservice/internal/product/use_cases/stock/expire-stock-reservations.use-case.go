@@ -0,0 +1,65 @@
+package use_cases
+
+import (
+	"log"
+	"time"
+
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+// expiredReservationsBatch limita quantas reservas vencidas cada varredura lê
+// por vez
+const expiredReservationsBatch = 100
+
+type ExpireStockReservationsUseCase struct {
+	stockGateway       gateways.StockGateway
+	transactionGateway gateways.TransactionGateway
+}
+
+func NewExpireStockReservationsUseCase(stockGateway gateways.StockGateway, transactionGateway gateways.TransactionGateway) *ExpireStockReservationsUseCase {
+	return &ExpireStockReservationsUseCase{
+		stockGateway:       stockGateway,
+		transactionGateway: transactionGateway,
+	}
+}
+
+// Execute libera as reservas pendentes vencidas até now, cada uma na sua
+// transação, e devolve quantas expirou. Uma reserva alterada por outra
+// requisição durante a varredura é apenas ignorada
+func (uc *ExpireStockReservationsUseCase) Execute(now time.Time) (int, error) {
+	expired := 0
+
+	for {
+		reservations, err := uc.stockGateway.FindExpiredReservations(now, expiredReservationsBatch)
+		if err != nil {
+			return expired, err
+		}
+
+		released := 0
+		for _, reservation := range reservations {
+			var restocked []entities.ProductStock
+
+			err := uc.transactionGateway.Run(func(transaction gateways.TransactionGateways) error {
+				var err error
+				restocked, err = releaseReservation(transaction.Stock, reservation, entities.StockReservationExpired, now)
+				return err
+			})
+
+			if err != nil {
+				log.Printf("failed to expire stock reservation %s: %v", reservation.ID, err)
+				continue
+			}
+
+			released++
+			publishAvailabilityChanges(uc.stockGateway, restocked, now)
+		}
+
+		expired += released
+
+		// Sem nenhuma liberação no lote, as que restam falharam e seriam lidas de novo
+		if len(reservations) < expiredReservationsBatch || released == 0 {
+			return expired, nil
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package use_cases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+func TestExpireStockReservationsUseCase_SkipsFailedReservations(t *testing.T) {
+	mocks := setupStockTest(t)
+	failed := pendingReservationDAO(stockNow)
+	failed.ID = "r2"
+	mocks.stockDataSource.EXPECT().FindExpiredReservations(stockNow, expiredReservationsBatch).
+		Return([]daos.StockReservationDAO{pendingReservationDAO(stockNow), failed}, nil)
+	mocks.stockDataSource.EXPECT().UpdateReservationStatus("r1", "pending", "expired", stockNow).Return(nil)
+	mocks.stockDataSource.EXPECT().Increment("p1", 2).Return(daos.ProductStockDAO{}, &exceptions.RecordNotFoundException{})
+	mocks.stockDataSource.EXPECT().UpdateReservationStatus("r2", "pending", "expired", stockNow).Return(&exceptions.VersionConflictException{})
+
+	expired, err := NewExpireStockReservationsUseCase(mocks.stockGateway, mocks.transactionGateway).Execute(stockNow)
+	require.NoError(t, err)
+	require.Equal(t, 1, expired)
+}
+
+func TestExpireStockReservationsUseCase_Error(t *testing.T) {
+	mocks := setupStockTest(t)
+	mocks.stockDataSource.EXPECT().FindExpiredReservations(stockNow, expiredReservationsBatch).Return(nil, errors.New("db down"))
+
+	_, err := NewExpireStockReservationsUseCase(mocks.stockGateway, mocks.transactionGateway).Execute(stockNow)
+	require.EqualError(t, err, "db down")
+}
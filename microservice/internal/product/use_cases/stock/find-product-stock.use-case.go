@@ -0,0 +1,39 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+type FindProductStockUseCase struct {
+	productGateway gateways.ProductGateway
+	stockGateway   gateways.StockGateway
+}
+
+func NewFindProductStockUseCase(productGateway gateways.ProductGateway, stockGateway gateways.StockGateway) *FindProductStockUseCase {
+	return &FindProductStockUseCase{
+		productGateway: productGateway,
+		stockGateway:   stockGateway,
+	}
+}
+
+func (uc *FindProductStockUseCase) Execute(productID string) (entities.ProductStock, error) {
+	if err := ensureProductExists(uc.productGateway, productID); err != nil {
+		return entities.ProductStock{}, err
+	}
+
+	return uc.stockGateway.FindByProductID(productID)
+}
+
+// ensureProductExists traduz a ausência do produto para o erro de produto
+// inexistente, citando o id quando há mais de um produto em jogo
+func ensureProductExists(productGateway gateways.ProductGateway, productID string) error {
+	_, err := productGateway.FindByID(productID)
+
+	if exceptions.IsRecordNotFound(err) {
+		return &exceptions.ProductNotFoundException{Message: "product %s not found", Args: []any{productID}}
+	}
+
+	return err
+}
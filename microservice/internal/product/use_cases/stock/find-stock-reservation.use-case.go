@@ -0,0 +1,20 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type FindStockReservationUseCase struct {
+	stockGateway gateways.StockGateway
+}
+
+func NewFindStockReservationUseCase(stockGateway gateways.StockGateway) *FindStockReservationUseCase {
+	return &FindStockReservationUseCase{
+		stockGateway: stockGateway,
+	}
+}
+
+func (uc *FindStockReservationUseCase) Execute(id string) (*entities.StockReservation, error) {
+	return uc.stockGateway.FindReservationByID(id)
+}
@@ -0,0 +1,83 @@
+package use_cases
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type ReleaseStockReservationUseCase struct {
+	stockGateway       gateways.StockGateway
+	transactionGateway gateways.TransactionGateway
+}
+
+func NewReleaseStockReservationUseCase(stockGateway gateways.StockGateway, transactionGateway gateways.TransactionGateway) *ReleaseStockReservationUseCase {
+	return &ReleaseStockReservationUseCase{
+		stockGateway:       stockGateway,
+		transactionGateway: transactionGateway,
+	}
+}
+
+// Execute devolve as unidades de uma reserva pendente, por exemplo quando o
+// pedido é cancelado antes do pagamento
+func (uc *ReleaseStockReservationUseCase) Execute(id string, now time.Time) (*entities.StockReservation, error) {
+	var reservation *entities.StockReservation
+	var restocked []entities.ProductStock
+
+	err := uc.transactionGateway.Run(func(transaction gateways.TransactionGateways) error {
+		var err error
+
+		reservation, err = transaction.Stock.FindReservationByID(id)
+		if err != nil {
+			return err
+		}
+
+		restocked, err = releaseReservation(transaction.Stock, reservation, entities.StockReservationReleased, now)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	publishAvailabilityChanges(uc.stockGateway, restocked, now)
+
+	return reservation, nil
+}
+
+// releaseReservation devolve ao estoque as unidades de uma reserva pendente,
+// dentro da transação de stockGateway, e marca a reserva com status. Devolve
+// os estoques que deixaram de estar esgotados
+func releaseReservation(stockGateway gateways.StockGateway, reservation *entities.StockReservation, status entities.StockReservationStatus, now time.Time) ([]entities.ProductStock, error) {
+	changed, err := reservation.Release(status, now)
+	if err != nil || !changed {
+		return nil, err
+	}
+
+	// O status muda antes das unidades voltarem: se outra requisição liberou
+	// a reserva antes, nada é devolvido duas vezes
+	if err := stockGateway.UpdateReservationStatus(*reservation, entities.StockReservationPending); err != nil {
+		return nil, err
+	}
+
+	restocked := make([]entities.ProductStock, 0)
+	for _, item := range reservation.Items {
+		stock, tracked, err := stockGateway.Increment(item.ProductID, item.Quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		if tracked && !stock.SoldOut && stock.Quantity == item.Quantity {
+			restocked = append(restocked, stock)
+		}
+	}
+
+	return restocked, nil
+}
+
+func publishAvailabilityChanges(stockGateway gateways.StockGateway, stocks []entities.ProductStock, now time.Time) {
+	for _, stock := range stocks {
+		stockGateway.PublishAvailabilityChanged(stock, now)
+	}
+}
@@ -0,0 +1,44 @@
+package use_cases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+func TestReleaseStockReservationUseCase_Success(t *testing.T) {
+	mocks := setupStockTest(t)
+	mocks.stockDataSource.EXPECT().FindReservationByID("r1").Return(pendingReservationDAO(stockNow.Add(time.Minute)), nil)
+	mocks.stockDataSource.EXPECT().UpdateReservationStatus("r1", "pending", "released", stockNow).Return(nil)
+	// Ainda havia saldo: o produto não estava esgotado e nenhum evento sai
+	mocks.stockDataSource.EXPECT().Increment("p1", 2).Return(daos.ProductStockDAO{ProductID: "p1", Tracked: true, Quantity: 7}, nil)
+
+	reservation, err := NewReleaseStockReservationUseCase(mocks.stockGateway, mocks.transactionGateway).Execute("r1", stockNow)
+	require.NoError(t, err)
+	require.Equal(t, entities.StockReservationReleased, reservation.Status)
+}
+
+func TestReleaseStockReservationUseCase_AlreadyReleased(t *testing.T) {
+	mocks := setupStockTest(t)
+	reservationDAO := pendingReservationDAO(stockNow.Add(time.Minute))
+	reservationDAO.Status = string(entities.StockReservationReleased)
+	mocks.stockDataSource.EXPECT().FindReservationByID("r1").Return(reservationDAO, nil)
+
+	reservation, err := NewReleaseStockReservationUseCase(mocks.stockGateway, mocks.transactionGateway).Execute("r1", stockNow)
+	require.NoError(t, err)
+	require.Equal(t, entities.StockReservationReleased, reservation.Status)
+}
+
+func TestReleaseStockReservationUseCase_ChangedConcurrently(t *testing.T) {
+	mocks := setupStockTest(t)
+	mocks.stockDataSource.EXPECT().FindReservationByID("r1").Return(pendingReservationDAO(stockNow.Add(time.Minute)), nil)
+	mocks.stockDataSource.EXPECT().UpdateReservationStatus("r1", "pending", "released", stockNow).Return(&exceptions.VersionConflictException{})
+
+	_, err := NewReleaseStockReservationUseCase(mocks.stockGateway, mocks.transactionGateway).Execute("r1", stockNow)
+	require.IsType(t, &exceptions.InvalidStockReservationStateException{}, err)
+}
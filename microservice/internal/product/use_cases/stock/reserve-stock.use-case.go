@@ -0,0 +1,89 @@
+package use_cases
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+type ReserveStockUseCase struct {
+	productGateway     gateways.ProductGateway
+	stockGateway       gateways.StockGateway
+	transactionGateway gateways.TransactionGateway
+}
+
+func NewReserveStockUseCase(productGateway gateways.ProductGateway, stockGateway gateways.StockGateway, transactionGateway gateways.TransactionGateway) *ReserveStockUseCase {
+	return &ReserveStockUseCase{
+		productGateway:     productGateway,
+		stockGateway:       stockGateway,
+		transactionGateway: transactionGateway,
+	}
+}
+
+// Execute reserva todos os itens ou nenhum: a baixa de cada produto e a
+// gravação da reserva acontecem na mesma transação
+func (uc *ReserveStockUseCase) Execute(reserveDTO dtos.ReserveStockDTO, now time.Time) (*entities.StockReservation, error) {
+	items := make([]entities.StockReservationItem, 0, len(reserveDTO.Items))
+	for _, item := range reserveDTO.Items {
+		items = append(items, entities.StockReservationItem{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+
+	reservation, err := entities.NewStockReservation(identity_manager.NewUUIDV4(), items, now, reserveDTO.TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range reservation.Items {
+		if err := ensureProductExists(uc.productGateway, item.ProductID); err != nil {
+			return nil, err
+		}
+	}
+
+	var soldOut []entities.ProductStock
+
+	err = uc.transactionGateway.Run(func(transaction gateways.TransactionGateways) error {
+		soldOut = nil
+
+		for _, item := range reservation.Items {
+			stock, err := transaction.Stock.FindByProductID(item.ProductID)
+			if err != nil {
+				return err
+			}
+
+			if err := stock.CanReserve(item.Quantity); err != nil {
+				return err
+			}
+
+			if !stock.Tracked {
+				continue
+			}
+
+			// O saldo lido acima pode ter mudado; a baixa confere de novo no banco
+			stock, err = transaction.Stock.Decrement(item.ProductID, item.Quantity)
+			if _, ok := err.(*exceptions.InsufficientStockException); ok {
+				return &exceptions.InsufficientStockException{Message: "insufficient stock for product %s", Args: []any{item.ProductID}}
+			}
+			if err != nil {
+				return err
+			}
+
+			if stock.IsSoldOut() {
+				soldOut = append(soldOut, stock)
+			}
+		}
+
+		return transaction.Stock.InsertReservation(*reservation)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	publishAvailabilityChanges(uc.stockGateway, soldOut, now)
+
+	return reservation, nil
+}
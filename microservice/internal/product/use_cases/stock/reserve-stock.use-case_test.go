@@ -0,0 +1,111 @@
+package use_cases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/interfaces"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+)
+
+var stockNow = time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+type stockTestMocks struct {
+	productDataSource  *mock_interfaces.MockIProductDataSource
+	stockDataSource    *mock_interfaces.MockIStockDataSource
+	publisher          *mock_interfaces.MockIEventPublisher
+	productGateway     gateways.ProductGateway
+	stockGateway       gateways.StockGateway
+	transactionGateway gateways.TransactionGateway
+}
+
+func setupStockTest(t *testing.T) stockTestMocks {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mocks := stockTestMocks{
+		productDataSource: mock_interfaces.NewMockIProductDataSource(ctrl),
+		stockDataSource:   mock_interfaces.NewMockIStockDataSource(ctrl),
+		publisher:         mock_interfaces.NewMockIEventPublisher(ctrl),
+	}
+	mocks.productGateway = *gateways.NewProductGateway(mocks.productDataSource, mock_interfaces.NewMockIFileProvider(ctrl))
+	mocks.stockGateway = gateways.NewStockGateway(mocks.stockDataSource, mocks.publisher)
+
+	// A transação roda sobre o próprio mock
+	transactionManager := mock_interfaces.NewMockITransactionManager(ctrl)
+	transactionManager.EXPECT().Transaction(gomock.Any()).DoAndReturn(
+		func(fn func(interfaces.TransactionDataSources) error) error {
+			return fn(interfaces.TransactionDataSources{Stock: mocks.stockDataSource})
+		},
+	).AnyTimes()
+	mocks.transactionGateway = gateways.NewTransactionGateway(transactionManager, nil)
+
+	return mocks
+}
+
+func stockProductDAO(id string) daos.ProductDAO {
+	return daos.ProductDAO{ID: id, Name: "Coca-Cola", CategoryID: "cat-1", Price: 5.99, Active: true, Images: []daos.ProductImageDAO{}}
+}
+
+func TestReserveStockUseCase_Success(t *testing.T) {
+	mocks := setupStockTest(t)
+	mocks.productDataSource.EXPECT().FindByID("p1").Return(stockProductDAO("p1"), nil)
+	mocks.productDataSource.EXPECT().FindByID("p2").Return(stockProductDAO("p2"), nil)
+	mocks.stockDataSource.EXPECT().FindByProductID("p1").Return(daos.ProductStockDAO{ProductID: "p1", Tracked: true, Quantity: 3}, nil)
+	mocks.stockDataSource.EXPECT().Decrement("p1", 3).Return(daos.ProductStockDAO{ProductID: "p1", Tracked: true, Quantity: 0}, nil)
+	mocks.stockDataSource.EXPECT().FindByProductID("p2").Return(daos.ProductStockDAO{}, &exceptions.RecordNotFoundException{})
+	mocks.stockDataSource.EXPECT().InsertReservation(gomock.Any()).Return(nil)
+	mocks.publisher.EXPECT().Publish(gomock.Any()).DoAndReturn(func(event shared_interfaces.Event) error {
+		require.Equal(t, gateways.StockAvailabilityChangedEvent, event.Type)
+		return nil
+	})
+
+	uc := NewReserveStockUseCase(mocks.productGateway, mocks.stockGateway, mocks.transactionGateway)
+	reservation, err := uc.Execute(dtos.ReserveStockDTO{
+		Items: []dtos.StockReservationItemDTO{{ProductID: "p1", Quantity: 1}, {ProductID: "p2", Quantity: 5}, {ProductID: "p1", Quantity: 2}},
+		TTL:   10 * time.Minute,
+	}, stockNow)
+
+	require.NoError(t, err)
+	require.Len(t, reservation.Items, 2)
+	require.Equal(t, stockNow.Add(10*time.Minute), reservation.ExpiresAt)
+}
+
+func TestReserveStockUseCase_InsufficientStock(t *testing.T) {
+	mocks := setupStockTest(t)
+	mocks.productDataSource.EXPECT().FindByID("p1").Return(stockProductDAO("p1"), nil)
+	mocks.stockDataSource.EXPECT().FindByProductID("p1").Return(daos.ProductStockDAO{ProductID: "p1", Tracked: true, Quantity: 5}, nil)
+	// Outra reserva levou o saldo entre a leitura e a baixa
+	mocks.stockDataSource.EXPECT().Decrement("p1", 2).Return(daos.ProductStockDAO{}, &exceptions.InsufficientStockException{})
+
+	uc := NewReserveStockUseCase(mocks.productGateway, mocks.stockGateway, mocks.transactionGateway)
+	_, err := uc.Execute(dtos.ReserveStockDTO{
+		Items: []dtos.StockReservationItemDTO{{ProductID: "p1", Quantity: 2}},
+		TTL:   time.Minute,
+	}, stockNow)
+
+	require.EqualError(t, err, "insufficient stock for product p1")
+	require.IsType(t, &exceptions.InsufficientStockException{}, err)
+}
+
+func TestReserveStockUseCase_ProductNotFound(t *testing.T) {
+	mocks := setupStockTest(t)
+	mocks.productDataSource.EXPECT().FindByID("p1").Return(daos.ProductDAO{}, &exceptions.RecordNotFoundException{})
+
+	uc := NewReserveStockUseCase(mocks.productGateway, mocks.stockGateway, mocks.transactionGateway)
+	_, err := uc.Execute(dtos.ReserveStockDTO{
+		Items: []dtos.StockReservationItemDTO{{ProductID: "p1", Quantity: 1}},
+		TTL:   time.Minute,
+	}, stockNow)
+
+	require.EqualError(t, err, "product p1 not found")
+	require.IsType(t, &exceptions.ProductNotFoundException{}, err)
+}
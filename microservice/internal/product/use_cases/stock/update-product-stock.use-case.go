@@ -0,0 +1,62 @@
+package use_cases
+
+import (
+	"time"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type UpdateProductStockUseCase struct {
+	productGateway     gateways.ProductGateway
+	stockGateway       gateways.StockGateway
+	transactionGateway gateways.TransactionGateway
+}
+
+func NewUpdateProductStockUseCase(productGateway gateways.ProductGateway, stockGateway gateways.StockGateway, transactionGateway gateways.TransactionGateway) *UpdateProductStockUseCase {
+	return &UpdateProductStockUseCase{
+		productGateway:     productGateway,
+		stockGateway:       stockGateway,
+		transactionGateway: transactionGateway,
+	}
+}
+
+// Execute substitui o estoque do produto. A quantidade informada é o saldo
+// livre para venda: unidades já reservadas não entram nela
+func (uc *UpdateProductStockUseCase) Execute(stockDTO dtos.UpdateProductStockDTO) (entities.ProductStock, error) {
+	stock, err := entities.NewProductStock(stockDTO.ProductID, stockDTO.Tracked, stockDTO.Quantity, stockDTO.SoldOut)
+	if err != nil {
+		return entities.ProductStock{}, err
+	}
+
+	if err := ensureProductExists(uc.productGateway, stockDTO.ProductID); err != nil {
+		return entities.ProductStock{}, err
+	}
+
+	// O estoque anterior é lido com a linha bloqueada e substituído na mesma
+	// transação: em gravações simultâneas, cada uma compara com a que a
+	// precedeu e a mudança de esgotado é publicada uma única vez
+	var previous entities.ProductStock
+
+	err = uc.transactionGateway.Run(func(transaction gateways.TransactionGateways) error {
+		var err error
+
+		previous, err = transaction.Stock.FindByProductIDForUpdate(stockDTO.ProductID)
+		if err != nil {
+			return err
+		}
+
+		return transaction.Stock.Save(stock)
+	})
+
+	if err != nil {
+		return entities.ProductStock{}, err
+	}
+
+	if previous.IsSoldOut() != stock.IsSoldOut() {
+		uc.stockGateway.PublishAvailabilityChanged(*stock, time.Now())
+	}
+
+	return *stock, nil
+}
@@ -0,0 +1,56 @@
+package use_cases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+)
+
+func TestUpdateProductStockUseCase_PublishesWhenSoldOut(t *testing.T) {
+	mocks := setupStockTest(t)
+	mocks.productDataSource.EXPECT().FindByID("p1").Return(stockProductDAO("p1"), nil)
+	// A leitura bloqueia a linha e a gravação acontece na mesma transação
+	gomock.InOrder(
+		mocks.stockDataSource.EXPECT().FindByProductIDForUpdate("p1").Return(daos.ProductStockDAO{ProductID: "p1", Tracked: true, Quantity: 2}, nil),
+		mocks.stockDataSource.EXPECT().Save(gomock.Any()).Return(nil),
+	)
+	mocks.publisher.EXPECT().Publish(gomock.Any()).DoAndReturn(func(event shared_interfaces.Event) error {
+		require.Equal(t, gateways.StockAvailabilityChangedEvent, event.Type)
+		return nil
+	})
+
+	stock, err := NewUpdateProductStockUseCase(mocks.productGateway, mocks.stockGateway, mocks.transactionGateway).
+		Execute(dtos.UpdateProductStockDTO{ProductID: "p1", Tracked: true, Quantity: 0})
+	require.NoError(t, err)
+	require.True(t, stock.IsSoldOut())
+}
+
+func TestUpdateProductStockUseCase_NoChangeInAvailability(t *testing.T) {
+	mocks := setupStockTest(t)
+	mocks.productDataSource.EXPECT().FindByID("p1").Return(stockProductDAO("p1"), nil)
+	// Outra gravação já esgotou o produto: esta não publica de novo
+	mocks.stockDataSource.EXPECT().FindByProductIDForUpdate("p1").Return(daos.ProductStockDAO{ProductID: "p1", Tracked: true, Quantity: 0}, nil)
+	mocks.stockDataSource.EXPECT().Save(gomock.Any()).Return(nil)
+
+	_, err := NewUpdateProductStockUseCase(mocks.productGateway, mocks.stockGateway, mocks.transactionGateway).
+		Execute(dtos.UpdateProductStockDTO{ProductID: "p1", Tracked: true, Quantity: 0})
+	require.NoError(t, err)
+}
+
+func TestUpdateProductStockUseCase_SaveError(t *testing.T) {
+	mocks := setupStockTest(t)
+	mocks.productDataSource.EXPECT().FindByID("p1").Return(stockProductDAO("p1"), nil)
+	mocks.stockDataSource.EXPECT().FindByProductIDForUpdate("p1").Return(daos.ProductStockDAO{ProductID: "p1", Tracked: true, Quantity: 2}, nil)
+	mocks.stockDataSource.EXPECT().Save(gomock.Any()).Return(errors.New("db error"))
+
+	_, err := NewUpdateProductStockUseCase(mocks.productGateway, mocks.stockGateway, mocks.transactionGateway).
+		Execute(dtos.UpdateProductStockDTO{ProductID: "p1", Tracked: true, Quantity: 0})
+	require.EqualError(t, err, "db error")
+}
@@ -0,0 +1,28 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+)
+
+type ClearStoreOverridesUseCase struct {
+	gateway            gateways.StoreGateway
+	transactionGateway gateways.TransactionGateway
+	cacheGateway       gateways.CatalogCacheGateway
+}
+
+func NewClearStoreOverridesUseCase(gateway gateways.StoreGateway, transactionGateway gateways.TransactionGateway, cacheGateway gateways.CatalogCacheGateway) *ClearStoreOverridesUseCase {
+	return &ClearStoreOverridesUseCase{gateway: gateway, transactionGateway: transactionGateway, cacheGateway: cacheGateway}
+}
+
+// Execute remove todos os ajustes, e a loja volta a seguir o catálogo
+func (uc *ClearStoreOverridesUseCase) Execute(storeID string) error {
+	if _, err := uc.gateway.FindByID(storeID); err != nil {
+		return err
+	}
+
+	defer uc.cacheGateway.Invalidate()
+
+	return uc.transactionGateway.Run(func(transaction gateways.TransactionGateways) error {
+		return transaction.Store.DeleteOverrides(storeID)
+	})
+}
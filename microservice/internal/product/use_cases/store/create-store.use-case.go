@@ -0,0 +1,32 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+type CreateStoreUseCase struct {
+	gateway      gateways.StoreGateway
+	cacheGateway gateways.CatalogCacheGateway
+}
+
+func NewCreateStoreUseCase(gateway gateways.StoreGateway, cacheGateway gateways.CatalogCacheGateway) *CreateStoreUseCase {
+	return &CreateStoreUseCase{gateway: gateway, cacheGateway: cacheGateway}
+}
+
+func (uc *CreateStoreUseCase) Execute(storeDTO dtos.CreateStoreDTO) (entities.Store, error) {
+	store, err := entities.NewStore(identity_manager.NewUUIDV4(), storeDTO.Name)
+	if err != nil {
+		return entities.Store{}, err
+	}
+
+	defer uc.cacheGateway.Invalidate()
+
+	if err := uc.gateway.Insert(*store); err != nil {
+		return entities.Store{}, err
+	}
+
+	return *store, nil
+}
@@ -0,0 +1,21 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+)
+
+type DeleteStoreUseCase struct {
+	gateway      gateways.StoreGateway
+	cacheGateway gateways.CatalogCacheGateway
+}
+
+func NewDeleteStoreUseCase(gateway gateways.StoreGateway, cacheGateway gateways.CatalogCacheGateway) *DeleteStoreUseCase {
+	return &DeleteStoreUseCase{gateway: gateway, cacheGateway: cacheGateway}
+}
+
+// Execute remove a loja e seus ajustes; o catálogo compartilhado não muda
+func (uc *DeleteStoreUseCase) Execute(id string) error {
+	defer uc.cacheGateway.Invalidate()
+
+	return uc.gateway.Delete(id)
+}
@@ -0,0 +1,18 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type FindAllStoresUseCase struct {
+	gateway gateways.StoreGateway
+}
+
+func NewFindAllStoresUseCase(gateway gateways.StoreGateway) *FindAllStoresUseCase {
+	return &FindAllStoresUseCase{gateway: gateway}
+}
+
+func (uc *FindAllStoresUseCase) Execute() ([]entities.Store, error) {
+	return uc.gateway.FindAll()
+}
@@ -0,0 +1,23 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type FindStoreByIDUseCase struct {
+	gateway gateways.StoreGateway
+}
+
+func NewFindStoreByIDUseCase(gateway gateways.StoreGateway) *FindStoreByIDUseCase {
+	return &FindStoreByIDUseCase{gateway: gateway}
+}
+
+func (uc *FindStoreByIDUseCase) Execute(id string) (entities.Store, error) {
+	store, err := uc.gateway.FindByID(id)
+	if err != nil {
+		return entities.Store{}, err
+	}
+
+	return *store, nil
+}
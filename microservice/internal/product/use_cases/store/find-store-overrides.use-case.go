@@ -0,0 +1,24 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type FindStoreOverridesUseCase struct {
+	gateway gateways.StoreGateway
+}
+
+func NewFindStoreOverridesUseCase(gateway gateways.StoreGateway) *FindStoreOverridesUseCase {
+	return &FindStoreOverridesUseCase{gateway: gateway}
+}
+
+// Execute devolve os ajustes da loja; uma loja inexistente é 404 mesmo sem
+// ajustes, para não ser confundida com uma loja que segue o catálogo
+func (uc *FindStoreOverridesUseCase) Execute(storeID string) (entities.StoreOverrides, error) {
+	if _, err := uc.gateway.FindByID(storeID); err != nil {
+		return entities.StoreOverrides{}, err
+	}
+
+	return uc.gateway.FindOverrides(storeID)
+}
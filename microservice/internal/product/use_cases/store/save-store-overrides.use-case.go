@@ -0,0 +1,156 @@
+package use_cases
+
+import (
+	"fmt"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/application/presenters"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+type SaveStoreOverridesUseCase struct {
+	gateway            gateways.StoreGateway
+	productGateway     gateways.ProductGateway
+	categoryGateway    gateways.CategoryGateway
+	transactionGateway gateways.TransactionGateway
+	cacheGateway       gateways.CatalogCacheGateway
+}
+
+func NewSaveStoreOverridesUseCase(
+	gateway gateways.StoreGateway,
+	productGateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+	transactionGateway gateways.TransactionGateway,
+	cacheGateway gateways.CatalogCacheGateway,
+) *SaveStoreOverridesUseCase {
+	return &SaveStoreOverridesUseCase{
+		gateway:            gateway,
+		productGateway:     productGateway,
+		categoryGateway:    categoryGateway,
+		transactionGateway: transactionGateway,
+		cacheGateway:       cacheGateway,
+	}
+}
+
+// Execute valida todos os ajustes antes de gravar qualquer um e grava todos
+// na mesma transação. Ajustes sem nenhum campo removem o ajuste do item
+func (uc *SaveStoreOverridesUseCase) Execute(saveDTO dtos.SaveStoreOverridesDTO) (entities.StoreOverrides, error) {
+	if _, err := uc.gateway.FindByID(saveDTO.StoreID); err != nil {
+		return entities.StoreOverrides{}, err
+	}
+
+	products, err := uc.productOverrides(saveDTO)
+	if err != nil {
+		return entities.StoreOverrides{}, err
+	}
+
+	categories, err := uc.categoryOverrides(saveDTO)
+	if err != nil {
+		return entities.StoreOverrides{}, err
+	}
+
+	var overrides entities.StoreOverrides
+	defer uc.cacheGateway.Invalidate()
+
+	err = uc.transactionGateway.Run(func(transaction gateways.TransactionGateways) error {
+		for _, override := range products {
+			if err := transaction.Store.SaveProductOverride(override); err != nil {
+				return err
+			}
+		}
+
+		for _, override := range categories {
+			if err := transaction.Store.SaveCategoryOverride(override); err != nil {
+				return err
+			}
+		}
+
+		overrides, err = transaction.Store.FindOverrides(saveDTO.StoreID)
+		return err
+	})
+
+	if err != nil {
+		return entities.StoreOverrides{}, err
+	}
+
+	return overrides, nil
+}
+
+func (uc *SaveStoreOverridesUseCase) productOverrides(saveDTO dtos.SaveStoreOverridesDTO) ([]*entities.ProductStoreOverride, error) {
+	if len(saveDTO.Products) == 0 {
+		return nil, nil
+	}
+
+	existing, err := uc.productGateway.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	productIDs := make(map[string]bool, len(existing))
+	for _, product := range existing {
+		productIDs[product.ID] = true
+	}
+
+	seen := make(map[string]bool, len(saveDTO.Products))
+	overrides := make([]*entities.ProductStoreOverride, 0, len(saveDTO.Products))
+	for i, overrideDTO := range saveDTO.Products {
+		field := fmt.Sprintf("products[%d]", i)
+
+		if !productIDs[overrideDTO.ProductID] {
+			return nil, &exceptions.ProductNotFoundException{Message: "%s: product %s not found", Args: []any{field, overrideDTO.ProductID}}
+		}
+
+		if seen[overrideDTO.ProductID] {
+			return nil, &exceptions.InvalidStoreOverrideException{Message: "%s: product %s is repeated", Args: []any{field, overrideDTO.ProductID}}
+		}
+		seen[overrideDTO.ProductID] = true
+
+		availability, err := presenters.AvailabilityFromDTOToDomain(overrideDTO.Availability)
+		if err != nil {
+			return nil, &exceptions.InvalidAvailabilityException{Message: "%s.availability: %s", Args: []any{field, err}}
+		}
+
+		override, err := entities.NewProductStoreOverride(saveDTO.StoreID, overrideDTO.ProductID, overrideDTO.Price, overrideDTO.Active, availability)
+		if err != nil {
+			return nil, &exceptions.InvalidStoreOverrideException{Message: "%s: %s", Args: []any{field, err}}
+		}
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides, nil
+}
+
+func (uc *SaveStoreOverridesUseCase) categoryOverrides(saveDTO dtos.SaveStoreOverridesDTO) ([]*entities.CategoryStoreOverride, error) {
+	if len(saveDTO.Categories) == 0 {
+		return nil, nil
+	}
+
+	existing, err := uc.categoryGateway.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	tree := entities.NewCategoryTree(existing)
+
+	seen := make(map[string]bool, len(saveDTO.Categories))
+	overrides := make([]*entities.CategoryStoreOverride, 0, len(saveDTO.Categories))
+	for i, overrideDTO := range saveDTO.Categories {
+		field := fmt.Sprintf("categories[%d]", i)
+
+		if _, ok := tree.Find(overrideDTO.CategoryID); !ok {
+			return nil, &exceptions.CategoryNotFoundException{Message: "%s: category %s not found", Args: []any{field, overrideDTO.CategoryID}}
+		}
+
+		if seen[overrideDTO.CategoryID] {
+			return nil, &exceptions.InvalidStoreOverrideException{Message: "%s: category %s is repeated", Args: []any{field, overrideDTO.CategoryID}}
+		}
+		seen[overrideDTO.CategoryID] = true
+
+		overrides = append(overrides, entities.NewCategoryStoreOverride(saveDTO.StoreID, overrideDTO.CategoryID, overrideDTO.Visible))
+	}
+
+	return overrides, nil
+}
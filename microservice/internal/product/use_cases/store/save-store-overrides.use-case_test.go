@@ -0,0 +1,140 @@
+package use_cases
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/interfaces"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+)
+
+type storeTestMocks struct {
+	productDataSource  *mock_interfaces.MockIProductDataSource
+	categoryDataSource *mock_interfaces.MockICategoryDataSource
+	storeDataSource    *mock_interfaces.MockIStoreDataSource
+	useCase            *SaveStoreOverridesUseCase
+}
+
+func setupStoreTest(t *testing.T) storeTestMocks {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mocks := storeTestMocks{
+		productDataSource:  mock_interfaces.NewMockIProductDataSource(ctrl),
+		categoryDataSource: mock_interfaces.NewMockICategoryDataSource(ctrl),
+		storeDataSource:    mock_interfaces.NewMockIStoreDataSource(ctrl),
+	}
+
+	// A transação roda sobre os próprios mocks
+	transactionManager := mock_interfaces.NewMockITransactionManager(ctrl)
+	transactionManager.EXPECT().Transaction(gomock.Any()).DoAndReturn(
+		func(fn func(interfaces.TransactionDataSources) error) error {
+			return fn(interfaces.TransactionDataSources{
+				Product:  mocks.productDataSource,
+				Category: mocks.categoryDataSource,
+				Store:    mocks.storeDataSource,
+			})
+		},
+	).AnyTimes()
+
+	mocks.useCase = NewSaveStoreOverridesUseCase(
+		gateways.NewStoreGateway(mocks.storeDataSource),
+		*gateways.NewProductGateway(mocks.productDataSource, nil),
+		gateways.NewCategoryGateway(mocks.categoryDataSource),
+		gateways.NewTransactionGateway(transactionManager, nil),
+		gateways.CatalogCacheGateway{},
+	)
+	return mocks
+}
+
+func storeProductsDAO() []daos.ProductDAO {
+	return []daos.ProductDAO{
+		{ID: "p1", Name: "X-Salada", CategoryID: "c1", Price: 25, Active: true, Images: []daos.ProductImageDAO{}},
+		{ID: "p2", Name: "X-Bacon", CategoryID: "c1", Price: 30, Active: true, Images: []daos.ProductImageDAO{}},
+	}
+}
+
+func TestSaveStoreOverridesUseCase_SavesAndRemoves(t *testing.T) {
+	mocks := setupStoreTest(t)
+	price, hidden := 27.5, false
+	mocks.storeDataSource.EXPECT().FindByID("s1").Return(daos.StoreDAO{ID: "s1", Name: "Aeroporto"}, nil)
+	mocks.productDataSource.EXPECT().FindAll().Return(storeProductsDAO(), nil)
+	mocks.categoryDataSource.EXPECT().FindAll().Return([]daos.CategoryDAO{{ID: "c1", Name: "Lanches", Active: true}}, nil)
+
+	gomock.InOrder(
+		mocks.storeDataSource.EXPECT().SaveProductOverride(gomock.Any()).DoAndReturn(func(override daos.ProductStoreOverrideDAO) error {
+			require.Equal(t, "s1", override.StoreID)
+			require.Equal(t, "p1", override.ProductID)
+			require.Equal(t, 27.5, *override.Price)
+			require.Nil(t, override.Active)
+			return nil
+		}),
+		// Um ajuste sem campos remove o existente
+		mocks.storeDataSource.EXPECT().DeleteProductOverride("s1", "p2").Return(nil),
+		mocks.storeDataSource.EXPECT().SaveCategoryOverride(gomock.Any()).Return(nil),
+		mocks.storeDataSource.EXPECT().FindProductOverrides("s1").Return([]daos.ProductStoreOverrideDAO{{StoreID: "s1", ProductID: "p1", Price: &price}}, nil),
+		mocks.storeDataSource.EXPECT().FindCategoryOverrides("s1").Return([]daos.CategoryStoreOverrideDAO{{StoreID: "s1", CategoryID: "c1", Visible: &hidden}}, nil),
+	)
+
+	overrides, err := mocks.useCase.Execute(dtos.SaveStoreOverridesDTO{
+		StoreID:    "s1",
+		Products:   []dtos.ProductStoreOverrideDTO{{ProductID: "p1", Price: &price}, {ProductID: "p2"}},
+		Categories: []dtos.CategoryStoreOverrideDTO{{CategoryID: "c1", Visible: &hidden}},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 27.5, overrides.Products["p1"].Price.Value())
+	require.False(t, *overrides.Categories["c1"].Visible)
+}
+
+func TestSaveStoreOverridesUseCase_StoreNotFound(t *testing.T) {
+	mocks := setupStoreTest(t)
+	mocks.storeDataSource.EXPECT().FindByID("s1").Return(daos.StoreDAO{}, &exceptions.RecordNotFoundException{})
+
+	_, err := mocks.useCase.Execute(dtos.SaveStoreOverridesDTO{StoreID: "s1"})
+
+	require.IsType(t, &exceptions.StoreNotFoundException{}, err)
+}
+
+func TestSaveStoreOverridesUseCase_InvalidItemsSaveNothing(t *testing.T) {
+	price, invalidPrice := 20.0, -1.0
+
+	cases := []struct {
+		name     string
+		products []dtos.ProductStoreOverrideDTO
+		err      error
+		message  string
+	}{
+		{"unknown product", []dtos.ProductStoreOverrideDTO{{ProductID: "p1", Price: &price}, {ProductID: "p9", Price: &price}},
+			&exceptions.ProductNotFoundException{}, "products[1]: product p9 not found"},
+		{"repeated product", []dtos.ProductStoreOverrideDTO{{ProductID: "p1", Price: &price}, {ProductID: "p1"}},
+			&exceptions.InvalidStoreOverrideException{}, "products[1]: product p1 is repeated"},
+		{"invalid price", []dtos.ProductStoreOverrideDTO{{ProductID: "p2", Price: &invalidPrice}},
+			&exceptions.InvalidStoreOverrideException{}, "products[0]: price must be greater than 0"},
+		{"invalid availability", []dtos.ProductStoreOverrideDTO{{ProductID: "p2", Availability: &dtos.AvailabilityDTO{StartDate: "amanhã"}}},
+			&exceptions.InvalidAvailabilityException{}, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mocks := setupStoreTest(t)
+			mocks.storeDataSource.EXPECT().FindByID("s1").Return(daos.StoreDAO{ID: "s1"}, nil)
+			mocks.productDataSource.EXPECT().FindAll().Return(storeProductsDAO(), nil)
+
+			_, err := mocks.useCase.Execute(dtos.SaveStoreOverridesDTO{StoreID: "s1", Products: c.products})
+
+			require.IsType(t, c.err, err)
+			if c.message != "" {
+				require.EqualError(t, err, c.message)
+			} else {
+				require.Contains(t, err.Error(), "products[0].availability: ")
+			}
+		})
+	}
+}
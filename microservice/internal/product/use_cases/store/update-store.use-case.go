@@ -0,0 +1,35 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+)
+
+type UpdateStoreUseCase struct {
+	gateway      gateways.StoreGateway
+	cacheGateway gateways.CatalogCacheGateway
+}
+
+func NewUpdateStoreUseCase(gateway gateways.StoreGateway, cacheGateway gateways.CatalogCacheGateway) *UpdateStoreUseCase {
+	return &UpdateStoreUseCase{gateway: gateway, cacheGateway: cacheGateway}
+}
+
+func (uc *UpdateStoreUseCase) Execute(storeDTO dtos.UpdateStoreDTO) (entities.Store, error) {
+	store, err := uc.gateway.FindByID(storeDTO.ID)
+	if err != nil {
+		return entities.Store{}, err
+	}
+
+	if err := store.SetName(storeDTO.Name); err != nil {
+		return entities.Store{}, err
+	}
+
+	defer uc.cacheGateway.Invalidate()
+
+	if err := uc.gateway.Update(store); err != nil {
+		return entities.Store{}, err
+	}
+
+	return *store, nil
+}
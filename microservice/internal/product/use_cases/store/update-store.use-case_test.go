@@ -0,0 +1,42 @@
+package use_cases
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+)
+
+func TestUpdateStoreUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataSource := mock_interfaces.NewMockIStoreDataSource(ctrl)
+	useCase := NewUpdateStoreUseCase(gateways.NewStoreGateway(dataSource), gateways.CatalogCacheGateway{})
+
+	dataSource.EXPECT().FindByID("s1").Return(daos.StoreDAO{ID: "s1", Name: "Aeroporto"}, nil)
+	dataSource.EXPECT().Update(gomock.Any()).DoAndReturn(func(store daos.StoreDAO) error {
+		require.Equal(t, "Aeroporto GRU", store.Name)
+		require.False(t, store.UpdatedAt.IsZero())
+		return nil
+	})
+
+	store, err := useCase.Execute(dtos.UpdateStoreDTO{ID: "s1", Name: " Aeroporto GRU "})
+	require.NoError(t, err)
+	require.Equal(t, "Aeroporto GRU", store.Name)
+}
+
+func TestUpdateStoreUseCase_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dataSource := mock_interfaces.NewMockIStoreDataSource(ctrl)
+	useCase := NewUpdateStoreUseCase(gateways.NewStoreGateway(dataSource), gateways.CatalogCacheGateway{})
+
+	dataSource.EXPECT().FindByID("s1").Return(daos.StoreDAO{}, &exceptions.RecordNotFoundException{})
+
+	_, err := useCase.Execute(dtos.UpdateStoreDTO{ID: "s1", Name: "Centro"})
+	require.IsType(t, &exceptions.StoreNotFoundException{}, err)
+}
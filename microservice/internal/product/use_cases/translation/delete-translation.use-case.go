@@ -0,0 +1,39 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+type DeleteTranslationUseCase struct {
+	transactionGateway gateways.TransactionGateway
+	cacheGateway       gateways.CatalogCacheGateway
+}
+
+func NewDeleteTranslationUseCase(transactionGateway gateways.TransactionGateway, cacheGateway gateways.CatalogCacheGateway) *DeleteTranslationUseCase {
+	return &DeleteTranslationUseCase{transactionGateway: transactionGateway, cacheGateway: cacheGateway}
+}
+
+// Execute remove a tradução; o item volta a aparecer no idioma padrão
+// naquele idioma
+func (uc *DeleteTranslationUseCase) Execute(deleteDTO dtos.DeleteTranslationDTO) error {
+	target, err := parseTranslationTarget(deleteDTO.Target)
+	if err != nil {
+		return err
+	}
+
+	locale, err := value_objects.NewTranslationLocale(deleteDTO.Locale)
+	if err != nil {
+		return err
+	}
+
+	defer uc.cacheGateway.Invalidate()
+
+	return uc.transactionGateway.Run(func(txGateways gateways.TransactionGateways) error {
+		if err := touchTranslatedEntity(txGateways, target, deleteDTO.EntityID); err != nil {
+			return err
+		}
+		return txGateways.Translation.Delete(target, deleteDTO.EntityID, locale)
+	})
+}
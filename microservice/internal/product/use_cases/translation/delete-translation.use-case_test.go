@@ -0,0 +1,37 @@
+package use_cases
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+func TestDeleteTranslationUseCase(t *testing.T) {
+	mocks := setupTranslationTest(t)
+	mocks.categoryDataSource.EXPECT().FindByID("c1").Return(daos.CategoryDAO{ID: "c1", Name: "Bebidas", Active: true, Version: 2}, nil)
+	mocks.categoryDataSource.EXPECT().Update(gomock.Any()).Return(nil)
+	mocks.translationDataSource.EXPECT().Delete("category", "c1", "es").Return(nil)
+
+	uc := NewDeleteTranslationUseCase(mocks.transactionGateway, gateways.CatalogCacheGateway{})
+	err := uc.Execute(dtos.DeleteTranslationDTO{Target: dtos.CategoryTranslationTarget, EntityID: "c1", Locale: "es"})
+
+	require.NoError(t, err)
+}
+
+func TestDeleteTranslationUseCase_NotFound(t *testing.T) {
+	mocks := setupTranslationTest(t)
+	mocks.productDataSource.EXPECT().FindByID("p1").Return(translatedProductDAO("p1"), nil)
+	mocks.productDataSource.EXPECT().Update(gomock.Any()).Return(nil)
+	mocks.translationDataSource.EXPECT().Delete("product", "p1", "en").Return(&exceptions.RecordNotFoundException{})
+
+	uc := NewDeleteTranslationUseCase(mocks.transactionGateway, gateways.CatalogCacheGateway{})
+	err := uc.Execute(dtos.DeleteTranslationDTO{Target: dtos.ProductTranslationTarget, EntityID: "p1", Locale: "en"})
+
+	require.IsType(t, &exceptions.TranslationNotFoundException{}, err)
+}
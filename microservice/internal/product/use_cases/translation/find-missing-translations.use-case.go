@@ -0,0 +1,102 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+type FindMissingTranslationsUseCase struct {
+	productGateway     gateways.ProductGateway
+	categoryGateway    gateways.CategoryGateway
+	translationGateway gateways.TranslationGateway
+}
+
+func NewFindMissingTranslationsUseCase(
+	productGateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+	translationGateway gateways.TranslationGateway,
+) *FindMissingTranslationsUseCase {
+	return &FindMissingTranslationsUseCase{
+		productGateway:     productGateway,
+		categoryGateway:    categoryGateway,
+		translationGateway: translationGateway,
+	}
+}
+
+// Execute monta o relatório do idioma informado ou, com locale vazio, de
+// todos os idiomas com tradução. Produtos e categorias inativos entram no
+// relatório, pois podem ser ativados a qualquer momento
+func (uc *FindMissingTranslationsUseCase) Execute(locale string) ([]dtos.MissingTranslationsDTO, error) {
+	locales := value_objects.TranslationLocales
+	if locale != "" {
+		parsed, err := value_objects.NewTranslationLocale(locale)
+		if err != nil {
+			return nil, err
+		}
+		locales = []value_objects.Locale{parsed}
+	}
+
+	products, err := uc.productGateway.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := uc.categoryGateway.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]dtos.MissingTranslationsDTO, 0, len(locales))
+	for _, locale := range locales {
+		productTranslations, err := uc.translationGateway.FindByLocale(entities.ProductTranslationTarget, locale)
+		if err != nil {
+			return nil, err
+		}
+
+		categoryTranslations, err := uc.translationGateway.FindByLocale(entities.CategoryTranslationTarget, locale)
+		if err != nil {
+			return nil, err
+		}
+
+		productGaps := make([]entities.TranslationGap, 0)
+		for _, product := range products {
+			if gap, missing := productTranslations.Gap(product.ID, product.Name.Value(), product.Description); missing {
+				productGaps = append(productGaps, gap)
+			}
+		}
+
+		categoryGaps := make([]entities.TranslationGap, 0)
+		for _, category := range categories {
+			if gap, missing := categoryTranslations.Gap(category.ID, category.Name.Value(), category.Description); missing {
+				categoryGaps = append(categoryGaps, gap)
+			}
+		}
+
+		reports = append(reports, dtos.MissingTranslationsDTO{
+			Locale:     locale.String(),
+			Products:   translationCoverage(len(products), productGaps),
+			Categories: translationCoverage(len(categories), categoryGaps),
+		})
+	}
+
+	return reports, nil
+}
+
+func translationCoverage(total int, gaps []entities.TranslationGap) dtos.TranslationCoverageDTO {
+	missing := make([]dtos.TranslationGapDTO, 0, len(gaps))
+	for _, gap := range gaps {
+		missing = append(missing, dtos.TranslationGapDTO{
+			ID:            gap.EntityID,
+			Name:          gap.Name,
+			MissingFields: gap.MissingFields,
+		})
+	}
+
+	return dtos.TranslationCoverageDTO{
+		Total:    total,
+		Complete: total - len(gaps),
+		Missing:  missing,
+	}
+}
@@ -0,0 +1,67 @@
+package use_cases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+func TestFindMissingTranslationsUseCase(t *testing.T) {
+	mocks := setupTranslationTest(t)
+	mocks.productDataSource.EXPECT().FindAll().Return([]daos.ProductDAO{translatedProductDAO("p1"), translatedProductDAO("p2")}, nil)
+	mocks.categoryDataSource.EXPECT().FindAll().Return([]daos.CategoryDAO{{ID: "c1", Name: "Lanches", Active: true}}, nil)
+	mocks.translationDataSource.EXPECT().FindAllByLocale("product", "en").Return([]daos.TranslationDAO{
+		{Target: "product", EntityID: "p1", Locale: "en", Name: "Cheeseburger", Description: "Beef and cheese"},
+		{Target: "product", EntityID: "p2", Locale: "en", Name: "Burger"},
+	}, nil)
+	mocks.translationDataSource.EXPECT().FindAllByLocale("category", "en").Return(nil, nil)
+
+	uc := NewFindMissingTranslationsUseCase(mocks.productGateway, mocks.categoryGateway, mocks.translationGateway)
+	reports, err := uc.Execute("en")
+
+	require.NoError(t, err)
+	require.Equal(t, []dtos.MissingTranslationsDTO{{
+		Locale: "en",
+		Products: dtos.TranslationCoverageDTO{
+			Total:    2,
+			Complete: 1,
+			Missing:  []dtos.TranslationGapDTO{{ID: "p2", Name: "X-Salada", MissingFields: []string{"description"}}},
+		},
+		Categories: dtos.TranslationCoverageDTO{
+			Total:    1,
+			Complete: 0,
+			Missing:  []dtos.TranslationGapDTO{{ID: "c1", Name: "Lanches", MissingFields: []string{"name"}}},
+		},
+	}}, reports)
+}
+
+func TestFindMissingTranslationsUseCase_AllLocales(t *testing.T) {
+	mocks := setupTranslationTest(t)
+	mocks.productDataSource.EXPECT().FindAll().Return(nil, nil)
+	mocks.categoryDataSource.EXPECT().FindAll().Return(nil, nil)
+	mocks.translationDataSource.EXPECT().FindAllByLocale("product", "en").Return(nil, nil)
+	mocks.translationDataSource.EXPECT().FindAllByLocale("category", "en").Return(nil, nil)
+	mocks.translationDataSource.EXPECT().FindAllByLocale("product", "es").Return(nil, nil)
+	mocks.translationDataSource.EXPECT().FindAllByLocale("category", "es").Return(nil, nil)
+
+	uc := NewFindMissingTranslationsUseCase(mocks.productGateway, mocks.categoryGateway, mocks.translationGateway)
+	reports, err := uc.Execute("")
+
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	require.Equal(t, "en", reports[0].Locale)
+	require.Equal(t, "es", reports[1].Locale)
+}
+
+func TestFindMissingTranslationsUseCase_UnsupportedLocale(t *testing.T) {
+	mocks := setupTranslationTest(t)
+
+	uc := NewFindMissingTranslationsUseCase(mocks.productGateway, mocks.categoryGateway, mocks.translationGateway)
+	_, err := uc.Execute("fr")
+
+	require.IsType(t, &exceptions.InvalidLocaleException{}, err)
+}
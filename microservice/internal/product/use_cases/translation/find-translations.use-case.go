@@ -0,0 +1,58 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+type FindTranslationsUseCase struct {
+	productGateway     gateways.ProductGateway
+	categoryGateway    gateways.CategoryGateway
+	translationGateway gateways.TranslationGateway
+}
+
+func NewFindTranslationsUseCase(
+	productGateway gateways.ProductGateway,
+	categoryGateway gateways.CategoryGateway,
+	translationGateway gateways.TranslationGateway,
+) *FindTranslationsUseCase {
+	return &FindTranslationsUseCase{
+		productGateway:     productGateway,
+		categoryGateway:    categoryGateway,
+		translationGateway: translationGateway,
+	}
+}
+
+// Execute lista as traduções de um item existente, ordenadas por idioma
+func (uc *FindTranslationsUseCase) Execute(target string, entityID string) ([]entities.Translation, error) {
+	translationTarget, err := parseTranslationTarget(target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.ensureExists(translationTarget, entityID); err != nil {
+		return nil, err
+	}
+
+	return uc.translationGateway.FindByEntityID(translationTarget, entityID)
+}
+
+func (uc *FindTranslationsUseCase) ensureExists(target entities.TranslationTarget, id string) error {
+	if target == entities.CategoryTranslationTarget {
+		_, err := uc.categoryGateway.FindByID(id)
+		if exceptions.IsRecordNotFound(err) {
+			return &exceptions.CategoryNotFoundException{}
+		}
+		return err
+	}
+
+	product, err := uc.productGateway.FindByID(id)
+	if err != nil && !exceptions.IsRecordNotFound(err) {
+		return err
+	}
+	if err != nil || product.IsEmpty() {
+		return &exceptions.ProductNotFoundException{}
+	}
+	return nil
+}
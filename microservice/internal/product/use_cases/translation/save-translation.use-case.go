@@ -0,0 +1,49 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+)
+
+type SaveTranslationUseCase struct {
+	transactionGateway gateways.TransactionGateway
+	cacheGateway       gateways.CatalogCacheGateway
+}
+
+func NewSaveTranslationUseCase(transactionGateway gateways.TransactionGateway, cacheGateway gateways.CatalogCacheGateway) *SaveTranslationUseCase {
+	return &SaveTranslationUseCase{transactionGateway: transactionGateway, cacheGateway: cacheGateway}
+}
+
+// Execute grava a tradução e avança a versão do item na mesma transação
+func (uc *SaveTranslationUseCase) Execute(translationDTO dtos.SaveTranslationDTO) (entities.Translation, error) {
+	target, err := parseTranslationTarget(translationDTO.Target)
+	if err != nil {
+		return entities.Translation{}, err
+	}
+
+	locale, err := value_objects.NewTranslationLocale(translationDTO.Locale)
+	if err != nil {
+		return entities.Translation{}, err
+	}
+
+	translation, err := entities.NewTranslation(target, translationDTO.EntityID, locale, translationDTO.Name, translationDTO.Description)
+	if err != nil {
+		return entities.Translation{}, err
+	}
+
+	defer uc.cacheGateway.Invalidate()
+
+	err = uc.transactionGateway.Run(func(txGateways gateways.TransactionGateways) error {
+		if err := touchTranslatedEntity(txGateways, target, translation.EntityID); err != nil {
+			return err
+		}
+		return txGateways.Translation.Save(translation)
+	})
+	if err != nil {
+		return entities.Translation{}, err
+	}
+
+	return *translation, nil
+}
@@ -0,0 +1,119 @@
+package use_cases
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/application/dtos"
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	value_objects "tech_challenge/internal/product/domain/value-objects"
+	"tech_challenge/internal/product/interfaces"
+	mock_interfaces "tech_challenge/internal/product/interfaces/mocks"
+)
+
+type translationTestMocks struct {
+	productDataSource     *mock_interfaces.MockIProductDataSource
+	categoryDataSource    *mock_interfaces.MockICategoryDataSource
+	translationDataSource *mock_interfaces.MockITranslationDataSource
+	productGateway        gateways.ProductGateway
+	categoryGateway       gateways.CategoryGateway
+	translationGateway    gateways.TranslationGateway
+	transactionGateway    gateways.TransactionGateway
+}
+
+func setupTranslationTest(t *testing.T) translationTestMocks {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mocks := translationTestMocks{
+		productDataSource:     mock_interfaces.NewMockIProductDataSource(ctrl),
+		categoryDataSource:    mock_interfaces.NewMockICategoryDataSource(ctrl),
+		translationDataSource: mock_interfaces.NewMockITranslationDataSource(ctrl),
+	}
+	mocks.productGateway = *gateways.NewProductGateway(mocks.productDataSource, nil)
+	mocks.categoryGateway = gateways.NewCategoryGateway(mocks.categoryDataSource)
+	mocks.translationGateway = gateways.NewTranslationGateway(mocks.translationDataSource)
+
+	// A transação roda sobre os próprios mocks
+	transactionManager := mock_interfaces.NewMockITransactionManager(ctrl)
+	transactionManager.EXPECT().Transaction(gomock.Any()).DoAndReturn(
+		func(fn func(interfaces.TransactionDataSources) error) error {
+			return fn(interfaces.TransactionDataSources{
+				Product:     mocks.productDataSource,
+				Category:    mocks.categoryDataSource,
+				Translation: mocks.translationDataSource,
+			})
+		},
+	).AnyTimes()
+	mocks.transactionGateway = gateways.NewTransactionGateway(transactionManager, nil)
+
+	return mocks
+}
+
+func translatedProductDAO(id string) daos.ProductDAO {
+	return daos.ProductDAO{ID: id, Name: "X-Salada", Description: "Pão, carne e queijo", CategoryID: "cat-1", Price: 25, Active: true, Version: 3, Images: []daos.ProductImageDAO{}}
+}
+
+func TestSaveTranslationUseCase_Product(t *testing.T) {
+	mocks := setupTranslationTest(t)
+	mocks.productDataSource.EXPECT().FindByID("p1").Return(translatedProductDAO("p1"), nil)
+	mocks.productDataSource.EXPECT().Update(gomock.Any()).DoAndReturn(func(product daos.ProductDAO) error {
+		// A gravação é condicional à versão lida
+		require.Equal(t, int64(3), product.Version)
+		return nil
+	})
+	mocks.translationDataSource.EXPECT().Save(gomock.Any()).DoAndReturn(func(translation daos.TranslationDAO) error {
+		require.Equal(t, daos.TranslationDAO{
+			Target:      "product",
+			EntityID:    "p1",
+			Locale:      "en",
+			Name:        "Cheeseburger",
+			Description: "Beef and cheese",
+			UpdatedAt:   translation.UpdatedAt,
+		}, translation)
+		return nil
+	})
+
+	uc := NewSaveTranslationUseCase(mocks.transactionGateway, gateways.CatalogCacheGateway{})
+	translation, err := uc.Execute(dtos.SaveTranslationDTO{
+		Target:      dtos.ProductTranslationTarget,
+		EntityID:    "p1",
+		Locale:      "EN",
+		Name:        " Cheeseburger ",
+		Description: "Beef and cheese",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, value_objects.LocaleEnglish, translation.Locale)
+	require.Equal(t, "Cheeseburger", translation.Name)
+}
+
+func TestSaveTranslationUseCase_CategoryNotFound(t *testing.T) {
+	mocks := setupTranslationTest(t)
+	mocks.categoryDataSource.EXPECT().FindByID("c1").Return(daos.CategoryDAO{}, &exceptions.RecordNotFoundException{})
+
+	uc := NewSaveTranslationUseCase(mocks.transactionGateway, gateways.CatalogCacheGateway{})
+	_, err := uc.Execute(dtos.SaveTranslationDTO{
+		Target:   dtos.CategoryTranslationTarget,
+		EntityID: "c1",
+		Locale:   "es",
+		Name:     "Bebidas",
+	})
+
+	require.IsType(t, &exceptions.CategoryNotFoundException{}, err)
+}
+
+func TestSaveTranslationUseCase_InvalidInput(t *testing.T) {
+	mocks := setupTranslationTest(t)
+	uc := NewSaveTranslationUseCase(mocks.transactionGateway, gateways.CatalogCacheGateway{})
+
+	_, err := uc.Execute(dtos.SaveTranslationDTO{Target: dtos.ProductTranslationTarget, EntityID: "p1", Locale: "pt-BR", Name: "X-Salada"})
+	require.IsType(t, &exceptions.InvalidLocaleException{}, err)
+
+	_, err = uc.Execute(dtos.SaveTranslationDTO{Target: dtos.ProductTranslationTarget, EntityID: "p1", Locale: "en", Name: "X"})
+	require.IsType(t, &exceptions.InvalidTranslationException{}, err)
+}
@@ -0,0 +1,42 @@
+package use_cases
+
+import (
+	"fmt"
+
+	"tech_challenge/internal/product/application/gateways"
+	"tech_challenge/internal/product/domain/entities"
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+func parseTranslationTarget(target string) (entities.TranslationTarget, error) {
+	switch entities.TranslationTarget(target) {
+	case entities.ProductTranslationTarget, entities.CategoryTranslationTarget:
+		return entities.TranslationTarget(target), nil
+	}
+	return "", fmt.Errorf("unknown translation target %q", target)
+}
+
+// touchTranslatedEntity avança a versão do produto ou categoria traduzido. A
+// tradução faz parte da representação localizada, então o ETag e o
+// Last-Modified precisam mudar com ela
+func touchTranslatedEntity(txGateways gateways.TransactionGateways, target entities.TranslationTarget, id string) error {
+	if target == entities.CategoryTranslationTarget {
+		category, err := txGateways.Category.FindByID(id)
+		if err != nil {
+			if exceptions.IsRecordNotFound(err) {
+				return &exceptions.CategoryNotFoundException{}
+			}
+			return err
+		}
+		return txGateways.Category.Update(category)
+	}
+
+	product, err := txGateways.Product.FindByID(id)
+	if err != nil && !exceptions.IsRecordNotFound(err) {
+		return err
+	}
+	if err != nil || product.IsEmpty() {
+		return &exceptions.ProductNotFoundException{}
+	}
+	return txGateways.Product.Update(&product)
+}
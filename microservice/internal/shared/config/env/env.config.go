@@ -3,18 +3,60 @@ package env
 import (
 	"log"
 	"os"
+	"strconv"
 	"sync"
+	"time"
+	// Embute a base de fusos para que APP_TIME_ZONE funcione em imagens sem tzdata
+	_ "time/tzdata"
 
 	"github.com/joho/godotenv"
 )
 
+// DefaultCacheControl obriga caches a revalidar as leituras do catálogo a cada
+// uso, o que com ETag custa apenas um 304
+const DefaultCacheControl = "public, no-cache"
+
+// DefaultGRPCPort é a porta do servidor gRPC dos serviços internos, que roda
+// ao lado da API HTTP
+const DefaultGRPCPort = "9090"
+
+// DefaultBatchMaxIDs limita quantos produtos uma leitura em lote pode pedir
+const DefaultBatchMaxIDs = 100
+
+// Sem CACHE_DRIVER, o cache é compartilhado no Redis sempre que REDIS_ADDR
+// estiver definido, pois com mais de uma réplica o cache em memória só é
+// limpo na instância que gravou
+const (
+	DefaultCacheDriver       = "memory"
+	DefaultSharedCacheDriver = "redis"
+	DefaultCacheTTL          = 30 * time.Second
+	DefaultCacheMaxEntries   = 1000
+	DefaultCacheMaxBytes     = 16 << 20
+	DefaultCacheKeyPrefix    = "tech_challenge:"
+)
+
+// DefaultTimeZone é o fuso usado para avaliar os horários de disponibilidade
+const DefaultTimeZone = "America/Sao_Paulo"
+
+const (
+	DefaultStockReservationTTL = 15 * time.Minute
+	DefaultStockSweepInterval  = time.Minute
+)
+
 type Config struct {
-	GoEnv        string
-	APIPort      string
-	APIHost      string
-	APIUrl       string
-	APIUploadUrl string
-	Database     struct {
+	GoEnv             string
+	APIPort           string
+	APIHost           string
+	APIUrl            string
+	APIUploadUrl      string
+	APIRequireIfMatch bool
+	APICacheControl   string
+	APIBatchMaxIDs    int
+	GRPCPort          string
+	GRPCUrl           string
+	TimeZone          string
+	location          *time.Location
+	Database          struct {
 		RunMigrations bool
 		Host          string
 		Name          string
@@ -22,6 +64,27 @@ type Config struct {
 		Username      string
 		Password      string
 	}
+	Cache struct {
+		Enabled    bool
+		Driver     string
+		TTL        time.Duration
+		MaxEntries int
+		MaxBytes   int64
+		KeyPrefix  string
+	}
+	Redis struct {
+		Addr     string
+		Password string
+		DB       int
+		TLS      bool
+	}
+	Stock struct {
+		ReservationTTL time.Duration
+		SweepInterval  time.Duration
+	}
+	Events struct {
+		WebhookURL string
+	}
 	AWS struct {
 		Region string
 		S3     struct {
@@ -57,6 +120,30 @@ func getEnvOptional(key string) string {
 	return os.Getenv(key)
 }
 
+func getEnvInt(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 0 {
+		log.Fatalf("Environment variable %s must be a non-negative integer", key)
+	}
+	return parsed
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed <= 0 {
+		log.Fatalf("Environment variable %s must be a positive duration (ex.: 30s)", key)
+	}
+	return parsed
+}
+
 func (c *Config) Load() {
 	dotEnvPath := ".env.local"
 	_, err := os.Stat(dotEnvPath)
@@ -73,6 +160,65 @@ func (c *Config) Load() {
 	c.APIHost = getEnv("API_HOST")
 	c.APIUploadUrl = getEnv("API_UPLOAD_URL")
 	c.APIUrl = c.APIHost + ":" + c.APIPort
+	c.APIRequireIfMatch = getEnvOptional("API_REQUIRE_IF_MATCH") == "true"
+
+	c.APICacheControl = getEnvOptional("API_CACHE_CONTROL")
+	if c.APICacheControl == "" {
+		c.APICacheControl = DefaultCacheControl
+	}
+
+	c.APIBatchMaxIDs = getEnvInt("API_BATCH_MAX_IDS", DefaultBatchMaxIDs)
+	if c.APIBatchMaxIDs == 0 {
+		c.APIBatchMaxIDs = DefaultBatchMaxIDs
+	}
+
+	c.GRPCPort = getEnvOptional("GRPC_PORT")
+	if c.GRPCPort == "" {
+		c.GRPCPort = DefaultGRPCPort
+	}
+	c.GRPCUrl = c.APIHost + ":" + c.GRPCPort
+
+	c.TimeZone = getEnvOptional("APP_TIME_ZONE")
+	if c.TimeZone == "" {
+		c.TimeZone = DefaultTimeZone
+	}
+	c.location, err = time.LoadLocation(c.TimeZone)
+	if err != nil {
+		log.Fatalf("Environment variable APP_TIME_ZONE is not a valid time zone: %v", err)
+	}
+
+	c.Redis.Addr = getEnvOptional("REDIS_ADDR")
+	c.Redis.Password = getEnvOptional("REDIS_PASSWORD")
+	c.Redis.DB = getEnvInt("REDIS_DB", 0)
+	c.Redis.TLS = getEnvOptional("REDIS_TLS") == "true"
+
+	c.Cache.Enabled = getEnvOptional("CACHE_ENABLED") != "false"
+	c.Cache.Driver = getEnvOptional("CACHE_DRIVER")
+	if c.Cache.Driver == "" {
+		c.Cache.Driver = DefaultCacheDriver
+		if c.Redis.Addr != "" {
+			c.Cache.Driver = DefaultSharedCacheDriver
+		}
+	}
+	if c.Cache.Driver != "memory" && c.Cache.Driver != "redis" {
+		log.Fatalf("Environment variable CACHE_DRIVER must be memory or redis")
+	}
+	c.Cache.TTL = getEnvDuration("CACHE_TTL", DefaultCacheTTL)
+	c.Cache.MaxEntries = getEnvInt("CACHE_MAX_ENTRIES", DefaultCacheMaxEntries)
+	c.Cache.MaxBytes = int64(getEnvInt("CACHE_MAX_BYTES", DefaultCacheMaxBytes))
+	c.Cache.KeyPrefix = getEnvOptional("CACHE_KEY_PREFIX")
+	if c.Cache.KeyPrefix == "" {
+		c.Cache.KeyPrefix = DefaultCacheKeyPrefix
+	}
+
+	if c.Cache.Enabled && c.Cache.Driver == "redis" && c.Redis.Addr == "" {
+		log.Fatalf("Environment variable REDIS_ADDR is not set")
+	}
+
+	c.Stock.ReservationTTL = getEnvDuration("STOCK_RESERVATION_TTL", DefaultStockReservationTTL)
+	c.Stock.SweepInterval = getEnvDuration("STOCK_SWEEP_INTERVAL", DefaultStockSweepInterval)
+
+	c.Events.WebhookURL = getEnvOptional("EVENTS_WEBHOOK_URL")
 
 	c.Database.RunMigrations = getEnv("DB_RUN_MIGRATIONS") == "true"
 	c.Database.Host = getEnv("DB_HOST")
@@ -89,6 +235,14 @@ func (c *Config) Load() {
 	c.AWS.S3.PresignExpiration = getEnv("AWS_S3_PRESIGN_EXPIRATION")
 }
 
+// Location devolve o fuso do estabelecimento, carregado de APP_TIME_ZONE
+func (c *Config) Location() *time.Location {
+	if c.location == nil {
+		return time.UTC
+	}
+	return c.location
+}
+
 func (c *Config) IsProduction() bool {
 	return c.GoEnv == "production"
 }
@@ -0,0 +1,17 @@
+package factories
+
+import (
+	"tech_challenge/internal/shared/config/env"
+	event_publisher "tech_challenge/internal/shared/infra/event_publisher"
+	"tech_challenge/internal/shared/interfaces"
+)
+
+// NewEventPublisher envia os eventos ao webhook de EVENTS_WEBHOOK_URL ou, sem
+// ele, apenas os registra no log
+func NewEventPublisher() interfaces.IEventPublisher {
+	if url := env.GetConfig().Events.WebhookURL; url != "" {
+		return event_publisher.NewWebhookEventPublisher(url)
+	}
+
+	return event_publisher.NewLogEventPublisher()
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/shared/interfaces"
+)
+
+type CacheStatsResponse struct {
+	Enabled       bool    `json:"enabled" example:"true"`
+	Driver        string  `json:"driver,omitempty" example:"memory"`
+	Hits          uint64  `json:"hits" example:"120"`
+	Misses        uint64  `json:"misses" example:"30"`
+	HitRatio      float64 `json:"hit_ratio" example:"0.8"`
+	Sets          uint64  `json:"sets" example:"30"`
+	Invalidations uint64  `json:"invalidations" example:"4"`
+	Evictions     uint64  `json:"evictions" example:"0"`
+	Errors        uint64  `json:"errors" example:"0"`
+	Entries       int     `json:"entries" example:"12"`
+	Bytes         int64   `json:"bytes" example:"20480"`
+}
+
+type CacheHandler struct {
+	cacheProvider interfaces.ICacheProvider
+}
+
+func NewCacheHandler(cacheProvider interfaces.ICacheProvider) *CacheHandler {
+	return &CacheHandler{
+		cacheProvider: cacheProvider,
+	}
+}
+
+// @Summary Cache statistics
+// @Description Counters of the read cache of products and categories since the instance started. Entries and bytes are only reported by the memory driver
+// @Tags Cache
+// @Produce json
+// @Success 200 {object} handlers.CacheStatsResponse
+// @Router /cache/stats [get]
+func (h *CacheHandler) Stats(ctx *gin.Context) {
+	if h.cacheProvider == nil {
+		ctx.JSON(http.StatusOK, CacheStatsResponse{Enabled: false})
+		return
+	}
+
+	stats := h.cacheProvider.Stats()
+	ctx.JSON(http.StatusOK, CacheStatsResponse{
+		Enabled:       true,
+		Driver:        stats.Driver,
+		Hits:          stats.Hits,
+		Misses:        stats.Misses,
+		HitRatio:      stats.HitRatio,
+		Sets:          stats.Sets,
+		Invalidations: stats.Invalidations,
+		Evictions:     stats.Evictions,
+		Errors:        stats.Errors,
+		Entries:       stats.Entries,
+		Bytes:         stats.Bytes,
+	})
+}
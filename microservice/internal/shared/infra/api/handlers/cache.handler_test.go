@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/shared/infra/cache_provider"
+)
+
+func TestCacheHandler_Stats(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cache := cache_provider.NewMemoryCacheProvider(time.Minute, 10, 1024)
+	require.NoError(t, cache.Set("product:all", []byte("[]")))
+	_, _, _ = cache.Get("product:all")
+	_, _, _ = cache.Get("product:id:1")
+
+	r := gin.New()
+	r.GET("/cache/stats", NewCacheHandler(cache).Stats)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/cache/stats", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"enabled":true,"driver":"memory","hits":1,"misses":1,"hit_ratio":0.5,"sets":1,"invalidations":0,"evictions":0,"errors":0,"entries":1,"bytes":2}`, w.Body.String())
+}
+
+func TestCacheHandler_StatsDisabled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/cache/stats", NewCacheHandler(nil).Stats)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/cache/stats", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"enabled":false`)
+}
@@ -27,6 +27,9 @@ func (m *mockFileProvider) DeleteFiles(fileNames []string) error {
 func (m *mockFileProvider) UploadFile(fileName string, file []byte) error {
 	return nil
 }
+func (m *mockFileProvider) ListFiles() ([]string, error) {
+	return nil, nil
+}
 
 func TestFileHandler_FindFile_Success(t *testing.T) {
 	mockProvider := &mockFileProvider{
@@ -1,13 +1,21 @@
 package middlewares
 
 import (
-	"net/http"
+	"log"
 
 	"github.com/gin-gonic/gin"
 
 	product_http_errors "tech_challenge/internal/product/infra/api/http_errors"
+	"tech_challenge/internal/shared/infra/api/problems"
 )
 
+var internalErrorMessage = problems.Text{
+	EN:   "An unexpected error occurred",
+	PTBR: "Ocorreu um erro inesperado",
+}
+
+// ErrorHandlerMiddleware é o único ponto que escreve respostas de erro: os
+// handlers só registram o erro com ctx.Error
 func ErrorHandlerMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		ctx.Next()
@@ -15,12 +23,27 @@ func ErrorHandlerMiddleware() gin.HandlerFunc {
 		if len(ctx.Errors) > 0 {
 			err := ctx.Errors.Last().Err
 
-			errorHasBinHandled := product_http_errors.HandleDomainErrors(err, ctx)
+			errorHasBinHandled := problems.HandleRequestErrors(err, ctx) ||
+				product_http_errors.HandleDomainErrors(err, ctx)
+
 			if !errorHasBinHandled {
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+				log.Printf("unexpected error on %s %s [%s]: %v", ctx.Request.Method, ctx.Request.URL.Path, ctx.GetString(problems.RequestIDKey), err)
+				problems.Write(ctx, problems.New(ctx, problems.InternalError, internalErrorMessage.In(problems.LanguageFromRequest(ctx))))
 			}
 
 			ctx.Abort()
 		}
 	}
 }
+
+// RouteNotFoundHandler responde rotas inexistentes no mesmo formato dos
+// demais erros
+func RouteNotFoundHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		detail := problems.Text{
+			EN:   "No route matches " + ctx.Request.Method + " " + ctx.Request.URL.Path,
+			PTBR: "Nenhuma rota corresponde a " + ctx.Request.Method + " " + ctx.Request.URL.Path,
+		}
+		problems.Write(ctx, problems.New(ctx, problems.RouteNotFound, detail.In(problems.LanguageFromRequest(ctx))))
+	}
+}
@@ -1,6 +1,7 @@
 package middlewares
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -8,6 +9,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/shared/infra/api/problems"
 )
 
 func TestErrorHandlerMiddleware_InternalServerError(t *testing.T) {
@@ -15,13 +19,17 @@ func TestErrorHandlerMiddleware_InternalServerError(t *testing.T) {
 	r := gin.New()
 	r.Use(ErrorHandlerMiddleware())
 	r.GET("/fail", func(c *gin.Context) {
-		_ = c.Error(errors.New("unexpected error"))
+		_ = c.Error(errors.New("pq: password authentication failed"))
 	})
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest("GET", "/fail", nil)
 	r.ServeHTTP(w, req)
 	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.Equal(t, problems.ContentType, w.Header().Get("Content-Type"))
 	require.Contains(t, w.Body.String(), "Internal server error")
+	require.Contains(t, w.Body.String(), problems.CodeInternalError)
+	// A mensagem do erro inesperado nunca vai para o cliente
+	require.NotContains(t, w.Body.String(), "password")
 }
 
 func TestErrorHandlerMiddleware_NoError(t *testing.T) {
@@ -37,3 +45,74 @@ func TestErrorHandlerMiddleware_NoError(t *testing.T) {
 	require.Equal(t, 200, w.Code)
 	require.Contains(t, w.Body.String(), "ok")
 }
+
+func TestErrorHandlerMiddleware_InfrastructureFailure(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(ErrorHandlerMiddleware())
+	r.GET("/unavailable", func(c *gin.Context) {
+		_ = c.Error(&exceptions.RepositoryUnavailableException{Err: errors.New("connection refused")})
+	})
+	r.GET("/timeout", func(c *gin.Context) {
+		_ = c.Error(&exceptions.RepositoryTimeoutException{RetryAfter: 1})
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/unavailable", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Equal(t, "5", w.Header().Get("Retry-After"))
+	require.Equal(t, problems.ContentType, w.Header().Get("Content-Type"))
+	require.JSONEq(t, `{
+		"type": "/problems/database-unavailable",
+		"title": "Database unavailable",
+		"status": 503,
+		"detail": "Database is unavailable",
+		"instance": "/unavailable",
+		"code": "DATABASE_UNAVAILABLE",
+		"retry_after": 5
+	}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/timeout", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Equal(t, "1", w.Header().Get("Retry-After"))
+}
+
+func TestErrorHandlerMiddleware_RequestError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(RequestIDMiddleware())
+	r.Use(ErrorHandlerMiddleware())
+	r.GET("/items", func(c *gin.Context) {
+		_ = c.Error(problems.InvalidBooleanError("dry_run"))
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/items?dry_run=maybe", nil)
+	req.Header.Set("Accept-Language", "pt-BR")
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	var problem problems.Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	require.Equal(t, problems.CodeInvalidParameter, problem.Code)
+	require.Equal(t, "Parâmetro inválido", problem.Title)
+	require.Equal(t, "dry_run deve ser true ou false", problem.Detail)
+	require.Equal(t, w.Header().Get(problems.RequestIDHeader), problem.RequestID)
+	require.Len(t, problem.Errors, 1)
+	require.Equal(t, "dry_run", problem.Errors[0].Field)
+}
+
+func TestRouteNotFoundHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(ErrorHandlerMiddleware())
+	r.NoRoute(RouteNotFoundHandler())
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/nowhere", nil))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.Contains(t, w.Body.String(), problems.CodeRouteNotFound)
+}
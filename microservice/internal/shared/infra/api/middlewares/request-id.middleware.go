@@ -0,0 +1,25 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/shared/infra/api/problems"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+const maxRequestIDLength = 128
+
+// RequestIDMiddleware reaproveita o X-Request-ID enviado pelo cliente (ou pelo
+// gateway) ou gera um novo, devolvendo-o no header e nos erros
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestID := ctx.GetHeader(problems.RequestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = identity_manager.NewUUIDV4()
+		}
+
+		ctx.Set(problems.RequestIDKey, requestID)
+		ctx.Header(problems.RequestIDHeader, requestID)
+		ctx.Next()
+	}
+}
@@ -0,0 +1,42 @@
+package middlewares
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/shared/infra/api/problems"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(RequestIDMiddleware())
+	r.GET("/id", func(c *gin.Context) {
+		c.String(200, c.GetString(problems.RequestIDKey))
+	})
+
+	// Reaproveita o id enviado pelo cliente
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/id", nil)
+	req.Header.Set(problems.RequestIDHeader, "abc-123")
+	r.ServeHTTP(w, req)
+	require.Equal(t, "abc-123", w.Body.String())
+	require.Equal(t, "abc-123", w.Header().Get(problems.RequestIDHeader))
+
+	// Gera um novo quando ausente
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/id", nil))
+	require.NotEmpty(t, w.Body.String())
+	require.Equal(t, w.Body.String(), w.Header().Get(problems.RequestIDHeader))
+
+	// Ids longos demais são descartados
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("GET", "/id", nil)
+	req.Header.Set(problems.RequestIDHeader, strings.Repeat("x", 200))
+	r.ServeHTTP(w, req)
+	require.NotEqual(t, strings.Repeat("x", 200), w.Body.String())
+}
@@ -0,0 +1,174 @@
+package problems
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// Os erros de validação passam a usar o nome do campo no JSON (ou no
+// formulário) em vez do nome do campo da struct
+func init() {
+	validate, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		for _, tag := range []string{"json", "form", "uri"} {
+			name := strings.Split(field.Tag.Get(tag), ",")[0]
+			if name == "-" {
+				return ""
+			}
+			if name != "" {
+				return name
+			}
+		}
+		return field.Name
+	})
+}
+
+var (
+	emptyBodyMessage   = Text{EN: "request body is empty", PTBR: "o corpo da requisição está vazio"}
+	invalidJSONMessage = Text{EN: "request body is not valid JSON", PTBR: "o corpo da requisição não é um JSON válido"}
+	unreadableMessage  = Text{EN: "request body could not be read", PTBR: "o corpo da requisição não pôde ser lido"}
+	validationMessage  = Text{EN: "one or more fields are invalid", PTBR: "um ou mais campos são inválidos"}
+	trailingMessage    = Text{EN: "request body must contain a single JSON value", PTBR: "o corpo da requisição deve conter um único valor JSON"}
+	parameterMessage   = Text{EN: "one or more parameters are invalid", PTBR: "um ou mais parâmetros são inválidos"}
+)
+
+// ErrTrailingData indica conteúdo depois do objeto JSON do corpo
+var ErrTrailingData = errors.New("request body has data after the JSON value")
+
+// encoding/json não exporta um tipo para campos desconhecidos
+const unknownFieldPrefix = "json: unknown field "
+
+// NewBindingError converte os erros de ShouldBindJSON/ShouldBind em um
+// RequestException com os erros de cada campo
+func NewBindingError(err error) *RequestException {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		e := &RequestException{Definition: ValidationFailed, Detail: validationMessage}
+		for _, fe := range validationErrors {
+			field := fieldPath(fe.Namespace())
+			e.addField(field, fe.Tag(), validationText(field, fe))
+		}
+		return e
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		e := &RequestException{Definition: ValidationFailed, Detail: validationMessage}
+		e.addField(typeErr.Field, "invalid_type", formatText(Text{
+			EN:   "%s must be of type %s",
+			PTBR: "%s deve ser do tipo %s",
+		}, typeErr.Field, jsonTypeName(typeErr.Type)))
+		return e
+	}
+
+	if field, ok := strings.CutPrefix(err.Error(), unknownFieldPrefix); ok {
+		field, _ = strconv.Unquote(field)
+		e := &RequestException{Definition: ValidationFailed, Detail: validationMessage}
+		e.addField(field, "unknown_field", formatText(Text{
+			EN:   "%s is not a known field",
+			PTBR: "%s não é um campo conhecido",
+		}, field))
+		return e
+	}
+
+	if errors.Is(err, io.EOF) {
+		return MalformedRequestError(emptyBodyMessage)
+	}
+
+	if errors.Is(err, ErrTrailingData) {
+		return MalformedRequestError(trailingMessage)
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return MalformedRequestError(invalidJSONMessage)
+	}
+
+	return MalformedRequestError(unreadableMessage)
+}
+
+// NewParameterBindingError converte os erros de ShouldBindUri/ShouldBindQuery;
+// os campos inválidos são parâmetros da rota ou da query, não do corpo
+func NewParameterBindingError(err error) *RequestException {
+	e := NewBindingError(err)
+	e.Definition = InvalidParameter
+	if len(e.fields) > 0 {
+		e.Detail = parameterMessage
+	}
+	return e
+}
+
+// O namespace começa pelo nome da struct raiz, que não interessa ao cliente
+func fieldPath(namespace string) string {
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+func validationText(field string, fe validator.FieldError) Text {
+	switch fe.Tag() {
+	case "required":
+		return formatText(Text{EN: "%s is required", PTBR: "%s é obrigatório"}, field)
+	case "min", "gte":
+		switch fe.Kind() {
+		case reflect.String:
+			return formatText(Text{EN: "%s must have at least %s characters", PTBR: "%s deve ter ao menos %s caracteres"}, field, fe.Param())
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return formatText(Text{EN: "%s must have at least %s items", PTBR: "%s deve ter ao menos %s itens"}, field, fe.Param())
+		}
+		return formatText(Text{EN: "%s must be greater than or equal to %s", PTBR: "%s deve ser maior ou igual a %s"}, field, fe.Param())
+	case "max", "lte":
+		switch fe.Kind() {
+		case reflect.String:
+			return formatText(Text{EN: "%s must have at most %s characters", PTBR: "%s deve ter no máximo %s caracteres"}, field, fe.Param())
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return formatText(Text{EN: "%s must have at most %s items", PTBR: "%s deve ter no máximo %s itens"}, field, fe.Param())
+		}
+		return formatText(Text{EN: "%s must be less than or equal to %s", PTBR: "%s deve ser menor ou igual a %s"}, field, fe.Param())
+	case "gt":
+		return formatText(Text{EN: "%s must be greater than %s", PTBR: "%s deve ser maior que %s"}, field, fe.Param())
+	case "lt":
+		return formatText(Text{EN: "%s must be less than %s", PTBR: "%s deve ser menor que %s"}, field, fe.Param())
+	case "oneof":
+		return formatText(Text{EN: "%s must be one of: %s", PTBR: "%s deve ser um dos valores: %s"}, field, strings.ReplaceAll(fe.Param(), " ", ", "))
+	case "uuid", "uuid4":
+		return formatText(Text{EN: "%s must be a valid UUID", PTBR: "%s deve ser um UUID válido"}, field)
+	}
+
+	return formatText(Text{EN: "%s is invalid", PTBR: "%s é inválido"}, field)
+}
+
+func jsonTypeName(t reflect.Type) string {
+	if t == nil {
+		return "unknown"
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Pointer:
+		return jsonTypeName(t.Elem())
+	}
+
+	return "object"
+}
@@ -0,0 +1,37 @@
+package problems
+
+import (
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+)
+
+type Language string
+
+const (
+	English             Language = "en"
+	BrazilianPortuguese Language = "pt-BR"
+)
+
+// O primeiro idioma é o padrão quando o Accept-Language não casa com nenhum
+var languageMatcher = language.NewMatcher([]language.Tag{
+	language.English,
+	language.BrazilianPortuguese,
+})
+
+func ParseLanguage(acceptLanguage string) Language {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return English
+	}
+
+	_, index, confidence := languageMatcher.Match(tags...)
+	if confidence == language.No || index == 0 {
+		return English
+	}
+
+	return BrazilianPortuguese
+}
+
+func LanguageFromRequest(ctx *gin.Context) Language {
+	return ParseLanguage(ctx.GetHeader("Accept-Language"))
+}
@@ -0,0 +1,124 @@
+package problems
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Respostas de erro no formato RFC 7807 (application/problem+json)
+
+const (
+	ContentType     = "application/problem+json"
+	RequestIDKey    = "request_id"
+	RequestIDHeader = "X-Request-ID"
+	typeBaseURI     = "/problems/"
+)
+
+// Códigos genéricos, usados por qualquer módulo
+const (
+	CodeInternalError    = "INTERNAL_ERROR"
+	CodeMalformedRequest = "MALFORMED_REQUEST"
+	CodeValidationFailed = "VALIDATION_FAILED"
+	CodeInvalidParameter = "INVALID_PARAMETER"
+	CodeRouteNotFound    = "ROUTE_NOT_FOUND"
+	CodeUnsupportedMedia = "UNSUPPORTED_MEDIA_TYPE"
+	CodeIfMatchRequired  = "PRECONDITION_REQUIRED"
+)
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type Problem struct {
+	Type       string       `json:"type"`
+	Title      string       `json:"title"`
+	Status     int          `json:"status"`
+	Detail     string       `json:"detail,omitempty"`
+	Instance   string       `json:"instance,omitempty"`
+	Code       string       `json:"code"`
+	RequestID  string       `json:"request_id,omitempty"`
+	Errors     []FieldError `json:"errors,omitempty"`
+	RetryAfter int          `json:"retry_after,omitempty"`
+}
+
+// Text guarda a mesma mensagem nos idiomas suportados
+type Text struct {
+	EN   string
+	PTBR string
+}
+
+func (t Text) In(lang Language) string {
+	if lang == BrazilianPortuguese && t.PTBR != "" {
+		return t.PTBR
+	}
+	return t.EN
+}
+
+// Definition descreve um tipo de problema: o código estável que os clientes
+// usam para tratar o erro, o status HTTP e o título localizado
+type Definition struct {
+	Status int
+	Code   string
+	Title  Text
+}
+
+var (
+	InternalError = Definition{
+		Status: http.StatusInternalServerError,
+		Code:   CodeInternalError,
+		Title:  Text{EN: "Internal server error", PTBR: "Erro interno do servidor"},
+	}
+	MalformedRequest = Definition{
+		Status: http.StatusBadRequest,
+		Code:   CodeMalformedRequest,
+		Title:  Text{EN: "Malformed request", PTBR: "Requisição malformada"},
+	}
+	ValidationFailed = Definition{
+		Status: http.StatusBadRequest,
+		Code:   CodeValidationFailed,
+		Title:  Text{EN: "Validation failed", PTBR: "Falha de validação"},
+	}
+	InvalidParameter = Definition{
+		Status: http.StatusBadRequest,
+		Code:   CodeInvalidParameter,
+		Title:  Text{EN: "Invalid parameter", PTBR: "Parâmetro inválido"},
+	}
+	RouteNotFound = Definition{
+		Status: http.StatusNotFound,
+		Code:   CodeRouteNotFound,
+		Title:  Text{EN: "Route not found", PTBR: "Rota não encontrada"},
+	}
+	UnsupportedMediaType = Definition{
+		Status: http.StatusUnsupportedMediaType,
+		Code:   CodeUnsupportedMedia,
+		Title:  Text{EN: "Unsupported media type", PTBR: "Tipo de mídia não suportado"},
+	}
+	PreconditionRequired = Definition{
+		Status: http.StatusPreconditionRequired,
+		Code:   CodeIfMatchRequired,
+		Title:  Text{EN: "Precondition required", PTBR: "Pré-condição obrigatória"},
+	}
+)
+
+// New monta o problema da requisição atual, com o título no idioma pedido
+// pelo cliente
+func New(ctx *gin.Context, definition Definition, detail string) Problem {
+	return Problem{
+		Type:      typeBaseURI + strings.ReplaceAll(strings.ToLower(definition.Code), "_", "-"),
+		Title:     definition.Title.In(LanguageFromRequest(ctx)),
+		Status:    definition.Status,
+		Detail:    detail,
+		Instance:  ctx.Request.URL.Path,
+		Code:      definition.Code,
+		RequestID: ctx.GetString(RequestIDKey),
+	}
+}
+
+func Write(ctx *gin.Context, problem Problem) {
+	ctx.Header("Content-Type", ContentType)
+	ctx.JSON(problem.Status, problem)
+}
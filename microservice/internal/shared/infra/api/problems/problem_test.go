@@ -0,0 +1,139 @@
+package problems
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLanguage(t *testing.T) {
+	cases := map[string]Language{
+		"":                     English,
+		"en-US":                English,
+		"pt-BR":                BrazilianPortuguese,
+		"pt":                   BrazilianPortuguese,
+		"fr-FR, pt-BR;q=0.8":   BrazilianPortuguese,
+		"en;q=0.9, pt-BR":      BrazilianPortuguese,
+		"de-DE":                English,
+		"not a language;q=abc": English,
+	}
+
+	for header, expected := range cases {
+		require.Equal(t, expected, ParseLanguage(header), header)
+	}
+}
+
+type bindingTestRequest struct {
+	Name  string   `json:"name" binding:"required,min=3"`
+	Price float64  `json:"price" binding:"gt=0"`
+	Tags  []string `json:"tags" binding:"max=2"`
+}
+
+func bind(body string) error {
+	var request bindingTestRequest
+	return binding.JSON.BindBody([]byte(body), &request)
+}
+
+func TestNewBindingError_Validation(t *testing.T) {
+	e := NewBindingError(bind(`{"name":"ab","price":0,"tags":["a","b","c"]}`))
+
+	require.Equal(t, CodeValidationFailed, e.Definition.Code)
+	require.Len(t, e.fields, 3)
+	require.Equal(t, "name", e.fields[0].field)
+	require.Equal(t, "min", e.fields[0].code)
+	require.Equal(t, "name must have at least 3 characters", e.fields[0].message.EN)
+	require.Equal(t, "name deve ter ao menos 3 caracteres", e.fields[0].message.PTBR)
+	require.Equal(t, "price", e.fields[1].field)
+	require.Equal(t, "price must be greater than 0", e.fields[1].message.EN)
+	require.Equal(t, "tags must have at most 2 items", e.fields[2].message.EN)
+}
+
+func TestNewBindingError_Body(t *testing.T) {
+	e := NewBindingError(bind(`{"name":1}`))
+	require.Equal(t, CodeValidationFailed, e.Definition.Code)
+	require.Equal(t, "name", e.fields[0].field)
+	require.Equal(t, "invalid_type", e.fields[0].code)
+	require.Equal(t, "name must be of type string", e.fields[0].message.EN)
+
+	e = NewBindingError(bind(`{"name":`))
+	require.Equal(t, CodeMalformedRequest, e.Definition.Code)
+	require.Equal(t, invalidJSONMessage, e.Detail)
+
+	e = NewBindingError(bind(`not json`))
+	require.Equal(t, CodeMalformedRequest, e.Definition.Code)
+	require.Equal(t, invalidJSONMessage, e.Detail)
+
+	e = NewBindingError(errors.New(`json: unknown field "stock"`))
+	require.Equal(t, CodeValidationFailed, e.Definition.Code)
+	require.Equal(t, "stock", e.fields[0].field)
+	require.Equal(t, "unknown_field", e.fields[0].code)
+	require.Equal(t, "stock não é um campo conhecido", e.fields[0].message.PTBR)
+
+	e = NewBindingError(ErrTrailingData)
+	require.Equal(t, CodeMalformedRequest, e.Definition.Code)
+	require.Equal(t, trailingMessage, e.Detail)
+}
+
+func TestNewParameterBindingError(t *testing.T) {
+	var uri struct {
+		ID string `uri:"id" binding:"required,uuid"`
+	}
+	err := binding.Uri.BindUri(map[string][]string{"id": {"1"}}, &uri)
+
+	e := NewParameterBindingError(err)
+	require.Equal(t, CodeInvalidParameter, e.Definition.Code)
+	require.Equal(t, parameterMessage, e.Detail)
+	require.Equal(t, "id", e.fields[0].field)
+	require.Equal(t, "id must be a valid UUID", e.fields[0].message.EN)
+}
+
+func TestTooManyItemsError(t *testing.T) {
+	e := TooManyItemsError("ids", 100)
+	require.Equal(t, CodeValidationFailed, e.Definition.Code)
+	require.Equal(t, "max", e.fields[0].code)
+	require.Equal(t, "ids deve ter no máximo 100 itens", e.fields[0].message.PTBR)
+
+	e = TooManyValuesError("ids", 100)
+	require.Equal(t, CodeInvalidParameter, e.Definition.Code)
+	require.Equal(t, "ids must have at most 100 items", e.Detail.EN)
+}
+
+func TestRequestException_Problem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/v1/catalog/export?format=xml", nil)
+	ctx.Request.Header.Set("Accept-Language", "pt-BR")
+	ctx.Set(RequestIDKey, "req-1")
+
+	require.True(t, HandleRequestErrors(InvalidOptionError("format", "json", "csv"), ctx))
+	require.False(t, HandleRequestErrors(nil, ctx))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.True(t, strings.HasPrefix(w.Header().Get("Content-Type"), ContentType))
+
+	var problem Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	require.Equal(t, Problem{
+		Type:      "/problems/invalid-parameter",
+		Title:     "Parâmetro inválido",
+		Status:    http.StatusBadRequest,
+		Detail:    "format deve ser um dos valores: json, csv",
+		Instance:  "/v1/catalog/export",
+		Code:      CodeInvalidParameter,
+		RequestID: "req-1",
+		Errors: []FieldError{{
+			Field:   "format",
+			Code:    "invalid",
+			Message: "format deve ser um dos valores: json, csv",
+		}},
+	}, problem)
+}
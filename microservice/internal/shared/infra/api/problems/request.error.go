@@ -0,0 +1,156 @@
+package problems
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type fieldError struct {
+	field   string
+	code    string
+	message Text
+}
+
+// RequestException representa erros da própria requisição (corpo malformado,
+// parâmetros inválidos), detectados pelos handlers antes dos casos de uso.
+// As mensagens ficam nos dois idiomas e só são escolhidas na resposta.
+type RequestException struct {
+	Definition Definition
+	Detail     Text
+	fields     []fieldError
+}
+
+func (e *RequestException) Error() string {
+	return e.Detail.EN
+}
+
+func (e *RequestException) addField(field, code string, message Text) {
+	e.fields = append(e.fields, fieldError{field: field, code: code, message: message})
+}
+
+func (e *RequestException) Problem(ctx *gin.Context) Problem {
+	lang := LanguageFromRequest(ctx)
+
+	problem := New(ctx, e.Definition, e.Detail.In(lang))
+	for _, field := range e.fields {
+		problem.Errors = append(problem.Errors, FieldError{
+			Field:   field.field,
+			Code:    field.code,
+			Message: field.message.In(lang),
+		})
+	}
+
+	return problem
+}
+
+func HandleRequestErrors(err error, ctx *gin.Context) bool {
+	e, ok := err.(*RequestException)
+	if !ok {
+		return false
+	}
+
+	Write(ctx, e.Problem(ctx))
+	return true
+}
+
+func formatText(text Text, args ...any) Text {
+	return Text{EN: fmt.Sprintf(text.EN, args...), PTBR: fmt.Sprintf(text.PTBR, args...)}
+}
+
+// InvalidParameterError indica um parâmetro de query, rota ou formulário inválido
+func InvalidParameterError(field string, message Text) *RequestException {
+	e := &RequestException{Definition: InvalidParameter, Detail: message}
+	e.addField(field, "invalid", message)
+	return e
+}
+
+func InvalidBooleanError(field string) *RequestException {
+	return InvalidParameterError(field, formatText(Text{
+		EN:   "%s must be a boolean",
+		PTBR: "%s deve ser true ou false",
+	}, field))
+}
+
+func InvalidDateTimeError(field string) *RequestException {
+	return InvalidParameterError(field, formatText(Text{
+		EN:   "%s must be a date-time in RFC 3339 format (ex.: 2025-01-15T08:30:00-03:00)",
+		PTBR: "%s deve ser uma data e hora no formato RFC 3339 (ex.: 2025-01-15T08:30:00-03:00)",
+	}, field))
+}
+
+func InvalidUUIDError(field string) *RequestException {
+	return InvalidParameterError(field, formatText(Text{
+		EN:   "%s must be a valid UUID",
+		PTBR: "%s deve ser um UUID válido",
+	}, field))
+}
+
+func InvalidOptionError(field string, options ...string) *RequestException {
+	return InvalidParameterError(field, formatText(Text{
+		EN:   "%s must be one of: %s",
+		PTBR: "%s deve ser um dos valores: %s",
+	}, field, strings.Join(options, ", ")))
+}
+
+// TooManyValuesError indica um parâmetro com mais valores que o limite
+func TooManyValuesError(field string, max int) *RequestException {
+	return InvalidParameterError(field, tooManyItemsText(field, max))
+}
+
+// TooManyItemsError indica uma lista do corpo com mais itens que o limite
+// configurado, que não cabe em uma tag de validação
+func TooManyItemsError(field string, max int) *RequestException {
+	message := tooManyItemsText(field, max)
+	e := &RequestException{Definition: ValidationFailed, Detail: validationMessage}
+	e.addField(field, "max", message)
+	return e
+}
+
+func tooManyItemsText(field string, max int) Text {
+	return formatText(Text{
+		EN:   "%s must have at most %d items",
+		PTBR: "%s deve ter no máximo %d itens",
+	}, field, max)
+}
+
+func RequiredFieldError(field string) *RequestException {
+	message := formatText(Text{EN: "%s is required", PTBR: "%s é obrigatório"}, field)
+	e := &RequestException{Definition: ValidationFailed, Detail: message}
+	e.addField(field, "required", message)
+	return e
+}
+
+func MalformedRequestError(message Text) *RequestException {
+	return &RequestException{Definition: MalformedRequest, Detail: message}
+}
+
+func UnsupportedMediaTypeError(contentType string, supported ...string) *RequestException {
+	return &RequestException{Definition: UnsupportedMediaType, Detail: formatText(Text{
+		EN:   "content type %q is not supported, use one of: %s",
+		PTBR: "o content type %q não é suportado, use um dos valores: %s",
+	}, contentType, strings.Join(supported, ", "))}
+}
+
+// IfMatchRequiredError indica uma alteração sem If-Match quando a API exige
+// controle de concorrência
+func IfMatchRequiredError() *RequestException {
+	return &RequestException{Definition: PreconditionRequired, Detail: Text{
+		EN:   "the If-Match header with the current ETag is required to change this resource",
+		PTBR: "o header If-Match com o ETag atual é obrigatório para alterar este recurso",
+	}}
+}
+
+// NotNullableError indica campos enviados como null em um merge patch que não
+// podem ser removidos
+func NotNullableError(fields ...string) *RequestException {
+	e := &RequestException{Definition: ValidationFailed, Detail: validationMessage}
+	for _, field := range fields {
+		e.addField(field, "not_nullable", formatText(Text{
+			EN:   "%s cannot be null",
+			PTBR: "%s não pode ser null",
+		}, field))
+	}
+	return e
+}
@@ -17,7 +17,7 @@ func RegisterFileRoutes(router *gin.RouterGroup) {
 		fileUrl, err := fileHandler.FindFile(fileName)
 
 		if err != nil {
-			c.JSON(500, gin.H{"error": "Failed to retrieve file"})
+			_ = c.Error(err)
 			return
 		}
 
@@ -8,11 +8,14 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 
 	product_router "tech_challenge/internal/product/infra/api/routes"
+	"tech_challenge/internal/product/infra/jobs"
 	"tech_challenge/internal/shared/config/env"
 	"tech_challenge/internal/shared/infra/api/handlers"
 	"tech_challenge/internal/shared/infra/api/middlewares"
 	_ "tech_challenge/internal/shared/infra/api/swagger"
+	"tech_challenge/internal/shared/infra/cache_provider"
 	"tech_challenge/internal/shared/infra/database"
+	"tech_challenge/internal/shared/infra/grpc_api"
 )
 
 func Init() {
@@ -24,18 +27,27 @@ func Init() {
 	}
 
 	database.Connect()
+	cache_provider.Connect()
 
 	if config.Database.RunMigrations {
-		database.RunMigrations()
+		if err := database.RunMigrations(); err != nil {
+			log.Fatalf("failed to run migrations: %v", err)
+		}
 	}
 
+	jobs.StartStockReservationSweeper(config.Stock.SweepInterval)
+
+	go grpc_api.Serve(config.GRPCUrl)
+
 	ginRouter := gin.Default()
 
 	ginRouter.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	ginRouter.Use(gin.Logger())
 	ginRouter.Use(gin.Recovery())
+	ginRouter.Use(middlewares.RequestIDMiddleware())
 	ginRouter.Use(middlewares.ErrorHandlerMiddleware())
+	ginRouter.NoRoute(middlewares.RouteNotFoundHandler())
 
 	healthHandler := handlers.NewHealthHandler()
 	ginRouter.GET("/health", healthHandler.Health)
@@ -44,6 +56,15 @@ func Init() {
 
 	product_router.RegisterProductRoutes(v1Routes.Group("/products"))
 	product_router.RegisterCategoryRoutes(v1Routes.Group("/categories"))
+	product_router.RegisterCatalogRoutes(v1Routes.Group("/catalog"))
+	product_router.RegisterMenuRoutes(v1Routes.Group("/menu"))
+	product_router.RegisterStockRoutes(v1Routes.Group("/stock"))
+	product_router.RegisterTranslationRoutes(v1Routes.Group("/translations"))
+	product_router.RegisterStoreRoutes(v1Routes.Group("/stores"))
+	product_router.RegisterPromotionRoutes(v1Routes.Group("/promotions"))
+
+	cacheHandler := handlers.NewCacheHandler(cache_provider.GetProvider())
+	v1Routes.GET("/cache/stats", cacheHandler.Stats)
 
 	if err := ginRouter.Run(config.APIUrl); err != nil {
 		log.Fatalf("failed to start gin server: %v", err)
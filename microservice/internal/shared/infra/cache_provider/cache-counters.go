@@ -0,0 +1,34 @@
+package cache_provider
+
+import (
+	"sync/atomic"
+
+	"tech_challenge/internal/shared/interfaces"
+)
+
+type cacheCounters struct {
+	hits          atomic.Uint64
+	misses        atomic.Uint64
+	sets          atomic.Uint64
+	invalidations atomic.Uint64
+	evictions     atomic.Uint64
+	errors        atomic.Uint64
+}
+
+func (c *cacheCounters) snapshot(driver string) interfaces.CacheStats {
+	stats := interfaces.CacheStats{
+		Driver:        driver,
+		Hits:          c.hits.Load(),
+		Misses:        c.misses.Load(),
+		Sets:          c.sets.Load(),
+		Invalidations: c.invalidations.Load(),
+		Evictions:     c.evictions.Load(),
+		Errors:        c.errors.Load(),
+	}
+
+	if lookups := stats.Hits + stats.Misses; lookups > 0 {
+		stats.HitRatio = float64(stats.Hits) / float64(lookups)
+	}
+
+	return stats
+}
@@ -0,0 +1,49 @@
+package cache_provider
+
+import (
+	"log"
+
+	"tech_challenge/internal/shared/config/env"
+	"tech_challenge/internal/shared/interfaces"
+)
+
+var provider interfaces.ICacheProvider
+
+// GetProvider devolve o cache configurado por Connect, ou nil quando o cache
+// está desligado ou ainda não foi configurado (como nos testes)
+func GetProvider() interfaces.ICacheProvider {
+	return provider
+}
+
+func Connect() {
+	if provider != nil {
+		log.Println("Cache provider already configured")
+		return
+	}
+
+	config := env.GetConfig()
+
+	if !config.Cache.Enabled {
+		log.Println("Cache disabled")
+		return
+	}
+
+	switch config.Cache.Driver {
+	case "redis":
+		provider = NewRedisCacheProvider(RedisConfig{
+			Addr:      config.Redis.Addr,
+			Password:  config.Redis.Password,
+			DB:        config.Redis.DB,
+			TLS:       config.Redis.TLS,
+			KeyPrefix: config.Cache.KeyPrefix,
+		}, config.Cache.TTL)
+	default:
+		provider = NewMemoryCacheProvider(config.Cache.TTL, config.Cache.MaxEntries, config.Cache.MaxBytes)
+	}
+
+	log.Printf("Cache enabled [driver=%s ttl=%s]", config.Cache.Driver, config.Cache.TTL)
+}
+
+func SetProvider(cache interfaces.ICacheProvider) {
+	provider = cache
+}
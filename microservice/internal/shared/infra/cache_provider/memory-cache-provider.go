@@ -0,0 +1,128 @@
+package cache_provider
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+
+	"tech_challenge/internal/shared/interfaces"
+)
+
+type memoryCacheEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// MemoryCacheProvider é um LRU em memória limitado por número de entradas e
+// por bytes. Entradas vencidas são descartadas na leitura ou despejadas pelo
+// LRU quando falta espaço
+type MemoryCacheProvider struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	maxBytes   int64
+	bytes      int64
+	order      *list.List
+	entries    map[string]*list.Element
+	now        func() time.Time
+	counters   cacheCounters
+}
+
+func NewMemoryCacheProvider(ttl time.Duration, maxEntries int, maxBytes int64) *MemoryCacheProvider {
+	return &MemoryCacheProvider{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+		now:        time.Now,
+	}
+}
+
+func (p *MemoryCacheProvider) Get(key string) ([]byte, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	element, ok := p.entries[key]
+	if !ok {
+		p.counters.misses.Add(1)
+		return nil, false, nil
+	}
+
+	entry := element.Value.(*memoryCacheEntry)
+	if !p.now().Before(entry.expiresAt) {
+		p.remove(element)
+		p.counters.misses.Add(1)
+		return nil, false, nil
+	}
+
+	p.order.MoveToFront(element)
+	p.counters.hits.Add(1)
+	return entry.value, true, nil
+}
+
+func (p *MemoryCacheProvider) Set(key string, value []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if element, ok := p.entries[key]; ok {
+		p.remove(element)
+	}
+
+	// Um valor maior que o limite esvaziaria o cache inteiro
+	if p.maxBytes > 0 && int64(len(value)) > p.maxBytes {
+		return nil
+	}
+
+	entry := &memoryCacheEntry{key: key, value: value, expiresAt: p.now().Add(p.ttl)}
+	p.entries[key] = p.order.PushFront(entry)
+	p.bytes += int64(len(value))
+	p.counters.sets.Add(1)
+
+	for p.overLimit() {
+		p.remove(p.order.Back())
+		p.counters.evictions.Add(1)
+	}
+
+	return nil
+}
+
+func (p *MemoryCacheProvider) DeletePrefix(prefix string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for key, element := range p.entries {
+		if strings.HasPrefix(key, prefix) {
+			p.remove(element)
+		}
+	}
+
+	p.counters.invalidations.Add(1)
+	return nil
+}
+
+func (p *MemoryCacheProvider) Stats() interfaces.CacheStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := p.counters.snapshot("memory")
+	stats.Entries = len(p.entries)
+	stats.Bytes = p.bytes
+	return stats
+}
+
+func (p *MemoryCacheProvider) overLimit() bool {
+	if p.maxEntries > 0 && len(p.entries) > p.maxEntries {
+		return true
+	}
+	return p.maxBytes > 0 && p.bytes > p.maxBytes
+}
+
+func (p *MemoryCacheProvider) remove(element *list.Element) {
+	entry := element.Value.(*memoryCacheEntry)
+	p.order.Remove(element)
+	delete(p.entries, entry.key)
+	p.bytes -= int64(len(entry.value))
+}
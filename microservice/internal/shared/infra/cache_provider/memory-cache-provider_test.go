@@ -0,0 +1,109 @@
+package cache_provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCacheProvider_GetSet(t *testing.T) {
+	provider := NewMemoryCacheProvider(time.Minute, 10, 1024)
+
+	_, found, err := provider.Get("product:id:1")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, provider.Set("product:id:1", []byte("coca")))
+	value, found, err := provider.Get("product:id:1")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("coca"), value)
+
+	stats := provider.Stats()
+	require.Equal(t, "memory", stats.Driver)
+	require.Equal(t, uint64(1), stats.Hits)
+	require.Equal(t, uint64(1), stats.Misses)
+	require.Equal(t, 0.5, stats.HitRatio)
+	require.Equal(t, 1, stats.Entries)
+	require.Equal(t, int64(4), stats.Bytes)
+}
+
+func TestMemoryCacheProvider_ExpiresAfterTTL(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	provider := NewMemoryCacheProvider(30*time.Second, 10, 1024)
+	provider.now = func() time.Time { return now }
+
+	require.NoError(t, provider.Set("k", []byte("v")))
+
+	now = now.Add(29 * time.Second)
+	_, found, _ := provider.Get("k")
+	require.True(t, found)
+
+	now = now.Add(time.Second)
+	_, found, _ = provider.Get("k")
+	require.False(t, found)
+	require.Equal(t, 0, provider.Stats().Entries)
+}
+
+func TestMemoryCacheProvider_EvictsLeastRecentlyUsed(t *testing.T) {
+	provider := NewMemoryCacheProvider(time.Minute, 2, 1024)
+
+	require.NoError(t, provider.Set("a", []byte("1")))
+	require.NoError(t, provider.Set("b", []byte("2")))
+	_, _, _ = provider.Get("a")
+	require.NoError(t, provider.Set("c", []byte("3")))
+
+	_, found, _ := provider.Get("b")
+	require.False(t, found)
+	_, found, _ = provider.Get("a")
+	require.True(t, found)
+	_, found, _ = provider.Get("c")
+	require.True(t, found)
+	require.Equal(t, uint64(1), provider.Stats().Evictions)
+}
+
+func TestMemoryCacheProvider_BoundedByBytes(t *testing.T) {
+	provider := NewMemoryCacheProvider(time.Minute, 10, 10)
+
+	require.NoError(t, provider.Set("a", []byte("12345")))
+	require.NoError(t, provider.Set("b", []byte("12345")))
+	require.NoError(t, provider.Set("c", []byte("123")))
+
+	stats := provider.Stats()
+	require.Equal(t, 2, stats.Entries)
+	require.Equal(t, int64(8), stats.Bytes)
+
+	// Valores maiores que o limite não são guardados
+	require.NoError(t, provider.Set("big", []byte("12345678901")))
+	_, found, _ := provider.Get("big")
+	require.False(t, found)
+	require.Equal(t, 2, provider.Stats().Entries)
+}
+
+func TestMemoryCacheProvider_ReplacesValue(t *testing.T) {
+	provider := NewMemoryCacheProvider(time.Minute, 10, 1024)
+
+	require.NoError(t, provider.Set("k", []byte("old value")))
+	require.NoError(t, provider.Set("k", []byte("new")))
+
+	value, _, _ := provider.Get("k")
+	require.Equal(t, []byte("new"), value)
+	require.Equal(t, int64(3), provider.Stats().Bytes)
+}
+
+func TestMemoryCacheProvider_DeletePrefix(t *testing.T) {
+	provider := NewMemoryCacheProvider(time.Minute, 10, 1024)
+
+	require.NoError(t, provider.Set("product:id:1", []byte("1")))
+	require.NoError(t, provider.Set("product:all", []byte("[]")))
+	require.NoError(t, provider.Set("category:all", []byte("[]")))
+
+	require.NoError(t, provider.DeletePrefix("product:"))
+
+	_, found, _ := provider.Get("product:id:1")
+	require.False(t, found)
+	_, found, _ = provider.Get("category:all")
+	require.True(t, found)
+	require.Equal(t, uint64(1), provider.Stats().Invalidations)
+}
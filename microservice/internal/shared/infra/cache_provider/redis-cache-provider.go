@@ -0,0 +1,134 @@
+package cache_provider
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"tech_challenge/internal/shared/interfaces"
+)
+
+const (
+	redisPoolSize    = 8
+	redisScanCount   = 100
+	redisDialTimeout = 2 * time.Second
+	redisIOTimeout   = time.Second
+)
+
+type RedisConfig struct {
+	Addr      string
+	Password  string
+	DB        int
+	TLS       bool
+	KeyPrefix string
+}
+
+// RedisCacheProvider usa o go-redis, que cuida do pool, da reconexão com
+// backoff e da negociação do protocolo. O TTL fica a cargo do Redis e os
+// limites de tamanho, da política de memória do servidor
+type RedisCacheProvider struct {
+	client    *redis.Client
+	keyPrefix string
+	ttl       time.Duration
+	counters  cacheCounters
+}
+
+func NewRedisCacheProvider(config RedisConfig, ttl time.Duration) *RedisCacheProvider {
+	options := &redis.Options{
+		Addr:         config.Addr,
+		Password:     config.Password,
+		DB:           config.DB,
+		PoolSize:     redisPoolSize,
+		DialTimeout:  redisDialTimeout,
+		ReadTimeout:  redisIOTimeout,
+		WriteTimeout: redisIOTimeout,
+	}
+	if config.TLS {
+		options.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	return &RedisCacheProvider{
+		client:    redis.NewClient(options),
+		keyPrefix: config.KeyPrefix,
+		ttl:       ttl,
+	}
+}
+
+func (p *RedisCacheProvider) Get(key string) ([]byte, bool, error) {
+	value, err := p.client.Get(context.Background(), p.keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		p.counters.misses.Add(1)
+		return nil, false, nil
+	}
+	if err != nil {
+		p.counters.errors.Add(1)
+		return nil, false, err
+	}
+
+	p.counters.hits.Add(1)
+	return value, true, nil
+}
+
+func (p *RedisCacheProvider) Set(key string, value []byte) error {
+	if err := p.client.Set(context.Background(), p.keyPrefix+key, value, p.ttl).Err(); err != nil {
+		p.counters.errors.Add(1)
+		return err
+	}
+
+	p.counters.sets.Add(1)
+	return nil
+}
+
+// DeletePrefix percorre as chaves com SCAN, que ao contrário de KEYS não
+// bloqueia o servidor, e só depois remove todas em lotes de um mesmo pipeline,
+// para que as remoções não desloquem as páginas ainda não lidas
+func (p *RedisCacheProvider) DeletePrefix(prefix string) error {
+	ctx := context.Background()
+	pattern := escapeRedisPattern(p.keyPrefix+prefix) + "*"
+
+	var keys []string
+	iter := p.client.Scan(ctx, 0, pattern, redisScanCount).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		p.counters.errors.Add(1)
+		return err
+	}
+
+	if len(keys) > 0 {
+		_, err := p.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for batch := range slices.Chunk(keys, redisScanCount) {
+				pipe.Del(ctx, batch...)
+			}
+			return nil
+		})
+		if err != nil {
+			p.counters.errors.Add(1)
+			return err
+		}
+	}
+
+	p.counters.invalidations.Add(1)
+	return nil
+}
+
+func (p *RedisCacheProvider) Stats() interfaces.CacheStats {
+	return p.counters.snapshot("redis")
+}
+
+func escapeRedisPattern(value string) string {
+	var escaped strings.Builder
+	for _, r := range value {
+		if strings.ContainsRune(`*?[]\`, r) {
+			escaped.WriteRune('\\')
+		}
+		escaped.WriteRune(r)
+	}
+	return escaped.String()
+}
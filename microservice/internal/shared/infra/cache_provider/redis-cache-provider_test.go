@@ -0,0 +1,107 @@
+package cache_provider
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisCacheProvider_GetSet(t *testing.T) {
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+	provider := NewRedisCacheProvider(RedisConfig{Addr: server.Addr(), Password: "secret", DB: 2, KeyPrefix: "tc:"}, 30*time.Second)
+
+	_, found, err := provider.Get("product:id:1")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, provider.Set("product:id:1", []byte(`{"name":"Coca"}`)))
+	value, found, err := provider.Get("product:id:1")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, `{"name":"Coca"}`, string(value))
+
+	server.Select(2)
+	require.Equal(t, 30*time.Second, server.TTL("tc:product:id:1"))
+
+	stats := provider.Stats()
+	require.Equal(t, "redis", stats.Driver)
+	require.Equal(t, uint64(1), stats.Hits)
+	require.Equal(t, uint64(1), stats.Misses)
+	require.Equal(t, uint64(1), stats.Sets)
+}
+
+func TestRedisCacheProvider_Expiry(t *testing.T) {
+	server := miniredis.RunT(t)
+	provider := NewRedisCacheProvider(RedisConfig{Addr: server.Addr()}, time.Minute)
+
+	require.NoError(t, provider.Set("k", []byte("v")))
+	server.FastForward(time.Minute)
+
+	_, found, err := provider.Get("k")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestRedisCacheProvider_DeletePrefix(t *testing.T) {
+	server := miniredis.RunT(t)
+	provider := NewRedisCacheProvider(RedisConfig{Addr: server.Addr(), KeyPrefix: "tc:"}, time.Minute)
+
+	for i := 0; i < 250; i++ {
+		require.NoError(t, provider.Set(fmt.Sprintf("product:id:%03d", i), []byte("p")))
+	}
+	require.NoError(t, provider.Set("category:all", []byte("[]")))
+
+	require.NoError(t, provider.DeletePrefix("product:"))
+
+	require.Equal(t, []string{"tc:category:all"}, server.Keys())
+	require.Equal(t, uint64(1), provider.Stats().Invalidations)
+}
+
+func TestRedisCacheProvider_AuthError(t *testing.T) {
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+	provider := NewRedisCacheProvider(RedisConfig{Addr: server.Addr(), Password: "wrong"}, time.Minute)
+
+	_, _, err := provider.Get("k")
+	require.ErrorContains(t, err, "WRONGPASS")
+	require.Equal(t, uint64(1), provider.Stats().Errors)
+}
+
+func TestRedisCacheProvider_Reconnects(t *testing.T) {
+	server := miniredis.RunT(t)
+	provider := NewRedisCacheProvider(RedisConfig{Addr: server.Addr()}, time.Minute)
+	require.NoError(t, provider.Set("k", []byte("v")))
+
+	// Um restart do Redis derruba as conexões do pool, e a próxima leitura
+	// abre uma nova
+	server.Close()
+	require.NoError(t, server.Restart())
+
+	value, found, err := provider.Get("k")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "v", string(value))
+}
+
+func TestRedisCacheProvider_Unavailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+
+	provider := NewRedisCacheProvider(RedisConfig{Addr: addr}, time.Minute)
+
+	_, _, err = provider.Get("k")
+	require.Error(t, err)
+	require.Error(t, provider.Set("k", []byte("v")))
+	require.Equal(t, uint64(2), provider.Stats().Errors)
+}
+
+func TestEscapeRedisPattern(t *testing.T) {
+	require.Equal(t, `tc:product\*\[1\]:`, escapeRedisPattern("tc:product*[1]:"))
+}
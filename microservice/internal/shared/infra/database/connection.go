@@ -1,6 +1,8 @@
 package database
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"os"
 	"sync"
@@ -97,15 +99,63 @@ func Close() {
 	sqlDriver.Close()
 }
 
-func RunMigrations() {
-	if err := dbConnection.AutoMigrate(
+func RunMigrations() error {
+	if err := dbConnection.AutoMigrate(migrationModels()...); err != nil {
+		log.Printf("Erro ao executar AutoMigrate: %v", err)
+		return err
+	}
+	if err := migrateNameKeys(dbConnection); err != nil {
+		log.Printf("Erro ao criar índices de nomes únicos: %v", err)
+		return err
+	}
+	if err := backfillUpdatedAt(dbConnection); err != nil {
+		log.Printf("Erro ao preencher updated_at: %v", err)
+		return err
+	}
+	return nil
+}
+
+func PendingMigrations() []string {
+	pending := make([]string, 0)
+	for _, model := range migrationModels() {
+		if !dbConnection.Migrator().HasTable(model) {
+			pending = append(pending, fmt.Sprintf("%T", model))
+		}
+	}
+	return pending
+}
+
+func Ping() error {
+	if dbConnection == nil {
+		return errors.New("database connection not established")
+	}
+
+	sqlDriver, err := dbConnection.DB()
+	if err != nil {
+		return err
+	}
+
+	return sqlDriver.Ping()
+}
+
+func migrationModels() []interface{} {
+	return []interface{}{
 		&product_models.CategoryModel{},
 		&product_models.ProductModel{},
 		&product_models.ProductImageModel{},
-	); err != nil {
-		log.Printf("Erro ao executar AutoMigrate: %v", err)
+		&product_models.ProductStockModel{},
+		&product_models.StockReservationModel{},
+		&product_models.ProductTranslationModel{},
+		&product_models.CategoryTranslationModel{},
+		&product_models.StoreModel{},
+		&product_models.StoreProductOverrideModel{},
+		&product_models.StoreCategoryOverrideModel{},
+		&product_models.PromotionModel{},
+		&product_models.PromotionProductModel{},
+		&product_models.PromotionCategoryModel{},
 	}
 }
+
 func SetDB(db *gorm.DB) {
 	dbConnection = db
 	instance = db
@@ -4,6 +4,11 @@ import (
 	"os"
 	testenv "tech_challenge/internal/shared/test"
 	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
 )
 
 func TestMain(m *testing.M) {
@@ -11,3 +16,22 @@ func TestMain(m *testing.M) {
 	code := m.Run()
 	os.Exit(code)
 }
+
+func TestPing_WithoutConnection(t *testing.T) {
+	SetDB(nil)
+	require.Error(t, Ping())
+}
+
+func TestPing_WithConnection(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	mock.ExpectPing()
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	SetDB(gormDB)
+	defer SetDB(nil)
+
+	mock.ExpectPing()
+	require.NoError(t, Ping())
+}
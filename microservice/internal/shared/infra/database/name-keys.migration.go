@@ -0,0 +1,178 @@
+package database
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	database_errors "tech_challenge/internal/product/infra/database/database_errors"
+	product_models "tech_challenge/internal/product/infra/database/models"
+	"tech_challenge/internal/shared/pkg/normalizer"
+)
+
+// nameKeyMaxLength é o tamanho máximo dos nomes de produtos e categorias
+const nameKeyMaxLength = 100
+
+type nameKeyRow struct {
+	ID      string
+	Name    string
+	NameKey string
+	Scope   string
+}
+
+// nameKeyIndex descreve um índice único sobre name_key, dentro de scope
+// quando informado. O AutoMigrate cria a coluna, mas o índice só pode ser
+// criado depois de preencher as linhas antigas e de renomear os duplicados
+type nameKeyIndex struct {
+	model   interface{}
+	table   string
+	name    string
+	scope   string
+	columns []string
+}
+
+func nameKeyIndexes() []nameKeyIndex {
+	return []nameKeyIndex{
+		{
+			model:   &product_models.CategoryModel{},
+			table:   product_models.CategoryModel{}.TableName(),
+			name:    database_errors.CategoryNameKeyIndex,
+			columns: []string{"name_key"},
+		},
+		{
+			model:   &product_models.ProductModel{},
+			table:   product_models.ProductModel{}.TableName(),
+			name:    database_errors.ProductNameKeyIndex,
+			scope:   "category_id",
+			columns: []string{"category_id", "name_key"},
+		},
+	}
+}
+
+func migrateNameKeys(db *gorm.DB) error {
+	for _, index := range nameKeyIndexes() {
+		if err := backfillNameKeys(db, index); err != nil {
+			return err
+		}
+
+		if err := renameDuplicatedNameKeys(db, index); err != nil {
+			return err
+		}
+
+		sql := fmt.Sprintf(
+			`CREATE UNIQUE INDEX IF NOT EXISTS %q ON %q (%s)`,
+			index.name, index.table, strings.Join(index.columns, ", "),
+		)
+		if err := db.Exec(sql).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func backfillNameKeys(db *gorm.DB, index nameKeyIndex) error {
+	var rows []nameKeyRow
+
+	err := db.Model(index.model).Select("id", "name").Where("name_key = ?", "").Find(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	// UpdateColumn mantém o updated_at: a chave não faz parte da representação
+	for _, row := range rows {
+		err := db.Model(index.model).Where("id = ?", row.ID).UpdateColumn("name_key", normalizer.NameKey(row.Name)).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renameDuplicatedNameKeys resolve os nomes que colidem depois da
+// normalização, gravados antes do índice existir. Em cada grupo, a linha de
+// menor id mantém o nome e as demais ganham um sufixo " (2)", " (3)"... livre
+// no mesmo escopo. A renomeação avança a versão, para invalidar os ETags
+func renameDuplicatedNameKeys(db *gorm.DB, index nameKeyIndex) error {
+	var duplicated []string
+
+	err := db.Model(index.model).
+		Select("name_key").
+		Group(strings.Join(index.columns, ", ")).
+		Having("COUNT(*) > 1").
+		Pluck("name_key", &duplicated).Error
+	if err != nil || len(duplicated) == 0 {
+		return err
+	}
+
+	scope := "''"
+	if index.scope != "" {
+		scope = index.scope
+	}
+
+	var rows []nameKeyRow
+	err = db.Model(index.model).
+		Select("id", "name", "name_key", scope+" AS scope").
+		Where("name_key IN ?", duplicated).
+		Order(strings.Join(index.columns, ", ") + ", id").
+		Find(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[[2]string]bool, len(rows))
+	for _, row := range rows {
+		group := [2]string{row.Scope, row.NameKey}
+		if !seen[group] {
+			seen[group] = true
+			continue
+		}
+
+		name, err := freeName(db, index, row)
+		if err != nil {
+			return err
+		}
+
+		err = db.Model(index.model).Where("id = ?", row.ID).UpdateColumns(map[string]any{
+			"name":       name,
+			"name_key":   normalizer.NameKey(name),
+			"version":    gorm.Expr("version + 1"),
+			"updated_at": time.Now(),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		log.Printf("%s: renamed %s from %q to %q to create %s", index.table, row.ID, row.Name, name, index.name)
+	}
+
+	return nil
+}
+
+func freeName(db *gorm.DB, index nameKeyIndex, row nameKeyRow) (string, error) {
+	for n := 2; ; n++ {
+		suffix := fmt.Sprintf(" (%d)", n)
+		base := []rune(row.Name)
+		if len(base)+len(suffix) > nameKeyMaxLength {
+			base = base[:nameKeyMaxLength-len(suffix)]
+		}
+		name := string(base) + suffix
+
+		query := db.Model(index.model).Where("name_key = ?", normalizer.NameKey(name))
+		if index.scope != "" {
+			query = query.Where(index.scope+" = ?", row.Scope)
+		}
+
+		var count int64
+		if err := query.Count(&count).Error; err != nil {
+			return "", err
+		}
+		if count == 0 {
+			return name, nil
+		}
+	}
+}
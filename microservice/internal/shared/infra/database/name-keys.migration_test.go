@@ -0,0 +1,104 @@
+package database
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func setupNameKeysMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	return gormDB, mock
+}
+
+func TestMigrateNameKeys(t *testing.T) {
+	db, mock := setupNameKeysMockDB(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","name" FROM "category" WHERE name_key = $1`)).
+		WithArgs("").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("cat1", "Açaí"))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "category" SET "name_key"=$1 WHERE id = $2`)).
+		WithArgs("acai", "cat1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "name_key" FROM "category" GROUP BY "name_key" HAVING COUNT(*) > 1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"name_key"}))
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE UNIQUE INDEX IF NOT EXISTS "idx_category_name_key" ON "category" (name_key)`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","name" FROM "products" WHERE name_key = $1`)).
+		WithArgs("").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "name_key" FROM "products" GROUP BY category_id, name_key HAVING COUNT(*) > 1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"name_key"}))
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE UNIQUE INDEX IF NOT EXISTS "idx_products_category_name_key" ON "products" (category_id, name_key)`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.NoError(t, migrateNameKeys(db))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrateNameKeys_RenamesLegacyDuplicates(t *testing.T) {
+	db, mock := setupNameKeysMockDB(t)
+
+	// Categorias gravadas antes do índice: "Bebidas" e "bebidas" colidem, e
+	// "Bebidas (2)" já existe
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","name" FROM "category" WHERE name_key = $1`)).
+		WithArgs("").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "name_key" FROM "category" GROUP BY "name_key" HAVING COUNT(*) > 1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"name_key"}).AddRow("bebidas"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","name","name_key",'' AS scope FROM "category" WHERE name_key IN ($1) ORDER BY name_key, id`)).
+		WithArgs("bebidas").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "name_key", "scope"}).
+			AddRow("cat1", "Bebidas", "bebidas", "").
+			AddRow("cat2", "bebidas", "bebidas", ""))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "category" WHERE name_key = $1`)).
+		WithArgs("bebidas (2)").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "category" WHERE name_key = $1`)).
+		WithArgs("bebidas (3)").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "category" SET "name"=$1,"name_key"=$2,"updated_at"=$3,"version"=version + 1 WHERE id = $4`)).
+		WithArgs("bebidas (3)", "bebidas (3)", sqlmock.AnyArg(), "cat2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE UNIQUE INDEX IF NOT EXISTS "idx_category_name_key" ON "category" (name_key)`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Produtos: só colidem dentro da mesma categoria
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","name" FROM "products" WHERE name_key = $1`)).
+		WithArgs("").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "name_key" FROM "products" GROUP BY category_id, name_key HAVING COUNT(*) > 1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"name_key"}).AddRow("coca"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","name","name_key",category_id AS scope FROM "products" WHERE name_key IN ($1) ORDER BY category_id, name_key, id`)).
+		WithArgs("coca").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "name_key", "scope"}).
+			AddRow("p1", "Coca", "coca", "cat1").
+			AddRow("p2", "COCA", "coca", "cat1").
+			AddRow("p3", "Coca", "coca", "cat2"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE name_key = $1 AND category_id = $2`)).
+		WithArgs("coca (2)", "cat1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "products" SET "name"=$1,"name_key"=$2,"updated_at"=$3,"version"=version + 1 WHERE id = $4`)).
+		WithArgs("COCA (2)", "coca (2)", sqlmock.AnyArg(), "p2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE UNIQUE INDEX IF NOT EXISTS "idx_products_category_name_key" ON "products" (category_id, name_key)`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.NoError(t, migrateNameKeys(db))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,30 @@
+package database
+
+import (
+	"gorm.io/gorm"
+
+	product_models "tech_challenge/internal/product/infra/database/models"
+)
+
+// backfillUpdatedAt preenche o updated_at das linhas gravadas antes da coluna
+// existir. Produtos e imagens usam o created_at; categorias não têm data de
+// criação e ficam com o horário da migração
+func backfillUpdatedAt(db *gorm.DB) error {
+	backfills := []struct {
+		model interface{}
+		value interface{}
+	}{
+		{&product_models.CategoryModel{}, gorm.Expr("CURRENT_TIMESTAMP")},
+		{&product_models.ProductModel{}, gorm.Expr("created_at")},
+		{&product_models.ProductImageModel{}, gorm.Expr("created_at")},
+	}
+
+	for _, backfill := range backfills {
+		err := db.Model(backfill.model).Where("updated_at IS NULL").UpdateColumn("updated_at", backfill.value).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
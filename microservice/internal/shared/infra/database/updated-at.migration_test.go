@@ -0,0 +1,41 @@
+package database
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackfillUpdatedAt(t *testing.T) {
+	db, mock := setupNameKeysMockDB(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "category" SET "updated_at"=CURRENT_TIMESTAMP WHERE updated_at IS NULL`)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "products" SET "updated_at"=created_at WHERE updated_at IS NULL`)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_images" SET "updated_at"=created_at WHERE updated_at IS NULL`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	require.NoError(t, backfillUpdatedAt(db))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBackfillUpdatedAt_Error(t *testing.T) {
+	db, mock := setupNameKeysMockDB(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "category" SET "updated_at"=CURRENT_TIMESTAMP WHERE updated_at IS NULL`)).
+		WillReturnError(errors.New("connection lost"))
+	mock.ExpectRollback()
+
+	require.EqualError(t, backfillUpdatedAt(db), "connection lost")
+}
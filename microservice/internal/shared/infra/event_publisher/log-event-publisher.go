@@ -0,0 +1,26 @@
+package event_publisher
+
+import (
+	"encoding/json"
+	"log"
+
+	"tech_challenge/internal/shared/interfaces"
+)
+
+// LogEventPublisher só registra os eventos no log; é o padrão quando nenhum
+// webhook está configurado
+type LogEventPublisher struct{}
+
+func NewLogEventPublisher() *LogEventPublisher {
+	return &LogEventPublisher{}
+}
+
+func (p *LogEventPublisher) Publish(event interfaces.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("event %s", payload)
+	return nil
+}
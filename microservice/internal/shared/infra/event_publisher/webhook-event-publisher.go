@@ -0,0 +1,53 @@
+package event_publisher
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"tech_challenge/internal/shared/interfaces"
+)
+
+const webhookTimeout = 5 * time.Second
+
+// WebhookEventPublisher envia cada evento como um POST JSON para a URL
+// configurada. Qualquer resposta fora da faixa 2xx é tratada como falha
+type WebhookEventPublisher struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookEventPublisher(url string) *WebhookEventPublisher {
+	return &WebhookEventPublisher{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+func (p *WebhookEventPublisher) Publish(event interfaces.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, p.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Event-Type", event.Type)
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("webhook %s answered %d", p.url, response.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package event_publisher
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/shared/interfaces"
+)
+
+func TestWebhookEventPublisher_Publish(t *testing.T) {
+	var received map[string]any
+	var eventType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		eventType = r.Header.Get("X-Event-Type")
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	err := NewWebhookEventPublisher(server.URL).Publish(interfaces.Event{
+		Type:       "product.stock.availability_changed",
+		OccurredAt: time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC),
+		Data:       map[string]any{"product_id": "p1"},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "product.stock.availability_changed", eventType)
+	require.Equal(t, "2025-01-15T12:00:00Z", received["occurred_at"])
+	require.Equal(t, map[string]any{"product_id": "p1"}, received["data"])
+}
+
+func TestWebhookEventPublisher_FailsOnErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	err := NewWebhookEventPublisher(server.URL).Publish(interfaces.Event{Type: "x"})
+	require.ErrorContains(t, err, "answered 502")
+}
+
+func TestLogEventPublisher_Publish(t *testing.T) {
+	require.NoError(t, NewLogEventPublisher().Publish(interfaces.Event{Type: "x", Data: map[string]any{"a": 1}}))
+}
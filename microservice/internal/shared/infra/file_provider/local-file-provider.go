@@ -49,6 +49,22 @@ func (l *LocalFileProvider) DeleteFile(fileName string) error {
 	return os.Remove(filePath)
 }
 
+func (l *LocalFileProvider) ListFiles() ([]string, error) {
+	entries, err := os.ReadDir(l.basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	fileNames := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			fileNames = append(fileNames, entry.Name())
+		}
+	}
+
+	return fileNames, nil
+}
+
 func (l *LocalFileProvider) fileExists(fileName string) bool {
 	filePath := filepath.Join(l.basePath, fileName)
 
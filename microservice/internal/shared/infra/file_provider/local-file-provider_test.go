@@ -46,3 +46,18 @@ func TestLocalFileProvider_fileExists(t *testing.T) {
 	require.True(t, provider.fileExists(fileName))
 	_ = os.Remove(filePath)
 }
+
+func TestLocalFileProvider_ListFiles(t *testing.T) {
+	provider := NewLocalFileProvider()
+	fileName := "test_list.txt"
+	filePath := filepath.Join(provider.basePath, fileName)
+	_ = os.Remove(filePath)
+
+	err := provider.UploadFile(fileName, []byte("abc"))
+	require.NoError(t, err)
+	defer os.Remove(filePath)
+
+	files, err := provider.ListFiles()
+	require.NoError(t, err)
+	require.Contains(t, files, fileName)
+}
@@ -20,6 +20,8 @@ import (
 type S3Client interface {
 	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
 	DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	ListObjectsV2(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)
+	HeadBucket(ctx context.Context, params *s3.HeadBucketInput, optFns ...func(*s3.Options)) (*s3.HeadBucketOutput, error)
 }
 
 // 2. Altere o S3FileProvider para usar a interface
@@ -137,3 +139,37 @@ func (s *S3FileProvider) DeleteFiles(fileNames []string) error {
 	}
 	return nil
 }
+
+func (s *S3FileProvider) ListFiles() ([]string, error) {
+	fileNames := make([]string, 0)
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucketName),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			if strings.Contains(err.Error(), "NoSuchBucket") || strings.Contains(err.Error(), "InvalidBucketName") {
+				return nil, &exceptions.BucketNotFoundException{}
+			}
+			return nil, fmt.Errorf("failed to list files: %w", err)
+		}
+
+		for _, object := range page.Contents {
+			fileNames = append(fileNames, aws.ToString(object.Key))
+		}
+	}
+
+	return fileNames, nil
+}
+
+func (s *S3FileProvider) CheckBucket() error {
+	_, err := s.client.HeadBucket(context.TODO(), &s3.HeadBucketInput{
+		Bucket: aws.String(s.bucketName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("bucket %s is not reachable: %w", s.bucketName, err)
+	}
+	return nil
+}
@@ -9,13 +9,17 @@ import (
 	testenv "tech_challenge/internal/shared/test"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/stretchr/testify/require"
 )
 
 type mockS3Client struct {
 	putFunc    func(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
 	deleteFunc func(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	listFunc   func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)
+	headFunc   func(ctx context.Context, params *s3.HeadBucketInput, optFns ...func(*s3.Options)) (*s3.HeadBucketOutput, error)
 }
 
 func TestMain(m *testing.M) {
@@ -37,6 +41,20 @@ func (m *mockS3Client) DeleteObject(ctx context.Context, params *s3.DeleteObject
 	return &s3.DeleteObjectOutput{}, nil
 }
 
+func (m *mockS3Client) ListObjectsV2(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	if m.listFunc != nil {
+		return m.listFunc(ctx, params, optFns...)
+	}
+	return &s3.ListObjectsV2Output{}, nil
+}
+
+func (m *mockS3Client) HeadBucket(ctx context.Context, params *s3.HeadBucketInput, optFns ...func(*s3.Options)) (*s3.HeadBucketOutput, error) {
+	if m.headFunc != nil {
+		return m.headFunc(ctx, params, optFns...)
+	}
+	return &s3.HeadBucketOutput{}, nil
+}
+
 func TestS3FileProvider_DeleteFiles_AllSuccess(t *testing.T) {
 	provider := &S3FileProvider{
 		client: &mockS3Client{
@@ -167,3 +185,54 @@ func TestS3FileProvider_UploadFile_OtherError(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "erro ao fazer upload no S3")
 }
+
+func TestS3FileProvider_ListFiles_Paginated(t *testing.T) {
+	provider := &S3FileProvider{
+		client: &mockS3Client{
+			listFunc: func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+				if params.ContinuationToken == nil {
+					return &s3.ListObjectsV2Output{
+						Contents:              []types.Object{{Key: aws.String("a.png")}},
+						IsTruncated:           aws.Bool(true),
+						NextContinuationToken: aws.String("next"),
+					}, nil
+				}
+				return &s3.ListObjectsV2Output{
+					Contents: []types.Object{{Key: aws.String("b.png")}},
+				}, nil
+			},
+		},
+		bucketName: "bucket",
+	}
+	files, err := provider.ListFiles()
+	require.NoError(t, err)
+	require.Equal(t, []string{"a.png", "b.png"}, files)
+}
+
+func TestS3FileProvider_ListFiles_BucketNotFound(t *testing.T) {
+	provider := &S3FileProvider{
+		client: &mockS3Client{
+			listFunc: func(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+				return nil, errors.New("NoSuchBucket: bucket not found")
+			},
+		},
+		bucketName: "bucket",
+	}
+	_, err := provider.ListFiles()
+	_, ok := err.(*exceptions.BucketNotFoundException)
+	require.True(t, ok)
+}
+
+func TestS3FileProvider_CheckBucket(t *testing.T) {
+	provider := &S3FileProvider{client: &mockS3Client{}, bucketName: "bucket"}
+	require.NoError(t, provider.CheckBucket())
+
+	provider.client = &mockS3Client{
+		headFunc: func(ctx context.Context, params *s3.HeadBucketInput, optFns ...func(*s3.Options)) (*s3.HeadBucketOutput, error) {
+			return nil, errors.New("forbidden")
+		},
+	}
+	err := provider.CheckBucket()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "bucket bucket is not reachable")
+}
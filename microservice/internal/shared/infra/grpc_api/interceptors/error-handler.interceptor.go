@@ -0,0 +1,53 @@
+package interceptors
+
+import (
+	"context"
+	"log"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	product_grpc_errors "tech_challenge/internal/product/infra/grpc/grpc_errors"
+)
+
+const internalErrorMessage = "An unexpected error occurred"
+
+// ErrorHandlerInterceptor é o único ponto que converte erros em status: os
+// serviços devolvem os erros do controller como vieram. Erros que já são
+// status, como as validações da requisição, seguem sem alteração
+func ErrorHandlerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+
+		if st, ok := product_grpc_errors.HandleDomainErrors(err, info.FullMethod); ok {
+			return nil, st.Err()
+		}
+
+		log.Printf("unexpected error on %s: %v", info.FullMethod, err)
+		return nil, status.Error(codes.Internal, internalErrorMessage)
+	}
+}
+
+// RecoveryInterceptor faz o papel do gin.Recovery: um panic em um serviço vira
+// Internal em vez de derrubar o processo, que também atende a API HTTP
+func RecoveryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("panic on %s: %v\n%s", info.FullMethod, r, debug.Stack())
+				err = status.Error(codes.Internal, internalErrorMessage)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
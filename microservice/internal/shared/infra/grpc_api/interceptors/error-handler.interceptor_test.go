@@ -0,0 +1,58 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"tech_challenge/internal/product/domain/exceptions"
+)
+
+var testInfo = &grpc.UnaryServerInfo{FullMethod: "/catalog.v1.ProductService/GetProduct"}
+
+func failingHandler(err error) grpc.UnaryHandler {
+	return func(ctx context.Context, req any) (any, error) {
+		return nil, err
+	}
+}
+
+func TestErrorHandlerInterceptor(t *testing.T) {
+	interceptor := ErrorHandlerInterceptor()
+
+	cases := []struct {
+		err          error
+		expectedCode codes.Code
+	}{
+		{&exceptions.ProductNotFoundException{}, codes.NotFound},
+		{status.Error(codes.InvalidArgument, "id must be a valid UUID"), codes.InvalidArgument},
+		{errors.New("pq: password authentication failed"), codes.Internal},
+	}
+
+	for _, c := range cases {
+		_, err := interceptor(context.Background(), nil, testInfo, failingHandler(c.err))
+		require.Equal(t, c.expectedCode, status.Code(err))
+	}
+
+	// A mensagem do erro inesperado nunca vai para o cliente
+	_, err := interceptor(context.Background(), nil, testInfo, failingHandler(errors.New("pq: password authentication failed")))
+	require.Equal(t, internalErrorMessage, status.Convert(err).Message())
+
+	resp, err := interceptor(context.Background(), nil, testInfo, func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+}
+
+func TestRecoveryInterceptor(t *testing.T) {
+	_, err := RecoveryInterceptor()(context.Background(), nil, testInfo, func(ctx context.Context, req any) (any, error) {
+		panic("boom")
+	})
+
+	require.Equal(t, codes.Internal, status.Code(err))
+}
@@ -0,0 +1,102 @@
+package grpc_api
+
+import (
+	"log"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	product_services "tech_challenge/internal/product/infra/grpc/services"
+	"tech_challenge/internal/shared/infra/database"
+	"tech_challenge/internal/shared/infra/grpc_api/interceptors"
+)
+
+// healthCheckInterval é o intervalo entre as verificações do banco que
+// atualizam o health do gRPC
+const healthCheckInterval = 10 * time.Second
+
+// NewServer monta o servidor gRPC com os interceptors, os serviços de cada
+// módulo, o health check e a reflection, que permite a clientes como o grpcurl
+// descobrir os serviços sem os arquivos .proto. O health começa com o resultado
+// de healthCheck e é atualizado por Health.Watch
+func NewServer(healthCheck func() error, registers ...func(grpc.ServiceRegistrar)) (*grpc.Server, *Health) {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		interceptors.RecoveryInterceptor(),
+		interceptors.ErrorHandlerInterceptor(),
+	))
+
+	for _, register := range registers {
+		register(server)
+	}
+
+	// O health responde pelo servidor ("") e por cada serviço registrado
+	healthServer := &Health{server: health.NewServer(), services: []string{""}, check: healthCheck}
+	for name := range server.GetServiceInfo() {
+		healthServer.services = append(healthServer.services, name)
+	}
+	healthServer.Update()
+	healthpb.RegisterHealthServer(server, healthServer.server)
+
+	reflection.Register(server)
+
+	return server, healthServer
+}
+
+// Health liga o health do gRPC à mesma verificação do banco usada pelo
+// comando check: com o banco fora, os serviços ficam NOT_SERVING e o
+// balanceador deixa de mandar chamadas que só falhariam
+type Health struct {
+	server   *health.Server
+	services []string
+	check    func() error
+}
+
+// Update refaz a verificação e grava o status de todos os serviços
+func (h *Health) Update() {
+	status := healthpb.HealthCheckResponse_SERVING
+	if err := h.check(); err != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+		log.Printf("gRPC health check failed: %v", err)
+	}
+
+	for _, service := range h.services {
+		h.server.SetServingStatus(service, status)
+	}
+}
+
+// Watch chama Update a cada interval enquanto o processo roda
+func (h *Health) Watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		h.Update()
+	}
+}
+
+// Serve atende os serviços internos em addr. Roda ao lado da API HTTP, que já
+// conectou o banco e o cache
+func Serve(addr string) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s for the gRPC server: %v", addr, err)
+	}
+
+	server, healthServer := NewServer(
+		database.Ping,
+		product_services.RegisterProductService,
+		product_services.RegisterCategoryService,
+	)
+
+	go healthServer.Watch(healthCheckInterval)
+
+	log.Printf("gRPC server listening on [%s]", addr)
+
+	if err := server.Serve(listener); err != nil {
+		log.Fatalf("failed to start gRPC server: %v", err)
+	}
+}
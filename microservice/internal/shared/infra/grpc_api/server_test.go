@@ -0,0 +1,85 @@
+package grpc_api
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/test/bufconn"
+
+	"tech_challenge/internal/product/infra/grpc/catalogpb"
+)
+
+func dialTestServer(t *testing.T) *grpc.ClientConn {
+	conn, _ := dialTestServerWithHealth(t, func() error { return nil })
+	return conn
+}
+
+func dialTestServerWithHealth(t *testing.T, healthCheck func() error) (*grpc.ClientConn, *Health) {
+	listener := bufconn.Listen(1 << 20)
+	server, healthServer := NewServer(healthCheck, func(server grpc.ServiceRegistrar) {
+		catalogpb.RegisterProductServiceServer(server, catalogpb.UnimplementedProductServiceServer{})
+	})
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn, healthServer
+}
+
+func TestNewServer_Health(t *testing.T) {
+	client := healthpb.NewHealthClient(dialTestServer(t))
+
+	for _, service := range []string{"", catalogpb.ProductService_ServiceDesc.ServiceName} {
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status, service)
+	}
+}
+
+func TestNewServer_HealthFollowsDatabase(t *testing.T) {
+	databaseErr := errors.New("database connection not established")
+	conn, healthServer := dialTestServerWithHealth(t, func() error { return databaseErr })
+	client := healthpb.NewHealthClient(conn)
+
+	status := func() healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: catalogpb.ProductService_ServiceDesc.ServiceName})
+		require.NoError(t, err)
+		return resp.Status
+	}
+
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status())
+
+	databaseErr = nil
+	healthServer.Update()
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, status())
+}
+
+func TestNewServer_Reflection(t *testing.T) {
+	stream, err := reflectionpb.NewServerReflectionClient(dialTestServer(t)).ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+
+	var services []string
+	for _, service := range resp.GetListServicesResponse().GetService() {
+		services = append(services, service.Name)
+	}
+	require.Contains(t, services, catalogpb.ProductService_ServiceDesc.ServiceName)
+	require.Contains(t, services, healthpb.Health_ServiceDesc.ServiceName)
+}
@@ -0,0 +1,23 @@
+package interfaces
+
+// ICacheProvider guarda valores já serializados por chave. Cada entrada expira
+// após o TTL configurado no provider
+type ICacheProvider interface {
+	Get(key string) ([]byte, bool, error)
+	Set(key string, value []byte) error
+	DeletePrefix(prefix string) error
+	Stats() CacheStats
+}
+
+type CacheStats struct {
+	Driver        string
+	Hits          uint64
+	Misses        uint64
+	HitRatio      float64
+	Sets          uint64
+	Invalidations uint64
+	Evictions     uint64
+	Errors        uint64
+	Entries       int
+	Bytes         int64
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import "time"
+
+// Event é uma notificação de mudança para outros serviços. Data é serializado
+// em JSON junto com o tipo e o instante do evento
+type Event struct {
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+type IEventPublisher interface {
+	Publish(event Event) error
+}
@@ -5,4 +5,5 @@ type IFileProvider interface {
 	DeleteFile(fileName string) error
 	DeleteFiles(filenames []string) error
 	GetPresignedURL(fileName string) (string, error)
+	ListFiles() ([]string, error)
 }
@@ -6,6 +6,11 @@ func NewUUIDV4() string {
 	return uuid.New().String()
 }
 
+// NewUUIDFromKey gera sempre o mesmo UUID para a mesma chave
+func NewUUIDFromKey(key string) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("tech_challenge:"+key)).String()
+}
+
 func IsValidUUID(uuidStr string) bool {
 	_, err := uuid.Parse(uuidStr)
 	return err == nil
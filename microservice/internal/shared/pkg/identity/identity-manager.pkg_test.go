@@ -24,3 +24,10 @@ func TestIsNotValidUUID(t *testing.T) {
 	require.False(t, IsNotValidUUID(valid))
 	require.True(t, IsNotValidUUID(invalid))
 }
+
+func TestNewUUIDFromKey(t *testing.T) {
+	first := NewUUIDFromKey("product:x-salada")
+	require.True(t, IsValidUUID(first))
+	require.Equal(t, first, NewUUIDFromKey("product:x-salada"))
+	require.NotEqual(t, first, NewUUIDFromKey("product:x-bacon"))
+}
@@ -0,0 +1,24 @@
+package normalizer
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NameKey gera a forma usada para comparar nomes sem diferenciar maiúsculas,
+// acentos e espaços repetidos: "  Pão de Açúcar " e "pao de acucar" geram a
+// mesma chave
+func NameKey(value string) string {
+	var key strings.Builder
+
+	for _, r := range norm.NFD.String(value) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		key.WriteRune(unicode.ToLower(r))
+	}
+
+	return strings.Join(strings.Fields(key.String()), " ")
+}
@@ -0,0 +1,16 @@
+package normalizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameKey(t *testing.T) {
+	require.Equal(t, "bebidas", NameKey("Bebidas"))
+	require.Equal(t, "bebidas", NameKey("  BEBIDAS "))
+	require.Equal(t, "pao de acucar", NameKey("Pão  de Açúcar"))
+	require.Equal(t, NameKey("Café"), NameKey("cafe"))
+	require.NotEqual(t, NameKey("Lanches"), NameKey("Lanche"))
+	require.Equal(t, "", NameKey("   "))
+}
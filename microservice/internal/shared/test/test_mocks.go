@@ -1,23 +1,35 @@
 package testenv
 
 import (
+	"errors"
+	"slices"
+	"time"
+
 	"tech_challenge/internal/product/application/dtos"
 	"tech_challenge/internal/product/daos"
+	"tech_challenge/internal/product/domain/exceptions"
+	"tech_challenge/internal/product/interfaces"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
 )
 
 type MockProductDataSource struct {
 	FindAllFunc                          func() ([]daos.ProductDAO, error)
+	FindAllActiveFunc                    func() ([]daos.ProductDAO, error)
 	FindByIDFunc                         func(string) (daos.ProductDAO, error)
+	FindAllByIDsFunc                     func([]string) ([]daos.ProductDAO, error)
 	FindAllImagesProductByIdFunc         func(string) ([]daos.ProductImageDAO, error)
 	FindAllByCategoryIDFunc              func(string) ([]daos.ProductDAO, error)
+	FindAllByCategoryIDsFunc             func([]string) ([]daos.ProductDAO, error)
 	InsertFunc                           func(daos.ProductDAO) error
 	UpdateFunc                           func(daos.ProductDAO) error
-	DeleteFunc                           func(string) error
+	DeleteFunc                           func(string, int64) error
 	DeleteImageFunc                      func(string) error
 	AddProductImageFunc                  func(daos.ProductImageDAO) error
 	SetAllPreviousImagesAsNotDefaultFunc func(productID, exceptImageID string) error
 	SetImageAsDefaultFunc                func(productID, imageID string) error
 	UploadImageFunc                      func(uploadDTO dtos.UploadProductImageDTO) error
+	FindAllImageFileNamesFunc            func() ([]string, error)
+	FindByExternalKeyFunc                func(string) (daos.ProductDAO, error)
 }
 
 func (m *MockProductDataSource) FindAll() ([]daos.ProductDAO, error) {
@@ -26,6 +38,12 @@ func (m *MockProductDataSource) FindAll() ([]daos.ProductDAO, error) {
 	}
 	return nil, nil
 }
+func (m *MockProductDataSource) FindAllActive() ([]daos.ProductDAO, error) {
+	if m.FindAllActiveFunc != nil {
+		return m.FindAllActiveFunc()
+	}
+	return nil, nil
+}
 func (m *MockProductDataSource) FindByID(id string) (daos.ProductDAO, error) {
 	if m.FindByIDFunc != nil {
 		return m.FindByIDFunc(id)
@@ -41,6 +59,25 @@ func (m *MockProductDataSource) FindByID(id string) (daos.ProductDAO, error) {
 		Images:      []daos.ProductImageDAO{{ID: "imgid", ProductID: id, FileName: "img.jpg", IsDefault: true}},
 	}, nil
 }
+
+// FindAllByIDs filtra o resultado de FindAllFunc quando FindAllByIDsFunc não
+// é informado
+func (m *MockProductDataSource) FindAllByIDs(ids []string) ([]daos.ProductDAO, error) {
+	if m.FindAllByIDsFunc != nil {
+		return m.FindAllByIDsFunc(ids)
+	}
+	products, err := m.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	var result []daos.ProductDAO
+	for _, product := range products {
+		if slices.Contains(ids, product.ID) {
+			result = append(result, product)
+		}
+	}
+	return result, nil
+}
 func (m *MockProductDataSource) FindAllImagesProductById(id string) ([]daos.ProductImageDAO, error) {
 	if m.FindAllImagesProductByIdFunc != nil {
 		return m.FindAllImagesProductByIdFunc(id)
@@ -53,6 +90,12 @@ func (m *MockProductDataSource) FindAllByCategoryID(categoryID string) ([]daos.P
 	}
 	return nil, nil
 }
+func (m *MockProductDataSource) FindAllByCategoryIDs(categoryIDs []string) ([]daos.ProductDAO, error) {
+	if m.FindAllByCategoryIDsFunc != nil {
+		return m.FindAllByCategoryIDsFunc(categoryIDs)
+	}
+	return nil, nil
+}
 func (m *MockProductDataSource) Insert(p daos.ProductDAO) error {
 	if m.InsertFunc != nil {
 		return m.InsertFunc(p)
@@ -65,9 +108,9 @@ func (m *MockProductDataSource) Update(p daos.ProductDAO) error {
 	}
 	return nil
 }
-func (m *MockProductDataSource) Delete(id string) error {
+func (m *MockProductDataSource) Delete(id string, version int64) error {
 	if m.DeleteFunc != nil {
-		return m.DeleteFunc(id)
+		return m.DeleteFunc(id, version)
 	}
 	return nil
 }
@@ -101,13 +144,29 @@ func (m *MockProductDataSource) UploadImage(uploadDTO dtos.UploadProductImageDTO
 	}
 	return nil
 }
+func (m *MockProductDataSource) FindAllImageFileNames() ([]string, error) {
+	if m.FindAllImageFileNamesFunc != nil {
+		return m.FindAllImageFileNamesFunc()
+	}
+	return nil, nil
+}
+func (m *MockProductDataSource) FindByExternalKey(externalKey string) (daos.ProductDAO, error) {
+	if m.FindByExternalKeyFunc != nil {
+		return m.FindByExternalKeyFunc(externalKey)
+	}
+	return daos.ProductDAO{}, errors.New("record not found")
+}
 
 type MockCategoryDataSource struct {
-	FindByIDFunc func(string) (daos.CategoryDAO, error)
-	DeleteFunc   func(string) error
-	InsertFunc   func(daos.CategoryDAO) error
-	FindAllFunc  func() ([]daos.CategoryDAO, error)
-	UpdateFunc   func(daos.CategoryDAO) error
+	FindByIDFunc          func(string) (daos.CategoryDAO, error)
+	DeleteFunc            func(string, int64) error
+	InsertFunc            func(daos.CategoryDAO) error
+	FindAllFunc           func() ([]daos.CategoryDAO, error)
+	UpdateFunc            func(daos.CategoryDAO) error
+	FindByExternalKeyFunc func(string) (daos.CategoryDAO, error)
+	UpdatePositionsFunc   func([]string) error
+	// FindAllWithAncestorsFunc, quando nil, filtra o resultado de FindAll
+	FindAllWithAncestorsFunc func([]string) ([]daos.CategoryDAO, error)
 }
 
 func (m *MockCategoryDataSource) FindByID(id string) (daos.CategoryDAO, error) {
@@ -116,9 +175,9 @@ func (m *MockCategoryDataSource) FindByID(id string) (daos.CategoryDAO, error) {
 	}
 	return daos.CategoryDAO{}, nil
 }
-func (m *MockCategoryDataSource) Delete(id string) error {
+func (m *MockCategoryDataSource) Delete(id string, version int64) error {
 	if m.DeleteFunc != nil {
-		return m.DeleteFunc(id)
+		return m.DeleteFunc(id, version)
 	}
 	return nil
 }
@@ -134,9 +193,401 @@ func (m *MockCategoryDataSource) FindAll() ([]daos.CategoryDAO, error) {
 	}
 	return nil, nil
 }
+func (m *MockCategoryDataSource) FindAllWithAncestors(ids []string) ([]daos.CategoryDAO, error) {
+	if m.FindAllWithAncestorsFunc != nil {
+		return m.FindAllWithAncestorsFunc(ids)
+	}
+	all, err := m.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]daos.CategoryDAO, len(all))
+	for _, category := range all {
+		byID[category.ID] = category
+	}
+	var result []daos.CategoryDAO
+	seen := map[string]bool{}
+	for _, id := range ids {
+		for category, ok := byID[id]; ok && !seen[category.ID]; category, ok = byID[category.ParentID] {
+			seen[category.ID] = true
+			result = append(result, category)
+		}
+	}
+	return result, nil
+}
 func (m *MockCategoryDataSource) Update(dao daos.CategoryDAO) error {
 	if m.UpdateFunc != nil {
 		return m.UpdateFunc(dao)
 	}
 	return nil
 }
+func (m *MockCategoryDataSource) FindByExternalKey(externalKey string) (daos.CategoryDAO, error) {
+	if m.FindByExternalKeyFunc != nil {
+		return m.FindByExternalKeyFunc(externalKey)
+	}
+	return daos.CategoryDAO{}, errors.New("record not found")
+}
+func (m *MockCategoryDataSource) UpdatePositions(orderedIDs []string) error {
+	if m.UpdatePositionsFunc != nil {
+		return m.UpdatePositionsFunc(orderedIDs)
+	}
+	return nil
+}
+
+// MockTransactionManager executa fn com os data sources informados, sem transação real
+type MockTransactionManager struct {
+	ProductDataSource     interfaces.IProductDataSource
+	CategoryDataSource    interfaces.ICategoryDataSource
+	TranslationDataSource interfaces.ITranslationDataSource
+	StoreDataSource       interfaces.IStoreDataSource
+	StockDataSource       interfaces.IStockDataSource
+	TransactionFunc       func(fn func(interfaces.TransactionDataSources) error) error
+}
+
+func (m *MockTransactionManager) Transaction(fn func(interfaces.TransactionDataSources) error) error {
+	if m.TransactionFunc != nil {
+		return m.TransactionFunc(fn)
+	}
+	return fn(interfaces.TransactionDataSources{
+		Product:     m.ProductDataSource,
+		Category:    m.CategoryDataSource,
+		Translation: m.TranslationDataSource,
+		Store:       m.StoreDataSource,
+		Stock:       m.StockDataSource,
+	})
+}
+
+// MockTranslationDataSource guarda as traduções em memória, indexadas por
+// target, entidade e idioma
+type MockTranslationDataSource struct {
+	Translations        []daos.TranslationDAO
+	FindByEntityIDFunc  func(target, entityID string) ([]daos.TranslationDAO, error)
+	FindAllByLocaleFunc func(target, locale string) ([]daos.TranslationDAO, error)
+	SaveFunc            func(daos.TranslationDAO) error
+	DeleteFunc          func(target, entityID, locale string) error
+}
+
+func (m *MockTranslationDataSource) FindByEntityID(target, entityID string) ([]daos.TranslationDAO, error) {
+	if m.FindByEntityIDFunc != nil {
+		return m.FindByEntityIDFunc(target, entityID)
+	}
+	var result []daos.TranslationDAO
+	for _, translation := range m.Translations {
+		if translation.Target == target && translation.EntityID == entityID {
+			result = append(result, translation)
+		}
+	}
+	return result, nil
+}
+func (m *MockTranslationDataSource) FindAllByLocale(target, locale string) ([]daos.TranslationDAO, error) {
+	if m.FindAllByLocaleFunc != nil {
+		return m.FindAllByLocaleFunc(target, locale)
+	}
+	var result []daos.TranslationDAO
+	for _, translation := range m.Translations {
+		if translation.Target == target && translation.Locale == locale {
+			result = append(result, translation)
+		}
+	}
+	return result, nil
+}
+func (m *MockTranslationDataSource) Save(translation daos.TranslationDAO) error {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(translation)
+	}
+	for i, existing := range m.Translations {
+		if existing.Target == translation.Target && existing.EntityID == translation.EntityID && existing.Locale == translation.Locale {
+			m.Translations[i] = translation
+			return nil
+		}
+	}
+	m.Translations = append(m.Translations, translation)
+	return nil
+}
+func (m *MockTranslationDataSource) Delete(target, entityID, locale string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(target, entityID, locale)
+	}
+	for i, existing := range m.Translations {
+		if existing.Target == target && existing.EntityID == entityID && existing.Locale == locale {
+			m.Translations = append(m.Translations[:i], m.Translations[i+1:]...)
+			return nil
+		}
+	}
+	return &exceptions.RecordNotFoundException{}
+}
+
+// MockStoreDataSource guarda lojas e ajustes em memória
+type MockStoreDataSource struct {
+	Stores                  []daos.StoreDAO
+	ProductOverrides        []daos.ProductStoreOverrideDAO
+	CategoryOverrides       []daos.CategoryStoreOverrideDAO
+	FindByIDFunc            func(string) (daos.StoreDAO, error)
+	SaveProductOverrideFunc func(daos.ProductStoreOverrideDAO) error
+}
+
+func (m *MockStoreDataSource) Insert(store daos.StoreDAO) error {
+	m.Stores = append(m.Stores, store)
+	return nil
+}
+func (m *MockStoreDataSource) FindAll() ([]daos.StoreDAO, error) {
+	return m.Stores, nil
+}
+func (m *MockStoreDataSource) FindByID(id string) (daos.StoreDAO, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(id)
+	}
+	for _, store := range m.Stores {
+		if store.ID == id {
+			return store, nil
+		}
+	}
+	return daos.StoreDAO{}, &exceptions.RecordNotFoundException{}
+}
+func (m *MockStoreDataSource) Update(store daos.StoreDAO) error {
+	for i, existing := range m.Stores {
+		if existing.ID == store.ID {
+			m.Stores[i] = store
+			return nil
+		}
+	}
+	return &exceptions.RecordNotFoundException{}
+}
+func (m *MockStoreDataSource) Delete(id string) error {
+	for i, existing := range m.Stores {
+		if existing.ID == id {
+			m.Stores = append(m.Stores[:i], m.Stores[i+1:]...)
+			return m.DeleteOverrides(id)
+		}
+	}
+	return &exceptions.RecordNotFoundException{}
+}
+func (m *MockStoreDataSource) FindProductOverrides(storeID string) ([]daos.ProductStoreOverrideDAO, error) {
+	var result []daos.ProductStoreOverrideDAO
+	for _, override := range m.ProductOverrides {
+		if override.StoreID == storeID {
+			result = append(result, override)
+		}
+	}
+	return result, nil
+}
+func (m *MockStoreDataSource) FindCategoryOverrides(storeID string) ([]daos.CategoryStoreOverrideDAO, error) {
+	var result []daos.CategoryStoreOverrideDAO
+	for _, override := range m.CategoryOverrides {
+		if override.StoreID == storeID {
+			result = append(result, override)
+		}
+	}
+	return result, nil
+}
+func (m *MockStoreDataSource) SaveProductOverride(override daos.ProductStoreOverrideDAO) error {
+	if m.SaveProductOverrideFunc != nil {
+		return m.SaveProductOverrideFunc(override)
+	}
+	_ = m.DeleteProductOverride(override.StoreID, override.ProductID)
+	m.ProductOverrides = append(m.ProductOverrides, override)
+	return nil
+}
+func (m *MockStoreDataSource) SaveCategoryOverride(override daos.CategoryStoreOverrideDAO) error {
+	_ = m.DeleteCategoryOverride(override.StoreID, override.CategoryID)
+	m.CategoryOverrides = append(m.CategoryOverrides, override)
+	return nil
+}
+func (m *MockStoreDataSource) DeleteProductOverride(storeID, productID string) error {
+	m.ProductOverrides = slices.DeleteFunc(m.ProductOverrides, func(o daos.ProductStoreOverrideDAO) bool {
+		return o.StoreID == storeID && o.ProductID == productID
+	})
+	return nil
+}
+func (m *MockStoreDataSource) DeleteCategoryOverride(storeID, categoryID string) error {
+	m.CategoryOverrides = slices.DeleteFunc(m.CategoryOverrides, func(o daos.CategoryStoreOverrideDAO) bool {
+		return o.StoreID == storeID && o.CategoryID == categoryID
+	})
+	return nil
+}
+func (m *MockStoreDataSource) DeleteOverrides(storeID string) error {
+	m.ProductOverrides = slices.DeleteFunc(m.ProductOverrides, func(o daos.ProductStoreOverrideDAO) bool { return o.StoreID == storeID })
+	m.CategoryOverrides = slices.DeleteFunc(m.CategoryOverrides, func(o daos.CategoryStoreOverrideDAO) bool { return o.StoreID == storeID })
+	return nil
+}
+
+// MockPromotionDataSource guarda promoções em memória
+type MockPromotionDataSource struct {
+	Promotions  []daos.PromotionDAO
+	FindAllFunc func() ([]daos.PromotionDAO, error)
+}
+
+func (m *MockPromotionDataSource) Insert(promotion daos.PromotionDAO) error {
+	m.Promotions = append(m.Promotions, promotion)
+	return nil
+}
+func (m *MockPromotionDataSource) FindAll() ([]daos.PromotionDAO, error) {
+	if m.FindAllFunc != nil {
+		return m.FindAllFunc()
+	}
+	return m.Promotions, nil
+}
+func (m *MockPromotionDataSource) FindAllByTargets(productIDs []string, categoryIDs []string) ([]daos.PromotionDAO, error) {
+	all, err := m.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	var result []daos.PromotionDAO
+	for _, promotion := range all {
+		if slices.ContainsFunc(promotion.ProductIDs, func(id string) bool { return slices.Contains(productIDs, id) }) ||
+			slices.ContainsFunc(promotion.CategoryIDs, func(id string) bool { return slices.Contains(categoryIDs, id) }) {
+			result = append(result, promotion)
+		}
+	}
+	return result, nil
+}
+func (m *MockPromotionDataSource) FindByID(id string) (daos.PromotionDAO, error) {
+	for _, promotion := range m.Promotions {
+		if promotion.ID == id {
+			return promotion, nil
+		}
+	}
+	return daos.PromotionDAO{}, &exceptions.RecordNotFoundException{}
+}
+func (m *MockPromotionDataSource) Update(promotion daos.PromotionDAO) error {
+	for i, existing := range m.Promotions {
+		if existing.ID == promotion.ID {
+			m.Promotions[i] = promotion
+			return nil
+		}
+	}
+	return &exceptions.RecordNotFoundException{}
+}
+func (m *MockPromotionDataSource) Delete(id string) error {
+	for i, existing := range m.Promotions {
+		if existing.ID == id {
+			m.Promotions = append(m.Promotions[:i], m.Promotions[i+1:]...)
+			return nil
+		}
+	}
+	return &exceptions.RecordNotFoundException{}
+}
+
+// MockStockDataSource trata produtos sem estoque cadastrado como não
+// controlados
+type MockStockDataSource struct {
+	FindByProductIDFunc          func(string) (daos.ProductStockDAO, error)
+	FindByProductIDForUpdateFunc func(string) (daos.ProductStockDAO, error)
+	FindAllFunc                  func() ([]daos.ProductStockDAO, error)
+	FindAllByProductIDsFunc      func([]string) ([]daos.ProductStockDAO, error)
+	SaveFunc                     func(daos.ProductStockDAO) error
+	DecrementFunc                func(string, int) (daos.ProductStockDAO, error)
+	IncrementFunc                func(string, int) (daos.ProductStockDAO, error)
+	InsertReservationFunc        func(daos.StockReservationDAO) error
+	FindReservationByIDFunc      func(string) (daos.StockReservationDAO, error)
+	UpdateReservationStatusFunc  func(id, fromStatus, toStatus string, updatedAt time.Time) error
+	FindExpiredReservationsFunc  func(time.Time, int) ([]daos.StockReservationDAO, error)
+}
+
+func (m *MockStockDataSource) FindByProductID(productID string) (daos.ProductStockDAO, error) {
+	if m.FindByProductIDFunc != nil {
+		return m.FindByProductIDFunc(productID)
+	}
+	return daos.ProductStockDAO{}, &exceptions.RecordNotFoundException{}
+}
+func (m *MockStockDataSource) FindByProductIDForUpdate(productID string) (daos.ProductStockDAO, error) {
+	if m.FindByProductIDForUpdateFunc != nil {
+		return m.FindByProductIDForUpdateFunc(productID)
+	}
+	return m.FindByProductID(productID)
+}
+func (m *MockStockDataSource) FindAll() ([]daos.ProductStockDAO, error) {
+	if m.FindAllFunc != nil {
+		return m.FindAllFunc()
+	}
+	return nil, nil
+}
+
+// FindAllByProductIDs filtra o resultado de FindAllFunc quando
+// FindAllByProductIDsFunc não é informado
+func (m *MockStockDataSource) FindAllByProductIDs(productIDs []string) ([]daos.ProductStockDAO, error) {
+	if m.FindAllByProductIDsFunc != nil {
+		return m.FindAllByProductIDsFunc(productIDs)
+	}
+	stocks, err := m.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	var result []daos.ProductStockDAO
+	for _, stock := range stocks {
+		if slices.Contains(productIDs, stock.ProductID) {
+			result = append(result, stock)
+		}
+	}
+	return result, nil
+}
+func (m *MockStockDataSource) Save(stock daos.ProductStockDAO) error {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(stock)
+	}
+	return nil
+}
+func (m *MockStockDataSource) Decrement(productID string, quantity int) (daos.ProductStockDAO, error) {
+	if m.DecrementFunc != nil {
+		return m.DecrementFunc(productID, quantity)
+	}
+	return daos.ProductStockDAO{}, &exceptions.InsufficientStockException{}
+}
+func (m *MockStockDataSource) Increment(productID string, quantity int) (daos.ProductStockDAO, error) {
+	if m.IncrementFunc != nil {
+		return m.IncrementFunc(productID, quantity)
+	}
+	return daos.ProductStockDAO{}, &exceptions.RecordNotFoundException{}
+}
+func (m *MockStockDataSource) InsertReservation(reservation daos.StockReservationDAO) error {
+	if m.InsertReservationFunc != nil {
+		return m.InsertReservationFunc(reservation)
+	}
+	return nil
+}
+func (m *MockStockDataSource) FindReservationByID(id string) (daos.StockReservationDAO, error) {
+	if m.FindReservationByIDFunc != nil {
+		return m.FindReservationByIDFunc(id)
+	}
+	return daos.StockReservationDAO{}, &exceptions.RecordNotFoundException{}
+}
+func (m *MockStockDataSource) UpdateReservationStatus(id, fromStatus, toStatus string, updatedAt time.Time) error {
+	if m.UpdateReservationStatusFunc != nil {
+		return m.UpdateReservationStatusFunc(id, fromStatus, toStatus, updatedAt)
+	}
+	return nil
+}
+func (m *MockStockDataSource) FindExpiredReservations(now time.Time, limit int) ([]daos.StockReservationDAO, error) {
+	if m.FindExpiredReservationsFunc != nil {
+		return m.FindExpiredReservationsFunc(now, limit)
+	}
+	return nil, nil
+}
+
+// MockEventPublisher guarda os eventos publicados para as asserções
+type MockEventPublisher struct {
+	Events      []shared_interfaces.Event
+	PublishFunc func(shared_interfaces.Event) error
+}
+
+func (m *MockEventPublisher) Publish(event shared_interfaces.Event) error {
+	m.Events = append(m.Events, event)
+	if m.PublishFunc != nil {
+		return m.PublishFunc(event)
+	}
+	return nil
+}
+
+// MockCatalogCache conta as limpezas pedidas pelos casos de uso
+type MockCatalogCache struct {
+	Invalidations  int
+	InvalidateFunc func() error
+}
+
+func (m *MockCatalogCache) Invalidate() error {
+	m.Invalidations++
+	if m.InvalidateFunc != nil {
+		return m.InvalidateFunc()
+	}
+	return nil
+}
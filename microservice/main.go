@@ -10,8 +10,12 @@
 //go:debug x509negativeserial=1
 package main
 
-import "tech_challenge/internal/shared/infra/api"
+import (
+	"os"
+
+	"tech_challenge/cmd"
+)
 
 func main() {
-	api.Init()
+	os.Exit(cmd.Execute(os.Args[1:]))
 }